@@ -0,0 +1,53 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mo_backup
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/backup"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/spf13/cobra"
+)
+
+func PrepareCommand() *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:        "backup",
+		Short:      "MO backup tool",
+		Long:       "MO backup tool. Helps to verify the backups and restore the data dir from them.",
+		SuggestFor: []string{"mo-tool"},
+		Version:    "0.1.0",
+	}
+
+	rootCmd.AddCommand(prepareRestoreCommand())
+	rootCmd.AddCommand(prepareVerifyCommand())
+	return rootCmd
+}
+
+// keyArg finds the keys of the encrypted backups.
+type keyArg struct {
+	keyFile string
+	kmsDir  string
+}
+
+func (arg *keyArg) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&arg.keyFile, "key-file", "k", "", "key file replacing the one recorded in the encrypted backups")
+	cmd.Flags().StringVar(&arg.kmsDir, "kms-dir", "", "dir of the master key files of the local kms")
+}
+
+func (arg *keyArg) keys() *backup.Keys {
+	if arg.kmsDir != "" {
+		backup.SetKMS(fileservice.NewLocalKMSProvider(arg.kmsDir))
+	}
+	return &backup.Keys{KeyFile: arg.keyFile}
+}
@@ -0,0 +1,66 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mo_backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/backup"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/spf13/cobra"
+)
+
+type restoreArg struct {
+	backupDir   string
+	dataDir     string
+	parallelism int
+	keys        keyArg
+}
+
+func prepareRestoreCommand() *cobra.Command {
+	arg := &restoreArg{}
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "restore the tae data from a backup",
+		Long: "Restore the tae data from a filesystem backup into the shared data dir. " +
+			"An incremental backup is restored together with the parent backups recorded in it.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return arg.run(cmd.Context())
+		},
+	}
+	cmd.Flags().StringVarP(&arg.backupDir, "backup-dir", "b", "", "dir of the backup")
+	cmd.Flags().StringVarP(&arg.dataDir, "data-dir", "d", "", "shared data dir to restore into")
+	cmd.Flags().IntVarP(&arg.parallelism, "parallelism", "p", 0, "number of files copied in parallel")
+	arg.keys.addFlags(cmd)
+	_ = cmd.MarkFlagRequired("backup-dir")
+	_ = cmd.MarkFlagRequired("data-dir")
+	return cmd
+}
+
+func (arg *restoreArg) run(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	dstFs, err := fileservice.NewLocalFS(ctx, "restore", arg.dataDir, fileservice.DisabledCacheConfig, nil)
+	if err != nil {
+		return err
+	}
+	if err = backup.Restore(ctx, arg.backupDir, dstFs, arg.parallelism, arg.keys.keys()); err != nil {
+		return err
+	}
+	fmt.Printf("restored %s into %s\n", arg.backupDir, arg.dataDir)
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mo_backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/matrixorigin/matrixone/pkg/backup"
+	"github.com/spf13/cobra"
+)
+
+type verifyArg struct {
+	backupDir string
+	keys      keyArg
+}
+
+func prepareVerifyCommand() *cobra.Command {
+	arg := &verifyArg{}
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "verify a backup without restoring it",
+		Long: "Verify the size and the SHA-256 of every file in the manifest of a filesystem backup, " +
+			"the signature of the manifest of an encrypted backup, and the files inherited from the parent backups.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return arg.run(cmd.Context())
+		},
+	}
+	cmd.Flags().StringVarP(&arg.backupDir, "backup-dir", "b", "", "dir of the backup")
+	arg.keys.addFlags(cmd)
+	_ = cmd.MarkFlagRequired("backup-dir")
+	return cmd
+}
+
+func (arg *verifyArg) run(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := backup.Verify(ctx, arg.backupDir, arg.keys.keys()); err != nil {
+		return err
+	}
+	fmt.Printf("backup %s is verified\n", arg.backupDir)
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package mo_logshard
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/logservice"
+	"github.com/spf13/cobra"
+)
+
+type dumpArg struct {
+	client   clientArg
+	output   string
+	firstLsn uint64
+	lastLsn  uint64
+}
+
+func prepareDumpCommand() *cobra.Command {
+	arg := &dumpArg{}
+	cmd := &cobra.Command{
+		Use:   "dump",
+		Short: "dump the records of a log shard to a file",
+		Long: "Dump the records of a log shard between the LSNs to a portable file, " +
+			"records before the truncated LSN of the shard are not available.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return arg.run(cmd.Context())
+		},
+	}
+	arg.client.addFlags(cmd)
+	cmd.Flags().StringVarP(&arg.output, "output", "o", "", "file to write the dump")
+	cmd.Flags().Uint64Var(&arg.firstLsn, "from", 0, "first LSN to dump")
+	cmd.Flags().Uint64Var(&arg.lastLsn, "to", 0, "last LSN to dump, 0 means the latest one")
+	_ = cmd.MarkFlagRequired("output")
+	return cmd
+}
+
+func (arg *dumpArg) run(ctx context.Context) (err error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, arg.client.timeout)
+	defer cancel()
+
+	c, err := arg.client.newClient(ctx, true)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	f, err := os.Create(arg.output)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if e := f.Close(); err == nil {
+			err = e
+		}
+	}()
+	summary, err := logservice.DumpShard(ctx, c, f, arg.firstLsn, arg.lastLsn)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("dumped %d records of log shard %d to %s, last LSN %d\n",
+		summary.Records, arg.client.shardID, arg.output, summary.LastLsn)
+	return nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package mo_logshard
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/matrixorigin/matrixone/pkg/logservice"
+	"github.com/spf13/cobra"
+)
+
+type importArg struct {
+	client clientArg
+	input  string
+	force  bool
+}
+
+func prepareImportCommand() *cobra.Command {
+	arg := &importArg{}
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "import a log shard dump",
+		Long: "Append the user records in a log shard dump to a log shard, usually a newly bootstrapped one. " +
+			"Internal records, such as lease updates, are not imported.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return arg.run(cmd.Context())
+		},
+	}
+	arg.client.addFlags(cmd)
+	cmd.Flags().StringVarP(&arg.input, "input", "i", "", "the dump file")
+	cmd.Flags().BoolVar(&arg.force, "force", false, "import even if the log shard has user records")
+	_ = cmd.MarkFlagRequired("input")
+	return cmd
+}
+
+func (arg *importArg) run(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, arg.client.timeout)
+	defer cancel()
+
+	f, err := os.Open(arg.input)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	r, err := logservice.NewDumpReader(f)
+	if err != nil {
+		return err
+	}
+
+	c, err := arg.client.newClient(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	summary, err := logservice.ImportShard(ctx, c, r, arg.force)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("imported %d records of log shard %d to log shard %d, last LSN %d\n",
+		summary.Records, r.Header().ShardID, arg.client.shardID, summary.LastLsn)
+	return nil
+}
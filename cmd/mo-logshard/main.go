@@ -0,0 +1,77 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mo_logshard
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/logservice"
+	"github.com/spf13/cobra"
+)
+
+const maxMessageSize = 1024 * 1024 * 100
+
+func PrepareCommand() *cobra.Command {
+	rootCmd := &cobra.Command{
+		Use:        "logshard",
+		Short:      "MO log shard tool",
+		Long:       "MO log shard tool. Helps to export the records of a log shard to a portable file, inspect and import it.",
+		SuggestFor: []string{"mo-tool"},
+		Version:    "0.1.0",
+	}
+
+	rootCmd.AddCommand(prepareDumpCommand())
+	rootCmd.AddCommand(prepareShowCommand())
+	rootCmd.AddCommand(prepareImportCommand())
+	return rootCmd
+}
+
+// clientArg is the args to connect to a log shard.
+type clientArg struct {
+	discoveryAddress string
+	serviceAddresses string
+	shardID          uint64
+	tnReplicaID      uint64
+	timeout          time.Duration
+}
+
+func (arg *clientArg) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&arg.discoveryAddress, "discovery-address", "d", "", "discovery address of the log service")
+	cmd.Flags().StringVar(&arg.serviceAddresses, "service-addresses", "", "service addresses of the log stores, separated by ';'")
+	cmd.Flags().Uint64VarP(&arg.shardID, "shard", "s", 1, "ID of the log shard")
+	cmd.Flags().Uint64Var(&arg.tnReplicaID, "tn-replica-id", 1, "TN replica ID of the client, which becomes the lease holder of the shard when importing")
+	cmd.Flags().DurationVar(&arg.timeout, "timeout", time.Minute*10, "timeout of the command")
+}
+
+func (arg *clientArg) newClient(ctx context.Context, readOnly bool) (logservice.Client, error) {
+	runtime.SetupServiceBasedRuntime("", runtime.DefaultRuntime())
+	cfg := logservice.ClientConfig{
+		Tag:              "mo-tool",
+		ReadOnly:         readOnly,
+		LogShardID:       arg.shardID,
+		TNReplicaID:      arg.tnReplicaID,
+		DiscoveryAddress: arg.discoveryAddress,
+		MaxMessageSize:   maxMessageSize,
+	}
+	for _, address := range strings.Split(arg.serviceAddresses, ";") {
+		if address = strings.TrimSpace(address); address != "" {
+			cfg.ServiceAddresses = append(cfg.ServiceAddresses, address)
+		}
+	}
+	return logservice.NewClient(ctx, "", cfg)
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package mo_logshard
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/logservice"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/driver/logservicedriver"
+	"github.com/spf13/cobra"
+)
+
+type showArg struct {
+	input  string
+	decode bool
+}
+
+func prepareShowCommand() *cobra.Command {
+	arg := &showArg{}
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "show the records in a log shard dump",
+		Long:  "Show the records in a log shard dump, and the TAE WAL entries in the user records if decode is set.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return arg.run(cmd.OutOrStdout())
+		},
+	}
+	cmd.Flags().StringVarP(&arg.input, "input", "i", "", "the dump file")
+	cmd.Flags().BoolVar(&arg.decode, "decode", false, "decode the TAE WAL entries")
+	_ = cmd.MarkFlagRequired("input")
+	return cmd
+}
+
+func (arg *showArg) run(w io.Writer) error {
+	f, err := os.Open(arg.input)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	r, err := logservice.NewDumpReader(f)
+	if err != nil {
+		return err
+	}
+
+	header := r.Header()
+	fmt.Fprintf(w, "log shard %d, LSN [%d, %d], truncated LSN %d, created at %s\n",
+		header.ShardID, header.FirstLsn, header.LastLsn, header.TruncatedLsn,
+		time.Unix(header.CreatedAt, 0).Format(time.RFC3339))
+	count := 0
+	for {
+		rec, err := r.Next()
+		if err == io.EOF {
+			fmt.Fprintf(w, "%d records\n", count)
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		count++
+		fmt.Fprintf(w, "LSN %d, %s, %d bytes\n", rec.Lsn, rec.Type, len(rec.Data))
+		if !arg.decode || rec.Type != pb.UserRecord {
+			continue
+		}
+		summary, err := logservicedriver.DecodeRecord(rec)
+		if err != nil {
+			fmt.Fprintf(w, "  failed to decode: %v\n", err)
+			continue
+		}
+		fmt.Fprintf(w, "  %s\n", summary)
+	}
+}
@@ -225,6 +225,11 @@ func (c *Config) validate() error {
 
 	// file service
 	c.setFileserviceDefaultValues()
+	for _, config := range c.FileServices {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+	}
 
 	// limit
 	if c.Limit.Memory == 0 {
@@ -68,6 +68,13 @@ var (
 	globalEtlFS       fileservice.FileService
 	globalServiceType string
 	globalNodeId      string
+
+	// file services are closed after all services stopped, to persist the
+	// states like the disk cache index
+	fileServicesToClose struct {
+		sync.Mutex
+		services []fileservice.FileService
+	}
 )
 
 func init() {
@@ -152,6 +159,7 @@ func waitSignalToStop(stopper *stopper.Stopper, shutdownC chan struct{}) {
 
 	logutil.GetGlobalLogger().Info(detail)
 	stopper.Stop()
+	closeFileServices()
 	if cnProxy != nil {
 		if err := cnProxy.Stop(); err != nil {
 			logutil.GetGlobalLogger().Error("shutdown cn proxy failed", zap.Error(err))
@@ -205,6 +213,9 @@ func startService(
 	if err != nil {
 		return err
 	}
+	fileServicesToClose.Lock()
+	fileServicesToClose.services = append(fileServicesToClose.services, fs)
+	fileServicesToClose.Unlock()
 
 	etlFS, err := fileservice.Get[fileservice.FileService](fs, defines.ETLFileServiceName)
 	if err != nil {
@@ -236,6 +247,15 @@ func startService(
 	}
 }
 
+func closeFileServices() {
+	fileServicesToClose.Lock()
+	defer fileServicesToClose.Unlock()
+	for _, fs := range fileServicesToClose.services {
+		fs.Close()
+	}
+	fileServicesToClose.services = nil
+}
+
 // serviceWG control motrace/mometric quit as last one.
 var serviceWG sync.WaitGroup
 
@@ -15,8 +15,10 @@
 package main
 
 import (
+	backup "github.com/matrixorigin/matrixone/cmd/mo-backup"
 	debug "github.com/matrixorigin/matrixone/cmd/mo-debug"
 	inspect "github.com/matrixorigin/matrixone/cmd/mo-inspect"
+	logshard "github.com/matrixorigin/matrixone/cmd/mo-logshard"
 	"github.com/spf13/cobra"
 	"os"
 )
@@ -30,6 +32,8 @@ func main() {
 
 	rootCmd.AddCommand(debug.PrepareCommand())
 	rootCmd.AddCommand(inspect.PrepareCommand())
+	rootCmd.AddCommand(backup.PrepareCommand())
+	rootCmd.AddCommand(logshard.PrepareCommand())
 
 	if err := rootCmd.Execute(); err != nil {
 		os.Exit(1)
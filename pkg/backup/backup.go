@@ -82,12 +82,32 @@ func Backup(
 		cfg.Parallelism = s3Conf.parallelism
 	}
 
-	if bs.BackupTs == "" {
+	cfg.BackupType = bs.BackupType
+	if bs.Parent != "" {
+		if bs.BackupTs != "" {
+			return moerr.NewInternalError(ctx, "backup ts can not be set with the parent backup")
+		}
+		if err = backupParent(ctx, bs, s3Conf, cfg); err != nil {
+			return err
+		}
+		if cfg.BackupType == "" {
+			cfg.BackupType = BackupTypeIncremental
+		}
+	} else if bs.BackupTs == "" {
 		cfg.BackupTs = types.TS{}
 	} else {
 		cfg.BackupTs = types.StringToTS(bs.BackupTs)
 	}
-	cfg.BackupType = bs.BackupType
+
+	//1.2 record the files written in the manifest, and encrypt them
+	cfg.manifest = newManifest()
+	cfg.GeneralDir = newRecordFS(cfg.GeneralDir, scopeGeneral, cfg.manifest)
+	cfg.TaeDir = newRecordFS(cfg.TaeDir, scopeData, cfg.manifest)
+	if bs.Encryption != "" {
+		if err = setupEncryption(ctx, bs.Encryption, cfg); err != nil {
+			return err
+		}
+	}
 
 	// step 2 : backup mo
 	if err = backupBuildInfo(ctx, cfg); err != nil {
@@ -110,9 +130,78 @@ func Backup(
 		return err
 	}
 
+	if err = saveManifest(ctx, cfg); err != nil {
+		return err
+	}
+
 	return err
 }
 
+// setupEncryption generates the data key of the backup, and encrypts the
+// files of tae and hakeeper by it. The data key is saved in metas wrapped by
+// the key of encryption.
+func setupEncryption(ctx context.Context, encryption string, cfg *Config) error {
+	source, err := newKeySource(ctx, encryption, nil)
+	if err != nil {
+		return err
+	}
+	dataKey, err := newDataKey()
+	if err != nil {
+		return err
+	}
+	wrapped, err := source.wrap(ctx, dataKey)
+	if err != nil {
+		return err
+	}
+	if cfg.TaeDir, err = newEncryptedFS(cfg.TaeDir, dataKey); err != nil {
+		return err
+	}
+	cfg.dataKey = dataKey
+	cfg.Metas.AppendEncryption(encryption, hexStr(wrapped))
+	return nil
+}
+
+// backupParent takes the backup ts of the parent backup as the base of the
+// incremental backup, and records the chain of the parent in the metas.
+func backupParent(ctx context.Context, bs *tree.BackupStart, s3Conf *s3Config, cfg *Config) error {
+	var (
+		err     error
+		general fileservice.FileService
+		data    fileservice.FileService
+	)
+	if !bs.IsS3 {
+		if general, _, err = setupFilesystem(ctx, bs.Parent, true); err != nil {
+			return err
+		}
+		if data, _, err = setupFilesystem(ctx, bs.Parent, false); err != nil {
+			return err
+		}
+	} else {
+		parentConf := *s3Conf
+		parentConf.filepath = bs.Parent
+		if general, _, err = setupS3(ctx, &parentConf, true); err != nil {
+			return err
+		}
+		if data, _, err = setupS3(ctx, &parentConf, false); err != nil {
+			return err
+		}
+	}
+	parent, err := openBackupFs(ctx, general, data, nil)
+	if err != nil {
+		return err
+	}
+	backupTs, err := readBackupTs(ctx, fileservice.SubPath(parent.data, taeDir))
+	if err != nil {
+		return err
+	}
+	cfg.BackupTs = types.StringToTS(backupTs)
+	cfg.Metas.AppendParent(backupTs, bs.Parent)
+	for _, ancestor := range parent.metas.Parents() {
+		cfg.Metas.Append(ancestor)
+	}
+	return nil
+}
+
 // saveBuildInfo saves backupVersion, build info.
 func backupBuildInfo(ctx context.Context, cfg *Config) error {
 	cfg.Metas.AppendVersion(Version)
@@ -180,7 +269,13 @@ func backupConfigFile(ctx context.Context, typ, configPath string, cfg *Config)
 	newfile := file + "_" + uid.String()
 	cfg.Metas.AppendLaunchconfig(typ, newfile)
 	filename := configDir + "/" + newfile
-	return writeFile(ctx, cfg.GeneralDir, filename, data)
+	fs := cfg.GeneralDir
+	if cfg.dataKey != nil {
+		if fs, err = newEncryptedFS(fs, cfg.dataKey); err != nil {
+			return err
+		}
+	}
+	return writeFile(ctx, fs, filename, data)
 }
 
 func saveMetas(ctx context.Context, cfg *Config) error {
@@ -244,3 +339,53 @@ func fromCsvBytes(data []byte) ([][]string, error) {
 	r := csv.NewReader(bytes.NewReader(data))
 	return r.ReadAll()
 }
+
+// readBackupTs reads the backup ts in the tae_sum of a backup.
+func readBackupTs(ctx context.Context, fs fileservice.FileService) (string, error) {
+	data, err := readFileAndCheck(ctx, fs, taeSum)
+	if err != nil {
+		return "", err
+	}
+	lines, err := fromCsvBytes(data)
+	if err != nil {
+		return "", err
+	}
+	if len(lines) != 1 || len(lines[0]) != 4 {
+		return "", moerr.NewInternalError(ctx, "invalid %s: %v", taeSum, lines)
+	}
+	return lines[0][2], nil
+}
+
+// readMetas reads the mo_meta of a backup.
+func readMetas(ctx context.Context, fs fileservice.FileService) (*Metas, error) {
+	data, err := readFileAndCheck(ctx, fs, moMeta)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := fromCsvBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	return metasFromCsv(lines), nil
+}
+
+// readTaeFilesList reads the tae_list of a backup.
+func readTaeFilesList(ctx context.Context, fs fileservice.FileService) ([]*taeFile, error) {
+	data, err := readFileAndCheck(ctx, fs, taeList)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := fromCsvBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]*taeFile, 0, len(lines))
+	for _, line := range lines {
+		file, err := taeFileFromCsv(ctx, line)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
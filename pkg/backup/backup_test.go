@@ -16,8 +16,11 @@ package backup
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"os"
 	"path"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -133,6 +136,234 @@ func TestBackupData(t *testing.T) {
 	assert.NoError(t, txn.Commit(context.Background()))
 }
 
+func TestIncrementalBackupAndRestore(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+
+	opts := config.WithLongScanAndCKPOptsAndQuickGC(nil)
+	db := testutil.NewTestEngine(ctx, ModuleName, t, opts)
+	defer db.Close()
+	defer opts.Fs.Close()
+
+	schema := catalog.MockSchemaAll(13, 3)
+	schema.BlockMaxRows = 10
+	schema.ObjectMaxBlocks = 10
+	db.BindSchema(schema)
+	testutil.CreateRelation(t, db.DB, "db", schema, true)
+
+	totalRows := uint64(schema.BlockMaxRows * 20)
+	bat := catalog.MockBatch(schema, int(totalRows))
+	defer bat.Close()
+	bats := bat.Split(4)
+
+	stubs := gostub.Stub(&backupTae, backupTaeOf(db))
+	defer stubs.Reset()
+
+	// the full backup
+	for _, data := range bats[:2] {
+		testutil.AppendClosure(t, data, schema.Name, db.DB, nil)()
+	}
+	testutil.CompactBlocks(t, 0, db.DB, "db", schema, false)
+	fullDir := getTempDir(t, "full")
+	doBackup(t, &tree.BackupStart{Dir: fullDir, Parallelism: "1"})
+
+	// the incremental backup
+	for _, data := range bats[2:] {
+		testutil.AppendClosure(t, data, schema.Name, db.DB, nil)()
+	}
+	incrDir := getTempDir(t, "incr")
+	cfg := doBackup(t, &tree.BackupStart{Dir: incrDir, Parallelism: "1", Parent: fullDir})
+	assert.Equal(t, BackupTypeIncremental, cfg.BackupType)
+	metas, err := readMetas(ctx, cfg.GeneralDir)
+	assert.NoError(t, err)
+	parents := metas.Parents()
+	assert.Equal(t, 1, len(parents))
+	assert.Equal(t, fullDir, parents[0].ParentDir)
+	assert.Equal(t, cfg.BackupTs.ToString(), parents[0].ParentBackupTs)
+
+	list, err := readTaeFilesList(ctx, fileservice.SubPath(cfg.TaeDir, taeDir))
+	assert.NoError(t, err)
+	inherited := 0
+	for _, file := range list {
+		if !file.needCopy {
+			inherited++
+		}
+	}
+	assert.Greater(t, inherited, 0)
+
+	// restore the chain
+	dir := path.Join(db.Dir, "/restore")
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+	}
+	service, err := fileservice.NewFileService(ctx, c, nil)
+	assert.NoError(t, err)
+	defer service.Close()
+	assert.NoError(t, Restore(ctx, incrDir, service, 1, nil))
+
+	// the restore fails if a file of the parent is lost
+	for _, file := range list {
+		if !file.needCopy {
+			taeFs, _, err := setupFilesystem(ctx, fullDir, false)
+			assert.NoError(t, err)
+			assert.NoError(t, fileservice.SubPath(taeFs, taeDir).Delete(ctx, file.path))
+			break
+		}
+	}
+	_, err = verifyChain(ctx, incrDir, nil)
+	assert.Error(t, err)
+
+	db.Opts.Fs = service
+	db.Restart(ctx)
+	txn, rel := testutil.GetDefaultRelation(t, db.DB, schema.Name)
+	testutil.CheckAllColRowsByScan(t, rel, int(totalRows), true)
+	assert.NoError(t, txn.Commit(context.Background()))
+}
+
+func TestEncryptedBackup(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+
+	opts := config.WithLongScanAndCKPOptsAndQuickGC(nil)
+	db := testutil.NewTestEngine(ctx, ModuleName, t, opts)
+	defer db.Close()
+	defer opts.Fs.Close()
+
+	schema := catalog.MockSchemaAll(13, 3)
+	schema.BlockMaxRows = 10
+	schema.ObjectMaxBlocks = 10
+	db.BindSchema(schema)
+	testutil.CreateRelation(t, db.DB, "db", schema, true)
+
+	totalRows := uint64(schema.BlockMaxRows * 20)
+	bat := catalog.MockBatch(schema, int(totalRows))
+	defer bat.Close()
+	bats := bat.Split(4)
+
+	stubs := gostub.Stub(&backupTae, backupTaeOf(db))
+	defer stubs.Reset()
+
+	// the key of the full backup is from a key file, the key of the
+	// incremental one is from the kms
+	keyDir := getTempDir(t, "keys")
+	assert.NoError(t, os.MkdirAll(keyDir, 0755))
+	keyFile := path.Join(keyDir, "backup.key")
+	key, err := newDataKey()
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(keyFile, []byte(hex.EncodeToString(key)), 0600))
+	kmsKey, err := newDataKey()
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(path.Join(keyDir, "k1"), kmsKey, 0600))
+	SetKMS(fileservice.NewLocalKMSProvider(keyDir))
+	defer SetKMS(nil)
+
+	for _, data := range bats[:2] {
+		testutil.AppendClosure(t, data, schema.Name, db.DB, nil)()
+	}
+	testutil.CompactBlocks(t, 0, db.DB, "db", schema, false)
+	fullDir := getTempDir(t, "full")
+	doBackup(t, &tree.BackupStart{Dir: fullDir, Parallelism: "1", Encryption: "file:" + keyFile})
+
+	for _, data := range bats[2:] {
+		testutil.AppendClosure(t, data, schema.Name, db.DB, nil)()
+	}
+	incrDir := getTempDir(t, "incr")
+	cfg := doBackup(t, &tree.BackupStart{Dir: incrDir, Parallelism: "1", Parent: fullDir, Encryption: "kms:k1"})
+
+	// the files are stored encrypted
+	raw, _, err := setupFilesystem(ctx, incrDir, false)
+	assert.NoError(t, err)
+	data, err := readFile(ctx, raw, taeDir+"/"+taeList)
+	assert.NoError(t, err)
+	assert.Equal(t, encryptMagic, string(data[:len(encryptMagic)]))
+	_, err = readTaeFilesList(ctx, fileservice.SubPath(cfg.TaeDir, taeDir))
+	assert.NoError(t, err)
+
+	assert.NoError(t, Verify(ctx, fullDir, nil))
+	assert.NoError(t, Verify(ctx, incrDir, nil))
+
+	dir := path.Join(db.Dir, "/restore")
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+	}
+	service, err := fileservice.NewFileService(ctx, c, nil)
+	assert.NoError(t, err)
+	defer service.Close()
+	assert.NoError(t, Restore(ctx, incrDir, service, 1, nil))
+
+	// a wrong key can not open the backup
+	wrongKey := path.Join(keyDir, "wrong.key")
+	assert.NoError(t, os.WriteFile(wrongKey, []byte(hex.EncodeToString(kmsKey)), 0600))
+	assert.Error(t, Verify(ctx, fullDir, &Keys{KeyFile: wrongKey}))
+
+	// the verify fails if a file is corrupted
+	list, err := raw.List(ctx, taeDir)
+	assert.NoError(t, err)
+	for _, entry := range list {
+		if entry.IsDir || !strings.HasSuffix(entry.Name, "_00000") {
+			continue
+		}
+		filePath := path.Join(incrDir, taeDir, entry.Name)
+		content, err := os.ReadFile(filePath)
+		assert.NoError(t, err)
+		content[len(content)-1] ^= 0xff
+		assert.NoError(t, os.WriteFile(filePath, content, 0644))
+		break
+	}
+	assert.Error(t, Verify(ctx, incrDir, nil))
+
+	db.Opts.Fs = service
+	db.Restart(ctx)
+	txn, rel := testutil.GetDefaultRelation(t, db.DB, schema.Name)
+	testutil.CheckAllColRowsByScan(t, rel, int(totalRows), true)
+	assert.NoError(t, txn.Commit(context.Background()))
+}
+
+// backupTaeOf backups the tae data of db by the checkpoints forced.
+func backupTaeOf(db *testutil.TestEngine) func(context.Context, string, *Config) error {
+	return func(ctx context.Context, sid string, cfg *Config) error {
+		backupTime := time.Now().UTC()
+		currTs := types.BuildTS(backupTime.UnixNano(), 0)
+		locations := []string{backupTime.Format(time.DateTime)}
+		location, err := db.ForceCheckpointForBackup(ctx, currTs, 20*time.Second)
+		if err != nil {
+			return err
+		}
+		db.BGCheckpointRunner.DisableCheckpoint()
+		defer db.BGCheckpointRunner.EnableCheckpoint()
+		locations = append(locations, location)
+		files := make(map[string]string)
+		for _, candidate := range db.BGCheckpointRunner.GetAllCheckpoints() {
+			name := candidate.GetLocation().Name().String()
+			if files[name] == "" {
+				files[name] = fmt.Sprintf("%s:%d", candidate.GetLocation().String(), candidate.GetVersion())
+			}
+		}
+		for _, location := range files {
+			locations = append(locations, location)
+		}
+		dstFs := fileservice.SubPath(cfg.TaeDir, taeDir)
+		return execBackup(ctx, sid, db.Opts.Fs, dstFs, locations, 1, cfg.BackupTs, cfg.BackupType)
+	}
+}
+
+func doBackup(t *testing.T, bs *tree.BackupStart) *Config {
+	cfg := &Config{
+		HAkeeper: &dumpHakeeper{},
+		Metas:    NewMetas(),
+	}
+	runtime.RunTest("", func(rt runtime.Runtime) {
+		assert.NoError(t, Backup(context.Background(), "", bs, cfg))
+	})
+	return cfg
+}
+
 func Test_saveTaeFilesList(t *testing.T) {
 	type args struct {
 		ctx        context.Context
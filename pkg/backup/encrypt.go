@@ -0,0 +1,456 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+)
+
+const (
+	keyFilePrefix = "file:"
+	kmsPrefix     = "kms:"
+	dataKeySize   = 32
+)
+
+var globalKMS struct {
+	sync.RWMutex
+	kms fileservice.MasterKeyProvider
+}
+
+// SetKMS sets the KMS of the backups encrypted by kms:<key id>, the data
+// keys are wrapped by the master key of the key id.
+func SetKMS(kms fileservice.MasterKeyProvider) {
+	globalKMS.Lock()
+	defer globalKMS.Unlock()
+	globalKMS.kms = kms
+}
+
+func getKMS() fileservice.MasterKeyProvider {
+	globalKMS.RLock()
+	defer globalKMS.RUnlock()
+	return globalKMS.kms
+}
+
+// Keys finds the keys to read the encrypted backups.
+type Keys struct {
+	// KeyFile replaces the key file recorded in the backups
+	KeyFile string
+}
+
+// keySource wraps and unwraps the data key of an encrypted backup. The data
+// key is generated for each backup, only the wrapped one is saved in metas.
+type keySource interface {
+	wrap(ctx context.Context, dataKey []byte) ([]byte, error)
+	unwrap(ctx context.Context, wrapped []byte) ([]byte, error)
+}
+
+type fileKeySource struct {
+	key []byte
+}
+
+func (s *fileKeySource) wrap(_ context.Context, dataKey []byte) ([]byte, error) {
+	return sealKey(s.key, dataKey)
+}
+
+func (s *fileKeySource) unwrap(ctx context.Context, wrapped []byte) ([]byte, error) {
+	return openKey(ctx, s.key, wrapped)
+}
+
+type kmsKeySource struct {
+	kms   fileservice.MasterKeyProvider
+	keyID string
+}
+
+func (s *kmsKeySource) wrap(ctx context.Context, dataKey []byte) ([]byte, error) {
+	return s.kms.WrapKey(ctx, s.keyID, dataKey)
+}
+
+func (s *kmsKeySource) unwrap(ctx context.Context, wrapped []byte) ([]byte, error) {
+	return s.kms.UnwrapKey(ctx, s.keyID, wrapped)
+}
+
+// newKeySource returns the key source of the encryption spec, file:<path>
+// or kms:<key id>.
+func newKeySource(ctx context.Context, spec string, keys *Keys) (keySource, error) {
+	switch {
+	case strings.HasPrefix(spec, keyFilePrefix):
+		path := strings.TrimPrefix(spec, keyFilePrefix)
+		if keys != nil && keys.KeyFile != "" {
+			path = keys.KeyFile
+		}
+		key, err := readKeyFile(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+		return &fileKeySource{key: key}, nil
+	case strings.HasPrefix(spec, kmsPrefix):
+		kms := getKMS()
+		if kms == nil {
+			return nil, moerr.NewInternalError(ctx, "kms is not set for %s", spec)
+		}
+		return &kmsKeySource{kms: kms, keyID: strings.TrimPrefix(spec, kmsPrefix)}, nil
+	default:
+		return nil, moerr.NewInternalError(ctx, "invalid encryption %s, file:<path> or kms:<key id> expected", spec)
+	}
+}
+
+// readKeyFile reads a 32 bytes key, in raw bytes or in hex.
+func readKeyFile(ctx context.Context, path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == dataKeySize {
+		return data, nil
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(key) != dataKeySize {
+		return nil, moerr.NewInternalError(ctx, "key file %s must hold a %d bytes key", path, dataKeySize)
+	}
+	return key, nil
+}
+
+func newDataKey() ([]byte, error) {
+	key := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// sealKey encrypts a data key by the master key, the nonce is prepended.
+func sealKey(key, dataKey []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, dataKey, nil), nil
+}
+
+func openKey(ctx context.Context, key, wrapped []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < gcm.NonceSize() {
+		return nil, moerr.NewInternalError(ctx, "invalid wrapped data key")
+	}
+	dataKey, err := gcm.Open(nil, wrapped[:gcm.NonceSize()], wrapped[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, moerr.NewInternalError(ctx, "unwrap data key: %v", err)
+	}
+	return dataKey, nil
+}
+
+// An encrypted file is a header followed by the chunks of the plaintext
+// sealed by fileservice.ChunkedAEAD with the nonce of the header. A chunk
+// can be opened alone, which serves the range reads of the object files.
+//
+//	| magic (4) | nonce (12) | chunk 0 | ... | chunk n |
+const (
+	encryptMagic  = "MOE1"
+	encryptHeader = len(encryptMagic) + fileservice.ChunkedAEADNonceSize
+	encryptChunk  = 64 * 1024
+)
+
+// plainSize returns the plaintext size of an encrypted file.
+func plainSize(size int64) int64 {
+	return fileservice.ChunkedPlainSize(size-int64(encryptHeader), encryptChunk)
+}
+
+func newEncryptReader(chunks *fileservice.ChunkedAEAD, r io.Reader) (io.Reader, error) {
+	nonce := make([]byte, fileservice.ChunkedAEADNonceSize)
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	header := make([]byte, 0, encryptHeader)
+	header = append(header, encryptMagic...)
+	header = append(header, nonce...)
+	return chunks.NewSealReader(nonce, header, r), nil
+}
+
+// decryptReader opens the sealed chunks of r.
+type decryptReader struct {
+	io.Reader
+	close func() error
+}
+
+func newDecryptReader(ctx context.Context, chunks *fileservice.ChunkedAEAD, rc io.ReadCloser) (*decryptReader, error) {
+	header := make([]byte, encryptHeader)
+	if _, err := io.ReadFull(rc, header); err != nil {
+		return nil, err
+	}
+	if string(header[:len(encryptMagic)]) != encryptMagic {
+		return nil, moerr.NewInternalError(ctx, "file is not encrypted by the backup")
+	}
+	return &decryptReader{
+		Reader: chunks.NewOpenReader(ctx, header[len(encryptMagic):], rc),
+		close:  rc.Close,
+	}, nil
+}
+
+func (r *decryptReader) Close() error {
+	return r.close()
+}
+
+// encryptedFS encrypts the files written to the backup by the data key of
+// the backup, and decrypts the files read.
+type encryptedFS struct {
+	upstream fileservice.FileService
+	chunks   *fileservice.ChunkedAEAD
+}
+
+var _ fileservice.FileService = (*encryptedFS)(nil)
+
+func newEncryptedFS(upstream fileservice.FileService, dataKey []byte) (*encryptedFS, error) {
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedFS{
+		upstream: upstream,
+		chunks:   fileservice.NewChunkedAEAD(gcm, encryptChunk),
+	}, nil
+}
+
+func (e *encryptedFS) Name() string {
+	return e.upstream.Name()
+}
+
+func (e *encryptedFS) Write(ctx context.Context, vector fileservice.IOVector) error {
+	// the entries are written as one stream, gaps are zero-filled
+	readers := make([]io.Reader, 0, len(vector.Entries))
+	offset := int64(0)
+	for _, entry := range vector.Entries {
+		if entry.Offset < offset {
+			return moerr.NewInternalError(ctx, "overlapped entries of %s", vector.FilePath)
+		}
+		if entry.Offset > offset {
+			readers = append(readers, io.LimitReader(zeroReader{}, entry.Offset-offset))
+		}
+		if entry.ReaderForWrite != nil {
+			if entry.Size < 0 {
+				// the size is unknown, it must be the last entry
+				readers = append(readers, entry.ReaderForWrite)
+				break
+			}
+			readers = append(readers, io.LimitReader(entry.ReaderForWrite, entry.Size))
+			offset = entry.Offset + entry.Size
+			continue
+		}
+		readers = append(readers, bytes.NewReader(entry.Data))
+		offset = entry.Offset + int64(len(entry.Data))
+	}
+	reader, err := newEncryptReader(e.chunks, io.MultiReader(readers...))
+	if err != nil {
+		return err
+	}
+	return e.upstream.Write(ctx, fileservice.IOVector{
+		FilePath: vector.FilePath,
+		Entries: []fileservice.IOEntry{
+			{
+				ReaderForWrite: reader,
+				Size:           -1,
+			},
+		},
+		Policy: vector.Policy,
+	})
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+func (e *encryptedFS) Read(ctx context.Context, vector *fileservice.IOVector) error {
+	if len(vector.Entries) == 0 {
+		return moerr.NewEmptyVectorNoCtx()
+	}
+	var size int64 = -1
+	for i := range vector.Entries {
+		entry := &vector.Entries[i]
+		if entry.ReadCloserForRead != nil && entry.Offset == 0 && entry.Size < 0 {
+			// stream the whole file
+			var rc io.ReadCloser
+			err := e.upstream.Read(ctx, &fileservice.IOVector{
+				FilePath: vector.FilePath,
+				Entries: []fileservice.IOEntry{
+					{
+						ReadCloserForRead: &rc,
+						Size:              -1,
+					},
+				},
+				Policy: vector.Policy,
+			})
+			if err != nil {
+				return err
+			}
+			r, err := newDecryptReader(ctx, e.chunks, rc)
+			if err != nil {
+				rc.Close()
+				return err
+			}
+			*entry.ReadCloserForRead = r
+			continue
+		}
+		if size < 0 {
+			stat, err := e.upstream.StatFile(ctx, vector.FilePath)
+			if err != nil {
+				return err
+			}
+			size = stat.Size
+		}
+		data, err := e.readRange(ctx, vector, size, entry.Offset, entry.Size)
+		if err != nil {
+			return err
+		}
+		if err = fillEntry(entry, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readRange reads the plaintext of [offset, offset+length) by opening the
+// chunks covering the range.
+func (e *encryptedFS) readRange(
+	ctx context.Context,
+	vector *fileservice.IOVector,
+	size, offset, length int64,
+) ([]byte, error) {
+	total := plainSize(size)
+	if length < 0 {
+		length = total - offset
+	}
+	if offset < 0 || offset+length > total {
+		return nil, moerr.NewUnexpectedEOFNoCtx(vector.FilePath)
+	}
+	if length == 0 {
+		return nil, moerr.NewEmptyRangeNoCtx(vector.FilePath)
+	}
+	start, end := e.chunks.SealedRange(total, offset, length)
+	// the nonce is read along with the chunks
+	iov := &fileservice.IOVector{
+		FilePath: vector.FilePath,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: 0,
+				Size:   int64(encryptHeader),
+			},
+			{
+				Offset: int64(encryptHeader) + start,
+				Size:   end - start,
+			},
+		},
+		Policy: vector.Policy,
+	}
+	if err := e.upstream.Read(ctx, iov); err != nil {
+		return nil, err
+	}
+	header, sealed := iov.Entries[0].Data, iov.Entries[1].Data
+	if string(header[:len(encryptMagic)]) != encryptMagic {
+		return nil, moerr.NewInternalError(ctx, "file %s is not encrypted by the backup", vector.FilePath)
+	}
+	return e.chunks.Open(ctx, vector.FilePath, header[len(encryptMagic):], sealed, total, offset, length)
+}
+
+func fillEntry(entry *fileservice.IOEntry, data []byte) error {
+	switch {
+	case entry.WriterForRead != nil:
+		if _, err := entry.WriterForRead.Write(data); err != nil {
+			return err
+		}
+	case entry.ReadCloserForRead != nil:
+		*entry.ReadCloserForRead = io.NopCloser(bytes.NewReader(data))
+	default:
+		entry.Data = data
+	}
+	if entry.ToCacheData != nil {
+		cacheData, err := entry.ToCacheData(bytes.NewReader(data), data, fileservice.GetDefaultCacheDataAllocator())
+		if err != nil {
+			return err
+		}
+		entry.CachedData = cacheData
+	}
+	return nil
+}
+
+func (e *encryptedFS) ReadCache(ctx context.Context, vector *fileservice.IOVector) error {
+	return nil
+}
+
+func (e *encryptedFS) List(ctx context.Context, dirPath string) ([]fileservice.DirEntry, error) {
+	entries, err := e.upstream.List(ctx, dirPath)
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		if !entries[i].IsDir {
+			entries[i].Size = plainSize(entries[i].Size)
+		}
+	}
+	return entries, nil
+}
+
+func (e *encryptedFS) Delete(ctx context.Context, filePaths ...string) error {
+	return e.upstream.Delete(ctx, filePaths...)
+}
+
+func (e *encryptedFS) StatFile(ctx context.Context, filePath string) (*fileservice.DirEntry, error) {
+	entry, err := e.upstream.StatFile(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	entry.Size = plainSize(entry.Size)
+	return entry, nil
+}
+
+func (e *encryptedFS) PrefetchFile(ctx context.Context, filePath string) error {
+	return nil
+}
+
+func (e *encryptedFS) Cost() *fileservice.CostAttr {
+	return e.upstream.Cost()
+}
+
+func (e *encryptedFS) Close() {
+	e.upstream.Close()
+}
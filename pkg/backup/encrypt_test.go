@@ -0,0 +1,155 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptedFS(t *testing.T) {
+	ctx := context.Background()
+	upstream, err := fileservice.NewMemoryFS("backup", fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	dataKey, err := newDataKey()
+	require.NoError(t, err)
+	fs, err := newEncryptedFS(upstream, dataKey)
+	require.NoError(t, err)
+
+	for _, size := range []int{1, 100, encryptChunk - 1, encryptChunk, encryptChunk + 1, 3*encryptChunk + 7} {
+		name := fmt.Sprintf("f%d", size)
+		data := make([]byte, size)
+		_, err = rand.Read(data)
+		require.NoError(t, err)
+		require.NoError(t, fs.Write(ctx, fileservice.IOVector{
+			FilePath: name,
+			Entries: []fileservice.IOEntry{
+				{Offset: 0, Size: int64(size), Data: data},
+			},
+		}))
+
+		stored, err := upstream.StatFile(ctx, name)
+		require.NoError(t, err)
+		assert.Equal(t, int64(size), plainSize(stored.Size))
+		stat, err := fs.StatFile(ctx, name)
+		require.NoError(t, err)
+		assert.Equal(t, int64(size), stat.Size)
+
+		// the whole file
+		got, err := readFile(ctx, fs, name)
+		require.NoError(t, err)
+		assert.Equal(t, data, got)
+
+		// the stream
+		var reader io.ReadCloser
+		require.NoError(t, fs.Read(ctx, &fileservice.IOVector{
+			FilePath: name,
+			Entries: []fileservice.IOEntry{
+				{ReadCloserForRead: &reader, Offset: 0, Size: -1},
+			},
+		}))
+		got, err = io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		assert.Equal(t, data, got)
+
+		// the ranges
+		for _, r := range [][2]int{{0, 1}, {size / 2, size - size/2}, {size - 1, 1}} {
+			if r[1] == 0 {
+				continue
+			}
+			iov := &fileservice.IOVector{
+				FilePath: name,
+				Entries: []fileservice.IOEntry{
+					{Offset: int64(r[0]), Size: int64(r[1])},
+				},
+			}
+			require.NoError(t, fs.Read(ctx, iov))
+			assert.Equal(t, data[r[0]:r[0]+r[1]], iov.Entries[0].Data)
+		}
+	}
+
+	// a chunk corrupted can not be read
+	raw, err := readFile(ctx, upstream, "f100")
+	require.NoError(t, err)
+	raw[encryptHeader] ^= 0xff
+	require.NoError(t, upstream.Delete(ctx, "f100"))
+	require.NoError(t, writeFile(ctx, upstream, "f100", raw))
+	_, err = readFile(ctx, fs, "f100")
+	assert.Error(t, err)
+
+	// the chunks can not be truncated
+	raw, err = readFile(ctx, upstream, fmt.Sprintf("f%d", 3*encryptChunk+7))
+	require.NoError(t, err)
+	require.NoError(t, writeFile(ctx, upstream, "truncated", raw[:encryptHeader+2*(encryptChunk+fileservice.ChunkedAEADTagSize)]))
+	_, err = readFile(ctx, fs, "truncated")
+	assert.Error(t, err)
+}
+
+func TestKeySource(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	key, err := newDataKey()
+	require.NoError(t, err)
+	keyFile := dir + "/key"
+	require.NoError(t, writeKeyFile(keyFile, key))
+
+	source, err := newKeySource(ctx, "file:"+keyFile, nil)
+	require.NoError(t, err)
+	dataKey, err := newDataKey()
+	require.NoError(t, err)
+	wrapped, err := source.wrap(ctx, dataKey)
+	require.NoError(t, err)
+	assert.False(t, bytes.Contains(wrapped, dataKey))
+	unwrapped, err := source.unwrap(ctx, wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, dataKey, unwrapped)
+
+	_, err = newKeySource(ctx, "kms:k1", nil)
+	assert.Error(t, err)
+	SetKMS(fileservice.NewLocalKMSProvider(dir))
+	defer SetKMS(nil)
+	require.NoError(t, writeKeyFile(dir+"/k1", key))
+	source, err = newKeySource(ctx, "kms:k1", nil)
+	require.NoError(t, err)
+	wrapped, err = source.wrap(ctx, dataKey)
+	require.NoError(t, err)
+	unwrapped, err = source.unwrap(ctx, wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, dataKey, unwrapped)
+
+	// the key id can not reach the files out of the kms dir
+	source, err = newKeySource(ctx, "kms:../"+filepath.Base(dir)+"/k1", nil)
+	require.NoError(t, err)
+	_, err = source.wrap(ctx, dataKey)
+	assert.Error(t, err)
+
+	_, err = newKeySource(ctx, "plain", nil)
+	assert.Error(t, err)
+}
+
+func writeKeyFile(path string, key []byte) error {
+	return os.WriteFile(path, []byte(hexStr(key)), 0600)
+}
@@ -0,0 +1,279 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+)
+
+const (
+	manifestFile    = "manifest"
+	manifestSigFile = "manifest.sig"
+)
+
+// the fileservices of the files in the manifest
+const (
+	scopeGeneral = "general"
+	scopeData    = "data"
+)
+
+// manifestEntry is a file written to the backup. The size and the SHA-256
+// are of the bytes stored, which are the ciphertext of encrypted backups,
+// so a backup can be verified without its key.
+type manifestEntry struct {
+	scope    string
+	path     string
+	size     int64
+	checksum []byte
+}
+
+func (m *manifestEntry) CsvString() []string {
+	return []string{m.scope, m.path, fmt.Sprintf("%d", m.size), hexStr(m.checksum)}
+}
+
+func manifestEntryFromCsv(ctx context.Context, line []string) (*manifestEntry, error) {
+	if len(line) != 4 {
+		return nil, moerr.NewInternalError(ctx, "invalid manifest line: %v", line)
+	}
+	size, err := strconv.ParseInt(line[2], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	checksum, err := hex.DecodeString(line[3])
+	if err != nil {
+		return nil, err
+	}
+	return &manifestEntry{
+		scope:    line[0],
+		path:     line[1],
+		size:     size,
+		checksum: checksum,
+	}, nil
+}
+
+// manifest collects the files written to the backup.
+type manifest struct {
+	sync.Mutex
+	entries map[string]*manifestEntry
+}
+
+func newManifest() *manifest {
+	return &manifest{
+		entries: make(map[string]*manifestEntry),
+	}
+}
+
+func (m *manifest) add(entry *manifestEntry) {
+	m.Lock()
+	defer m.Unlock()
+	m.entries[entry.scope+"/"+entry.path] = entry
+}
+
+func (m *manifest) csvString() [][]string {
+	m.Lock()
+	defer m.Unlock()
+	lines := make([][]string, 0, len(m.entries))
+	for _, entry := range m.entries {
+		lines = append(lines, entry.CsvString())
+	}
+	sort.Slice(lines, func(i, j int) bool {
+		if lines[i][0] != lines[j][0] {
+			return lines[i][0] < lines[j][0]
+		}
+		return lines[i][1] < lines[j][1]
+	})
+	return lines
+}
+
+// signKey derives the key signing the manifest from the data key.
+func signKey(dataKey []byte) []byte {
+	mac := hmac.New(sha256.New, dataKey)
+	mac.Write([]byte(manifestFile))
+	return mac.Sum(nil)
+}
+
+func signManifest(dataKey, data []byte) []byte {
+	mac := hmac.New(sha256.New, signKey(dataKey))
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+// saveManifest saves the manifest of the backup in the general dir. The
+// manifest of an encrypted backup is signed by the data key.
+func saveManifest(ctx context.Context, cfg *Config) error {
+	if cfg.manifest == nil {
+		return nil
+	}
+	data, err := ToCsvLine2(cfg.manifest.csvString())
+	if err != nil {
+		return err
+	}
+	fs := cfg.GeneralDir.(*recordFS).upstream
+	if err = writeFile(ctx, fs, manifestFile, []byte(data)); err != nil {
+		return err
+	}
+	if cfg.dataKey == nil {
+		return nil
+	}
+	sig := signManifest(cfg.dataKey, []byte(data))
+	return writeFile(ctx, fs, manifestSigFile, []byte(hexStr(sig)))
+}
+
+// recordFS records the files written through it in the manifest.
+type recordFS struct {
+	fileservice.FileService
+	upstream fileservice.FileService
+	scope    string
+	manifest *manifest
+}
+
+func newRecordFS(upstream fileservice.FileService, scope string, m *manifest) *recordFS {
+	return &recordFS{
+		FileService: upstream,
+		upstream:    upstream,
+		scope:       scope,
+		manifest:    m,
+	}
+}
+
+func (r *recordFS) Write(ctx context.Context, vector fileservice.IOVector) error {
+	hasher := sha256.New()
+	counter := &countWriter{w: hasher}
+	entries := make([]fileservice.IOEntry, len(vector.Entries))
+	copy(entries, vector.Entries)
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Offset < entries[j].Offset
+	})
+	for i := range entries {
+		if entries[i].ReaderForWrite != nil {
+			entries[i].ReaderForWrite = io.TeeReader(entries[i].ReaderForWrite, counter)
+		}
+	}
+	vector.Entries = entries
+	if err := r.upstream.Write(ctx, vector); err != nil {
+		return err
+	}
+	// the bytes of data entries are hashed after written, it only
+	// works with the entries written in order without gaps
+	for _, entry := range entries {
+		if entry.ReaderForWrite == nil {
+			counter.Write(entry.Data)
+		}
+	}
+	r.manifest.add(&manifestEntry{
+		scope:    r.scope,
+		path:     vector.FilePath,
+		size:     counter.n,
+		checksum: hasher.Sum(nil),
+	})
+	return nil
+}
+
+type countWriter struct {
+	w hash.Hash
+	n int64
+}
+
+func (c *countWriter) Write(p []byte) (int, error) {
+	c.n += int64(len(p))
+	return c.w.Write(p)
+}
+
+// Verify checks the backup in dir without restoring it. Every file in the
+// manifest is checked with its size and SHA-256, the signature of the
+// manifest is checked if the backup is encrypted, and the files inherited
+// from the parents of an incremental backup are checked to exist.
+func Verify(ctx context.Context, dir string, keys *Keys) error {
+	b, err := openBackup(ctx, dir, keys)
+	if err != nil {
+		return err
+	}
+	data, err := readFileAndCheck(ctx, b.general, manifestFile)
+	if err != nil {
+		return err
+	}
+	if b.dataKey != nil {
+		sig, err := readFileAndCheck(ctx, b.general, manifestSigFile)
+		if err != nil {
+			return err
+		}
+		if !hmac.Equal(sig, []byte(hexStr(signManifest(b.dataKey, data)))) {
+			return moerr.NewInternalError(ctx, "signature of the manifest of %s mismatched", dir)
+		}
+	}
+	lines, err := fromCsvBytes(data)
+	if err != nil {
+		return err
+	}
+	for _, line := range lines {
+		entry, err := manifestEntryFromCsv(ctx, line)
+		if err != nil {
+			return err
+		}
+		fs := b.rawData
+		if entry.scope == scopeGeneral {
+			fs = b.general
+		}
+		if err = verifyFile(ctx, fs, entry); err != nil {
+			return err
+		}
+	}
+	_, err = verifyChain(ctx, dir, keys)
+	return err
+}
+
+func verifyFile(ctx context.Context, fs fileservice.FileService, entry *manifestEntry) error {
+	var reader io.ReadCloser
+	err := fs.Read(ctx, &fileservice.IOVector{
+		FilePath: entry.path,
+		Entries: []fileservice.IOEntry{
+			{
+				ReadCloserForRead: &reader,
+				Offset:            0,
+				Size:              -1,
+			},
+		},
+		Policy: fileservice.SkipAllCache,
+	})
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	hasher := sha256.New()
+	size, err := io.Copy(hasher, reader)
+	if err != nil {
+		return err
+	}
+	if size != entry.size {
+		return moerr.NewInternalError(ctx, "size %d of %s is not equal to %d", size, entry.path, entry.size)
+	}
+	if checksum := hasher.Sum(nil); !bytes.Equal(checksum, entry.checksum) {
+		return moerr.NewInternalError(ctx, checksumErrorInfo(hexStr(checksum), hexStr(entry.checksum), entry.path))
+	}
+	return nil
+}
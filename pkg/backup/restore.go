@@ -0,0 +1,228 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"golang.org/x/sync/errgroup"
+)
+
+// chainBackup is a backup in the parent chain of the restored backup.
+type chainBackup struct {
+	dir   string
+	fs    fileservice.FileService
+	list  []*taeFile
+	files map[string]*taeFile
+}
+
+// restoreFile is a tae file and the backup holding its copy.
+type restoreFile struct {
+	file *taeFile
+	from *chainBackup
+}
+
+// Restore rebuilds the tae data of the filesystem backup in dir into dstFs.
+// The files of an incremental backup which were copied by its ancestors are
+// restored from the ancestors recorded in its metas. Every file is verified
+// to exist with the recorded size before copying, and the checksum of every
+// copied file is compared with the recorded one.
+func Restore(ctx context.Context, dir string, dstFs fileservice.FileService, parallelism int, keys *Keys) error {
+	files, err := verifyChain(ctx, dir, keys)
+	if err != nil {
+		return err
+	}
+
+	group, gctx := errgroup.WithContext(ctx)
+	group.SetLimit(getParallelCount(parallelism))
+	for _, f := range files {
+		f := f
+		group.Go(func() error {
+			checksum, err := CopyFileWithRetry(gctx, f.from.fs, dstFs, f.file.path, "")
+			if err != nil {
+				return err
+			}
+			if len(f.file.checksum) > 0 && !bytes.Equal(checksum, f.file.checksum) {
+				return moerr.NewInternalError(gctx,
+					checksumErrorInfo(hexStr(checksum), hexStr(f.file.checksum), f.file.path))
+			}
+			return nil
+		})
+	}
+	if err = group.Wait(); err != nil {
+		return err
+	}
+	logutil.Info("backup", common.OperationField("restore"),
+		common.AnyField("backup", dir),
+		common.AnyField("file num", len(files)))
+	return nil
+}
+
+// verifyChain resolves every tae file of the backup in dir to the backup
+// holding its copy and checks that the copy exists with the recorded size.
+func verifyChain(ctx context.Context, dir string, keys *Keys) ([]restoreFile, error) {
+	chain, err := loadChain(ctx, dir, keys)
+	if err != nil {
+		return nil, err
+	}
+	files, err := resolveChain(ctx, chain)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		entry, err := f.from.fs.StatFile(ctx, f.file.path)
+		if err != nil {
+			return nil, err
+		}
+		if f.file.size > 0 && entry.Size != f.file.size {
+			return nil, moerr.NewInternalError(ctx, "size %d of %s in backup %s is not equal to %d",
+				entry.Size, f.file.path, f.from.dir, f.file.size)
+		}
+	}
+	return files, nil
+}
+
+// loadChain loads the tae file lists of the backup in dir and its ancestors,
+// from the backup itself to the full backup.
+func loadChain(ctx context.Context, dir string, keys *Keys) ([]*chainBackup, error) {
+	load := func(dir string) (*chainBackup, *openedBackup, error) {
+		opened, err := openBackup(ctx, dir, keys)
+		if err != nil {
+			return nil, nil, err
+		}
+		b := &chainBackup{
+			dir: dir,
+			fs:  fileservice.SubPath(opened.data, taeDir),
+		}
+		if b.list, err = readTaeFilesList(ctx, b.fs); err != nil {
+			return nil, nil, err
+		}
+		b.files = make(map[string]*taeFile, len(b.list))
+		for _, file := range b.list {
+			b.files[file.path] = file
+		}
+		return b, opened, nil
+	}
+	b, opened, err := load(dir)
+	if err != nil {
+		return nil, err
+	}
+	parents := opened.metas.Parents()
+	chain := make([]*chainBackup, 0, len(parents)+1)
+	chain = append(chain, b)
+	for _, parent := range parents {
+		if b, _, err = load(parent.ParentDir); err != nil {
+			return nil, err
+		}
+		// the parent must not be replaced after the child is taken
+		backupTs, err := readBackupTs(ctx, b.fs)
+		if err != nil {
+			return nil, err
+		}
+		if backupTs != parent.ParentBackupTs {
+			return nil, moerr.NewInternalError(ctx, "backup ts %s of the parent %s is not equal to %s",
+				backupTs, parent.ParentDir, parent.ParentBackupTs)
+		}
+		chain = append(chain, b)
+	}
+	return chain, nil
+}
+
+// openedBackup is a backup opened to read, data decrypts the files of an
+// encrypted backup and rawData reads the files as they are stored.
+type openedBackup struct {
+	general fileservice.FileService
+	rawData fileservice.FileService
+	data    fileservice.FileService
+	metas   *Metas
+	dataKey []byte
+}
+
+// openBackup opens the filesystem backup in dir.
+func openBackup(ctx context.Context, dir string, keys *Keys) (*openedBackup, error) {
+	general, _, err := setupFilesystem(ctx, dir, true)
+	if err != nil {
+		return nil, err
+	}
+	data, _, err := setupFilesystem(ctx, dir, false)
+	if err != nil {
+		return nil, err
+	}
+	return openBackupFs(ctx, general, data, keys)
+}
+
+func openBackupFs(ctx context.Context, general, data fileservice.FileService, keys *Keys) (*openedBackup, error) {
+	metas, err := readMetas(ctx, general)
+	if err != nil {
+		return nil, err
+	}
+	b := &openedBackup{
+		general: general,
+		rawData: data,
+		data:    data,
+		metas:   metas,
+	}
+	encryption := metas.Encryption()
+	if encryption == nil {
+		return b, nil
+	}
+	source, err := newKeySource(ctx, encryption.EncryptionKey, keys)
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := hex.DecodeString(encryption.WrappedDataKey)
+	if err != nil {
+		return nil, err
+	}
+	if b.dataKey, err = source.unwrap(ctx, wrapped); err != nil {
+		return nil, err
+	}
+	if b.data, err = newEncryptedFS(data, b.dataKey); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// resolveChain finds the nearest backup which has copied each file of the
+// first backup in the chain.
+func resolveChain(ctx context.Context, chain []*chainBackup) ([]restoreFile, error) {
+	top := chain[0]
+	files := make([]restoreFile, 0, len(top.list))
+	for _, file := range top.list {
+		if file.needCopy {
+			files = append(files, restoreFile{file: file, from: top})
+			continue
+		}
+		var found *restoreFile
+		for _, b := range chain[1:] {
+			if f, ok := b.files[file.path]; ok && f.needCopy {
+				found = &restoreFile{file: f, from: b}
+				break
+			}
+		}
+		if found == nil {
+			return nil, moerr.NewInternalError(ctx, "file %s of backup %s is not found in its parents",
+				file.path, top.dir)
+		}
+		files = append(files, *found)
+	}
+	return files, nil
+}
@@ -15,10 +15,14 @@
 package backup
 
 import (
+	"context"
+	"encoding/hex"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
@@ -28,6 +32,10 @@ const (
 	Version = "0823"
 )
 
+const (
+	BackupTypeIncremental = "incremental"
+)
+
 const (
 	moMeta       = "mo_meta"
 	configDir    = "config"
@@ -56,10 +64,14 @@ const (
 	              | Launchconfig
 	              | Tae
 	              | Hakeeper
+	              | Parent
+	              | Encryption
 	*/
 	TypeVersion MetaType = iota
 	TypeBuildinfo
 	TypeLaunchconfig
+	TypeParent
+	TypeEncryption
 )
 
 func (t MetaType) String() string {
@@ -70,6 +82,10 @@ func (t MetaType) String() string {
 		return "buildinfo"
 	case TypeLaunchconfig:
 		return "launchconfig"
+	case TypeParent:
+		return "parent"
+	case TypeEncryption:
+		return "encryption"
 	default:
 		return fmt.Sprintf("invalid type %d", t)
 	}
@@ -89,6 +105,14 @@ type Meta struct {
 
 	//launch config
 	LaunchConfigFile string
+
+	//parent backup
+	ParentBackupTs string
+	ParentDir      string
+
+	//encryption
+	EncryptionKey  string
+	WrappedDataKey string
 }
 
 func (m *Meta) String() string {
@@ -106,6 +130,12 @@ func (m *Meta) CsvString() []string {
 		format[SubTypePos] = m.Buildinfo
 	case TypeLaunchconfig:
 		format[FileNameOrDirNamePos] = m.LaunchConfigFile
+	case TypeParent:
+		format[SubTypePos] = m.ParentBackupTs
+		format[FileNameOrDirNamePos] = m.ParentDir
+	case TypeEncryption:
+		format[SubTypePos] = m.EncryptionKey
+		format[FileNameOrDirNamePos] = m.WrappedDataKey
 	}
 	return format
 }
@@ -147,12 +177,72 @@ func (m *Metas) AppendLaunchconfig(subTyp, file string) {
 	})
 }
 
+// AppendParent records an ancestor backup of the incremental backup.
+// The ancestors are appended from the parent to the full backup.
+func (m *Metas) AppendParent(backupTs, dir string) {
+	m.Append(&Meta{
+		Typ:            TypeParent,
+		ParentBackupTs: backupTs,
+		ParentDir:      dir,
+	})
+}
+
+// Parents returns the ancestor backups from the parent to the full backup.
+func (m *Metas) Parents() []*Meta {
+	var parents []*Meta
+	for _, meta := range m.metas {
+		if meta.Typ == TypeParent {
+			parents = append(parents, meta)
+		}
+	}
+	return parents
+}
+
+// AppendEncryption records the key and the wrapped data key of the
+// encrypted backup.
+func (m *Metas) AppendEncryption(key, wrappedDataKey string) {
+	m.Append(&Meta{
+		Typ:            TypeEncryption,
+		EncryptionKey:  key,
+		WrappedDataKey: wrappedDataKey,
+	})
+}
+
+// Encryption returns the encryption of the backup, nil if not encrypted.
+func (m *Metas) Encryption() *Meta {
+	for _, meta := range m.metas {
+		if meta.Typ == TypeEncryption {
+			return meta
+		}
+	}
+	return nil
+}
+
+// metasFromCsv loads the metas of the parents and the encryption, which are
+// needed to read the backup.
+func metasFromCsv(lines [][]string) *Metas {
+	m := NewMetas()
+	for _, line := range lines {
+		if len(line) != 3 {
+			continue
+		}
+		switch line[TypePos] {
+		case TypeParent.String():
+			m.AppendParent(line[SubTypePos], line[FileNameOrDirNamePos])
+		case TypeEncryption.String():
+			m.AppendEncryption(line[SubTypePos], line[FileNameOrDirNamePos])
+		}
+	}
+	return m
+}
+
 func (m *Metas) orderTypes() []int {
 	idx := make([]int, 0, len(m.metas))
 	for i := range m.metas {
 		idx = append(idx, i)
 	}
-	sort.Slice(idx, func(i, j int) bool {
+	// keep the order of the parents
+	sort.SliceStable(idx, func(i, j int) bool {
 		return m.metas[idx[i]].Typ < m.metas[idx[j]].Typ
 	})
 	return idx
@@ -227,6 +317,9 @@ type Config struct {
 
 	BackupType string
 	BackupTs   types.TS
+
+	dataKey  []byte
+	manifest *manifest
 }
 
 // metasGeneralFsMustBeSet denotes metas and generalFs must be ready
@@ -286,6 +379,31 @@ func (tfs *taeFile) CsvString() []string {
 		tfs.ts.ToString()}
 }
 
+func taeFileFromCsv(ctx context.Context, line []string) (*taeFile, error) {
+	if len(line) != 5 {
+		return nil, moerr.NewInternalError(ctx, "invalid tae file line: %v", line)
+	}
+	size, err := strconv.ParseInt(line[1], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	checksum, err := hex.DecodeString(line[2])
+	if err != nil {
+		return nil, err
+	}
+	needCopy, err := strconv.ParseBool(line[3])
+	if err != nil {
+		return nil, err
+	}
+	return &taeFile{
+		path:     line[0],
+		size:     size,
+		checksum: checksum,
+		needCopy: needCopy,
+		ts:       types.StringToTS(line[4]),
+	}, nil
+}
+
 func taeFileListToCsv(files []*taeFile) ([][]string, int64) {
 	lines := make([][]string, 0, len(files))
 	ret := int64(0)
@@ -68,3 +68,21 @@ func TestMetas_AppendLaunchconfig(t *testing.T) {
 		})
 	}
 }
+
+func TestMetas_AppendParent(t *testing.T) {
+	m := NewMetas()
+	m.AppendParent("2-0", "/backup/incr1")
+	m.AppendVersion(Version)
+	m.AppendParent("1-0", "/backup/full")
+
+	lines := m.CsvString()
+	assert.Equal(t, 3, len(lines))
+	assert.Equal(t, []string{"version", Version, ""}, lines[0])
+	assert.Equal(t, []string{"parent", "2-0", "/backup/incr1"}, lines[1])
+	assert.Equal(t, []string{"parent", "1-0", "/backup/full"}, lines[2])
+
+	parents := m.Parents()
+	assert.Equal(t, 2, len(parents))
+	assert.Equal(t, "/backup/incr1", parents[0].ParentDir)
+	assert.Equal(t, "/backup/full", parents[1].ParentDir)
+}
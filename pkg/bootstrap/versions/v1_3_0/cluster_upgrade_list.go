@@ -15,14 +15,20 @@
 package v1_3_0
 
 import (
+	"context"
+
 	"github.com/matrixorigin/matrixone/pkg/bootstrap/versions"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/frontend"
 	"github.com/matrixorigin/matrixone/pkg/util/executor"
+	"github.com/matrixorigin/matrixone/pkg/util/trace/impl/motrace"
 )
 
 var clusterUpgEntries = []versions.UpgradeEntry{
 	upg_mo_pitr,
+	upg_system_scrub_report,
+	upg_system_gc_report,
+	upg_mo_cn_scaling,
 }
 
 var upg_mo_pitr = versions.UpgradeEntry{
@@ -42,3 +48,45 @@ var upg_mo_pitr = versions.UpgradeEntry{
 		return false, nil
 	},
 }
+
+var upg_system_scrub_report = versions.UpgradeEntry{
+	Schema:    motrace.SystemDBConst,
+	TableName: motrace.ScrubReportTbl,
+	UpgType:   versions.CREATE_VIEW,
+	UpgSql:    motrace.ScrubReportView.ToCreateSql(context.Background(), true),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, _, err := versions.CheckViewDefinition(txn, accountId, motrace.SystemDBConst, motrace.ScrubReportTbl)
+		if err != nil {
+			return false, err
+		}
+		return exists, nil
+	},
+}
+
+var upg_system_gc_report = versions.UpgradeEntry{
+	Schema:    motrace.SystemDBConst,
+	TableName: motrace.GCReportTbl,
+	UpgType:   versions.CREATE_VIEW,
+	UpgSql:    motrace.GCReportView.ToCreateSql(context.Background(), true),
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, _, err := versions.CheckViewDefinition(txn, accountId, motrace.SystemDBConst, motrace.GCReportTbl)
+		if err != nil {
+			return false, err
+		}
+		return exists, nil
+	},
+}
+
+var upg_mo_cn_scaling = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_CN_SCALING,
+	UpgType:   versions.CREATE_VIEW,
+	UpgSql:    frontend.MoCatalogMoCNScalingDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		exists, _, err := versions.CheckViewDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_CN_SCALING)
+		if err != nil {
+			return false, err
+		}
+		return exists, nil
+	},
+}
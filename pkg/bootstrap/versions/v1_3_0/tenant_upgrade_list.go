@@ -19,12 +19,24 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/bootstrap/versions"
 	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/frontend"
 	"github.com/matrixorigin/matrixone/pkg/util/executor"
 )
 
 var tenantUpgEntries = []versions.UpgradeEntry{
 	upg_systemMetrics_server_snapshot_usage,
 	upg_mo_snapshots,
+	upg_mo_statistics_ext,
+	upg_mo_user_password_last_changed,
+	upg_mo_user_password_expired,
+	upg_mo_user_password_lifetime,
+	upg_mo_user_password_reuse_history,
+	upg_mo_user_password_reuse_time,
+	upg_mo_user_password_history,
+	upg_mo_user_failed_login_attempts,
+	upg_mo_user_password_lock_time,
+	upg_mo_user_failed_login_count,
+	upg_mo_user_locked_time,
 }
 
 const viewServerSnapshotUsage = "server_snapshot_usage"
@@ -58,3 +70,43 @@ var upg_mo_snapshots = versions.UpgradeEntry{
 		return false, nil
 	},
 }
+
+var upg_mo_statistics_ext = versions.UpgradeEntry{
+	Schema:    catalog.MO_CATALOG,
+	TableName: catalog.MO_STATISTICS_EXT,
+	UpgType:   versions.CREATE_NEW_TABLE,
+	UpgSql:    frontend.MoCatalogMoStatisticsExtDDL,
+	CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+		return versions.CheckTableDefinition(txn, accountId, catalog.MO_CATALOG, catalog.MO_STATISTICS_EXT)
+	},
+}
+
+// the columns of mo_user that store the password and the login policies of the user
+var (
+	upg_mo_user_password_last_changed  = upgMoUserAddColumn("password_last_changed", "timestamp", "default_role")
+	upg_mo_user_password_expired       = upgMoUserAddColumn("password_expired", "bool", "password_last_changed")
+	upg_mo_user_password_lifetime      = upgMoUserAddColumn("password_lifetime", "int signed", "password_expired")
+	upg_mo_user_password_reuse_history = upgMoUserAddColumn("password_reuse_history", "int signed", "password_lifetime")
+	upg_mo_user_password_reuse_time    = upgMoUserAddColumn("password_reuse_time", "int signed", "password_reuse_history")
+	upg_mo_user_password_history       = upgMoUserAddColumn("password_history", "text", "password_reuse_time")
+	upg_mo_user_failed_login_attempts  = upgMoUserAddColumn("failed_login_attempts", "int signed", "password_history")
+	upg_mo_user_password_lock_time     = upgMoUserAddColumn("password_lock_time", "int signed", "failed_login_attempts")
+	upg_mo_user_failed_login_count     = upgMoUserAddColumn("failed_login_count", "int signed", "password_lock_time")
+	upg_mo_user_locked_time            = upgMoUserAddColumn("locked_time", "timestamp", "failed_login_count")
+)
+
+func upgMoUserAddColumn(column, typ, after string) versions.UpgradeEntry {
+	return versions.UpgradeEntry{
+		Schema:    catalog.MO_CATALOG,
+		TableName: catalog.MOUserTable,
+		UpgType:   versions.ADD_COLUMN,
+		UpgSql:    fmt.Sprintf("alter table %s.%s add column %s %s after %s", catalog.MO_CATALOG, catalog.MOUserTable, column, typ, after),
+		CheckFunc: func(txn executor.TxnExecutor, accountId uint32) (bool, error) {
+			colInfo, err := versions.CheckTableColumn(txn, accountId, catalog.MO_CATALOG, catalog.MOUserTable, column)
+			if err != nil {
+				return false, err
+			}
+			return colInfo.IsExits, nil
+		},
+	}
+}
@@ -16,8 +16,10 @@ package catalog
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine"
@@ -183,3 +185,109 @@ func BuildQueryResultMetaPath(accountName, statementId string) string {
 func BuildProfilePath(serviceTyp string, nodeId string, typ, name string) string {
 	return fmt.Sprintf("%s/%s_%s_%s_%s", ProfileDir, serviceTyp, nodeId, typ, name)
 }
+
+// GetCompressAlg returns the compress algorithm of the column data set by the
+// table option COMPRESSION, lz4 if it is not set.
+func GetCompressAlg(props []*plan.Property) uint8 {
+	for _, p := range props {
+		if p.GetKey() != PropCompression {
+			continue
+		}
+		if alg, ok := compress.Algorithms[p.GetValue()]; ok {
+			return uint8(alg)
+		}
+	}
+	return compress.Lz4
+}
+
+// GetCompressAlgFromTableDef returns the compress algorithm of the table.
+func GetCompressAlgFromTableDef(tableDef *plan.TableDef) uint8 {
+	return GetCompressAlg(propertiesFromTableDef(tableDef))
+}
+
+// GetCompressAlgFromConstraint returns the compress algorithm kept in the
+// table properties of the marshaled constraint.
+func GetCompressAlgFromConstraint(data []byte) uint8 {
+	return GetCompressAlg(propertiesFromConstraint(data))
+}
+
+// GetFilterColumns returns the columns that get a block level bloom filter
+// or ngram filter, set by the table properties bloom_filter_columns and
+// ngram_filter_columns as comma separated column names.
+func GetFilterColumns(props []*plan.Property) (bloom, ngram []string) {
+	for _, p := range props {
+		switch p.GetKey() {
+		case PropBloomFilterColumns:
+			bloom = append(bloom, SplitFilterColumns(p.GetValue())...)
+		case PropNgramFilterColumns:
+			ngram = append(ngram, SplitFilterColumns(p.GetValue())...)
+		}
+	}
+	return
+}
+
+// SplitFilterColumns splits the value of a filter columns property.
+func SplitFilterColumns(value string) []string {
+	var cols []string
+	for _, col := range strings.Split(value, ",") {
+		if col = strings.TrimSpace(col); col != "" {
+			cols = append(cols, col)
+		}
+	}
+	return cols
+}
+
+// GetFilterColumnsFromTableDef returns the positions in tableDef.Cols of
+// the columns that get a bloom filter or an ngram filter.
+func GetFilterColumnsFromTableDef(tableDef *plan.TableDef) (bloom, ngram []uint16) {
+	bloomNames, ngramNames := GetFilterColumns(propertiesFromTableDef(tableDef))
+	if len(bloomNames) == 0 && len(ngramNames) == 0 {
+		return
+	}
+	positions := func(names []string) []uint16 {
+		var ret []uint16
+		for _, name := range names {
+			for i, col := range tableDef.GetCols() {
+				if strings.EqualFold(col.GetName(), name) {
+					ret = append(ret, uint16(i))
+					break
+				}
+			}
+		}
+		return ret
+	}
+	return positions(bloomNames), positions(ngramNames)
+}
+
+// GetFilterColumnsFromConstraint returns the names of the columns that get
+// a bloom filter or an ngram filter kept in the marshaled constraint.
+func GetFilterColumnsFromConstraint(data []byte) (bloom, ngram []string) {
+	return GetFilterColumns(propertiesFromConstraint(data))
+}
+
+func propertiesFromTableDef(tableDef *plan.TableDef) []*plan.Property {
+	var props []*plan.Property
+	for _, def := range tableDef.GetDefs() {
+		if p := def.GetProperties(); p != nil {
+			props = append(props, p.GetProperties()...)
+		}
+	}
+	return props
+}
+
+func propertiesFromConstraint(data []byte) []*plan.Property {
+	if len(data) == 0 {
+		return nil
+	}
+	c := &engine.ConstraintDef{}
+	if err := c.UnmarshalBinary(data); err != nil {
+		return nil
+	}
+	var props []*plan.Property
+	for _, ct := range c.Cts {
+		if cfg, ok := ct.(*engine.StreamConfigsDef); ok {
+			props = append(props, cfg.Configs...)
+		}
+	}
+	return props
+}
@@ -0,0 +1,201 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCompressAlg(t *testing.T) {
+	require.Equal(t, uint8(compress.Lz4), GetCompressAlg(nil))
+
+	props := []*plan.Property{
+		{Key: SystemRelAttr_Comment, Value: "zstd"},
+		{Key: PropCompression, Value: "zstd"},
+	}
+	require.Equal(t, uint8(compress.Zstd), GetCompressAlg(props))
+	require.Equal(t, uint8(compress.None), GetCompressAlg([]*plan.Property{{Key: PropCompression, Value: "none"}}))
+	require.Equal(t, uint8(compress.Lz4), GetCompressAlg([]*plan.Property{{Key: PropCompression, Value: "zlib"}}))
+
+	tableDef := &plan.TableDef{
+		Defs: []*plan.TableDef_DefType{
+			{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{Properties: props},
+				},
+			},
+		},
+	}
+	require.Equal(t, uint8(compress.Zstd), GetCompressAlgFromTableDef(tableDef))
+	require.Equal(t, uint8(compress.Lz4), GetCompressAlgFromTableDef(&plan.TableDef{}))
+
+	c := &engine.ConstraintDef{
+		Cts: []engine.Constraint{&engine.StreamConfigsDef{Configs: props}},
+	}
+	data, err := c.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, uint8(compress.Zstd), GetCompressAlgFromConstraint(data))
+	require.Equal(t, uint8(compress.Lz4), GetCompressAlgFromConstraint(nil))
+
+	// the table has a StreamConfigsDef for every properties def, the
+	// compression is not always in the first one.
+	first := []*plan.Property{{Key: SystemRelAttr_Comment, Value: "t1"}}
+	second := []*plan.Property{{Key: PropCompression, Value: "none"}}
+	tableDef = &plan.TableDef{
+		Defs: []*plan.TableDef_DefType{
+			{Def: &plan.TableDef_DefType_Properties{Properties: &plan.PropertiesDef{Properties: first}}},
+			{Def: &plan.TableDef_DefType_Properties{Properties: &plan.PropertiesDef{Properties: second}}},
+		},
+	}
+	require.Equal(t, uint8(compress.None), GetCompressAlgFromTableDef(tableDef))
+	c = &engine.ConstraintDef{
+		Cts: []engine.Constraint{
+			&engine.StreamConfigsDef{Configs: first},
+			&engine.StreamConfigsDef{Configs: second},
+		},
+	}
+	data, err = c.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, uint8(compress.None), GetCompressAlgFromConstraint(data))
+}
+
+func TestGetFilterColumns(t *testing.T) {
+	props := []*plan.Property{
+		{Key: SystemRelAttr_Comment, Value: "a"},
+		{Key: PropBloomFilterColumns, Value: " b, c ,"},
+		{Key: PropNgramFilterColumns, Value: "d"},
+	}
+	bloom, ngram := GetFilterColumns(props)
+	require.Equal(t, []string{"b", "c"}, bloom)
+	require.Equal(t, []string{"d"}, ngram)
+
+	tableDef := &plan.TableDef{
+		Cols: []*plan.ColDef{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}},
+		Defs: []*plan.TableDef_DefType{
+			{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{Properties: props[:1]},
+				},
+			},
+			{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{Properties: props[1:]},
+				},
+			},
+		},
+	}
+	bloomPos, ngramPos := GetFilterColumnsFromTableDef(tableDef)
+	require.Equal(t, []uint16{1, 2}, bloomPos)
+	require.Equal(t, []uint16{3}, ngramPos)
+	bloomPos, ngramPos = GetFilterColumnsFromTableDef(&plan.TableDef{})
+	require.Nil(t, bloomPos)
+	require.Nil(t, ngramPos)
+
+	// the filter columns may come after other properties
+	c := &engine.ConstraintDef{
+		Cts: []engine.Constraint{
+			&engine.StreamConfigsDef{Configs: props[:1]},
+			&engine.StreamConfigsDef{Configs: props[1:]},
+		},
+	}
+	data, err := c.MarshalBinary()
+	require.NoError(t, err)
+	bloom, ngram = GetFilterColumnsFromConstraint(data)
+	require.Equal(t, []string{"b", "c"}, bloom)
+	require.Equal(t, []string{"d"}, ngram)
+	bloom, _ = GetFilterColumnsFromConstraint(nil)
+	require.Nil(t, bloom)
+}
+
+func TestTableTTL(t *testing.T) {
+	v, err := NormalizeTTLInterval(30, "DAY")
+	require.NoError(t, err)
+	require.Equal(t, "30 day", v)
+	v, err = NormalizeTTLInterval(2, "SQL_TSI_HOUR")
+	require.NoError(t, err)
+	require.Equal(t, "2 hour", v)
+	_, err = NormalizeTTLInterval(1, "month")
+	require.Error(t, err)
+	_, err = NormalizeTTLInterval(0, "day")
+	require.Error(t, err)
+
+	d, err := ParseTTLInterval("30 day")
+	require.NoError(t, err)
+	require.Equal(t, 30*24*time.Hour, d)
+	_, err = ParseTTLInterval("30")
+	require.Error(t, err)
+	_, err = ParseTTLInterval("-1 day")
+	require.Error(t, err)
+
+	_, ok := GetTTL([]*plan.Property{{Key: PropTTLColumn, Value: "created_at"}})
+	require.False(t, ok)
+	ttl, ok := GetTTL([]*plan.Property{
+		{Key: PropTTLColumn, Value: "created_at"},
+		{Key: PropTTLInterval, Value: "1 day"},
+	})
+	require.True(t, ok)
+	require.Equal(t, TableTTL{Column: "created_at", Interval: 24 * time.Hour}, ttl)
+
+	mp := mpool.MustNewZero()
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	at := func(h int) time.Time { return now.Add(time.Duration(h) * time.Hour) }
+
+	// timestamp: -48h and exactly -24h are expired, null never expires
+	vec := vector.NewVec(types.T_timestamp.ToType())
+	for _, h := range []int{-48, -24, -23, 0} {
+		ts := types.UnixNanoToTimestamp(at(h).UnixNano())
+		require.NoError(t, vector.AppendFixed(vec, ts, false, mp))
+	}
+	require.NoError(t, vector.AppendFixed(vec, types.Timestamp(0), true, mp))
+	dels := ttl.ExpiredRows(vec, now, nil)
+	require.Equal(t, []uint64{0, 1}, dels.ToArray())
+	vec.Free(mp)
+
+	// datetime in UTC
+	vec = vector.NewVec(types.T_datetime.ToType())
+	for _, h := range []int{-25, -1} {
+		dt := types.UnixNanoToTimestamp(at(h).UnixNano()).ToDatetime(time.UTC)
+		require.NoError(t, vector.AppendFixed(vec, dt, false, mp))
+	}
+	dels = ttl.ExpiredRows(vec, now, nil)
+	require.Equal(t, []uint64{0}, dels.ToArray())
+	vec.Free(mp)
+
+	// date and const vectors
+	date, err := types.ParseDateCast("2024-06-09")
+	require.NoError(t, err)
+	cvec, err := vector.NewConstFixed(types.T_date.ToType(), date, 3, mp)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 1, 2}, ttl.ExpiredRows(cvec, now, nil).ToArray())
+	require.Nil(t, ttl.ExpiredRows(cvec, now.Add(-24*time.Hour), nil))
+	cvec.Free(mp)
+
+	zm := objectio.NewZM(types.T_timestamp, 0)
+	require.False(t, ttl.AnyExpired(zm, now))
+	zm.Update(types.UnixNanoToTimestamp(at(-1).UnixNano()))
+	require.False(t, ttl.AnyExpired(zm, now))
+	zm.Update(types.UnixNanoToTimestamp(at(-30).UnixNano()))
+	require.True(t, ttl.AnyExpired(zm, now))
+}
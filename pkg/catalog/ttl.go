@@ -0,0 +1,190 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package catalog
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+)
+
+var ttlUnits = map[string]time.Duration{
+	"microsecond": time.Microsecond,
+	"second":      time.Second,
+	"minute":      time.Minute,
+	"hour":        time.Hour,
+	"day":         24 * time.Hour,
+	"week":        7 * 24 * time.Hour,
+}
+
+// TableTTL is the row expiry of a table. A row is expired at the time now
+// if its Column plus Interval is not after now. Rows with a null Column
+// never expire, datetime and date values are taken as UTC so that every
+// CN and TN agrees on which rows are expired.
+type TableTTL struct {
+	Column   string
+	Interval time.Duration
+}
+
+// IsTTLColumnType reports whether a column of the type can be a ttl column.
+func IsTTLColumnType(oid types.T) bool {
+	return oid == types.T_timestamp || oid == types.T_datetime || oid == types.T_date
+}
+
+// NormalizeTTLInterval checks the interval of the table option TTL and
+// returns it in the form kept by the property ttl_interval.
+func NormalizeTTLInterval(n int64, unit string) (string, error) {
+	unit = strings.TrimPrefix(strings.ToLower(unit), "sql_tsi_")
+	if _, ok := ttlUnits[unit]; !ok {
+		return "", moerr.NewNotSupportedNoCtx("ttl interval unit '%s'", unit)
+	}
+	if n <= 0 {
+		return "", moerr.NewInvalidInputNoCtx("ttl interval must be positive")
+	}
+	return strconv.FormatInt(n, 10) + " " + unit, nil
+}
+
+// ParseTTLInterval parses the value of the property ttl_interval.
+func ParseTTLInterval(value string) (time.Duration, error) {
+	fields := strings.Fields(value)
+	if len(fields) != 2 {
+		return 0, moerr.NewInvalidInputNoCtx("invalid ttl interval '%s'", value)
+	}
+	n, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil || n <= 0 {
+		return 0, moerr.NewInvalidInputNoCtx("invalid ttl interval '%s'", value)
+	}
+	unit, ok := ttlUnits[fields[1]]
+	if !ok {
+		return 0, moerr.NewInvalidInputNoCtx("invalid ttl interval '%s'", value)
+	}
+	return time.Duration(n) * unit, nil
+}
+
+// GetTTL returns the row expiry kept in the table properties.
+func GetTTL(props []*plan.Property) (ttl TableTTL, ok bool) {
+	var interval string
+	for _, p := range props {
+		switch p.GetKey() {
+		case PropTTLColumn:
+			ttl.Column = p.GetValue()
+		case PropTTLInterval:
+			interval = p.GetValue()
+		}
+	}
+	if ttl.Column == "" || interval == "" {
+		return TableTTL{}, false
+	}
+	var err error
+	if ttl.Interval, err = ParseTTLInterval(interval); err != nil {
+		return TableTTL{}, false
+	}
+	return ttl, true
+}
+
+// GetTTLFromTableDef returns the row expiry of the table.
+func GetTTLFromTableDef(tableDef *plan.TableDef) (TableTTL, bool) {
+	return GetTTL(propertiesFromTableDef(tableDef))
+}
+
+// GetTTLFromConstraint returns the row expiry kept in the table properties
+// of the marshaled constraint.
+func GetTTLFromConstraint(data []byte) (TableTTL, bool) {
+	return GetTTL(propertiesFromConstraint(data))
+}
+
+// TTLNow returns the time at which a snapshot at ts sees the rows expired.
+// Reads, the primary key dedup and merge all take it from a timestamp of
+// the txn clock so that they agree on the expired rows.
+func TTLNow(ts timestamp.Timestamp) time.Time {
+	return time.Unix(0, ts.PhysicalTime)
+}
+
+func (ttl TableTTL) timestampCutoff(now time.Time) types.Timestamp {
+	return types.UnixNanoToTimestamp(now.Add(-ttl.Interval).UnixNano())
+}
+
+func (ttl TableTTL) datetimeCutoff(now time.Time) types.Datetime {
+	return ttl.timestampCutoff(now).ToDatetime(time.UTC)
+}
+
+// ExpiredRows adds the rows of vec expired at now to dels and returns it,
+// dels is allocated if it is nil and some rows are expired.
+func (ttl TableTTL) ExpiredRows(vec *vector.Vector, now time.Time, dels *nulls.Nulls) *nulls.Nulls {
+	switch vec.GetType().Oid {
+	case types.T_timestamp:
+		return expiredRows(vec, ttl.timestampCutoff(now), dels)
+	case types.T_datetime:
+		return expiredRows(vec, ttl.datetimeCutoff(now), dels)
+	case types.T_date:
+		return expiredRows(vec, ttl.datetimeCutoff(now).ToDate(), dels)
+	}
+	return dels
+}
+
+// AnyExpired reports whether some values covered by the zonemap of the ttl
+// column are expired at now.
+func (ttl TableTTL) AnyExpired(zm objectio.ZoneMap, now time.Time) bool {
+	if !zm.IsInited() {
+		return false
+	}
+	switch zm.GetType() {
+	case types.T_timestamp:
+		return zm.AnyLEByValue(types.EncodeFixed(ttl.timestampCutoff(now)))
+	case types.T_datetime:
+		return zm.AnyLEByValue(types.EncodeFixed(ttl.datetimeCutoff(now)))
+	case types.T_date:
+		return zm.AnyLEByValue(types.EncodeFixed(ttl.datetimeCutoff(now).ToDate()))
+	}
+	return false
+}
+
+func expiredRows[T types.Timestamp | types.Datetime | types.Date](
+	vec *vector.Vector, cutoff T, dels *nulls.Nulls,
+) *nulls.Nulls {
+	if vec.IsConstNull() || vec.Length() == 0 {
+		return dels
+	}
+	add := func(row int) {
+		if dels == nil {
+			dels = nulls.NewWithSize(vec.Length())
+		}
+		dels.Add(uint64(row))
+	}
+	vals := vector.MustFixedCol[T](vec)
+	if vec.IsConst() {
+		if vals[0] <= cutoff {
+			for i := 0; i < vec.Length(); i++ {
+				add(i)
+			}
+		}
+		return dels
+	}
+	nsp := vec.GetNulls()
+	for i, v := range vals {
+		if v <= cutoff && !nsp.Contains(uint64(i)) {
+			add(i)
+		}
+	}
+	return dels
+}
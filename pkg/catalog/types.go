@@ -44,6 +44,7 @@ const (
 	TableTailAttrPKVal    = "__mo_%1_pk_val"
 
 	MOAccountTable = "mo_account"
+	MOUserTable    = "mo_user"
 	// MOVersionTable mo version table. This table records information about the
 	// versions of the MO cluster that have been upgraded. In other words, you can
 	// query this table to find out all the versions of the MO cluster that have
@@ -144,6 +145,12 @@ const (
 
 	//MO_Pitr
 	MO_PITR = "mo_pitr"
+
+	// MO_STATISTICS_EXT extended statistics created by CREATE STATISTICS
+	MO_STATISTICS_EXT = "mo_statistics_ext"
+
+	// MO_CN_SCALING the view of the CN scale recommendations of HAKeeper
+	MO_CN_SCALING = "mo_cn_scaling"
 )
 
 const (
@@ -196,6 +203,18 @@ const (
 	SystemRelAttr_CatalogVersion = "catalog_version"
 	SystemRelAttr_CPKey          = CPrimaryKeyColName
 
+	// table properties not stored in 'mo_tables'
+	// PropCompression is the compress algorithm of the column data, set by the table option COMPRESSION
+	PropCompression = "compression"
+	// PropBloomFilterColumns and PropNgramFilterColumns are the columns besides
+	// the sort key that get block level filters, set by the table option PROPERTIES
+	PropBloomFilterColumns = "bloom_filter_columns"
+	PropNgramFilterColumns = "ngram_filter_columns"
+	// PropTTLColumn and PropTTLInterval are the row expiry of the table, set by
+	// the table option TTL as 'column + INTERVAL n unit'
+	PropTTLColumn   = "ttl_column"
+	PropTTLInterval = "ttl_interval"
+
 	// 'mo_indexes' table
 	IndexAlgoName      = "algo"
 	IndexAlgoTableType = "algo_table_type"
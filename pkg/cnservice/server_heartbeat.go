@@ -91,6 +91,11 @@ func (s *service) heartbeat(ctx context.Context) {
 		},
 		CommitID: version.CommitID,
 	}
+	sessions, queries := s.sessionMgr.GetLoad()
+	hb.Load = logservicepb.CNLoad{
+		ActiveSessions: uint64(sessions),
+		ActiveQueries:  uint64(queries),
+	}
 	if s.gossipNode != nil {
 		hb.GossipAddress = s.gossipServiceAddr()
 		hb.GossipJoined = s.gossipNode.Joined()
@@ -86,6 +86,12 @@ const (
 	ErrWrongDatetimeSpec    uint16 = 20310
 	ErrUpgrateError         uint16 = 20311
 	ErrInvalidTz            uint16 = 20312
+	ErrUserLocked           uint16 = 20313
+	ErrUserBlocked          uint16 = 20314
+	ErrMustChangePassword   uint16 = 20315
+	ErrPasswordExpired      uint16 = 20316
+	ErrInvalidPassword      uint16 = 20317
+	ErrPasswordReused       uint16 = 20318
 
 	// Group 4: unexpected state and io errors
 	ErrInvalidState                             uint16 = 20400
@@ -334,6 +340,12 @@ var errorMsgRefer = map[uint16]moErrorMsgItem{
 	ErrBadFieldError:        {ER_BAD_FIELD_ERROR, []string{MySQLDefaultSqlState}, "Unknown column '%s' in '%s'"},
 	ErrWrongDatetimeSpec:    {ER_WRONG_DATETIME_SPEC, []string{MySQLDefaultSqlState}, "wrong date/time format specifier: %s"},
 	ErrUpgrateError:         {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "CN upgrade table or view '%s.%s' under tenant '%s:%d' reports error: %s"},
+	ErrUserLocked:           {ER_ACCOUNT_HAS_BEEN_LOCKED, []string{MySQLDefaultSqlState}, "Access denied for user '%s'. Account is locked."},
+	ErrUserBlocked:          {ER_USER_ACCESS_DENIED_FOR_USER_ACCOUNT_BLOCKED_BY_PASSWORD_LOCK, []string{MySQLDefaultSqlState}, "Access denied for user '%s'. Account is blocked for %s due to %d consecutive failed logins."},
+	ErrMustChangePassword:   {ER_MUST_CHANGE_PASSWORD, []string{MySQLDefaultSqlState}, "You must reset your password using ALTER USER statement before executing this statement."},
+	ErrPasswordExpired:      {ER_MUST_CHANGE_PASSWORD_LOGIN, []string{MySQLDefaultSqlState}, "Your password has expired. To log in you must change it using a client that supports expired passwords."},
+	ErrInvalidPassword:      {ER_NOT_VALID_PASSWORD, []string{MySQLDefaultSqlState}, "Your password does not satisfy the current policy requirements: %s"},
+	ErrPasswordReused:       {ER_CREDENTIALS_CONTRADICT_TO_HISTORY, []string{MySQLDefaultSqlState}, "Cannot use these credentials for '%s' because they contradict the password history policy"},
 
 	// Group 4: unexpected state or file io error
 	ErrInvalidState:                             {ER_UNKNOWN_ERROR, []string{MySQLDefaultSqlState}, "invalid state %s"},
@@ -1128,6 +1140,31 @@ func NewRoleGrantedToSelf(ctx context.Context, from, to string) *Error {
 	return newError(ctx, ErrRoleGrantedToSelf, from, to)
 }
 
+func NewUserLocked(ctx context.Context, user string) *Error {
+	return newError(ctx, ErrUserLocked, user)
+}
+
+func NewUserBlocked(ctx context.Context, user string, lockTime string, attempts int64) *Error {
+	return newError(ctx, ErrUserBlocked, user, lockTime, attempts)
+}
+
+func NewMustChangePassword(ctx context.Context) *Error {
+	return newError(ctx, ErrMustChangePassword)
+}
+
+func NewPasswordExpired(ctx context.Context) *Error {
+	return newError(ctx, ErrPasswordExpired)
+}
+
+func NewInvalidPassword(ctx context.Context, msg string, args ...any) *Error {
+	xmsg := fmt.Sprintf(msg, args...)
+	return newError(ctx, ErrInvalidPassword, xmsg)
+}
+
+func NewPasswordReused(ctx context.Context, user string) *Error {
+	return newError(ctx, ErrPasswordReused, user)
+}
+
 func NewTxnInternal(ctx context.Context) *Error {
 	return newError(ctx, ErrTxnInternal)
 }
@@ -15,12 +15,38 @@
 package compress
 
 import (
+	"fmt"
+
+	"github.com/klauspost/compress/zstd"
 	"github.com/pierrec/lz4/v4"
 )
 
 var Algorithms map[string]int = map[string]int{
 	"lz4":  Lz4,
 	"none": None,
+	"zstd": Zstd,
+}
+
+var (
+	// zstd encoder and decoder are safe for concurrent use of EncodeAll and DecodeAll
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+)
+
+// CompressBlockBound returns the max size of the compressed data of n bytes
+func CompressBlockBound(n int, typ int) int {
+	switch typ {
+	case Lz4:
+		return lz4.CompressBlockBound(n)
+	case Zstd:
+		// same as ZSTD_COMPRESSBOUND
+		bound := n + n>>8
+		if n < 128<<10 {
+			bound += (128<<10 - n) >> 11
+		}
+		return bound
+	}
+	return n
 }
 
 func Compress(src, dst []byte, typ int) ([]byte, error) {
@@ -31,6 +57,8 @@ func Compress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		return zstdEncoder.EncodeAll(src, dst[:0]), nil
 	}
 	return nil, nil
 }
@@ -43,6 +71,16 @@ func Decompress(src, dst []byte, typ int) ([]byte, error) {
 			return nil, err
 		}
 		return dst[:n], nil
+	case Zstd:
+		data, err := zstdDecoder.DecodeAll(src, dst[:0])
+		if err != nil {
+			return nil, err
+		}
+		// the caller expects the data is decompressed into dst
+		if len(data) > len(dst) {
+			return nil, fmt.Errorf("zstd: decompressed size %d exceeds buffer size %d", len(data), len(dst))
+		}
+		return data, nil
 	}
 	return nil, nil
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 
 	"github.com/pierrec/lz4/v4"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLz4(t *testing.T) {
@@ -41,3 +42,23 @@ func TestLz4(t *testing.T) {
 	}
 	fmt.Printf("dat: %v\n", data)
 }
+
+func TestZstd(t *testing.T) {
+	xs := make([]int64, 8192)
+	for i := range xs {
+		xs[i] = int64(i % 100)
+	}
+	raw := types.EncodeSlice(xs)
+	buf := make([]byte, CompressBlockBound(len(raw), Zstd))
+	buf, err := Compress(raw, buf, Zstd)
+	require.NoError(t, err)
+	require.Less(t, len(buf), len(raw))
+
+	data, err := Decompress(buf, make([]byte, len(raw)), Zstd)
+	require.NoError(t, err)
+	require.Equal(t, raw, data)
+
+	_, err = Decompress(buf, make([]byte, len(raw)/2), Zstd)
+	require.Error(t, err)
+	require.Equal(t, "ZSTD", T(Zstd).String())
+}
@@ -19,6 +19,7 @@ import "fmt"
 const (
 	None = iota
 	Lz4
+	Zstd
 )
 
 type T uint8
@@ -29,6 +30,8 @@ func (t T) String() string {
 		return "None"
 	case Lz4:
 		return "LZ4"
+	case Zstd:
+		return "ZSTD"
 	}
 	return fmt.Sprintf("unexpected compress type: %d", t)
 }
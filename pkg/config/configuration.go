@@ -123,6 +123,18 @@ var (
 	// defaultSessionTimeout default: 24 hour
 	defaultSessionTimeout = 24 * time.Hour
 
+	// defaultZlibCompressionLevel default: 6
+	defaultZlibCompressionLevel = 6
+
+	// defaultAuthenticationPlugin default: mysql_native_password
+	defaultAuthenticationPlugin = "mysql_native_password"
+
+	// defaultCursorIdleTimeout default: 10 minutes
+	defaultCursorIdleTimeout = 10 * time.Minute
+
+	// defaultCursorMaxBufferSize default: 64MB
+	defaultCursorMaxBufferSize int64 = 64 << 20
+
 	// defaultOBShowStatsInterval default: 1min
 	defaultOBShowStatsInterval = time.Minute
 
@@ -243,6 +255,18 @@ type FrontendParameters struct {
 	//default is ''. Path of file that contains X509 key in PEM format for client
 	TlsKeyFile string `toml:"tlsKeyFile" user_setting:"advanced"`
 
+	//default is false. With true. Server will support the compressed protocol with zlib and zstd
+	EnableCompression bool `toml:"enableCompression" user_setting:"advanced"`
+
+	//default is 6. Level of zlib compression, the level of zstd is sent by the client
+	ZlibCompressionLevel int `toml:"zlibCompressionLevel" user_setting:"advanced"`
+
+	//default is mysql_native_password. Authentication plugin advertised in the handshake, mysql_native_password or caching_sha2_password
+	DefaultAuthenticationPlugin string `toml:"defaultAuthenticationPlugin" user_setting:"advanced"`
+
+	//default is ''. Path of file that contains RSA private key in PEM format for caching_sha2_password. A key is generated if it is empty
+	CachingSha2PasswordPrivateKeyFile string `toml:"cachingSha2PasswordPrivateKeyFile" user_setting:"advanced"`
+
 	//default is 1
 	LogShardID uint64 `toml:"logshardid"`
 
@@ -254,6 +278,12 @@ type FrontendParameters struct {
 	//timeout of the session. the default is 10minutes
 	SessionTimeout toml.Duration `toml:"sessionTimeout"`
 
+	//default is 10 minutes. The cursor opened by COM_STMT_EXECUTE is closed if the client does not fetch in time
+	CursorIdleTimeout toml.Duration `toml:"cursorIdleTimeout" user_setting:"advanced"`
+
+	//default is 64MB. Max size of the rows buffered by a cursor when the client runs other commands before fetching all rows
+	CursorMaxBufferSize int64 `toml:"cursorMaxBufferSize" user_setting:"advanced"`
+
 	// MaxMessageSize max size for read messages from dn. Default is 10M
 	MaxMessageSize uint64 `toml:"max-message-size"`
 
@@ -373,6 +403,22 @@ func (fp *FrontendParameters) SetDefaultValues() {
 		fp.SessionTimeout.Duration = defaultSessionTimeout
 	}
 
+	if fp.ZlibCompressionLevel == 0 {
+		fp.ZlibCompressionLevel = defaultZlibCompressionLevel
+	}
+
+	if fp.DefaultAuthenticationPlugin == "" {
+		fp.DefaultAuthenticationPlugin = defaultAuthenticationPlugin
+	}
+
+	if fp.CursorIdleTimeout.Duration == 0 {
+		fp.CursorIdleTimeout.Duration = defaultCursorIdleTimeout
+	}
+
+	if fp.CursorMaxBufferSize == 0 {
+		fp.CursorMaxBufferSize = defaultCursorMaxBufferSize
+	}
+
 	if fp.SaveQueryResult == "" {
 		fp.SaveQueryResult = "off"
 	}
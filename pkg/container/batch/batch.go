@@ -120,6 +120,7 @@ func (bat *Batch) Shrink(sels []int64, negate bool) {
 	for _, vec := range bat.Vecs {
 		vec.Shrink(sels, negate)
 	}
+	bat.dictGroups = nil
 	if negate {
 		bat.rowCount -= len(sels)
 		return
@@ -140,6 +141,7 @@ func (bat *Batch) Shuffle(sels []int64, m *mpool.MPool) error {
 			}
 		}
 		bat.rowCount = len(sels)
+		bat.dictGroups = nil
 	}
 	return nil
 }
@@ -179,6 +181,37 @@ func (bat *Batch) GetVector(pos int32) *vector.Vector {
 	return bat.Vecs[pos]
 }
 
+// SetDictGroups records the dictionary groups of the column at pos. They
+// describe the current rows of the vector and are ignored once the vector
+// is replaced or changes length, and dropped when the batch is shrunk.
+func (bat *Batch) SetDictGroups(pos int, groups []uint32, count int) {
+	if len(bat.dictGroups) < len(bat.Vecs) {
+		bat.dictGroups = append(bat.dictGroups, make([]*DictGroups, len(bat.Vecs)-len(bat.dictGroups))...)
+	}
+	bat.dictGroups[pos] = &DictGroups{
+		vec:    bat.Vecs[pos],
+		Groups: groups,
+		Count:  count,
+	}
+}
+
+// GetDictGroups returns the dictionary groups of the column at pos, it
+// returns nil if there is none or they do not match the column any more
+func (bat *Batch) GetDictGroups(pos int) *DictGroups {
+	if pos >= len(bat.dictGroups) || pos >= len(bat.Vecs) {
+		return nil
+	}
+	dict := bat.dictGroups[pos]
+	if dict == nil || dict.vec != bat.Vecs[pos] || len(dict.Groups) != dict.vec.Length() {
+		return nil
+	}
+	return dict
+}
+
+func (bat *Batch) HasDictGroups() bool {
+	return len(bat.dictGroups) > 0
+}
+
 func (bat *Batch) GetSubBatch(cols []string) *Batch {
 	mp := make(map[string]int)
 	for i, attr := range bat.Attrs {
@@ -213,6 +246,7 @@ func (bat *Batch) Clean(m *mpool.MPool) {
 	bat.Aggs = nil
 	bat.Vecs = nil
 	bat.Attrs = nil
+	bat.dictGroups = nil
 	bat.SetRowCount(0)
 }
 
@@ -238,6 +272,7 @@ func (bat *Batch) CleanOnlyData() {
 			vec.CleanOnlyData()
 		}
 	}
+	bat.dictGroups = nil
 	bat.rowCount = 0
 }
 
@@ -190,4 +190,16 @@ type Batch struct {
 
 	// row count of batch, to instead of old len(Zs).
 	rowCount int
+
+	// dictGroups holds the dictionary groups of the columns read from
+	// dictionary encoded blocks, indexed by column position
+	dictGroups []*DictGroups
+}
+
+// DictGroups is the group of every row of a dictionary encoded column.
+// Rows with the same value share a group and Count is the number of groups.
+type DictGroups struct {
+	vec    *vector.Vector
+	Groups []uint32
+	Count  int
 }
@@ -0,0 +1,484 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	gotrace "runtime/trace"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	// azureBlockSize is the size of the blocks staged by uploads
+	azureBlockSize = 8 << 20
+	// azureUploadConcurrency is the number of blocks staged concurrently
+	azureUploadConcurrency = 4
+)
+
+// AzureSDK is the ObjectStorage of Azure Blob Storage. The bucket is the
+// container name, and the key id and secret are the account name and key.
+type AzureSDK struct {
+	name            string
+	container       *container.Client
+	perfCounterSets []*perfcounter.CounterSet
+	listMaxResults  int32
+}
+
+func NewAzureSDK(
+	ctx context.Context,
+	args ObjectStorageArguments,
+	perfCounterSets []*perfcounter.CounterSet,
+) (_ *AzureSDK, err error) {
+	defer catch(&err)
+
+	serviceURL, err := args.azureServiceURL()
+	if err != nil {
+		return nil, err
+	}
+	opts := &azblob.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Transport: newHTTPClient(args),
+			Retry: policy.RetryOptions{
+				MaxRetries: 8,
+			},
+		},
+	}
+	client, err := args.newAzureClient(ctx, serviceURL, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	logutil.Info("new object storage",
+		zap.Any("sdk", "azure"),
+		zap.Any("arguments", args),
+	)
+
+	containerClient := client.ServiceClient().NewContainerClient(args.Bucket)
+	if !args.NoBucketValidation {
+		// validate container
+		_, err := containerClient.GetProperties(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &AzureSDK{
+		name:            args.Name,
+		container:       containerClient,
+		perfCounterSets: perfCounterSets,
+	}, nil
+}
+
+var _ ObjectStorage = new(AzureSDK)
+
+func (a *AzureSDK) List(
+	ctx context.Context,
+	prefix string,
+	fn func(bool, string, int64) (bool, error),
+) error {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	opts := &container.ListBlobsHierarchyOptions{}
+	if prefix != "" {
+		opts.Prefix = &prefix
+	}
+	if a.listMaxResults > 0 {
+		opts.MaxResults = &a.listMaxResults
+	}
+	pager := a.container.NewListBlobsHierarchyPager("/", opts)
+
+	for pager.More() {
+		page, err := a.listBlobs(ctx, func() (container.ListBlobsHierarchyResponse, error) {
+			return pager.NextPage(ctx)
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, item := range page.Segment.BlobItems {
+			var size int64
+			if item.Properties != nil && item.Properties.ContentLength != nil {
+				size = *item.Properties.ContentLength
+			}
+			more, err := fn(false, *item.Name, size)
+			if err != nil {
+				return err
+			}
+			if !more {
+				return nil
+			}
+		}
+
+		for _, prefix := range page.Segment.BlobPrefixes {
+			more, err := fn(true, *prefix.Name, 0)
+			if err != nil {
+				return err
+			}
+			if !more {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+func (a *AzureSDK) Stat(
+	ctx context.Context,
+	key string,
+) (
+	size int64,
+	err error,
+) {
+
+	defer func() {
+		if a.is404(err) {
+			err = moerr.NewFileNotFoundNoCtx(key)
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	props, err := a.getProperties(ctx, key)
+	if err != nil {
+		return
+	}
+	if props.ContentLength != nil {
+		size = *props.ContentLength
+	}
+
+	return
+}
+
+func (a *AzureSDK) Exists(
+	ctx context.Context,
+	key string,
+) (
+	bool,
+	error,
+) {
+
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	_, err := a.getProperties(ctx, key)
+	if err != nil {
+		if a.is404(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (a *AzureSDK) Write(
+	ctx context.Context,
+	key string,
+	r io.Reader,
+	size int64,
+	expire *time.Time,
+) (
+	err error,
+) {
+	defer catch(&err)
+	ctx, task := gotrace.NewTask(ctx, "AzureSDK.Write")
+	defer task.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Put.Add(1)
+	}, a.perfCounterSets...)
+
+	// the blocks are staged and committed by the block list,
+	// not retryable because Reader may be half consumed
+	opts := &blockblob.UploadStreamOptions{
+		BlockSize:   azureBlockSize,
+		Concurrency: azureUploadConcurrency,
+	}
+	if size >= 0 && size < azureBlockSize {
+		opts.Concurrency = 1
+	}
+	if expire != nil {
+		// blob has no expire time, it is kept in the metadata for lifecycle policies
+		value := expire.UTC().Format(time.RFC3339)
+		opts.Metadata = map[string]*string{
+			"expire": &value,
+		}
+	}
+	_, err = a.container.NewBlockBlobClient(key).UploadStream(ctx, r, opts)
+	return err
+}
+
+func (a *AzureSDK) Read(
+	ctx context.Context,
+	key string,
+	min *int64,
+	max *int64,
+) (
+	r io.ReadCloser,
+	err error,
+) {
+
+	defer func() {
+		if a.is404(err) {
+			err = moerr.NewFileNotFoundNoCtx(key)
+		}
+	}()
+
+	if max == nil {
+		// read to end
+		r, err := a.getObject(
+			ctx,
+			key,
+			min,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return r, nil
+	}
+
+	r, err = a.getObject(
+		ctx,
+		key,
+		min,
+		max,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &readCloser{
+		r:         io.LimitReader(r, int64(*max-*min)),
+		closeFunc: r.Close,
+	}, nil
+}
+
+func (a *AzureSDK) Delete(
+	ctx context.Context,
+	keys ...string,
+) (
+	err error,
+) {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := a.deleteSingle(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *AzureSDK) deleteSingle(ctx context.Context, key string) error {
+	ctx, span := trace.Start(ctx, "AzureSDK.deleteSingle")
+	defer span.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Delete.Add(1)
+	}, a.perfCounterSets...)
+
+	_, err := DoWithRetry(
+		"azure delete blob",
+		func() (blob.DeleteResponse, error) {
+			return a.container.NewBlobClient(key).Delete(ctx, nil)
+		},
+		maxRetryAttemps,
+		IsRetryableError,
+	)
+	if err != nil && !a.is404(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (a *AzureSDK) listBlobs(
+	ctx context.Context,
+	fn func() (container.ListBlobsHierarchyResponse, error),
+) (container.ListBlobsHierarchyResponse, error) {
+	ctx, task := gotrace.NewTask(ctx, "AzureSDK.listBlobs")
+	defer task.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.List.Add(1)
+	}, a.perfCounterSets...)
+	return DoWithRetry(
+		"azure list blobs",
+		fn,
+		maxRetryAttemps,
+		IsRetryableError,
+	)
+}
+
+func (a *AzureSDK) getProperties(ctx context.Context, key string) (blob.GetPropertiesResponse, error) {
+	ctx, task := gotrace.NewTask(ctx, "AzureSDK.getProperties")
+	defer task.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Head.Add(1)
+	}, a.perfCounterSets...)
+	return DoWithRetry(
+		"azure get blob properties",
+		func() (blob.GetPropertiesResponse, error) {
+			return a.container.NewBlobClient(key).GetProperties(ctx, nil)
+		},
+		maxRetryAttemps,
+		IsRetryableError,
+	)
+}
+
+func (a *AzureSDK) getObject(ctx context.Context, key string, min *int64, max *int64) (io.ReadCloser, error) {
+	ctx, task := gotrace.NewTask(ctx, "AzureSDK.getObject")
+	defer task.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Get.Add(1)
+	}, a.perfCounterSets...)
+	var start int64
+	if min != nil {
+		start = *min
+	}
+	r, err := newRetryableReader(
+		func(offset int64) (io.ReadCloser, error) {
+			// zero count reads to the end
+			rang := blob.HTTPRange{
+				Offset: offset,
+			}
+			if max != nil {
+				rang.Count = *max - offset
+			}
+			resp, err := DoWithRetry(
+				"azure download blob",
+				func() (blob.DownloadStreamResponse, error) {
+					return a.container.NewBlobClient(key).DownloadStream(ctx, &blob.DownloadStreamOptions{
+						Range: rang,
+					})
+				},
+				maxRetryAttemps,
+				IsRetryableError,
+			)
+			if err != nil {
+				return nil, err
+			}
+			return resp.Body, nil
+		},
+		start,
+		IsRetryableError,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (a *AzureSDK) is404(err error) bool {
+	if err == nil {
+		return false
+	}
+	if bloberror.HasCode(err, bloberror.BlobNotFound, bloberror.ResourceNotFound) {
+		return true
+	}
+	var respErr *azcore.ResponseError
+	if errors.As(err, &respErr) {
+		return respErr.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
+// azureServiceURL returns the endpoint, or the endpoint of the account named by the key id
+func (o ObjectStorageArguments) azureServiceURL() (string, error) {
+	if o.Endpoint != "" {
+		if !strings.Contains(o.Endpoint, "://") {
+			return "https://" + o.Endpoint, nil
+		}
+		return o.Endpoint, nil
+	}
+	if o.KeyID == "" {
+		return "", moerr.NewInvalidInputNoCtx("azure endpoint or account name (key-id) is required")
+	}
+	return fmt.Sprintf("https://%s.blob.core.windows.net/", o.KeyID), nil
+}
+
+// newAzureClient creates the client by the credentials in the order of
+// account key, SAS token, default credential chain and anonymous access
+func (o ObjectStorageArguments) newAzureClient(
+	ctx context.Context,
+	serviceURL string,
+	opts *azblob.ClientOptions,
+) (*azblob.Client, error) {
+
+	// account key
+	if o.KeyID != "" && o.KeySecret != "" {
+		logutil.Info("azure: using account key", zap.Any("account", o.KeyID))
+		cred, err := azblob.NewSharedKeyCredential(o.KeyID, o.KeySecret)
+		if err != nil {
+			return nil, err
+		}
+		return azblob.NewClientWithSharedKeyCredential(serviceURL, cred, opts)
+	}
+
+	// SAS token
+	if o.SessionToken != "" {
+		logutil.Info("azure: using SAS token")
+		sep := "?"
+		if strings.Contains(serviceURL, "?") {
+			sep = "&"
+		}
+		return azblob.NewClientWithNoCredential(
+			serviceURL+sep+strings.TrimPrefix(o.SessionToken, "?"),
+			opts,
+		)
+	}
+
+	// environment, workload identity, managed identity and azure cli
+	if o.shouldLoadDefaultCredentials() {
+		logutil.Info("azure: using default credential chain")
+		cred, err := azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{
+			ClientOptions: opts.ClientOptions,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return azblob.NewClient(serviceURL, cred, opts)
+	}
+
+	logutil.Info("azure: using anonymous access")
+	return azblob.NewClientWithNoCredential(serviceURL, opts)
+}
@@ -41,6 +41,13 @@ type CacheConfig struct {
 	RPC                  morpc.Config   `toml:"rpc"`
 	CheckOverlaps        bool           `toml:"check-overlaps"`
 
+	// ReadAheadCapacity is the max bytes of the read ahead buffers of sequential scans
+	ReadAheadCapacity *toml.ByteSize `toml:"read-ahead-capacity"`
+	// ReadAheadMaxWindow is the max bytes to read ahead of a sequential reader
+	ReadAheadMaxWindow *toml.ByteSize `toml:"read-ahead-max-window"`
+	// ReadAheadConcurrency is the max number of concurrent read ahead operations
+	ReadAheadConcurrency int `toml:"read-ahead-concurrency"`
+
 	QueryClient      client.QueryClient            `json:"-"`
 	KeyRouterFactory KeyRouterFactory[pb.CacheKey] `json:"-"`
 	KeyRouter        client.KeyRouter[pb.CacheKey] `json:"-"`
@@ -76,6 +83,17 @@ func (c *CacheConfig) setDefaults() {
 		target := 0.8
 		c.DiskEvictTarget = &target
 	}
+	if c.ReadAheadCapacity == nil {
+		size := toml.ByteSize(256 << 20)
+		c.ReadAheadCapacity = &size
+	}
+	if c.ReadAheadMaxWindow == nil {
+		size := toml.ByteSize(8 << 20)
+		c.ReadAheadMaxWindow = &size
+	}
+	if c.ReadAheadConcurrency <= 0 {
+		c.ReadAheadConcurrency = 16
+	}
 	c.RPC.Adjust()
 }
 
@@ -121,6 +139,8 @@ func (c *CacheConfig) SetRemoteCacheCallback() {
 var DisabledCacheConfig = CacheConfig{
 	MemoryCapacity: ptrTo[toml.ByteSize](DisableCacheCapacity),
 	DiskCapacity:   ptrTo[toml.ByteSize](DisableCacheCapacity),
+
+	ReadAheadCapacity: ptrTo[toml.ByteSize](DisableCacheCapacity),
 }
 
 const DisableCacheCapacity = 1
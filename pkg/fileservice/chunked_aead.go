@@ -0,0 +1,189 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bufio"
+	"context"
+	"crypto/cipher"
+	"encoding/binary"
+	"io"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	ChunkedAEADNonceSize = 12
+	ChunkedAEADTagSize   = 16
+)
+
+// ChunkedAEAD seals a stream chunk by chunk. The chunks are sealed by an
+// AES-GCM with the nonce of the stream xor the chunk index, and the last
+// chunk is marked in the additional data, so chunks can not be reordered or
+// truncated. A chunk can be opened alone, which serves the range reads.
+//
+// The sealed body is the sealed chunks only, the nonce is kept by the
+// callers, in the headers of their files.
+type ChunkedAEAD struct {
+	aead      cipher.AEAD
+	chunkSize int64
+}
+
+func NewChunkedAEAD(aead cipher.AEAD, chunkSize int64) *ChunkedAEAD {
+	return &ChunkedAEAD{
+		aead:      aead,
+		chunkSize: chunkSize,
+	}
+}
+
+func (c *ChunkedAEAD) sealedChunkSize() int64 {
+	return c.chunkSize + ChunkedAEADTagSize
+}
+
+// ChunkedPlainSize returns the plaintext size of a sealed body of size
+// bytes, sealed in chunks of chunkSize
+func ChunkedPlainSize(size, chunkSize int64) int64 {
+	if size < ChunkedAEADTagSize {
+		return 0
+	}
+	sealedChunkSize := chunkSize + ChunkedAEADTagSize
+	n := (size + sealedChunkSize - 1) / sealedChunkSize
+	return size - n*ChunkedAEADTagSize
+}
+
+// SealedRange returns the range in the sealed body of the chunks covering
+// the plaintext of [offset, offset+size), plainSize is the size of the
+// whole plaintext
+func (c *ChunkedAEAD) SealedRange(plainSize, offset, size int64) (start, end int64) {
+	first, last := offset/c.chunkSize, (offset+size-1)/c.chunkSize
+	start = first * c.sealedChunkSize()
+	end = last*c.sealedChunkSize() +
+		min(c.sealedChunkSize(), plainSize-last*c.chunkSize+ChunkedAEADTagSize)
+	return
+}
+
+// Open opens the sealed chunks of file read by SealedRange and returns the
+// plaintext of [offset, offset+size)
+func (c *ChunkedAEAD) Open(
+	ctx context.Context,
+	file string,
+	nonce, sealed []byte,
+	plainSize, offset, size int64,
+) ([]byte, error) {
+	lastChunk := int64(0)
+	if plainSize > 0 {
+		lastChunk = (plainSize - 1) / c.chunkSize
+	}
+	first, last := offset/c.chunkSize, (offset+size-1)/c.chunkSize
+	plain := make([]byte, 0, (last-first+1)*c.chunkSize)
+	for idx := first; idx <= last; idx++ {
+		n := min(c.sealedChunkSize(), int64(len(sealed)))
+		var err error
+		plain, err = c.aead.Open(plain, chunkNonce(nonce, idx), sealed[:n], chunkAD(idx, idx == lastChunk))
+		if err != nil {
+			return nil, moerr.NewInternalError(ctx, "decrypt chunk %d of %s: %v", idx, file, err)
+		}
+		sealed = sealed[n:]
+	}
+	skip := offset - first*c.chunkSize
+	return plain[skip : skip+size], nil
+}
+
+// NewSealReader returns a reader of the header followed by the sealed
+// chunks of src
+func (c *ChunkedAEAD) NewSealReader(nonce, header []byte, src io.Reader) io.Reader {
+	out := make([]byte, 0, max(int(c.sealedChunkSize()), len(header)))
+	out = append(out, header...)
+	return &chunkReader{
+		src: bufio.NewReaderSize(src, int(c.chunkSize)),
+		buf: make([]byte, c.chunkSize),
+		out: out,
+		next: func(dst, chunk []byte, idx int64, last bool) ([]byte, error) {
+			return c.aead.Seal(dst, chunkNonce(nonce, idx), chunk, chunkAD(idx, last)), nil
+		},
+	}
+}
+
+// NewOpenReader returns a reader of the plaintext of the sealed body read
+// from src
+func (c *ChunkedAEAD) NewOpenReader(ctx context.Context, nonce []byte, src io.Reader) io.Reader {
+	return &chunkReader{
+		src: bufio.NewReaderSize(src, int(c.sealedChunkSize())),
+		buf: make([]byte, c.sealedChunkSize()),
+		next: func(dst, chunk []byte, idx int64, last bool) ([]byte, error) {
+			plain, err := c.aead.Open(dst, chunkNonce(nonce, idx), chunk, chunkAD(idx, last))
+			if err != nil {
+				return nil, moerr.NewInternalError(ctx, "decrypt chunk %d: %v", idx, err)
+			}
+			return plain, nil
+		},
+	}
+}
+
+func chunkNonce(base []byte, idx int64) []byte {
+	nonce := make([]byte, ChunkedAEADNonceSize)
+	copy(nonce, base)
+	n := binary.BigEndian.Uint64(nonce[4:]) ^ uint64(idx)
+	binary.BigEndian.PutUint64(nonce[4:], n)
+	return nonce
+}
+
+func chunkAD(idx int64, last bool) []byte {
+	ad := make([]byte, 9)
+	binary.BigEndian.PutUint64(ad, uint64(idx))
+	if last {
+		ad[8] = 1
+	}
+	return ad
+}
+
+// chunkReader reads src chunk by chunk and returns the chunks transformed
+// by next, which knows whether a chunk is the last one
+type chunkReader struct {
+	src  *bufio.Reader
+	idx  int64
+	buf  []byte
+	out  []byte
+	done bool
+	next func(dst, chunk []byte, idx int64, last bool) ([]byte, error)
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		n, err := io.ReadFull(r.src, r.buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return 0, err
+		}
+		last := err != nil
+		if !last {
+			if _, err = r.src.Peek(1); err == io.EOF {
+				last = true
+			} else if err != nil {
+				return 0, err
+			}
+		}
+		if r.out, err = r.next(r.out[:0], r.buf[:n], r.idx, last); err != nil {
+			return 0, err
+		}
+		r.idx++
+		r.done = last
+	}
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	return n, nil
+}
@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/perfcounter"
 )
 
@@ -29,13 +30,15 @@ const (
 	diskETLFileServiceBackend = "DISK-ETL"
 	s3FileServiceBackend      = "S3"
 	minioFileServiceBackend   = "MINIO"
+	azureFileServiceBackend   = "AZURE"
+	gcsFileServiceBackend     = "GCS"
 )
 
 // Config fileService config
 type Config struct {
 	// Name name of fileservice, describe what an instance of fileservice is used for
 	Name string `toml:"name"`
-	// Backend fileservice backend. [MEM|DISK|DISK-ETL|S3|MINIO]
+	// Backend fileservice backend. [MEM|DISK|DISK-ETL|S3|MINIO|AZURE|GCS]
 	Backend string `toml:"backend"`
 	// S3 used to create fileservice using s3 as the backend
 	S3 ObjectStorageArguments `toml:"s3"`
@@ -45,6 +48,40 @@ type Config struct {
 	DataDir string `toml:"data-dir"`
 	// FixMissing inidicates the file service to try its best to fix missing files
 	FixMissing bool `toml:"fix-missing"`
+	// Encryption specifies configs for encryption at rest
+	Encryption EncryptionConfig `toml:"encryption"`
+}
+
+// EncryptionConfig encryption at rest config
+type EncryptionConfig struct {
+	// Enable encrypts the files by the data keys wrapped by the master keys
+	Enable bool `toml:"enable"`
+	// KeyFile is the file of the master keys, a key id and a hex encoded key each line
+	KeyFile string `toml:"key-file"`
+	// KMSDir is the dir of the local KMS, a master key each file named by the key id.
+	// It is used if KeyFile is empty
+	KMSDir string `toml:"kms-dir"`
+	// KeyID is the id of the master key wrapping the data keys of new files
+	KeyID string `toml:"key-id"`
+	// SubPathKeys maps sub paths to the ids of the master keys, the files
+	// under a sub path are encrypted by the master key of it instead of KeyID.
+	// It is used for the account specific keys
+	SubPathKeys map[string]string `toml:"sub-path-keys"`
+}
+
+// Validate checks the config. The encryption is rejected for the ETL file
+// services, which must save the contents as-is, and for the LOCAL one, which
+// must be a ReplaceableFileService.
+func (c Config) Validate() error {
+	if !c.Encryption.Enable {
+		return nil
+	}
+	if strings.EqualFold(c.Backend, diskETLFileServiceBackend) ||
+		strings.EqualFold(c.Name, defines.ETLFileServiceName) ||
+		strings.EqualFold(c.Name, defines.LocalFileServiceName) {
+		return moerr.NewInternalErrorNoCtx("encryption is not supported by file service %s of backend %s", c.Name, c.Backend)
+	}
+	return nil
 }
 
 // NewFileServicesFunc creates a new *FileServices
@@ -57,20 +94,52 @@ func NewFileService(
 	if cfg.Name == "" {
 		panic("empty name")
 	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	var fs FileService
+	var err error
 	switch strings.ToUpper(cfg.Backend) {
 	case memFileServiceBackend:
-		return newMemFileService(cfg, perfCounterSets)
+		fs, err = newMemFileService(cfg, perfCounterSets)
 	case diskFileServiceBackend:
-		return newDiskFileService(ctx, cfg, perfCounterSets)
+		fs, err = newDiskFileService(ctx, cfg, perfCounterSets)
 	case diskETLFileServiceBackend:
-		return newDiskETLFileService(cfg, perfCounterSets)
+		fs, err = newDiskETLFileService(cfg, perfCounterSets)
 	case minioFileServiceBackend:
-		return newMinioFileService(ctx, cfg, perfCounterSets)
+		fs, err = newMinioFileService(ctx, cfg, perfCounterSets)
 	case s3FileServiceBackend:
-		return newS3FileService(ctx, cfg, perfCounterSets)
+		fs, err = newS3FileService(ctx, cfg, perfCounterSets)
+	case azureFileServiceBackend:
+		cfg.S3.IsAzure = true
+		fs, err = newS3FileService(ctx, cfg, perfCounterSets)
+	case gcsFileServiceBackend:
+		cfg.S3.IsGCS = true
+		fs, err = newS3FileService(ctx, cfg, perfCounterSets)
 	default:
 		return nil, moerr.NewInternalErrorNoCtx("file service backend %s not implemented", cfg.Backend)
 	}
+	if err != nil || !cfg.Encryption.Enable {
+		return fs, err
+	}
+	return newEncryptedFileService(ctx, cfg, fs)
+}
+
+func newEncryptedFileService(ctx context.Context, cfg Config, upstream FileService) (FileService, error) {
+	var keys MasterKeyProvider
+	switch {
+	case cfg.Encryption.KeyFile != "":
+		var err error
+		keys, err = NewKeyFileProvider(ctx, cfg.Encryption.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+	case cfg.Encryption.KMSDir != "":
+		keys = NewLocalKMSProvider(cfg.Encryption.KMSDir)
+	default:
+		return nil, moerr.NewInternalErrorNoCtx("no master keys for the encryption of file service %s", cfg.Name)
+	}
+	return NewEncryptedFS(upstream, keys, cfg.Encryption.KeyID, cfg.Encryption.SubPathKeys)
 }
 
 func newMemFileService(cfg Config, perfCounters []*perfcounter.CounterSet) (FileService, error) {
@@ -25,6 +25,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -47,6 +48,12 @@ type DiskCache struct {
 	}
 
 	cache *fifocache.Cache[string, struct{}]
+
+	// loaded indicates all cached files are loaded
+	loaded         atomic.Bool
+	closeOnce      sync.Once
+	closing        chan struct{}
+	indexSaverDone chan struct{}
 }
 
 func NewDiskCache(
@@ -83,19 +90,55 @@ func NewDiskCache(
 	}
 	ret.updatingPaths.Cond = sync.NewCond(new(sync.Mutex))
 	ret.updatingPaths.m = make(map[string]bool)
+	ret.closing = make(chan struct{})
+	ret.indexSaverDone = make(chan struct{})
 
 	if asyncLoad {
 		go ret.loadCache()
 	} else {
 		ret.loadCache()
 	}
+	go ret.runIndexSaver()
 
 	return ret, nil
 }
 
 func (d *DiskCache) loadCache() {
+	defer d.loaded.Store(true)
 	t0 := time.Now()
 
+	// load index
+	indexLoaded, clean, indexItems, err := d.loadIndex()
+	if err != nil {
+		logutil.Warn("load disk cache index failed, loading from files",
+			zap.Any("path", d.indexPath()),
+			zap.Error(err),
+		)
+	}
+	if indexLoaded {
+		logutil.Info("disk cache index loaded",
+			zap.Any("entries", len(indexItems)),
+			zap.Any("clean", clean),
+			zap.Any("time", time.Since(t0)),
+		)
+		// files written from now on are not in the index
+		if err := d.saveIndex(false); err != nil {
+			logutil.Warn("save disk cache index failed",
+				zap.Any("path", d.indexPath()),
+				zap.Error(err),
+			)
+		}
+		if clean {
+			return
+		}
+	}
+
+	// walk files to reconcile with the index, or to load all
+	var walked map[string]struct{}
+	if indexLoaded {
+		walked = make(map[string]struct{}, len(indexItems))
+	}
+
 	type Info struct {
 		Path  string
 		Entry os.DirEntry
@@ -140,6 +183,9 @@ func (d *DiskCache) loadCache() {
 		}
 
 		numCacheFiles++
+		if walked != nil {
+			walked[path] = struct{}{}
+		}
 		works <- Info{
 			Path:  path,
 			Entry: entry,
@@ -151,9 +197,22 @@ func (d *DiskCache) loadCache() {
 	close(works)
 	wg.Wait()
 
+	// remove the indexed but not existed files
+	numRemoved := 0
+	for _, item := range indexItems {
+		if _, ok := walked[item.Key]; ok {
+			continue
+		}
+		if _, err := os.Stat(item.Key); os.IsNotExist(err) {
+			d.cache.Delete(item.Key)
+			numRemoved++
+		}
+	}
+
 	logutil.Info("disk cache info loaded",
 		zap.Any("all files", numFiles),
 		zap.Any("cache files", numCacheFiles),
+		zap.Any("removed index entries", numRemoved),
 		zap.Any("time", time.Since(t0)),
 	)
 
@@ -454,6 +513,22 @@ func (d *DiskCache) writeFile(
 func (d *DiskCache) Flush() {
 }
 
+// Close stops saving the index periodically, and saves the index for warm
+// restarts. The cache should not be updated after closed.
+func (d *DiskCache) Close() {
+	d.closeOnce.Do(func() {
+		close(d.closing)
+		<-d.indexSaverDone
+		// the index is clean only if all files are loaded
+		if err := d.saveIndex(d.loaded.Load()); err != nil {
+			logutil.Warn("save disk cache index failed",
+				zap.Any("path", d.indexPath()),
+				zap.Error(err),
+			)
+		}
+	})
+}
+
 const cacheFileSuffix = ".mofscache"
 
 func (d *DiskCache) pathForIOEntry(path string, entry IOEntry) string {
@@ -0,0 +1,240 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/fileservice/fifocache"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"go.uber.org/zap"
+)
+
+// the disk cache index persists the cached entries with their access
+// frequencies and eviction order, so restarts resume with the hot set, without
+// walking the cache directory.
+//
+// layout:
+//
+//	magic (8 bytes) | clean flag (1 byte) | uvarint count |
+//	entries: uvarint path length | path | uvarint size | freq (1 byte) | main (1 byte) |
+//	crc32 of all above (4 bytes)
+//
+// the index is written to a temp file and renamed, so it's either the old or
+// the new one. an index is clean only if it's written on close, otherwise the
+// files written after the last save are not in the index, and the directory
+// is walked in background to reconcile.
+
+const (
+	diskCacheIndexFileName = "disk_cache.index"
+	diskCacheIndexInterval = time.Minute
+)
+
+var diskCacheIndexMagic = []byte("MODCIDX1")
+
+var ErrBadDiskCacheIndex = errorStr("bad disk cache index")
+
+func (d *DiskCache) indexPath() string {
+	return filepath.Join(d.path, diskCacheIndexFileName)
+}
+
+// saveIndex writes the index of the cache
+func (d *DiskCache) saveIndex(clean bool) (err error) {
+	t0 := time.Now()
+	items := d.cache.Snapshot()
+
+	f, err := os.CreateTemp(d.path, diskCacheIndexFileName+".*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}()
+
+	hash := crc32.NewIEEE()
+	w := bufio.NewWriterSize(io.MultiWriter(f, hash), 1<<20)
+	var buf [binary.MaxVarintLen64]byte
+	writeUvarint := func(v uint64) {
+		n := binary.PutUvarint(buf[:], v)
+		_, _ = w.Write(buf[:n])
+	}
+
+	_, _ = w.Write(diskCacheIndexMagic)
+	if clean {
+		_ = w.WriteByte(1)
+	} else {
+		_ = w.WriteByte(0)
+	}
+	writeUvarint(uint64(len(items)))
+	for _, item := range items {
+		path, err := filepath.Rel(d.path, item.Key)
+		if err != nil {
+			return err
+		}
+		writeUvarint(uint64(len(path)))
+		_, _ = w.WriteString(path)
+		writeUvarint(uint64(item.Size))
+		_ = w.WriteByte(byte(item.Freq))
+		if item.Main {
+			_ = w.WriteByte(1)
+		} else {
+			_ = w.WriteByte(0)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if err := binary.Write(f, binary.LittleEndian, hash.Sum32()); err != nil {
+		return err
+	}
+
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(f.Name(), d.indexPath()); err != nil {
+		return err
+	}
+
+	logutil.Debug("disk cache index saved",
+		zap.Any("path", d.indexPath()),
+		zap.Any("entries", len(items)),
+		zap.Any("clean", clean),
+		zap.Any("time", time.Since(t0)),
+	)
+	return nil
+}
+
+// loadIndex restores the cache from the index file
+func (d *DiskCache) loadIndex() (
+	loaded bool,
+	clean bool,
+	items []fifocache.Item[string, struct{}],
+	err error,
+) {
+	// remove temp files of interrupted saves
+	if tmps, err := filepath.Glob(d.indexPath() + ".*"); err == nil {
+		for _, tmp := range tmps {
+			_ = os.Remove(tmp)
+		}
+	}
+
+	content, err := os.ReadFile(d.indexPath())
+	if os.IsNotExist(err) {
+		return false, false, nil, nil
+	}
+	if err != nil {
+		return false, false, nil, err
+	}
+
+	items, clean, err = d.decodeIndex(content)
+	if err != nil {
+		return false, false, nil, err
+	}
+	for _, item := range items {
+		d.cache.Restore(item)
+	}
+	return true, clean, items, nil
+}
+
+func (d *DiskCache) decodeIndex(content []byte) (
+	items []fifocache.Item[string, struct{}],
+	clean bool,
+	err error,
+) {
+	if len(content) < len(diskCacheIndexMagic)+1+4 ||
+		!bytes.Equal(content[:len(diskCacheIndexMagic)], diskCacheIndexMagic) {
+		return nil, false, ErrBadDiskCacheIndex
+	}
+	body := content[:len(content)-4]
+	if crc32.ChecksumIEEE(body) != binary.LittleEndian.Uint32(content[len(body):]) {
+		return nil, false, ErrBadDiskCacheIndex
+	}
+
+	r := bytes.NewReader(body[len(diskCacheIndexMagic):])
+	flag, _ := r.ReadByte()
+	clean = flag == 1
+	count, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, false, ErrBadDiskCacheIndex
+	}
+	if count > uint64(len(body)) {
+		return nil, false, ErrBadDiskCacheIndex
+	}
+	items = make([]fifocache.Item[string, struct{}], 0, count)
+	for i := uint64(0); i < count; i++ {
+		length, err := binary.ReadUvarint(r)
+		if err != nil || length > uint64(r.Len()) {
+			return nil, false, ErrBadDiskCacheIndex
+		}
+		path := make([]byte, length)
+		_, _ = io.ReadFull(r, path)
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, false, ErrBadDiskCacheIndex
+		}
+		freq, err := r.ReadByte()
+		if err != nil {
+			return nil, false, ErrBadDiskCacheIndex
+		}
+		main, err := r.ReadByte()
+		if err != nil {
+			return nil, false, ErrBadDiskCacheIndex
+		}
+		if !filepath.IsLocal(string(path)) {
+			return nil, false, ErrBadDiskCacheIndex
+		}
+		items = append(items, fifocache.Item[string, struct{}]{
+			Key:  filepath.Join(d.path, string(path)),
+			Size: int64(size),
+			Freq: int32(freq),
+			Main: main == 1,
+		})
+	}
+
+	return items, clean, nil
+}
+
+// runIndexSaver saves the index periodically, until the cache is closed
+func (d *DiskCache) runIndexSaver() {
+	defer close(d.indexSaverDone)
+	ticker := time.NewTicker(diskCacheIndexInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := d.saveIndex(false); err != nil {
+				logutil.Warn("save disk cache index failed",
+					zap.Any("path", d.indexPath()),
+					zap.Error(err),
+				)
+			}
+		case <-d.closing:
+			return
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"io"
 	"io/fs"
 	mrand "math/rand"
+	"os"
 	"path/filepath"
 	"strconv"
 	"testing"
@@ -296,6 +297,98 @@ func TestDiskCacheDirSize(t *testing.T) {
 	assert.True(t, counter.FileService.Cache.Disk.Evict.Load() > 0)
 }
 
+func TestDiskCacheIndex(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	update := func(cache *DiskCache, path string) {
+		err := cache.Update(ctx, &IOVector{
+			FilePath: path,
+			Entries: []IOEntry{
+				{
+					Offset: 0,
+					Size:   1,
+					Data:   []byte(path),
+				},
+			},
+		}, false)
+		assert.Nil(t, err)
+	}
+	read := func(cache *DiskCache, path string) {
+		vec := &IOVector{
+			FilePath: path,
+			Entries: []IOEntry{
+				{
+					Offset: 0,
+					Size:   1,
+				},
+			},
+		}
+		assert.Nil(t, cache.Read(ctx, vec))
+		assert.Equal(t, []byte(path), vec.Entries[0].Data)
+		vec.Release()
+	}
+	keys := func(cache *DiskCache) map[string]int32 {
+		ret := make(map[string]int32)
+		for _, item := range cache.cache.Snapshot() {
+			path, err := filepath.Rel(dir, item.Key)
+			assert.Nil(t, err)
+			ret[path] = item.Freq
+		}
+		return ret
+	}
+	diskPath := func(cache *DiskCache, path string) string {
+		return cache.pathForIOEntry(path, IOEntry{Offset: 0, Size: 1})
+	}
+
+	cache, err := NewDiskCache(ctx, dir, fscache.ConstCapacity(1<<20), nil, false)
+	assert.Nil(t, err)
+	update(cache, "a")
+	update(cache, "b")
+	update(cache, "c")
+	read(cache, "b")
+	read(cache, "b")
+	cache.Close()
+	snapshot := cache.cache.Snapshot()
+
+	// a file not in the index
+	stray := filepath.Join(dir, "stray"+cacheFileSuffix)
+	assert.Nil(t, os.WriteFile(stray, []byte("x"), 0644))
+
+	// clean index, no walking
+	cache, err = NewDiskCache(ctx, dir, fscache.ConstCapacity(1<<20), nil, false)
+	assert.Nil(t, err)
+	assert.Equal(t, snapshot, cache.cache.Snapshot())
+	read(cache, "b")
+
+	// crash after updates
+	update(cache, "d")
+	assert.Nil(t, os.Remove(diskPath(cache, "a")))
+	cache, err = NewDiskCache(ctx, dir, fscache.ConstCapacity(1<<20), nil, false)
+	assert.Nil(t, err)
+	got := keys(cache)
+	assert.Equal(t, 4, len(got))
+	_, ok := got["stray"+cacheFileSuffix]
+	assert.True(t, ok)
+	rel, err := filepath.Rel(dir, diskPath(cache, "d"))
+	assert.Nil(t, err)
+	_, ok = got[rel]
+	assert.True(t, ok)
+	rel, err = filepath.Rel(dir, diskPath(cache, "b"))
+	assert.Nil(t, err)
+	// the frequency is kept
+	assert.Equal(t, int32(2), got[rel])
+	cache.Close()
+
+	// bad index
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, diskCacheIndexFileName), []byte("foo"), 0644))
+	cache, err = NewDiskCache(ctx, dir, fscache.ConstCapacity(1<<20), nil, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(keys(cache)))
+	read(cache, "c")
+	cache.Close()
+}
+
 func dirSize(path string) (ret int) {
 	if err := filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
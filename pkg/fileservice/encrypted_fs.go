@@ -0,0 +1,579 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	gopath "path"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/fileservice/fifocache"
+	"github.com/matrixorigin/matrixone/pkg/fileservice/fscache"
+)
+
+// An encrypted file is a fixed size header followed by the sealed chunks of
+// the contents. Each file has its own data key, which is wrapped by a master
+// key and saved in the header along with the master key id, so rotating a
+// master key only rewrites the headers.
+//
+// The chunks are sealed by ChunkedAEAD with the nonce of the header, the
+// range reads are mapped to the chunks covering the ranges.
+//
+//	header: | magic (4) | nonce (12) | key id len (1) | key id | wrapped len (2) | wrapped key | padding |
+//	file:   | header (512) | chunk 0 | ... | chunk n |
+const (
+	encryptionMagic      = "MOFE"
+	encryptionNonceSize  = ChunkedAEADNonceSize
+	encryptionHeaderSize = 512
+	encryptionChunkSize  = 16 * 1024
+	encryptionTagSize    = ChunkedAEADTagSize
+
+	// rewrapSuffix is the suffix of the copy kept while a file is rewritten
+	rewrapSuffix = ".rewrap"
+
+	encryptedFileMetaCacheSize = 8192
+)
+
+// EncryptedFS encrypts the files written to the upstream and decrypts the
+// files read from it. Caches, the io merger and checksums of the upstream
+// work on the encrypted contents, so nothing is kept in plaintext under it.
+type EncryptedFS struct {
+	upstream FileService
+	keys     MasterKeyProvider
+	keyID    string
+	// subPathKeys are the master key ids of the sub paths, sorted by the
+	// length of the sub paths in descending order
+	subPathKeys []subPathKey
+	metas       *fifocache.Cache[string, *encryptedFileMeta]
+}
+
+type subPathKey struct {
+	path  string
+	keyID string
+}
+
+// encryptedFileMeta is the decoded header of a file
+type encryptedFileMeta struct {
+	// size is the size of the plaintext
+	size   int64
+	keyID  string
+	nonce  []byte
+	chunks *ChunkedAEAD
+}
+
+var _ FileService = new(EncryptedFS)
+
+// NewEncryptedFS returns an EncryptedFS over upstream. The data keys of the
+// new files are wrapped by the master key of keyID, or the one of the longest
+// sub path in subPathKeys containing the file, which are used for the
+// account specific keys.
+func NewEncryptedFS(
+	upstream FileService,
+	keys MasterKeyProvider,
+	keyID string,
+	subPathKeys map[string]string,
+) (*EncryptedFS, error) {
+	if keyID == "" {
+		return nil, moerr.NewInternalErrorNoCtx("empty master key id of %s", upstream.Name())
+	}
+	e := &EncryptedFS{
+		upstream: upstream,
+		keys:     keys,
+		keyID:    keyID,
+		metas: fifocache.New[string, *encryptedFileMeta](
+			fscache.ConstCapacity(encryptedFileMetaCacheSize),
+			nil,
+			func(path string) uint8 {
+				return uint8(crc32.ChecksumIEEE([]byte(path)))
+			},
+		),
+	}
+	for p, id := range subPathKeys {
+		p = strings.Trim(gopath.Clean("/"+p), "/")
+		if p == "" || id == "" {
+			return nil, moerr.NewInternalErrorNoCtx("invalid sub path key %s = %s", p, id)
+		}
+		e.subPathKeys = append(e.subPathKeys, subPathKey{path: p, keyID: id})
+	}
+	sort.Slice(e.subPathKeys, func(i, j int) bool {
+		return len(e.subPathKeys[i].path) > len(e.subPathKeys[j].path)
+	})
+	return e, nil
+}
+
+// Upstream returns the file service under the encryption
+func (e *EncryptedFS) Upstream() FileService {
+	return e.upstream
+}
+
+// keyIDOf returns the master key id of the file
+func (e *EncryptedFS) keyIDOf(file string) string {
+	file = strings.TrimPrefix(file, "/")
+	for _, k := range e.subPathKeys {
+		if file == k.path || strings.HasPrefix(file, k.path+"/") {
+			return k.keyID
+		}
+	}
+	return e.keyID
+}
+
+func (e *EncryptedFS) Name() string {
+	return e.upstream.Name()
+}
+
+func (e *EncryptedFS) Write(ctx context.Context, vector IOVector) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	path, err := ParsePathAtService(vector.FilePath, e.upstream.Name())
+	if err != nil {
+		return err
+	}
+	keyID := e.keyIDOf(path.File)
+	dataKey, err := newDataKey()
+	if err != nil {
+		return err
+	}
+	wrapped, err := e.keys.WrapKey(ctx, keyID, dataKey)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, encryptionNonceSize)
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+	header, err := encodeEncryptionHeader(ctx, nonce, keyID, wrapped)
+	if err != nil {
+		return err
+	}
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(vector.Entries, func(i, j int) bool {
+		return vector.Entries[i].Offset < vector.Entries[j].Offset
+	})
+	reader := NewChunkedAEAD(aead, encryptionChunkSize).NewSealReader(
+		nonce, header, newIOEntriesReader(ctx, vector.Entries))
+	e.metas.Delete(path.File)
+	return e.upstream.Write(ctx, IOVector{
+		FilePath: vector.FilePath,
+		Entries: []IOEntry{
+			{
+				ReaderForWrite: reader,
+				Size:           -1,
+			},
+		},
+		ExpireAt: vector.ExpireAt,
+		Policy:   vector.Policy,
+		Caches:   vector.Caches,
+	})
+}
+
+func (e *EncryptedFS) Read(ctx context.Context, vector *IOVector) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(vector.Entries) == 0 {
+		return moerr.NewEmptyVectorNoCtx()
+	}
+	path, err := ParsePathAtService(vector.FilePath, e.upstream.Name())
+	if err != nil {
+		return err
+	}
+	meta, err := e.getMeta(ctx, vector.FilePath, path.File, vector.Policy)
+	if err != nil {
+		return err
+	}
+	return e.read(ctx, vector, path.File, meta, e.upstream.Read)
+}
+
+func (e *EncryptedFS) ReadCache(ctx context.Context, vector *IOVector) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(vector.Entries) == 0 {
+		return moerr.NewEmptyVectorNoCtx()
+	}
+	path, err := ParsePathAtService(vector.FilePath, e.upstream.Name())
+	if err != nil {
+		return err
+	}
+	// no io for the header, files not read recently are not in the caches anyway
+	meta, ok := e.metas.Get(path.File)
+	if !ok {
+		return nil
+	}
+	return e.read(ctx, vector, path.File, meta, e.upstream.ReadCache)
+}
+
+// read maps the entries to the chunks covering them, reads the chunks by fn
+// and fills the entries with the opened chunks
+func (e *EncryptedFS) read(
+	ctx context.Context,
+	vector *IOVector,
+	file string,
+	meta *encryptedFileMeta,
+	fn func(context.Context, *IOVector) error,
+) error {
+	upstream := IOVector{
+		FilePath: vector.FilePath,
+		Entries:  make([]IOEntry, 0, len(vector.Entries)),
+		Policy:   vector.Policy,
+		Caches:   vector.Caches,
+	}
+	indexes := make([]int, 0, len(vector.Entries))
+	for i := range vector.Entries {
+		entry := &vector.Entries[i]
+		if entry.done {
+			continue
+		}
+		if entry.Size == 0 {
+			return moerr.NewEmptyRangeNoCtx(file)
+		}
+		size := entry.Size
+		if size < 0 {
+			size = meta.size - entry.Offset
+		}
+		if entry.Offset < 0 || size <= 0 || entry.Offset+size > meta.size {
+			return moerr.NewUnexpectedEOFNoCtx(file)
+		}
+		start, end := meta.chunks.SealedRange(meta.size, entry.Offset, size)
+		sealed := IOEntry{
+			Offset: encryptionHeaderSize + start,
+			Size:   end - start,
+		}
+		if entry.ToCacheData != nil {
+			// the caches under keep the sealed chunks instead of the plaintext
+			sealed.ToCacheData = CacheOriginalData
+		}
+		upstream.Entries = append(upstream.Entries, sealed)
+		indexes = append(indexes, i)
+	}
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	if err := fn(ctx, &upstream); err != nil {
+		return err
+	}
+	defer upstream.Release()
+
+	for i, idx := range indexes {
+		sealed := upstream.Entries[i].Data
+		if upstream.Entries[i].CachedData != nil {
+			sealed = upstream.Entries[i].CachedData.Bytes()
+		}
+		if len(sealed) == 0 {
+			// not in the caches
+			continue
+		}
+		entry := &vector.Entries[idx]
+		size := entry.Size
+		if size < 0 {
+			size = meta.size - entry.Offset
+		}
+		plain, err := meta.chunks.Open(ctx, file, meta.nonce, sealed, meta.size, entry.Offset, size)
+		if err != nil {
+			return err
+		}
+		entry.Size = size
+		if int64(cap(entry.Data)) < size {
+			// the plaintext is in a new buffer already, no need to allocate
+			entry.Data = plain
+		}
+		if err = entry.readFromBytes(ctx, plain); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getMeta returns the decoded header of a file, the data key is unwrapped
+// once and cached
+func (e *EncryptedFS) getMeta(ctx context.Context, filePath, file string, policy Policy) (*encryptedFileMeta, error) {
+	if meta, ok := e.metas.Get(file); ok {
+		return meta, nil
+	}
+	stat, err := e.upstream.StatFile(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	vector := &IOVector{
+		FilePath: filePath,
+		Entries: []IOEntry{
+			{
+				Size: encryptionHeaderSize,
+			},
+		},
+		Policy: policy,
+	}
+	if stat.Size < encryptionHeaderSize+encryptionTagSize {
+		return nil, moerr.NewInternalError(ctx, "file %s is not encrypted", file)
+	}
+	if err = e.upstream.Read(ctx, vector); err != nil {
+		return nil, err
+	}
+	defer vector.Release()
+	nonce, keyID, wrapped, err := decodeEncryptionHeader(ctx, file, vector.Entries[0].Data)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := e.keys.UnwrapKey(ctx, keyID, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	meta := &encryptedFileMeta{
+		size:   encryptedPlainSize(stat.Size),
+		keyID:  keyID,
+		nonce:  nonce,
+		chunks: NewChunkedAEAD(aead, encryptionChunkSize),
+	}
+	e.metas.Set(file, meta, 1)
+	return meta, nil
+}
+
+func (e *EncryptedFS) List(ctx context.Context, dirPath string) ([]DirEntry, error) {
+	entries, err := e.upstream.List(ctx, dirPath)
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		if !entries[i].IsDir {
+			entries[i].Size = encryptedPlainSize(entries[i].Size)
+		}
+	}
+	return entries, nil
+}
+
+func (e *EncryptedFS) Delete(ctx context.Context, filePaths ...string) error {
+	for _, filePath := range filePaths {
+		if path, err := ParsePathAtService(filePath, e.upstream.Name()); err == nil {
+			e.metas.Delete(path.File)
+		}
+	}
+	return e.upstream.Delete(ctx, filePaths...)
+}
+
+func (e *EncryptedFS) StatFile(ctx context.Context, filePath string) (*DirEntry, error) {
+	entry, err := e.upstream.StatFile(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if !entry.IsDir {
+		entry.Size = encryptedPlainSize(entry.Size)
+	}
+	return entry, nil
+}
+
+func (e *EncryptedFS) PrefetchFile(ctx context.Context, filePath string) error {
+	return e.upstream.PrefetchFile(ctx, filePath)
+}
+
+func (e *EncryptedFS) Cost() *CostAttr {
+	return e.upstream.Cost()
+}
+
+func (e *EncryptedFS) Close() {
+	e.upstream.Close()
+}
+
+// Rewrap wraps the data key of a file by the master key of its path again,
+// which rotates the master keys without touching the sealed chunks. It
+// returns false if the file is wrapped by the master key already.
+//
+// Files can not be overwritten, so the file is rewritten after a copy of it
+// is written to <path>.rewrap, which is removed at the end. A copy left by
+// an interrupted rewrap is restored by the next rewrap of the file.
+func (e *EncryptedFS) Rewrap(ctx context.Context, filePath string) (bool, error) {
+	path, err := ParsePathAtService(filePath, e.upstream.Name())
+	if err != nil {
+		return false, err
+	}
+	copyPath := filePath + rewrapSuffix
+
+	vector := &IOVector{
+		FilePath: filePath,
+		Entries: []IOEntry{
+			{
+				Size: -1,
+			},
+		},
+		Policy: SkipAllCache,
+	}
+	err = e.upstream.Read(ctx, vector)
+	if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+		// restore from the copy of an interrupted rewrap
+		vector.FilePath = copyPath
+		vector.Entries[0] = IOEntry{Size: -1}
+		if e.upstream.Read(ctx, vector) != nil {
+			return false, err
+		}
+		err = e.writeRaw(ctx, filePath, vector.Entries[0].Data)
+		vector.Release()
+		if err != nil {
+			return false, err
+		}
+		if err = e.upstream.Delete(ctx, copyPath); err != nil {
+			return false, err
+		}
+		return e.Rewrap(ctx, filePath)
+	}
+	if err != nil {
+		return false, err
+	}
+	defer vector.Release()
+	data := vector.Entries[0].Data
+	if len(data) < encryptionHeaderSize {
+		return false, moerr.NewInternalError(ctx, "file %s is not encrypted", path.File)
+	}
+	nonce, keyID, wrapped, err := decodeEncryptionHeader(ctx, path.File, data[:encryptionHeaderSize])
+	if err != nil {
+		return false, err
+	}
+	newKeyID := e.keyIDOf(path.File)
+	if keyID == newKeyID {
+		return false, nil
+	}
+	dataKey, err := e.keys.UnwrapKey(ctx, keyID, wrapped)
+	if err != nil {
+		return false, err
+	}
+	if wrapped, err = e.keys.WrapKey(ctx, newKeyID, dataKey); err != nil {
+		return false, err
+	}
+	header, err := encodeEncryptionHeader(ctx, nonce, newKeyID, wrapped)
+	if err != nil {
+		return false, err
+	}
+	copy(data, header)
+
+	if err = e.upstream.Delete(ctx, copyPath); err != nil {
+		return false, err
+	}
+	if err = e.writeRaw(ctx, copyPath, data); err != nil {
+		return false, err
+	}
+	if err = e.Delete(ctx, filePath); err != nil {
+		return false, err
+	}
+	if err = e.writeRaw(ctx, filePath, data); err != nil {
+		return false, err
+	}
+	if err = e.upstream.Delete(ctx, copyPath); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RewrapAll rewraps the files under dirPath, and returns the number of the
+// files rewrapped
+func (e *EncryptedFS) RewrapAll(ctx context.Context, dirPath string) (int, error) {
+	entries, err := e.upstream.List(ctx, dirPath)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, entry := range entries {
+		p := gopath.Join(dirPath, entry.Name)
+		if entry.IsDir {
+			m, err := e.RewrapAll(ctx, p)
+			n += m
+			if err != nil {
+				return n, err
+			}
+			continue
+		}
+		if strings.HasSuffix(entry.Name, rewrapSuffix) {
+			p = strings.TrimSuffix(p, rewrapSuffix)
+		}
+		ok, err := e.Rewrap(ctx, p)
+		if err != nil {
+			return n, err
+		}
+		if ok {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (e *EncryptedFS) writeRaw(ctx context.Context, filePath string, data []byte) error {
+	return e.upstream.Write(ctx, IOVector{
+		FilePath: filePath,
+		Entries: []IOEntry{
+			{
+				Size: int64(len(data)),
+				Data: data,
+			},
+		},
+		Policy: SkipAllCache,
+	})
+}
+
+func encodeEncryptionHeader(ctx context.Context, nonce []byte, keyID string, wrapped []byte) ([]byte, error) {
+	size := len(encryptionMagic) + encryptionNonceSize + 1 + len(keyID) + 2 + len(wrapped)
+	if len(keyID) == 0 || len(keyID) > 255 || size > encryptionHeaderSize {
+		return nil, moerr.NewInternalError(ctx, "master key id %s or wrapped key is too long", keyID)
+	}
+	header := make([]byte, 0, encryptionHeaderSize)
+	header = append(header, encryptionMagic...)
+	header = append(header, nonce...)
+	header = append(header, byte(len(keyID)))
+	header = append(header, keyID...)
+	header = binary.BigEndian.AppendUint16(header, uint16(len(wrapped)))
+	header = append(header, wrapped...)
+	return header[:encryptionHeaderSize], nil
+}
+
+func decodeEncryptionHeader(ctx context.Context, file string, header []byte) (nonce []byte, keyID string, wrapped []byte, err error) {
+	invalid := func() error {
+		return moerr.NewInternalError(ctx, "file %s is not encrypted", file)
+	}
+	if len(header) < encryptionHeaderSize || string(header[:len(encryptionMagic)]) != encryptionMagic {
+		return nil, "", nil, invalid()
+	}
+	header = header[len(encryptionMagic):encryptionHeaderSize]
+	nonce = append([]byte(nil), header[:encryptionNonceSize]...)
+	header = header[encryptionNonceSize:]
+	n := int(header[0])
+	if len(header) < 1+n+2 {
+		return nil, "", nil, invalid()
+	}
+	keyID = string(header[1 : 1+n])
+	header = header[1+n:]
+	m := int(binary.BigEndian.Uint16(header))
+	if len(header) < 2+m {
+		return nil, "", nil, invalid()
+	}
+	wrapped = append([]byte(nil), header[2:2+m]...)
+	return
+}
+
+// encryptedPlainSize returns the plaintext size of an encrypted file
+func encryptedPlainSize(size int64) int64 {
+	return ChunkedPlainSize(size-encryptionHeaderSize, encryptionChunkSize)
+}
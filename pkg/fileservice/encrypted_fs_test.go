@@ -0,0 +1,308 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/util/toml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKeyFile(t *testing.T, ids ...string) string {
+	path := filepath.Join(t.TempDir(), "keys")
+	buf := new(bytes.Buffer)
+	for _, id := range ids {
+		key := make([]byte, encryptionKeySize)
+		_, err := rand.Read(key)
+		require.NoError(t, err)
+		fmt.Fprintf(buf, "%s %s\n", id, hex.EncodeToString(key))
+	}
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0600))
+	return path
+}
+
+func TestEncryptedFS(t *testing.T) {
+	ctx := context.Background()
+	keys, err := NewKeyFileProvider(ctx, newTestKeyFile(t, "k1"))
+	require.NoError(t, err)
+
+	t.Run("memory fs", func(t *testing.T) {
+		testFileService(t, 0, func(name string) FileService {
+			upstream, err := NewMemoryFS(name, DisabledCacheConfig, nil)
+			assert.Nil(t, err)
+			fs, err := NewEncryptedFS(upstream, keys, "k1", nil)
+			assert.Nil(t, err)
+			return fs
+		})
+	})
+
+	t.Run("local fs with memory cache", func(t *testing.T) {
+		testFileService(t, 0, func(name string) FileService {
+			upstream, err := NewLocalFS(ctx, name, t.TempDir(), CacheConfig{
+				MemoryCapacity: ptrTo[toml.ByteSize](128 * 1024),
+			}, nil)
+			assert.Nil(t, err)
+			fs, err := NewEncryptedFS(upstream, keys, "k1", nil)
+			assert.Nil(t, err)
+			return fs
+		})
+	})
+
+	t.Run("local fs with disk cache", func(t *testing.T) {
+		testFileService(t, 0, func(name string) FileService {
+			upstream, err := NewLocalFS(ctx, name, t.TempDir(), CacheConfig{
+				DiskPath:     ptrTo(t.TempDir()),
+				DiskCapacity: ptrTo[toml.ByteSize](1 << 20),
+			}, nil)
+			assert.Nil(t, err)
+			fs, err := NewEncryptedFS(upstream, keys, "k1", nil)
+			assert.Nil(t, err)
+			return fs
+		})
+	})
+}
+
+func TestNewEncryptedFileService(t *testing.T) {
+	ctx := context.Background()
+	fs, err := NewFileService(ctx, Config{
+		Name:    "mem",
+		Backend: memFileServiceBackend,
+		Cache:   DisabledCacheConfig,
+		Encryption: EncryptionConfig{
+			Enable:  true,
+			KeyFile: newTestKeyFile(t, "k1"),
+			KeyID:   "k1",
+		},
+	}, nil)
+	require.NoError(t, err)
+	_, ok := fs.(*EncryptedFS)
+	require.True(t, ok)
+
+	_, err = NewFileService(ctx, Config{
+		Name:       "mem",
+		Backend:    memFileServiceBackend,
+		Cache:      DisabledCacheConfig,
+		Encryption: EncryptionConfig{Enable: true, KeyID: "k1"},
+	}, nil)
+	require.Error(t, err)
+
+	// the ETL and LOCAL file services can not be encrypted
+	for _, cfg := range []Config{
+		{Name: "etl", Backend: diskETLFileServiceBackend},
+		{Name: defines.ETLFileServiceName, Backend: memFileServiceBackend},
+		{Name: defines.LocalFileServiceName, Backend: memFileServiceBackend},
+	} {
+		cfg.DataDir = t.TempDir()
+		cfg.Cache = DisabledCacheConfig
+		cfg.Encryption = EncryptionConfig{
+			Enable:  true,
+			KeyFile: newTestKeyFile(t, "k1"),
+			KeyID:   "k1",
+		}
+		_, err = NewFileService(ctx, cfg, nil)
+		require.Error(t, err, cfg.Name)
+		cfg.Encryption.Enable = false
+		_, err = NewFileService(ctx, cfg, nil)
+		require.NoError(t, err, cfg.Name)
+	}
+}
+
+func TestEncryptedFSChunks(t *testing.T) {
+	ctx := context.Background()
+	keys, err := NewKeyFileProvider(ctx, newTestKeyFile(t, "k1"))
+	require.NoError(t, err)
+	upstream, err := NewMemoryFS("mem", DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	fs, err := NewEncryptedFS(upstream, keys, "k1", nil)
+	require.NoError(t, err)
+
+	for _, size := range []int{
+		0, 1,
+		encryptionChunkSize - 1, encryptionChunkSize, encryptionChunkSize + 1,
+		3*encryptionChunkSize + 42,
+	} {
+		data := make([]byte, size)
+		_, err = rand.Read(data)
+		require.NoError(t, err)
+		name := fmt.Sprintf("file-%d", size)
+		require.NoError(t, fs.Write(ctx, IOVector{
+			FilePath: name,
+			Entries: []IOEntry{
+				{
+					Size: int64(size),
+					Data: data,
+				},
+			},
+		}))
+
+		stat, err := fs.StatFile(ctx, name)
+		require.NoError(t, err)
+		require.Equal(t, int64(size), stat.Size)
+		if size == 0 {
+			continue
+		}
+
+		// the contents are encrypted
+		raw := &IOVector{
+			FilePath: name,
+			Entries:  []IOEntry{{Size: -1}},
+		}
+		require.NoError(t, upstream.Read(ctx, raw))
+		if size > 16 {
+			require.False(t, bytes.Contains(raw.Entries[0].Data, data[:16]))
+		}
+
+		// ranges across the chunks
+		for _, r := range [][2]int{
+			{0, size},
+			{size - 1, 1},
+			{size / 2, size - size/2},
+			{max(0, encryptionChunkSize-2), min(4, size-max(0, encryptionChunkSize-2))},
+		} {
+			if r[1] <= 0 {
+				continue
+			}
+			vec := &IOVector{
+				FilePath: name,
+				Entries: []IOEntry{
+					{
+						Offset: int64(r[0]),
+						Size:   int64(r[1]),
+					},
+				},
+			}
+			require.NoError(t, fs.Read(ctx, vec))
+			require.Equal(t, data[r[0]:r[0]+r[1]], vec.Entries[0].Data)
+		}
+	}
+
+	// tampered chunks can not be opened
+	raw := &IOVector{
+		FilePath: "file-1",
+		Entries:  []IOEntry{{Size: -1}},
+	}
+	require.NoError(t, upstream.Read(ctx, raw))
+	data := raw.Entries[0].Data
+	data[len(data)-1] ^= 1
+	require.NoError(t, upstream.Delete(ctx, "file-1"))
+	require.NoError(t, upstream.Write(ctx, IOVector{
+		FilePath: "file-1",
+		Entries:  []IOEntry{{Size: int64(len(data)), Data: data}},
+	}))
+	fs.metas.Delete("file-1")
+	err = fs.Read(ctx, &IOVector{
+		FilePath: "file-1",
+		Entries:  []IOEntry{{Size: -1}},
+	})
+	require.Error(t, err)
+}
+
+func TestEncryptedFSRewrap(t *testing.T) {
+	ctx := context.Background()
+	kmsDir := t.TempDir()
+	for _, id := range []string{"k1", "k2", "acc1"} {
+		key := make([]byte, encryptionKeySize)
+		_, err := rand.Read(key)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(kmsDir, id), []byte(hex.EncodeToString(key)), 0600))
+	}
+	keys := NewLocalKMSProvider(kmsDir)
+	upstream, err := NewMemoryFS("mem", DisabledCacheConfig, nil)
+	require.NoError(t, err)
+	subPathKeys := map[string]string{"acc1/": "acc1"}
+	fs, err := NewEncryptedFS(upstream, keys, "k1", subPathKeys)
+	require.NoError(t, err)
+
+	files := []string{"a", "dir/b", "acc1/c", "acc10/d"}
+	for _, name := range files {
+		require.NoError(t, fs.Write(ctx, IOVector{
+			FilePath: name,
+			Entries: []IOEntry{
+				{
+					Size: int64(len(name)),
+					Data: []byte(name),
+				},
+			},
+		}))
+	}
+	keyIDOf := func(name string) string {
+		vec := &IOVector{
+			FilePath: name,
+			Entries:  []IOEntry{{Size: encryptionHeaderSize}},
+		}
+		require.NoError(t, upstream.Read(ctx, vec))
+		_, keyID, _, err := decodeEncryptionHeader(ctx, name, vec.Entries[0].Data)
+		require.NoError(t, err)
+		return keyID
+	}
+	require.Equal(t, "k1", keyIDOf("a"))
+	require.Equal(t, "acc1", keyIDOf("acc1/c"))
+	require.Equal(t, "k1", keyIDOf("acc10/d"))
+
+	// rotate k1 to k2
+	fs, err = NewEncryptedFS(upstream, keys, "k2", subPathKeys)
+	require.NoError(t, err)
+	n, err := fs.RewrapAll(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+	n, err = fs.RewrapAll(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+	require.Equal(t, "k2", keyIDOf("a"))
+	require.Equal(t, "acc1", keyIDOf("acc1/c"))
+
+	// k1 is not needed any more
+	require.NoError(t, os.Remove(filepath.Join(kmsDir, "k1")))
+	fs, err = NewEncryptedFS(upstream, keys, "k2", subPathKeys)
+	require.NoError(t, err)
+	for _, name := range files {
+		vec := &IOVector{
+			FilePath: name,
+			Entries:  []IOEntry{{Size: -1}},
+		}
+		require.NoError(t, fs.Read(ctx, vec))
+		require.Equal(t, []byte(name), vec.Entries[0].Data)
+	}
+
+	// restore the copy of an interrupted rewrap
+	vec := &IOVector{
+		FilePath: "a",
+		Entries:  []IOEntry{{Size: -1}},
+	}
+	require.NoError(t, upstream.Read(ctx, vec))
+	require.NoError(t, fs.writeRaw(ctx, "a"+rewrapSuffix, vec.Entries[0].Data))
+	require.NoError(t, upstream.Delete(ctx, "a"))
+	_, err = fs.Rewrap(ctx, "a")
+	require.NoError(t, err)
+	_, err = upstream.StatFile(ctx, "a"+rewrapSuffix)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrFileNotFound))
+	vec = &IOVector{
+		FilePath: "a",
+		Entries:  []IOEntry{{Size: -1}},
+	}
+	require.NoError(t, fs.Read(ctx, vec))
+	require.Equal(t, []byte("a"), vec.Entries[0].Data)
+}
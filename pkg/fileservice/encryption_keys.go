@@ -0,0 +1,200 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const encryptionKeySize = 32
+
+// MasterKeyProvider wraps and unwraps the data keys of the encrypted files by
+// the master key of keyID
+type MasterKeyProvider interface {
+	WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error)
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// keyFileProvider keeps the master keys loaded from a key file. Each line of
+// the file is a key id and a hex encoded 32 bytes key separated by spaces,
+// lines starting with '#' are ignored. The old keys must be kept in the file
+// until the files wrapped by them are re-wrapped.
+type keyFileProvider struct {
+	keys map[string][]byte
+}
+
+var _ MasterKeyProvider = new(keyFileProvider)
+
+// NewKeyFileProvider loads the master keys from the key file
+func NewKeyFileProvider(ctx context.Context, path string) (MasterKeyProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[string][]byte)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, moerr.NewInternalError(ctx, "invalid line of key file %s: %s", path, line)
+		}
+		key, err := hex.DecodeString(fields[1])
+		if err != nil || len(key) != encryptionKeySize {
+			return nil, moerr.NewInternalError(ctx, "key %s of key file %s must be %d bytes in hex",
+				fields[0], path, encryptionKeySize)
+		}
+		keys[fields[0]] = key
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return &keyFileProvider{keys: keys}, nil
+}
+
+func (k *keyFileProvider) masterKey(ctx context.Context, keyID string) ([]byte, error) {
+	key, ok := k.keys[keyID]
+	if !ok {
+		return nil, moerr.NewInternalError(ctx, "master key %s not found", keyID)
+	}
+	return key, nil
+}
+
+func (k *keyFileProvider) WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error) {
+	key, err := k.masterKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	return sealDataKey(key, dataKey)
+}
+
+func (k *keyFileProvider) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	key, err := k.masterKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	return openDataKey(ctx, key, wrapped)
+}
+
+// localKMSProvider is a stand-in of a key management service. The master key
+// of a key id is kept in the file named by the key id under dir, in raw bytes
+// or in hex, and is read on each call, so the keys can be added or removed
+// without restarting.
+type localKMSProvider struct {
+	dir string
+}
+
+var _ MasterKeyProvider = new(localKMSProvider)
+
+// NewLocalKMSProvider returns a MasterKeyProvider reading the master keys from dir
+func NewLocalKMSProvider(dir string) MasterKeyProvider {
+	return &localKMSProvider{dir: dir}
+}
+
+func (k *localKMSProvider) masterKey(ctx context.Context, keyID string) ([]byte, error) {
+	if keyID == "" || strings.ContainsAny(keyID, `/\`) || keyID == "." || keyID == ".." {
+		return nil, moerr.NewInternalError(ctx, "invalid master key id %q", keyID)
+	}
+	data, err := os.ReadFile(filepath.Join(k.dir, keyID))
+	if os.IsNotExist(err) {
+		return nil, moerr.NewInternalError(ctx, "master key %s not found", keyID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == encryptionKeySize {
+		return data, nil
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil || len(key) != encryptionKeySize {
+		return nil, moerr.NewInternalError(ctx, "master key %s must be %d bytes", keyID, encryptionKeySize)
+	}
+	return key, nil
+}
+
+func (k *localKMSProvider) WrapKey(ctx context.Context, keyID string, dataKey []byte) ([]byte, error) {
+	key, err := k.masterKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	return sealDataKey(key, dataKey)
+}
+
+func (k *localKMSProvider) UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	key, err := k.masterKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	return openDataKey(ctx, key, wrapped)
+}
+
+func newDataKey() ([]byte, error) {
+	key := make([]byte, encryptionKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// sealDataKey encrypts a data key by the master key, the nonce is prepended
+func sealDataKey(masterKey, dataKey []byte) ([]byte, error) {
+	gcm, err := newGCM(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, dataKey, nil), nil
+}
+
+func openDataKey(ctx context.Context, masterKey, wrapped []byte) ([]byte, error) {
+	gcm, err := newGCM(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < gcm.NonceSize() {
+		return nil, moerr.NewInternalError(ctx, "invalid wrapped data key")
+	}
+	dataKey, err := gcm.Open(nil, wrapped[:gcm.NonceSize()], wrapped[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, moerr.NewInternalError(ctx, "unwrap data key: %v", err)
+	}
+	return dataKey, nil
+}
@@ -142,6 +142,84 @@ func (c *Cache[K, V]) Delete(key K) {
 	// we don't update queues
 }
 
+// Item is the state of a cache item, for persisting and restoring the cache
+type Item[K comparable, V any] struct {
+	Key   K
+	Value V
+	Size  int64
+	// Freq is the access frequency, 0 to 3
+	Freq int32
+	// Main indicates whether the item is in the main queue
+	Main bool
+}
+
+// Snapshot returns the items in eviction order, the small queue first.
+// Restoring the items in the same order reproduces the eviction order.
+func (c *Cache[K, V]) Snapshot() []Item[K, V] {
+	c.queueLock.Lock()
+	defer c.queueLock.Unlock()
+
+	var ret []Item[K, V]
+	appendItems := func(main bool) func(*_CacheItem[K, V]) bool {
+		return func(item *_CacheItem[K, V]) bool {
+			shard := &c.shards[c.keyShardFunc(item.key)]
+			shard.RLock()
+			current := shard.values[item.key]
+			shard.RUnlock()
+			if current != item {
+				// deleted
+				return true
+			}
+			ret = append(ret, Item[K, V]{
+				Key:   item.key,
+				Value: item.value,
+				Size:  item.size,
+				Freq:  item.count.Load(),
+				Main:  main,
+			})
+			return true
+		}
+	}
+	c.queue1.iter(appendItems(false))
+	c.queue2.iter(appendItems(true))
+	return ret
+}
+
+// Restore sets an item with its queue and access frequency.
+// It does nothing if the key exists.
+func (c *Cache[K, V]) Restore(state Item[K, V]) {
+	shard := &c.shards[c.keyShardFunc(state.Key)]
+	shard.Lock()
+	_, ok := shard.values[state.Key]
+	if ok {
+		// existed
+		shard.Unlock()
+		return
+	}
+
+	item := &_CacheItem[K, V]{
+		key:   state.Key,
+		value: state.Value,
+		size:  state.Size,
+	}
+	item.count.Store(min(max(state.Freq, 0), 3))
+	shard.values[state.Key] = item
+	shard.Unlock()
+
+	c.queueLock.Lock()
+	defer c.queueLock.Unlock()
+	if state.Main {
+		c.queue2.enqueue(item)
+		c.used2 += state.Size
+	} else {
+		c.queue1.enqueue(item)
+		c.used1 += state.Size
+	}
+	if c.used1+c.used2 > c.capacity() {
+		c.evict()
+	}
+}
+
 func (c *Cache[K, V]) evict() {
 	for c.used1+c.used2 > c.capacity() {
 		if c.used1 > c.capacity1() {
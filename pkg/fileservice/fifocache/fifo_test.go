@@ -99,3 +99,41 @@ func TestCacheEvict3(t *testing.T) {
 	assert.Equal(t, int64(922), cache.used2)
 	assert.Equal(t, 1024, nEvict)
 }
+
+func TestCacheSnapshotRestore(t *testing.T) {
+	cache := New[int, int](fscache.ConstCapacity(2), nil, ShardInt[int])
+	cache.Set(1, 1, 1)
+	cache.Set(2, 2, 1)
+	cache.Get(2)
+	cache.Get(2)
+	// 1 will be evicted
+	cache.Set(3, 3, 1)
+	// 2 moves to the main queue, 3 will be evicted
+	cache.Set(4, 4, 1)
+
+	items := cache.Snapshot()
+	assert.Equal(t, []Item[int, int]{
+		{Key: 4, Value: 4, Size: 1, Freq: 0, Main: false},
+		{Key: 2, Value: 2, Size: 1, Freq: 2, Main: true},
+	}, items)
+
+	// deleted items are not included
+	cache.Delete(4)
+	assert.Equal(t, 1, len(cache.Snapshot()))
+
+	restored := New[int, int](fscache.ConstCapacity(2), nil, ShardInt[int])
+	for _, item := range items {
+		restored.Restore(item)
+	}
+	assert.Equal(t, items, restored.Snapshot())
+	assert.Equal(t, int64(1), restored.used1)
+	assert.Equal(t, int64(1), restored.used2)
+
+	// 2 survives the evictions of the small queue
+	restored.Set(6, 6, 1)
+	restored.Set(7, 7, 1)
+	_, ok := restored.Get(2)
+	assert.True(t, ok)
+	_, ok = restored.Get(4)
+	assert.False(t, ok)
+}
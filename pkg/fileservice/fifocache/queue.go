@@ -90,3 +90,14 @@ func (p *Queue[T]) dequeue() (ret T, ok bool) {
 	ok = true
 	return
 }
+
+// iter calls fn for the values from the oldest to the newest, until fn returns false
+func (p *Queue[T]) iter(fn func(T) bool) {
+	for part := p.tail; part != nil; part = part.next {
+		for _, v := range part.values[part.begin:] {
+			if !fn(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,441 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	gotrace "runtime/trace"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/perfcounter"
+	"github.com/matrixorigin/matrixone/pkg/util/trace"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+// gcsChunkSize is the size of the chunks of resumable uploads
+const gcsChunkSize = 8 << 20
+
+// GCSSDK is the ObjectStorage of Google Cloud Storage
+type GCSSDK struct {
+	name            string
+	client          *storage.Client
+	bucket          *storage.BucketHandle
+	perfCounterSets []*perfcounter.CounterSet
+	listMaxResults  int
+}
+
+func NewGCSSDK(
+	ctx context.Context,
+	args ObjectStorageArguments,
+	perfCounterSets []*perfcounter.CounterSet,
+) (_ *GCSSDK, err error) {
+	defer catch(&err)
+
+	opts, err := args.gcsClientOptions()
+	if err != nil {
+		return nil, err
+	}
+	client, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	logutil.Info("new object storage",
+		zap.Any("sdk", "gcs"),
+		zap.Any("arguments", args),
+	)
+
+	bucket := client.Bucket(args.Bucket)
+	if !args.NoBucketValidation {
+		// validate bucket
+		_, err := bucket.Attrs(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &GCSSDK{
+		name:            args.Name,
+		client:          client,
+		bucket:          bucket,
+		perfCounterSets: perfCounterSets,
+	}, nil
+}
+
+var _ ObjectStorage = new(GCSSDK)
+
+func (g *GCSSDK) List(
+	ctx context.Context,
+	prefix string,
+	fn func(bool, string, int64) (bool, error),
+) error {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	ctx, task := gotrace.NewTask(ctx, "GCSSDK.List")
+	defer task.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.List.Add(1)
+	}, g.perfCounterSets...)
+
+	query := &storage.Query{
+		Prefix:    prefix,
+		Delimiter: "/",
+	}
+	if err := query.SetAttrSelection([]string{"Name", "Size"}); err != nil {
+		return err
+	}
+	it := g.bucket.Objects(ctx, query)
+	if g.listMaxResults > 0 {
+		it.PageInfo().MaxSize = g.listMaxResults
+	}
+
+	for {
+		attrs, err := DoWithRetry(
+			"gcs list objects",
+			it.Next,
+			maxRetryAttemps,
+			IsRetryableError,
+		)
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		var more bool
+		if attrs.Prefix != "" {
+			more, err = fn(true, attrs.Prefix, 0)
+		} else {
+			more, err = fn(false, attrs.Name, attrs.Size)
+		}
+		if err != nil {
+			return err
+		}
+		if !more {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (g *GCSSDK) Stat(
+	ctx context.Context,
+	key string,
+) (
+	size int64,
+	err error,
+) {
+
+	defer func() {
+		if g.is404(err) {
+			err = moerr.NewFileNotFoundNoCtx(key)
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	attrs, err := g.statObject(ctx, key)
+	if err != nil {
+		return
+	}
+	size = attrs.Size
+
+	return
+}
+
+func (g *GCSSDK) Exists(
+	ctx context.Context,
+	key string,
+) (
+	bool,
+	error,
+) {
+
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	_, err := g.statObject(ctx, key)
+	if err != nil {
+		if g.is404(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (g *GCSSDK) Write(
+	ctx context.Context,
+	key string,
+	r io.Reader,
+	size int64,
+	expire *time.Time,
+) (
+	err error,
+) {
+	defer catch(&err)
+	ctx, task := gotrace.NewTask(ctx, "GCSSDK.Write")
+	defer task.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Put.Add(1)
+	}, g.perfCounterSets...)
+
+	// canceling the context aborts the upload
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// large objects are uploaded by chunks in a resumable upload,
+	// not retryable because Reader may be half consumed
+	w := g.bucket.Object(key).NewWriter(ctx)
+	w.ChunkSize = gcsChunkSize
+	if size >= 0 && size < gcsChunkSize {
+		// single request upload
+		w.ChunkSize = 0
+	}
+	if expire != nil {
+		// lifecycle rules act on the custom time
+		w.CustomTime = *expire
+	}
+	if _, err = io.Copy(w, r); err != nil {
+		cancel()
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func (g *GCSSDK) Read(
+	ctx context.Context,
+	key string,
+	min *int64,
+	max *int64,
+) (
+	r io.ReadCloser,
+	err error,
+) {
+
+	defer func() {
+		if g.is404(err) {
+			err = moerr.NewFileNotFoundNoCtx(key)
+		}
+	}()
+
+	if max == nil {
+		// read to end
+		r, err := g.getObject(
+			ctx,
+			key,
+			min,
+			nil,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return r, nil
+	}
+
+	r, err = g.getObject(
+		ctx,
+		key,
+		min,
+		max,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &readCloser{
+		r:         io.LimitReader(r, int64(*max-*min)),
+		closeFunc: r.Close,
+	}, nil
+}
+
+func (g *GCSSDK) Delete(
+	ctx context.Context,
+	keys ...string,
+) (
+	err error,
+) {
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := g.deleteSingle(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *GCSSDK) deleteSingle(ctx context.Context, key string) error {
+	ctx, span := trace.Start(ctx, "GCSSDK.deleteSingle")
+	defer span.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Delete.Add(1)
+	}, g.perfCounterSets...)
+
+	_, err := DoWithRetry(
+		"gcs delete object",
+		func() (bool, error) {
+			if err := g.bucket.Object(key).Delete(ctx); err != nil {
+				return false, err
+			}
+			return true, nil
+		},
+		maxRetryAttemps,
+		IsRetryableError,
+	)
+	if err != nil && !g.is404(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (g *GCSSDK) statObject(ctx context.Context, key string) (*storage.ObjectAttrs, error) {
+	ctx, task := gotrace.NewTask(ctx, "GCSSDK.statObject")
+	defer task.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Head.Add(1)
+	}, g.perfCounterSets...)
+	return DoWithRetry(
+		"gcs object attrs",
+		func() (*storage.ObjectAttrs, error) {
+			return g.bucket.Object(key).Attrs(ctx)
+		},
+		maxRetryAttemps,
+		IsRetryableError,
+	)
+}
+
+func (g *GCSSDK) getObject(ctx context.Context, key string, min *int64, max *int64) (io.ReadCloser, error) {
+	ctx, task := gotrace.NewTask(ctx, "GCSSDK.getObject")
+	defer task.End()
+	perfcounter.Update(ctx, func(counter *perfcounter.CounterSet) {
+		counter.FileService.S3.Get.Add(1)
+	}, g.perfCounterSets...)
+	var start int64
+	if min != nil {
+		start = *min
+	}
+	r, err := newRetryableReader(
+		func(offset int64) (io.ReadCloser, error) {
+			// negative length reads to the end
+			length := int64(-1)
+			if max != nil {
+				length = *max - offset
+			}
+			r, err := DoWithRetry(
+				"gcs get object",
+				func() (*storage.Reader, error) {
+					return g.bucket.Object(key).NewRangeReader(ctx, offset, length)
+				},
+				maxRetryAttemps,
+				IsRetryableError,
+			)
+			if err != nil {
+				return nil, err
+			}
+			return r, nil
+		},
+		start,
+		IsRetryableError,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (g *GCSSDK) is404(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return true
+	}
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Code == http.StatusNotFound
+	}
+	return false
+}
+
+// gcsClientOptions returns the options of the client. The credentials are
+// loaded in the order of credentials file, bearer token and the application
+// default credentials. The endpoint is the one of the emulator or a private
+// endpoint, the JSON API path is appended if it is missing.
+func (o ObjectStorageArguments) gcsClientOptions() ([]option.ClientOption, error) {
+	var opts []option.ClientOption
+
+	if o.Endpoint != "" {
+		endpoint := o.Endpoint
+		if !strings.Contains(endpoint, "://") {
+			endpoint = "https://" + endpoint
+		}
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		if strings.Trim(u.Path, "/") == "" {
+			u.Path = "/storage/v1/"
+		}
+		opts = append(opts, option.WithEndpoint(u.String()))
+	}
+
+	switch {
+	case o.CredentialsFile != "":
+		logutil.Info("gcs: using credentials file", zap.Any("file", o.CredentialsFile))
+		opts = append(opts, option.WithCredentialsFile(o.CredentialsFile))
+	case o.BearerToken != "":
+		logutil.Info("gcs: using bearer token")
+		opts = append(opts, option.WithTokenSource(oauth2.StaticTokenSource(&oauth2.Token{
+			AccessToken: o.BearerToken,
+		})))
+	case o.shouldLoadDefaultCredentials():
+		logutil.Info("gcs: using application default credentials")
+	default:
+		logutil.Info("gcs: using anonymous access")
+		opts = append(opts,
+			option.WithoutAuthentication(),
+			option.WithHTTPClient(newHTTPClient(o)),
+		)
+	}
+
+	return opts, nil
+}
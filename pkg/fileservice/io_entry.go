@@ -84,6 +84,29 @@ func (i *IOEntry) ReadFromOSFile(ctx context.Context, file *os.File) (err error)
 	return nil
 }
 
+// readFromBytes fills the entry with data, which must be of the entry size
+func (i *IOEntry) readFromBytes(ctx context.Context, data []byte) (err error) {
+	finally := i.prepareData()
+	defer finally(&err)
+	copy(i.Data, data)
+
+	if i.WriterForRead != nil {
+		if _, err := i.WriterForRead.Write(i.Data); err != nil {
+			return err
+		}
+	}
+	if i.ReadCloserForRead != nil {
+		*i.ReadCloserForRead = io.NopCloser(bytes.NewReader(i.Data))
+	}
+	if err := i.setCachedData(ctx); err != nil {
+		return err
+	}
+
+	i.done = true
+
+	return nil
+}
+
 func CacheOriginalData(r io.Reader, data []byte, allocator CacheDataAllocator) (cacheData fscache.Data, err error) {
 	if len(data) == 0 {
 		data, err = io.ReadAll(r)
@@ -1039,6 +1039,9 @@ var _ CachingFileService = new(LocalFS)
 
 func (l *LocalFS) Close() {
 	l.FlushCache()
+	if l.diskCache != nil {
+		l.diskCache.Close()
+	}
 }
 
 func (l *LocalFS) FlushCache() {
@@ -40,6 +40,12 @@ type ObjectStorageArguments struct {
 	Region    string   `toml:"region"`
 	CertFiles []string `toml:"cert-files"`
 
+	// azure, bucket is the container name
+	IsAzure bool `toml:"is-azure"`
+
+	// gcs
+	IsGCS bool `toml:"is-gcs"`
+
 	// credentials
 	RoleARN         string `json:"-" toml:"role-arn"`
 	BearerToken     string `json:"-" toml:"bearer-token"`
@@ -50,6 +56,7 @@ type ObjectStorageArguments struct {
 	RoleSessionName string `json:"-" toml:"role-session-name"`
 	SecurityToken   string `json:"-" toml:"security-token"`
 	SessionToken    string `json:"-" toml:"session-token"`
+	CredentialsFile string `json:"-" toml:"credentials-file"`
 }
 
 func (o ObjectStorageArguments) String() string {
@@ -101,6 +108,10 @@ func (o *ObjectStorageArguments) SetFromString(arguments []string) error {
 			o.Region = value
 		case "cert-files":
 			o.CertFiles = strings.Split(value, ",")
+		case "is-azure", "azure":
+			o.IsAzure = value != "false" && value != "0"
+		case "is-gcs", "gcs":
+			o.IsGCS = value != "false" && value != "0"
 
 		case "role-arn":
 			o.RoleARN = value
@@ -120,6 +131,8 @@ func (o *ObjectStorageArguments) SetFromString(arguments []string) error {
 			o.SecurityToken = value
 		case "token", "session-token":
 			o.SessionToken = value
+		case "credentials-file":
+			o.CredentialsFile = value
 
 		default:
 			return moerr.NewInvalidInputNoCtx("invalid S3 argument: %s", pair)
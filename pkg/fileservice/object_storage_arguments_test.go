@@ -53,4 +53,39 @@ func TestObjectStorageArguments(t *testing.T) {
 		}
 
 	})
+
+	t.Run("azure and gcs", func(t *testing.T) {
+		var args ObjectStorageArguments
+		err := args.SetFromString([]string{
+			"is-azure=true",
+			"gcs=false",
+			"credentials-file=/tmp/foo.json",
+			"key-id=account",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !args.IsAzure || args.IsGCS || args.CredentialsFile != "/tmp/foo.json" {
+			t.Fatalf("got %+v", args)
+		}
+
+		url, err := args.azureServiceURL()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if url != "https://account.blob.core.windows.net/" {
+			t.Fatalf("got %s", url)
+		}
+		args.Endpoint = "127.0.0.1:10000/devstoreaccount1"
+		url, err = args.azureServiceURL()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if url != "https://127.0.0.1:10000/devstoreaccount1" {
+			t.Fatalf("got %s", url)
+		}
+		if _, err := (ObjectStorageArguments{}).azureServiceURL(); err == nil {
+			t.Fatal()
+		}
+	})
 }
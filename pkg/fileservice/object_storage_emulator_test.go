@@ -0,0 +1,223 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// the well known account of Azurite
+	azuriteAccount = "devstoreaccount1"
+	azuriteKey     = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFMNhdH1q/2lBclcFmYw=="
+)
+
+func waitForPort(t *testing.T, addr string) {
+	deadline := time.Now().Add(time.Second * 30)
+	for time.Now().Before(deadline) {
+		conn, err := net.Dial("tcp", addr)
+		if err == nil {
+			conn.Close()
+			return
+		}
+		time.Sleep(time.Millisecond * 100)
+	}
+	t.Fatalf("%s not ready", addr)
+}
+
+func TestAzureSDKAzurite(t *testing.T) {
+
+	// find azurite executable
+	exePath, err := exec.LookPath("azurite-blob")
+	if errors.Is(err, exec.ErrNotFound) {
+		// azurite not found in machine
+		return
+	}
+
+	// start azurite
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cmd := exec.CommandContext(ctx,
+		exePath,
+		"--silent",
+		"--location", t.TempDir(),
+		"--blobHost", "127.0.0.1",
+		"--blobPort", "10000",
+	)
+	err = cmd.Start()
+	require.Nil(t, err)
+	waitForPort(t, "127.0.0.1:10000")
+
+	endpoint := "http://127.0.0.1:10000/" + azuriteAccount
+
+	// create container
+	cred, err := azblob.NewSharedKeyCredential(azuriteAccount, azuriteKey)
+	require.Nil(t, err)
+	client, err := azblob.NewClientWithSharedKeyCredential(endpoint, cred, nil)
+	require.Nil(t, err)
+	_, err = client.CreateContainer(ctx, "test", nil)
+	require.Nil(t, err)
+
+	// run test
+	t.Run("file service", func(t *testing.T) {
+		testFileService(t, 0, func(name string) FileService {
+			fs, err := NewS3FS(
+				context.Background(),
+				ObjectStorageArguments{
+					Name:      name,
+					Endpoint:  endpoint,
+					Bucket:    "test",
+					KeyPrefix: time.Now().Format("2006-01-02.15:04:05.000000"),
+					KeyID:     azuriteAccount,
+					KeySecret: azuriteKey,
+					IsAzure:   true,
+				},
+				DisabledCacheConfig,
+				nil,
+				true,
+				true,
+			)
+			assert.Nil(t, err)
+			return fs
+		})
+	})
+
+	t.Run("list paging", func(t *testing.T) {
+		sdk, err := NewAzureSDK(ctx, ObjectStorageArguments{
+			Endpoint:  endpoint,
+			Bucket:    "test",
+			KeyID:     azuriteAccount,
+			KeySecret: azuriteKey,
+		}, nil)
+		require.Nil(t, err)
+		sdk.listMaxResults = 1
+		testObjectStorageListPaging(t, sdk)
+	})
+}
+
+func TestGCSSDKFakeServer(t *testing.T) {
+
+	// find fake-gcs-server executable
+	exePath, err := exec.LookPath("fake-gcs-server")
+	if errors.Is(err, exec.ErrNotFound) {
+		// fake-gcs-server not found in machine
+		return
+	}
+
+	// start fake-gcs-server, buckets are the dirs under the data dir
+	dataDir := t.TempDir()
+	require.Nil(t, os.Mkdir(filepath.Join(dataDir, "test"), 0755))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cmd := exec.CommandContext(ctx,
+		exePath,
+		"-scheme", "http",
+		"-host", "127.0.0.1",
+		"-port", "4443",
+		"-public-host", "127.0.0.1:4443",
+		"-backend", "memory",
+		"-data", dataDir,
+		"-log-level", "error",
+	)
+	err = cmd.Start()
+	require.Nil(t, err)
+	waitForPort(t, "127.0.0.1:4443")
+
+	endpoint := "http://127.0.0.1:4443"
+
+	// run test
+	t.Run("file service", func(t *testing.T) {
+		testFileService(t, 0, func(name string) FileService {
+			fs, err := NewS3FS(
+				context.Background(),
+				ObjectStorageArguments{
+					Name:      name,
+					Endpoint:  endpoint,
+					Bucket:    "test",
+					KeyPrefix: time.Now().Format("2006-01-02.15:04:05.000000"),
+					IsGCS:     true,
+				},
+				DisabledCacheConfig,
+				nil,
+				true,
+				true,
+			)
+			assert.Nil(t, err)
+			return fs
+		})
+	})
+
+	t.Run("list paging", func(t *testing.T) {
+		sdk, err := NewGCSSDK(ctx, ObjectStorageArguments{
+			Endpoint:             endpoint,
+			Bucket:               "test",
+			NoDefaultCredentials: true,
+		}, nil)
+		require.Nil(t, err)
+		sdk.listMaxResults = 1
+		testObjectStorageListPaging(t, sdk)
+	})
+}
+
+func testObjectStorageListPaging(t *testing.T, storage ObjectStorage) {
+	ctx := context.Background()
+	prefix := time.Now().Format("paging-2006-01-02.15:04:05.000000/")
+	for _, key := range []string{"a", "b", "c", "d/e"} {
+		data := []byte(key)
+		err := storage.Write(ctx, prefix+key, bytes.NewReader(data), int64(len(data)), nil)
+		require.Nil(t, err)
+	}
+
+	var files, dirs []string
+	err := storage.List(ctx, prefix, func(isPrefix bool, key string, size int64) (bool, error) {
+		if isPrefix {
+			dirs = append(dirs, key)
+		} else {
+			files = append(files, key)
+			assert.Equal(t, int64(1), size)
+		}
+		return true, nil
+	})
+	require.Nil(t, err)
+	assert.Equal(t, []string{prefix + "a", prefix + "b", prefix + "c"}, files)
+	assert.Equal(t, []string{prefix + "d/"}, dirs)
+
+	// range read
+	min, max := int64(0), int64(1)
+	r, err := storage.Read(ctx, prefix+"b", &min, &max)
+	require.Nil(t, err)
+	buf := make([]byte, 2)
+	n, _ := r.Read(buf)
+	assert.Equal(t, "b", string(buf[:n]))
+	require.Nil(t, r.Close())
+
+	err = storage.Delete(ctx, prefix+"a", prefix+"b", prefix+"not-exists")
+	require.Nil(t, err)
+	ok, err := storage.Exists(ctx, prefix+"a")
+	require.Nil(t, err)
+	assert.False(t, ok)
+}
@@ -43,6 +43,12 @@ func (o *objectStorageSemaphore) release() {
 	<-o.semaphore
 }
 
+// idle reports whether less than half of the slots are in use,
+// background operations like read ahead run only when the storage is idle
+func (o *objectStorageSemaphore) idle() bool {
+	return len(o.semaphore) < cap(o.semaphore)/2
+}
+
 var _ ObjectStorage = new(objectStorageSemaphore)
 
 func (o *objectStorageSemaphore) Delete(ctx context.Context, keys ...string) (err error) {
@@ -22,6 +22,7 @@ const (
 	SkipDiskCacheReads
 	SkipDiskCacheWrites
 	SkipFullFilePreloads
+	SkipReadAhead
 )
 
 const (
@@ -0,0 +1,380 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	metric "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
+	"github.com/matrixorigin/matrixone/pkg/util/trace/impl/motrace/statistic"
+)
+
+const (
+	// number of consecutive sequential reads to start reading ahead
+	readAheadTrigger = 2
+	// the first window of a sequential stream
+	readAheadMinWindow = 256 << 10
+	// max number of tracked streams
+	readAheadMaxStreams = 4096
+	// streams not accessed in this duration are evicted
+	readAheadStreamTTL = time.Second * 30
+	// timeout of a single read ahead operation
+	readAheadTimeout = time.Minute
+)
+
+// readAhead detects sequential reads of an object in a query and reads the
+// following ranges concurrently before they are requested.
+//
+// A stream is keyed by the statement statistics of the context and the object
+// key. The window of a stream starts at readAheadMinWindow, doubles on each
+// sequential read, up to the max window, and shrinks back on random accesses.
+// Read ahead operations use only idle slots of the object storage semaphore,
+// so they never delay demand reads.
+type readAhead struct {
+	storage   ObjectStorage
+	semaphore *objectStorageSemaphore
+	maxWindow int64
+	capacity  int64
+	slots     chan struct{}
+
+	// bytes of unconsumed buffers
+	bufferedBytes atomic.Int64
+
+	// statistics
+	issuedBytes atomic.Int64
+	hitBytes    atomic.Int64
+	wasteBytes  atomic.Int64
+
+	mu struct {
+		sync.Mutex
+		streams map[readAheadKey]*readAheadStream
+		queue   []readAheadKey
+	}
+}
+
+type readAheadKey struct {
+	stats *statistic.StatsInfo
+	key   string
+}
+
+type readAheadStream struct {
+	mu         sync.Mutex
+	key        string
+	nextOffset int64
+	sequential int
+	window     int64
+	issuedEnd  int64
+	eof        bool
+	buffers    []*readAheadBuffer
+	lastAccess time.Time
+}
+
+type readAheadBuffer struct {
+	offset int64
+	size   int64
+	used   int64
+	done   chan struct{}
+	data   []byte
+	err    error
+}
+
+func newReadAhead(
+	config CacheConfig,
+	storage ObjectStorage,
+	semaphore *objectStorageSemaphore,
+) *readAhead {
+	config.setDefaults()
+	if *config.ReadAheadCapacity <= DisableCacheCapacity {
+		return nil
+	}
+	r := &readAhead{
+		storage:   storage,
+		semaphore: semaphore,
+		maxWindow: max(int64(*config.ReadAheadMaxWindow), readAheadMinWindow),
+		capacity:  int64(*config.ReadAheadCapacity),
+		slots:     make(chan struct{}, config.ReadAheadConcurrency),
+	}
+	r.mu.streams = make(map[readAheadKey]*readAheadStream)
+	return r
+}
+
+// Read fills the not done entries of the vector from the read ahead buffers,
+// and issues read ahead operations if the reads of the object are sequential.
+// Entries not filled are left to the demand read.
+func (r *readAhead) Read(ctx context.Context, key string, vector *IOVector) error {
+	if vector.Policy.Any(SkipReadAhead) {
+		return nil
+	}
+	if _, _, readFull := vector.readRange(); readFull {
+		// the full object will be read and cached
+		return nil
+	}
+
+	// the range of the vector
+	min := int64(-1)
+	max := int64(-1)
+	for _, entry := range vector.Entries {
+		if entry.Size <= 0 {
+			// read to end, not a range read
+			return nil
+		}
+		if min < 0 || entry.Offset < min {
+			min = entry.Offset
+		}
+		if end := entry.Offset + entry.Size; end > max {
+			max = end
+		}
+	}
+	if min < 0 {
+		return nil
+	}
+
+	stream := r.getStream(ctx, key)
+
+	stream.mu.Lock()
+	stream.lastAccess = time.Now()
+	r.observe(stream, min, max)
+
+	// buffers for the entries
+	var matches []*readAheadBuffer
+	for i := range vector.Entries {
+		entry := &vector.Entries[i]
+		var match *readAheadBuffer
+		if !entry.done {
+			for _, buf := range stream.buffers {
+				if entry.Offset >= buf.offset &&
+					entry.Offset+entry.Size <= buf.offset+buf.size {
+					match = buf
+					break
+				}
+			}
+		}
+		matches = append(matches, match)
+	}
+
+	r.schedule(stream)
+	stream.mu.Unlock()
+
+	for i, buf := range matches {
+		if buf == nil {
+			continue
+		}
+		select {
+		case <-buf.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		entry := &vector.Entries[i]
+		if buf.err != nil {
+			stream.stop()
+			continue
+		}
+		start := entry.Offset - buf.offset
+		end := start + entry.Size
+		if end > int64(len(buf.data)) {
+			// short read at the end of the object
+			stream.stop()
+			continue
+		}
+		if err := entry.readFromBytes(ctx, buf.data[start:end]); err != nil {
+			return err
+		}
+		stream.mu.Lock()
+		buf.used += entry.Size
+		stream.mu.Unlock()
+		r.hitBytes.Add(entry.Size)
+		metric.FSReadAheadHitCounter.Add(1)
+		metric.FSReadAheadHitBytesCounter.Add(float64(entry.Size))
+	}
+
+	return nil
+}
+
+func (r *readAhead) getStream(ctx context.Context, key string) *readAheadStream {
+	streamKey := readAheadKey{
+		stats: statistic.StatsInfoFromContext(ctx),
+		key:   key,
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stream, ok := r.mu.streams[streamKey]
+	if ok {
+		return stream
+	}
+
+	// evict stale streams
+	now := time.Now()
+	for len(r.mu.queue) > 0 {
+		head := r.mu.streams[r.mu.queue[0]]
+		if len(r.mu.queue) < readAheadMaxStreams && !head.idle(now) {
+			break
+		}
+		delete(r.mu.streams, r.mu.queue[0])
+		r.mu.queue = r.mu.queue[1:]
+		head.mu.Lock()
+		r.dropBuffers(head, len(head.buffers))
+		head.mu.Unlock()
+	}
+
+	stream = &readAheadStream{
+		key:        key,
+		window:     readAheadMinWindow,
+		lastAccess: now,
+	}
+	r.mu.streams[streamKey] = stream
+	r.mu.queue = append(r.mu.queue, streamKey)
+	return stream
+}
+
+// observe updates the access pattern of the stream by the range [start, end)
+// of a read. stream.mu must be held.
+func (r *readAhead) observe(stream *readAheadStream, start int64, end int64) {
+	switch {
+
+	case start >= stream.nextOffset && start-stream.nextOffset <= r.maxWindow:
+		// sequential, gaps of skipped columns are allowed
+		stream.sequential++
+		if stream.sequential > readAheadTrigger {
+			stream.window = min(stream.window*2, r.maxWindow)
+		}
+
+	case end <= stream.nextOffset && start >= stream.nextOffset-stream.window:
+		// a read of the recent range, do not break the stream
+		return
+
+	default:
+		// random access
+		stream.sequential = 0
+		stream.window = readAheadMinWindow
+		r.dropBuffers(stream, len(stream.buffers))
+		stream.issuedEnd = 0
+	}
+
+	stream.nextOffset = end
+
+	// drop buffers passed by the reader
+	n := 0
+	for _, buf := range stream.buffers {
+		if buf.offset+buf.size > start {
+			break
+		}
+		n++
+	}
+	r.dropBuffers(stream, n)
+}
+
+// schedule issues the read ahead operation of the stream if it's sequential.
+// stream.mu must be held.
+func (r *readAhead) schedule(stream *readAheadStream) {
+	if stream.eof || stream.sequential < readAheadTrigger {
+		return
+	}
+
+	start := max(stream.issuedEnd, stream.nextOffset)
+	end := stream.nextOffset + stream.window
+	size := end - start
+	if size < readAheadMinWindow/2 {
+		// not worth a request
+		return
+	}
+
+	// use only idle slots of the object storage
+	if r.semaphore != nil && !r.semaphore.idle() {
+		metric.FSReadAheadSkipCounter.Add(1)
+		return
+	}
+	if r.bufferedBytes.Load()+size > r.capacity {
+		metric.FSReadAheadSkipCounter.Add(1)
+		return
+	}
+	select {
+	case r.slots <- struct{}{}:
+	default:
+		metric.FSReadAheadSkipCounter.Add(1)
+		return
+	}
+
+	buf := &readAheadBuffer{
+		offset: start,
+		size:   size,
+		done:   make(chan struct{}),
+	}
+	stream.buffers = append(stream.buffers, buf)
+	stream.issuedEnd = end
+	r.bufferedBytes.Add(size)
+	r.issuedBytes.Add(size)
+	metric.FSReadAheadIssueCounter.Add(1)
+	metric.FSReadAheadIssueBytesCounter.Add(float64(size))
+
+	go func() {
+		defer func() {
+			<-r.slots
+		}()
+		defer close(buf.done)
+
+		ctx, cancel := context.WithTimeout(context.Background(), readAheadTimeout)
+		defer cancel()
+		reader, err := r.storage.Read(ctx, stream.key, ptrTo(buf.offset), ptrTo(buf.offset+buf.size))
+		if err == nil {
+			buf.data, err = io.ReadAll(reader)
+			_ = reader.Close()
+		}
+		buf.err = err
+		if err != nil || int64(len(buf.data)) < buf.size {
+			// end of the object
+			stream.stop()
+		}
+	}()
+}
+
+// dropBuffers drops the first n buffers of the stream. stream.mu must be held.
+func (r *readAhead) dropBuffers(stream *readAheadStream, n int) {
+	if n == 0 {
+		return
+	}
+	for _, buf := range stream.buffers[:n] {
+		r.bufferedBytes.Add(-buf.size)
+		fetched := buf.size
+		select {
+		case <-buf.done:
+			fetched = int64(len(buf.data))
+		default:
+		}
+		if waste := fetched - buf.used; waste > 0 {
+			r.wasteBytes.Add(waste)
+			metric.FSReadAheadWasteBytesCounter.Add(float64(waste))
+		}
+	}
+	stream.buffers = stream.buffers[n:]
+}
+
+// stop stops reading ahead of the stream, on the end of the object or errors
+func (s *readAheadStream) stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.eof = true
+}
+
+func (s *readAheadStream) idle(now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return now.Sub(s.lastAccess) > readAheadStreamTTL
+}
@@ -0,0 +1,131 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileservice
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/util/toml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newReadAheadTestFS(t *testing.T, name string) *S3FS {
+	fs, err := NewS3FS(
+		context.Background(),
+		ObjectStorageArguments{
+			Name:     name,
+			Endpoint: "disk",
+			Bucket:   t.TempDir(),
+		},
+		CacheConfig{
+			MemoryCapacity:     ptrTo[toml.ByteSize](DisableCacheCapacity),
+			DiskCapacity:       ptrTo[toml.ByteSize](DisableCacheCapacity),
+			ReadAheadCapacity:  ptrTo[toml.ByteSize](64 << 20),
+			ReadAheadMaxWindow: ptrTo[toml.ByteSize](1 << 20),
+		},
+		nil,
+		false,
+		true,
+	)
+	require.Nil(t, err)
+	require.NotNil(t, fs.readAhead)
+	return fs
+}
+
+func TestReadAheadFileService(t *testing.T) {
+	testFileService(t, SkipFullFilePreloads, func(name string) FileService {
+		return newReadAheadTestFS(t, name)
+	})
+}
+
+func TestReadAhead(t *testing.T) {
+	ctx := context.Background()
+	fs := newReadAheadTestFS(t, "s3")
+
+	const size = 4 << 20
+	const chunk = 64 << 10
+	data := make([]byte, size)
+	_, err := rand.Read(data)
+	require.Nil(t, err)
+	for _, name := range []string{"seq", "backward", "skip"} {
+		err = fs.Write(ctx, IOVector{
+			FilePath: name,
+			Entries: []IOEntry{
+				{
+					Size: size,
+					Data: data,
+				},
+			},
+		})
+		require.Nil(t, err)
+	}
+
+	read := func(name string, offset int64, policy Policy) {
+		vec := &IOVector{
+			FilePath: name,
+			Policy:   SkipFullFilePreloads | policy,
+			Entries: []IOEntry{
+				{
+					Offset: offset,
+					Size:   chunk / 2,
+				},
+				{
+					// skipped column
+					Offset: offset + chunk*3/4,
+					Size:   chunk / 4,
+				},
+			},
+		}
+		err := fs.Read(ctx, vec)
+		require.Nil(t, err)
+		assert.Equal(t, data[offset:offset+chunk/2], vec.Entries[0].Data)
+		assert.Equal(t, data[offset+chunk*3/4:offset+chunk], vec.Entries[1].Data)
+		vec.Release()
+	}
+
+	// backward reads are not sequential
+	for offset := int64(size - chunk); offset >= 0; offset -= chunk {
+		read("backward", offset, 0)
+	}
+	assert.Equal(t, int64(0), fs.readAhead.issuedBytes.Load())
+
+	// read ahead is disabled by policy
+	for offset := int64(0); offset < size; offset += chunk {
+		read("skip", offset, SkipReadAhead)
+	}
+	assert.Equal(t, int64(0), fs.readAhead.issuedBytes.Load())
+
+	// sequential reads
+	for offset := int64(0); offset < size; offset += chunk {
+		read("seq", offset, 0)
+	}
+	hit := fs.readAhead.hitBytes.Load()
+	// all but the first two reads are served by read ahead
+	assert.Equal(t, int64(size-2*chunk)*3/4, hit)
+	assert.True(t, fs.readAhead.issuedBytes.Load() >= int64(size-2*chunk))
+	// the window grows to the max window
+	stream := fs.readAhead.getStream(ctx, fs.pathToKey("seq"))
+	assert.Equal(t, int64(1<<20), stream.window)
+
+	// random access resets the stream, and drops the buffers
+	read("seq", 0, 0)
+	assert.Equal(t, int64(readAheadMinWindow), stream.window)
+	assert.Equal(t, 0, len(stream.buffers))
+	assert.True(t, fs.readAhead.wasteBytes.Load() > 0)
+	assert.Equal(t, int64(0), fs.readAhead.bufferedBytes.Load())
+}
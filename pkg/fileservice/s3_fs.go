@@ -53,7 +53,8 @@ type S3FS struct {
 
 	perfCounterSets []*perfcounter.CounterSet
 
-	ioMerger *IOMerger
+	ioMerger  *IOMerger
+	readAhead *readAhead
 }
 
 // key mapping scheme:
@@ -81,8 +82,23 @@ func NewS3FS(
 	}
 
 	var err error
+	metricsName := "s3"
 	switch {
 
+	case args.IsAzure:
+		metricsName = "azure"
+		fs.storage, err = NewAzureSDK(ctx, args, perfCounterSets)
+		if err != nil {
+			return nil, err
+		}
+
+	case args.IsGCS:
+		metricsName = "gcs"
+		fs.storage, err = NewGCSSDK(ctx, args, perfCounterSets)
+		if err != nil {
+			return nil, err
+		}
+
 	case strings.Contains(args.Endpoint, "ctyunapi.cn"):
 		fs.storage, err = NewMinioSDK(ctx, args, perfCounterSets)
 		if err != nil {
@@ -114,15 +130,16 @@ func NewS3FS(
 	if concurrency == 0 {
 		concurrency = 100
 	}
-	fs.storage = newObjectStorageSemaphore(
+	semaphore := newObjectStorageSemaphore(
 		fs.storage,
 		concurrency,
 	)
+	fs.storage = semaphore
 
 	// metrics
 	fs.storage = newObjectStorageMetrics(
 		fs.storage,
-		"s3",
+		metricsName,
 	)
 
 	// cache
@@ -130,6 +147,7 @@ func NewS3FS(
 		if err := fs.initCaches(ctx, cacheConfig); err != nil {
 			return nil, err
 		}
+		fs.readAhead = newReadAhead(cacheConfig, fs.storage, semaphore)
 	}
 
 	// allocator
@@ -568,6 +586,19 @@ read_memory_cache:
 		}
 	}
 
+	if s.readAhead != nil {
+		path, err := ParsePathAtService(vector.FilePath, s.name)
+		if err != nil {
+			return err
+		}
+		if err := s.readAhead.Read(ctx, s.pathToKey(path.File), vector); err != nil {
+			return err
+		}
+		if vector.allDone() {
+			return nil
+		}
+	}
+
 	if err := s.read(ctx, vector); err != nil {
 		return err
 	}
@@ -912,6 +943,9 @@ var _ CachingFileService = new(S3FS)
 
 func (s *S3FS) Close() {
 	s.FlushCache()
+	if s.diskCache != nil {
+		s.diskCache.Close()
+	}
 }
 
 func (s *S3FS) FlushCache() {
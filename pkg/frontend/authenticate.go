@@ -910,6 +910,7 @@ var (
 		catalog.MOUpgradeTable:       {},
 		catalog.MOUpgradeTenantTable: {},
 		catalog.MO_PITR:              {},
+		catalog.MO_CN_SCALING:        {},
 	}
 	//predefined tables of the database mo_catalog in every account
 	predefinedTables = map[string]int8{
@@ -938,6 +939,7 @@ var (
 		"mo_cache":                    0,
 		"mo_foreign_keys":             0,
 		"mo_snapshots":                0,
+		catalog.MO_STATISTICS_EXT:     0,
 	}
 	createDbInformationSchemaSql = "create database information_schema;"
 	createAutoTableSql           = MoCatalogMoAutoIncrTableDDL
@@ -970,6 +972,7 @@ var (
 		MoCatalogMoVariablesDDL,
 		MoCatalogMoTransactionsDDL,
 		MoCatalogMoCacheDDL,
+		MoCatalogMoStatisticsExtDDL,
 	}
 
 	//drop tables for the tenant
@@ -989,6 +992,7 @@ var (
 		`drop view if exists mo_catalog.mo_transactions;`,
 		`drop view if exists mo_catalog.mo_cache;`,
 		`drop table if exists mo_catalog.mo_snapshots;`,
+		`drop table if exists mo_catalog.mo_statistics_ext;`,
 	}
 	dropMoMysqlCompatibilityModeSql = `drop table if exists mo_catalog.mo_mysql_compatibility_mode;`
 	dropMoPubsSql                   = `drop table if exists mo_catalog.mo_pubs;`
@@ -2709,7 +2713,6 @@ func doAlterUser(ctx context.Context, ses *Session, au *alterUser) (err error) {
 	var sql string
 	var vr *verifiedRole
 	var erArray []ExecResult
-	var encryption string
 	account := ses.GetTenantInfo()
 	currentUser := account.GetUser()
 
@@ -2717,9 +2720,6 @@ func doAlterUser(ctx context.Context, ses *Session, au *alterUser) (err error) {
 	if au.Role != nil {
 		return moerr.NewInternalError(ctx, "not support alter role")
 	}
-	if au.MiscOpt != nil {
-		return moerr.NewInternalError(ctx, "not support password or lock operation")
-	}
 	if au.CommentOrAttribute.Exist {
 		return moerr.NewInternalError(ctx, "not support alter comment or attribute")
 	}
@@ -2737,9 +2737,23 @@ func doAlterUser(ctx context.Context, ses *Session, au *alterUser) (err error) {
 	}
 	hostName := user.Hostname
 	password := user.IdentStr
-	if len(password) == 0 {
+	if user.AuthExist && len(password) == 0 {
 		return moerr.NewInternalError(ctx, "password is empty string")
 	}
+	//the password is validated before it is changed
+	if user.AuthExist && user.IdentTyp == tree.AccountIdentifiedByPassword {
+		if err = validatePassword(ctx, ses, userName, password); err != nil {
+			return err
+		}
+	}
+
+	//the user that changes its own expired password can execute other statements
+	defer func() {
+		if err == nil && user.AuthExist && currentUser == userName && ses.getRoutine() != nil {
+			ses.getRoutine().setPasswordExpired(false)
+		}
+	}()
+
 	//put it into the single transaction
 	err = bh.Exec(ctx, "begin")
 	defer func() {
@@ -2749,11 +2763,11 @@ func doAlterUser(ctx context.Context, ses *Session, au *alterUser) (err error) {
 		return err
 	}
 
-	if !user.AuthExist {
+	if !user.AuthExist && au.MiscOpt == nil {
 		return moerr.NewInternalError(ctx, "Operation ALTER USER failed for '%s'@'%s', alter Auth is nil", userName, hostName)
 	}
 
-	if user.IdentTyp != tree.AccountIdentifiedByPassword {
+	if user.AuthExist && user.IdentTyp != tree.AccountIdentifiedByPassword {
 		return moerr.NewInternalError(ctx, "Operation ALTER USER failed for '%s'@'%s', only support alter Auth by identified by", userName, hostName)
 	}
 
@@ -2780,7 +2794,7 @@ func doAlterUser(ctx context.Context, ses *Session, au *alterUser) (err error) {
 
 	//if the user is admin user with the role moadmin or accountadmin,
 	//the user can be altered
-	//otherwise only general user can alter itself
+	//otherwise only general user can alter the password of itself
 	if account.IsSysTenant() {
 		sql, err = getSqlForCheckUserHasRole(ctx, currentUser, moAdminRoleID)
 	} else {
@@ -2801,23 +2815,21 @@ func doAlterUser(ctx context.Context, ses *Session, au *alterUser) (err error) {
 		return err
 	}
 
-	//encryption the password
-	encryption = HashPassWord(password)
-
-	if execResultArrayHasData(erArray) || getGlobalPu().SV.SkipCheckPrivilege {
-		sql, err = getSqlForUpdatePasswordOfUser(ctx, encryption, userName)
-		if err != nil {
-			return err
+	if !execResultArrayHasData(erArray) && !getGlobalPu().SV.SkipCheckPrivilege {
+		if currentUser != userName || au.MiscOpt != nil {
+			return moerr.NewInternalError(ctx, "Operation ALTER USER failed for '%s'@'%s', don't have the privilege to alter", userName, hostName)
 		}
-		err = bh.Exec(ctx, sql)
+	}
+
+	if user.AuthExist {
+		err = changePasswordOfUser(ctx, ses, bh, vr.id, userName, password)
 		if err != nil {
 			return err
 		}
-	} else {
-		if currentUser != userName {
-			return moerr.NewInternalError(ctx, "Operation ALTER USER failed for '%s'@'%s', don't have the privilege to alter", userName, hostName)
-		}
-		sql, err = getSqlForUpdatePasswordOfUser(ctx, encryption, userName)
+	}
+
+	if au.MiscOpt != nil {
+		sql, err = getSqlForUpdatePasswordPolicyOfUser(ctx, au.MiscOpt, vr.id)
 		if err != nil {
 			return err
 		}
@@ -5775,6 +5787,20 @@ func determinePrivilegeSetOfStatement(stmt tree.Statement) *privilege {
 		if st.Table != nil {
 			dbName = string(st.Table.SchemaName)
 		}
+	case *tree.CreateStatistics:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeAlterTable, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		if st.Table != nil {
+			dbName = string(st.Table.SchemaName)
+		}
+	case *tree.DropStatistics:
+		objType = objectTypeDatabase
+		typs = append(typs, PrivilegeTypeAlterTable, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
+		writeDatabaseAndTableDirectly = true
+		if st.Table != nil {
+			dbName = string(st.Table.SchemaName)
+		}
 	case *tree.CreateProcedure:
 		objType = objectTypeDatabase
 		typs = append(typs, PrivilegeTypeCreateView, PrivilegeTypeDatabaseAll, PrivilegeTypeDatabaseOwnership)
@@ -8176,7 +8202,6 @@ func InitUser(ctx context.Context, ses *Session, tenant *TenantInfo, cu *createU
 		}
 	}
 
-	//TODO: get password_option or lock_option. there is no field in mo_user to store it.
 	status = userStatusUnlock
 	if cu.MiscOpt != nil {
 		if _, ok := cu.MiscOpt.(*tree.UserMiscOptionAccountLock); ok {
@@ -8251,6 +8276,9 @@ func InitUser(ctx context.Context, ses *Session, tenant *TenantInfo, cu *createU
 		if len(password) == 0 {
 			return moerr.NewInternalError(ctx, "password is empty string")
 		}
+		if err = validatePassword(ctx, ses, user.Username, password); err != nil {
+			return err
+		}
 
 		//encryption the password
 		encryption := HashPassWord(password)
@@ -8294,6 +8322,20 @@ func InitUser(ctx context.Context, ses *Session, tenant *TenantInfo, cu *createU
 			return err
 		}
 
+		//the password option. the lock option has been saved in the status
+		switch cu.MiscOpt.(type) {
+		case nil, *tree.UserMiscOptionAccountLock, *tree.UserMiscOptionAccountUnlock:
+		default:
+			sql, err = getSqlForUpdatePasswordPolicyOfUser(ctx, cu.MiscOpt, newUserId)
+			if err != nil {
+				return err
+			}
+			err = bh.Exec(ctx, sql)
+			if err != nil {
+				return err
+			}
+		}
+
 		initMoUserGrant1 := fmt.Sprintf(initMoUserGrantFormat, newRoleId, newUserId, types.CurrentTimestamp().String2(time.UTC, 0), true)
 		err = bh.Exec(ctx, initMoUserGrant1)
 		if err != nil {
@@ -423,7 +423,11 @@ func Test_initUser(t *testing.T) {
 			DefaultRoleID: moAdminRoleID,
 		}
 
-		ses := &Session{}
+		sysVars := make(map[string]interface{})
+		for name, sysVar := range gSysVarsDefs {
+			sysVars[name] = sysVar.Default
+		}
+		ses := &Session{feSessionImpl: feSessionImpl{gSysVars: &SystemVariables{sysVars: sysVars}}}
 		err := InitUser(ctx, ses, tenant, cu)
 		convey.So(err, convey.ShouldBeError)
 	})
@@ -6731,6 +6735,10 @@ func Test_doAlterUser(t *testing.T) {
 				{0, 0},
 			})
 			bh.sql2result[sql] = mrs
+
+			bh.sql2result[getSqlForPasswordPolicyOfUser(int64(i))] = newMrsForPasswordPolicyOfUser([][]interface{}{
+				{"111", userStatusUnlock, 0, false, -1, -1, -1, "", 0, 0, 0, 0},
+			})
 		}
 
 		for _, user := range stmt.Users {
@@ -840,6 +840,7 @@ func (tcc *TxnCompilerContext) Stats(obj *plan2.ObjectRef, snapshot plan2.Snapsh
 	}
 	if statsInfo != nil {
 		tcc.UpdateStatsInCache(table.GetTableID(ctx), statsInfo)
+		tcc.updateExtendedStatsInCache(dbName, table.GetTableID(ctx))
 		return statsInfo, nil
 	}
 	return cached, nil
@@ -0,0 +1,393 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+var _ Responser = &stmtCursor{}
+
+// stmtCursor is the read-only cursor opened by COM_STMT_EXECUTE with
+// CURSOR_TYPE_READ_ONLY.
+//
+// The cursor replaces the responser of the session while the statement is
+// executed. After the column definitions are sent, the rows are not sent
+// until the client asks for them with COM_STMT_FETCH. The pipeline is suspended
+// in the output callback and reads the next command from the connection:
+//  1. COM_STMT_FETCH of the statement: sends the rows asked by the client.
+//  2. COM_STMT_CLOSE, COM_STMT_RESET, COM_STMT_EXECUTE of the statement or
+//     COM_QUIT: stops the pipeline, the command is handled after that.
+//  3. other commands: the rest rows are buffered in memory, at most
+//     cursorMaxBufferSize bytes, and served by COM_STMT_FETCH later. The
+//     command is handled after the pipeline finished.
+//
+// The cursor is closed if the client does not fetch in cursorIdleTimeout.
+type stmtCursor struct {
+	*MysqlResp
+	ses     *Session
+	proto   *MysqlProtocolImpl
+	stmtID  uint32
+	timeout time.Duration
+	maxSize int64
+	// mrs holds the columns of the cursor
+	mrs *MysqlResultSet
+
+	// opened denotes the column definitions have been sent with SERVER_STATUS_CURSOR_EXISTS
+	opened bool
+	// want is the count of the rows the client is waiting for
+	want uint64
+	// materialized denotes the rest rows are buffered instead of being sent
+	materialized bool
+	// exhausted denotes the last row has been sent
+	exhausted bool
+
+	mu struct {
+		sync.Mutex
+		batches []*batch.Batch
+		// offset of the next row in batches[0]
+		offset int
+		size   int64
+		err    error
+		closed bool
+		active time.Time
+		timer  *time.Timer
+	}
+}
+
+// newStmtCursor returns nil if the session does not talk the mysql protocol
+func newStmtCursor(ses *Session, stmtID uint32) *stmtCursor {
+	resper, ok := ses.GetResponser().(*MysqlResp)
+	if !ok {
+		return nil
+	}
+	proto, ok := resper.mysqlRrWr.(*MysqlProtocolImpl)
+	if !ok {
+		return nil
+	}
+	return &stmtCursor{
+		MysqlResp: resper,
+		ses:       ses,
+		proto:     proto,
+		stmtID:    stmtID,
+		timeout:   getGlobalPu().SV.CursorIdleTimeout.Duration,
+		maxSize:   getGlobalPu().SV.CursorMaxBufferSize,
+		mrs:       &MysqlResultSet{},
+	}
+}
+
+// executeWithCursor executes the statement with the responser replaced by the cursor
+func executeWithCursor(ses *Session, execCtx *ExecCtx, input *UserInput, cursor *stmtCursor) error {
+	prev := ses.ReplaceResponser(cursor)
+	defer ses.ReplaceResponser(prev)
+	return doComQuery(ses, execCtx, input)
+}
+
+func (c *stmtCursor) RespPreMeta(execCtx *ExecCtx, meta any) (err error) {
+	st, ok := execCtx.stmt.(*tree.Select)
+	if !ok || st.Ep != nil || execCtx.inMigration {
+		return c.MysqlResp.RespPreMeta(execCtx, meta)
+	}
+	columns := meta.([]any)
+	mrs := c.ses.GetMysqlResultSet()
+	if err = c.proto.WriteLengthEncodedNumber(uint64(len(columns))); err != nil {
+		return
+	}
+	for _, col := range columns {
+		mysqlc := col.(Column)
+		mrs.AddColumn(mysqlc)
+		c.mrs.AddColumn(mysqlc)
+		if err = c.proto.WriteColumnDef(execCtx.reqCtx, mysqlc, int(COM_STMT_EXECUTE)); err != nil {
+			return
+		}
+	}
+	// the column definitions of the cursor always end with EOF or OK,
+	// even if CLIENT_DEPRECATE_EOF is set.
+	if err = c.proto.WriteEOFOrOK(0, c.ses.GetTxnHandler().GetServerStatus()|SERVER_STATUS_CURSOR_EXISTS); err != nil {
+		return
+	}
+	c.opened = true
+	return
+}
+
+func (c *stmtCursor) RespResult(execCtx *ExecCtx, bat *batch.Batch) (err error) {
+	if !c.opened {
+		return c.MysqlResp.RespResult(execCtx, bat)
+	}
+	if bat == nil || bat.RowCount() == 0 {
+		return nil
+	}
+	if c.materialized {
+		return c.buffer(bat, 0)
+	}
+
+	mrs := c.newRowSet(len(bat.Vecs))
+	for j := 0; j < bat.RowCount(); j++ {
+		if c.want == 0 {
+			if err = c.waitFetch(execCtx.reqCtx); err != nil {
+				return err
+			}
+			if c.materialized {
+				return c.buffer(bat, j)
+			}
+		}
+		if err = extractRowFromEveryVector(execCtx.reqCtx, c.ses, bat, j, mrs.Data[0]); err != nil {
+			return err
+		}
+		if err = c.proto.WriteResultSetRow(mrs, 1); err != nil {
+			return err
+		}
+		c.want--
+		if c.want == 0 {
+			if err = c.proto.WriteEOFOrOK(0, c.ses.GetTxnHandler().GetServerStatus()|SERVER_STATUS_CURSOR_EXISTS); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *stmtCursor) RespPostMeta(execCtx *ExecCtx, meta any) (err error) {
+	if !c.opened {
+		return c.MysqlResp.RespPostMeta(execCtx, meta)
+	}
+	if len(execCtx.proc.GetSessionInfo().SeqAddValues) != 0 {
+		c.ses.AddSeqValues(execCtx.proc)
+	}
+	c.ses.SetSeqLastValue(execCtx.proc)
+	if c.want > 0 {
+		// the client is waiting for the rows that do not exist
+		c.want = 0
+		c.exhausted = true
+		return c.proto.WriteEOFOrOK(0, c.ses.getStatusAfterTxnIsEnded(execCtx.reqCtx)|SERVER_STATUS_LAST_ROW_SENT)
+	}
+	return nil
+}
+
+// waitFetch suspends the pipeline until the client fetches rows or runs other commands
+func (c *stmtCursor) waitFetch(ctx context.Context) error {
+	for {
+		payload, err := c.proto.tcpConn.ReadWithTimeout(c.timeout)
+		if err != nil {
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				return c.idleTimeoutError()
+			}
+			return err
+		}
+		if len(payload) == 0 {
+			return moerr.NewInvalidInput(ctx, "sql command contains malformed packet")
+		}
+
+		cmd := CommandType(payload[0])
+		data := payload[1:]
+		sameStmt := len(data) >= 4 && binary.LittleEndian.Uint32(data[0:4]) == c.stmtID
+		switch {
+		case cmd == COM_STMT_FETCH && sameStmt:
+			if len(data) < 8 {
+				err = moerr.NewInvalidInput(ctx, "sql command contains malformed packet")
+				resp := NewGeneralErrorResponse(COM_STMT_FETCH, c.ses.GetTxnHandler().GetServerStatus(), err)
+				if err = c.proto.WriteResponse(ctx, resp); err != nil {
+					return err
+				}
+				continue
+			}
+			c.want = uint64(binary.LittleEndian.Uint32(data[4:8]))
+			if c.want == 0 {
+				if err = c.proto.WriteEOFOrOK(0, c.ses.GetTxnHandler().GetServerStatus()|SERVER_STATUS_CURSOR_EXISTS); err != nil {
+					return err
+				}
+				continue
+			}
+			return nil
+		case cmd == COM_QUIT,
+			sameStmt && (cmd == COM_STMT_CLOSE || cmd == COM_STMT_RESET || cmd == COM_STMT_EXECUTE):
+			// the cursor is closed by the command, stop the pipeline and handle
+			// the command after that.
+			c.proto.tcpConn.Unread(payload)
+			return moerr.NewInvalidState(ctx, "the cursor of statement (%d) is closed", c.stmtID)
+		default:
+			c.proto.tcpConn.Unread(payload)
+			c.materialized = true
+			return nil
+		}
+	}
+}
+
+// buffer keeps the rows of the batch from the offset in memory
+func (c *stmtCursor) buffer(bat *batch.Batch, offset int) error {
+	mp := c.ses.GetMemPool()
+	dup, err := bat.Dup(mp)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	size := int64(dup.Size())
+	if c.mu.size+size > c.maxSize {
+		dup.Clean(mp)
+		return moerr.NewInternalErrorNoCtx("the rows of the cursor exceed the buffer size %d", c.maxSize)
+	}
+	if len(c.mu.batches) == 0 {
+		c.mu.offset = offset
+	}
+	c.mu.batches = append(c.mu.batches, dup)
+	c.mu.size += size
+	return nil
+}
+
+// end is called after the pipeline of the cursor finished. The cursor is kept
+// in the statement if the client can fetch it. It returns the error which should
+// be sent to the client.
+func (c *stmtCursor) end(stmt *PrepareStmt, err error) error {
+	if err != nil && c.want > 0 {
+		// the client is waiting for the rows
+		c.close()
+		return err
+	}
+	if c.exhausted {
+		c.close()
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// the error is sent to the client by the next COM_STMT_FETCH
+	c.mu.err = err
+	c.mu.active = time.Now()
+	c.mu.timer = time.AfterFunc(c.timeout, c.expire)
+	stmt.cursor = c
+	return nil
+}
+
+// fetch sends at most n buffered rows to the client. It returns true if the last
+// row has been sent.
+func (c *stmtCursor) fetch(ctx context.Context, n uint32) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.mu.err != nil {
+		return true, c.mu.err
+	}
+	c.mu.active = time.Now()
+
+	var err error
+	mp := c.ses.GetMemPool()
+	sent := uint32(0)
+	for sent < n && len(c.mu.batches) > 0 {
+		bat := c.mu.batches[0]
+		mrs := c.newRowSet(len(bat.Vecs))
+		for ; c.mu.offset < bat.RowCount() && sent < n; c.mu.offset++ {
+			if err = extractRowFromEveryVector(ctx, c.ses, bat, c.mu.offset, mrs.Data[0]); err != nil {
+				return true, err
+			}
+			if err = c.proto.WriteResultSetRow(mrs, 1); err != nil {
+				return true, err
+			}
+			sent++
+		}
+		if c.mu.offset >= bat.RowCount() {
+			c.mu.size -= int64(bat.Size())
+			bat.Clean(mp)
+			c.mu.batches = c.mu.batches[1:]
+			c.mu.offset = 0
+		}
+	}
+
+	status := c.ses.GetTxnHandler().GetServerStatus()
+	if len(c.mu.batches) == 0 {
+		return true, c.proto.WriteEOFOrOK(0, status|SERVER_STATUS_LAST_ROW_SENT)
+	}
+	return false, c.proto.WriteEOFOrOK(0, status|SERVER_STATUS_CURSOR_EXISTS)
+}
+
+// expire releases the rows if the client does not fetch in time
+func (c *stmtCursor) expire() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.mu.closed {
+		return
+	}
+	if idle := time.Since(c.mu.active); idle < c.timeout {
+		c.mu.timer.Reset(c.timeout - idle)
+		return
+	}
+	c.freeLocked()
+	c.mu.err = c.idleTimeoutError()
+}
+
+func (c *stmtCursor) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.mu.closed {
+		return
+	}
+	c.mu.closed = true
+	if c.mu.timer != nil {
+		c.mu.timer.Stop()
+	}
+	c.freeLocked()
+}
+
+func (c *stmtCursor) freeLocked() {
+	mp := c.ses.GetMemPool()
+	for _, bat := range c.mu.batches {
+		bat.Clean(mp)
+	}
+	c.mu.batches = nil
+	c.mu.offset = 0
+	c.mu.size = 0
+}
+
+func (c *stmtCursor) idleTimeoutError() error {
+	return moerr.NewInvalidStateNoCtx("the cursor of statement (%d) is closed after idle for %s", c.stmtID, c.timeout)
+}
+
+// newRowSet returns the result set holding one row for writing
+func (c *stmtCursor) newRowSet(colCnt int) *MysqlResultSet {
+	return &MysqlResultSet{
+		Columns:    c.mrs.Columns,
+		Name2Index: c.mrs.Name2Index,
+		Data:       [][]any{make([]any, colCnt)},
+	}
+}
+
+func handleStmtFetch(ses *Session, execCtx *ExecCtx, data []byte) error {
+	// see https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_stmt_fetch.html
+	if len(data) < 8 {
+		return moerr.NewInvalidInput(execCtx.reqCtx, "sql command contains malformed packet")
+	}
+	stmtID := binary.LittleEndian.Uint32(data[0:4])
+	numRows := binary.LittleEndian.Uint32(data[4:8])
+	preStmt, err := ses.GetPrepareStmt(execCtx.reqCtx, getPrepareStmtName(stmtID))
+	if err != nil {
+		return err
+	}
+	if preStmt.cursor == nil {
+		return moerr.NewInvalidState(execCtx.reqCtx, "the statement (%d) has no open cursor", stmtID)
+	}
+	last, err := preStmt.cursor.fetch(execCtx.reqCtx, numRows)
+	if last {
+		preStmt.closeCursor()
+	}
+	return err
+}
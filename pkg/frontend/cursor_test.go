@@ -0,0 +1,266 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type cursorTestClient struct {
+	t    *testing.T
+	conn net.Conn
+}
+
+func (c *cursorTestClient) readPacket() []byte {
+	header := make([]byte, 4)
+	_, err := io.ReadFull(c.conn, header)
+	require.NoError(c.t, err)
+	length := int(uint32(header[0]) | uint32(header[1])<<8 | uint32(header[2])<<16)
+	payload := make([]byte, length)
+	_, err = io.ReadFull(c.conn, payload)
+	require.NoError(c.t, err)
+	return payload
+}
+
+func (c *cursorTestClient) writeCommand(cmd CommandType, data ...uint32) {
+	payload := []byte{byte(cmd)}
+	for _, d := range data {
+		payload = binary.LittleEndian.AppendUint32(payload, d)
+	}
+	packet := []byte{byte(len(payload)), 0, 0, 0}
+	_, err := c.conn.Write(append(packet, payload...))
+	require.NoError(c.t, err)
+}
+
+// readRows reads the binary rows until the EOF packet and returns the rows and the status
+func (c *cursorTestClient) readRows() ([]int64, uint16) {
+	var rows []int64
+	for {
+		payload := c.readPacket()
+		if payload[0] == 0xfe && len(payload) == 5 {
+			return rows, binary.LittleEndian.Uint16(payload[3:5])
+		}
+		require.Equal(c.t, byte(0), payload[0])
+		rows = append(rows, int64(binary.LittleEndian.Uint64(payload[len(payload)-8:])))
+	}
+}
+
+func (c *cursorTestClient) readMeta() uint16 {
+	assert.Equal(c.t, []byte{1}, c.readPacket())
+	c.readPacket()
+	rows, status := c.readRows()
+	assert.Empty(c.t, rows)
+	return status
+}
+
+func newCursorTestSession(t *testing.T) (*Session, *cursorTestClient) {
+	clientConn, serverConn := net.Pipe()
+	t.Cleanup(func() {
+		_ = clientConn.Close()
+		_ = serverConn.Close()
+	})
+
+	sv := &config.FrontendParameters{}
+	sv.SetDefaultValues()
+	pu := config.NewParameterUnit(sv, nil, nil, nil)
+	setGlobalPu(pu)
+	ioses, err := NewIOSession(serverConn, pu)
+	require.NoError(t, err)
+	proto := NewMysqlClientProtocol("", 0, ioses, 1024, pu.SV)
+	proto.capability = DefaultCapability &^ CLIENT_DEPRECATE_EOF
+
+	mp, err := mpool.NewMPool("cursor_test", 0, mpool.NoFixed)
+	require.NoError(t, err)
+	ses := NewSession(context.TODO(), "", proto, mp)
+	proto.SetSession(ses)
+	ses.SetMysqlResultSet(&MysqlResultSet{})
+	ses.SetCmd(COM_STMT_EXECUTE)
+	ses.proc.Base.SessionInfo.SeqLastValue = []string{""}
+	return ses, &cursorTestClient{t: t, conn: clientConn}
+}
+
+func newCursorTestBatch(t *testing.T, mp *mpool.MPool, rows ...int64) *batch.Batch {
+	vec := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixedList(vec, rows, nil, mp))
+	bat := batch.NewWithSize(1)
+	bat.Vecs[0] = vec
+	bat.SetRowCount(len(rows))
+	return bat
+}
+
+// runCursor runs the cursor like the pipeline of the statement
+func runCursor(t *testing.T, ses *Session, cursor *stmtCursor, stmt *PrepareStmt, batches ...*batch.Batch) chan error {
+	col := &MysqlColumn{}
+	col.SetName("a")
+	col.SetColumnType(defines.MYSQL_TYPE_LONGLONG)
+	execCtx := &ExecCtx{
+		reqCtx: context.TODO(),
+		ses:    ses,
+		stmt:   &tree.Select{},
+		proc:   ses.proc,
+	}
+	done := make(chan error, 1)
+	go func() {
+		err := cursor.RespPreMeta(execCtx, []any{col})
+		for _, bat := range batches {
+			if err != nil {
+				break
+			}
+			err = cursor.RespResult(execCtx, bat)
+		}
+		if err == nil {
+			err = cursor.RespPostMeta(execCtx, nil)
+		}
+		done <- cursor.end(stmt, err)
+	}()
+	return done
+}
+
+func TestStmtCursorFetch(t *testing.T) {
+	ses, client := newCursorTestSession(t)
+	mp := ses.GetMemPool()
+	cursor := newStmtCursor(ses, 1)
+	require.NotNil(t, cursor)
+	stmt := &PrepareStmt{}
+
+	done := runCursor(t, ses, cursor, stmt,
+		newCursorTestBatch(t, mp, 1, 2, 3),
+		newCursorTestBatch(t, mp, 4, 5))
+	assert.NotZero(t, client.readMeta()&SERVER_STATUS_CURSOR_EXISTS)
+
+	client.writeCommand(COM_STMT_FETCH, 1, 2)
+	rows, status := client.readRows()
+	assert.Equal(t, []int64{1, 2}, rows)
+	assert.NotZero(t, status&SERVER_STATUS_CURSOR_EXISTS)
+
+	client.writeCommand(COM_STMT_FETCH, 1, 10)
+	rows, status = client.readRows()
+	assert.Equal(t, []int64{3, 4, 5}, rows)
+	assert.NotZero(t, status&SERVER_STATUS_LAST_ROW_SENT)
+
+	require.NoError(t, <-done)
+	assert.Nil(t, stmt.cursor)
+}
+
+func TestStmtCursorMaterialize(t *testing.T) {
+	ses, client := newCursorTestSession(t)
+	mp := ses.GetMemPool()
+	cursor := newStmtCursor(ses, 1)
+	require.NotNil(t, cursor)
+	stmt := &PrepareStmt{}
+	ses.prepareStmts[getPrepareStmtName(1)] = stmt
+
+	done := runCursor(t, ses, cursor, stmt,
+		newCursorTestBatch(t, mp, 1, 2, 3),
+		newCursorTestBatch(t, mp, 4, 5))
+	client.readMeta()
+	client.writeCommand(COM_STMT_FETCH, 1, 2)
+	rows, _ := client.readRows()
+	assert.Equal(t, []int64{1, 2}, rows)
+
+	// other command makes the cursor buffer the rest rows
+	client.writeCommand(COM_PING)
+	require.NoError(t, <-done)
+	require.NotNil(t, stmt.cursor)
+	payload, err := cursor.proto.Read()
+	require.NoError(t, err)
+	assert.Equal(t, []byte{byte(COM_PING)}, payload)
+
+	execCtx := &ExecCtx{reqCtx: context.TODO(), ses: ses}
+	ses.SetCmd(COM_STMT_FETCH)
+	fetched := make(chan struct{})
+	go func() {
+		defer close(fetched)
+		assert.NoError(t, handleStmtFetch(ses, execCtx, binary.LittleEndian.AppendUint32([]byte{1, 0, 0, 0}, 2)))
+		assert.NoError(t, handleStmtFetch(ses, execCtx, binary.LittleEndian.AppendUint32([]byte{1, 0, 0, 0}, 2)))
+	}()
+	rows, status := client.readRows()
+	assert.Equal(t, []int64{3, 4}, rows)
+	assert.NotZero(t, status&SERVER_STATUS_CURSOR_EXISTS)
+	rows, status = client.readRows()
+	assert.Equal(t, []int64{5}, rows)
+	assert.NotZero(t, status&SERVER_STATUS_LAST_ROW_SENT)
+	<-fetched
+	require.Nil(t, stmt.cursor)
+
+	err = handleStmtFetch(ses, execCtx, binary.LittleEndian.AppendUint32([]byte{1, 0, 0, 0}, 2))
+	assert.Error(t, err)
+}
+
+func TestStmtCursorClose(t *testing.T) {
+	ses, client := newCursorTestSession(t)
+	cursor := newStmtCursor(ses, 1)
+	require.NotNil(t, cursor)
+	stmt := &PrepareStmt{}
+
+	done := runCursor(t, ses, cursor, stmt, newCursorTestBatch(t, ses.GetMemPool(), 1, 2, 3))
+	client.readMeta()
+	client.writeCommand(COM_STMT_CLOSE, 1)
+	// the error is not sent to the client
+	require.NoError(t, <-done)
+	payload, err := cursor.proto.Read()
+	require.NoError(t, err)
+	assert.Equal(t, byte(COM_STMT_CLOSE), payload[0])
+
+	_, err = stmt.cursor.fetch(context.TODO(), 1)
+	assert.Error(t, err)
+	stmt.closeCursor()
+}
+
+func TestStmtCursorLimits(t *testing.T) {
+	ses, client := newCursorTestSession(t)
+	mp := ses.GetMemPool()
+
+	// the buffered rows exceed the limit
+	cursor := newStmtCursor(ses, 1)
+	require.NotNil(t, cursor)
+	cursor.maxSize = 1
+	stmt := &PrepareStmt{}
+	done := runCursor(t, ses, cursor, stmt, newCursorTestBatch(t, mp, 1, 2, 3))
+	client.readMeta()
+	client.writeCommand(COM_PING)
+	require.NoError(t, <-done)
+	_, err := cursor.proto.Read()
+	require.NoError(t, err)
+	_, err = stmt.cursor.fetch(context.TODO(), 1)
+	assert.Error(t, err)
+	stmt.closeCursor()
+
+	// the client does not fetch in time
+	cursor = newStmtCursor(ses, 1)
+	require.NotNil(t, cursor)
+	cursor.timeout = time.Millisecond * 10
+	done = runCursor(t, ses, cursor, stmt, newCursorTestBatch(t, mp, 1, 2, 3))
+	client.readMeta()
+	require.NoError(t, <-done)
+	_, err = stmt.cursor.fetch(context.TODO(), 1)
+	assert.Error(t, err)
+	stmt.closeCursor()
+}
@@ -0,0 +1,313 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+const (
+	// AuthMoreDataHeader is the header of the AuthMoreData packet which
+	// carries the extra data of the authentication method.
+	AuthMoreDataHeader byte = 0x01
+	// CachingSha2RequestPublicKey is sent by the client to ask for the RSA
+	// public key of the server.
+	CachingSha2RequestPublicKey byte = 0x02
+	// CachingSha2FastAuthSuccess is sent by the server when the scramble
+	// matches the cached digest.
+	CachingSha2FastAuthSuccess byte = 0x03
+	// CachingSha2PerformFullAuth is sent by the server to ask the client
+	// for the password.
+	CachingSha2PerformFullAuth byte = 0x04
+
+	// the size of the RSA key generated when the key file is not set.
+	sha2RSAKeyBits = 2048
+)
+
+func isSupportedAuthPlugin(name string) bool {
+	return name == AuthNativePassword || name == AuthCachingSha2Password
+}
+
+// sha2CacheEntry is the cached digest of a user. pwd is the password stored
+// in mo_user when the entry is cached, the entry is stale once the password
+// has been changed, no matter on which CN.
+type sha2CacheEntry struct {
+	pwd []byte
+	// SHA256(SHA256(password))
+	digest []byte
+}
+
+// sha2Cache keeps the digests of the users that have passed the full
+// authentication of caching_sha2_password on this CN.
+type sha2Cache struct {
+	sync.RWMutex
+	entries map[string]sha2CacheEntry
+}
+
+var globalSha2Cache = &sha2Cache{entries: make(map[string]sha2CacheEntry)}
+
+func sha2CacheKey(tenant *TenantInfo) string {
+	return tenant.GetTenant() + ":" + tenant.GetUser()
+}
+
+func (c *sha2Cache) get(key string, pwd []byte) ([]byte, bool) {
+	c.RLock()
+	defer c.RUnlock()
+	entry, ok := c.entries[key]
+	if !ok || !bytes.Equal(entry.pwd, pwd) {
+		return nil, false
+	}
+	return entry.digest, true
+}
+
+func (c *sha2Cache) set(key string, pwd, digest []byte) {
+	c.Lock()
+	defer c.Unlock()
+	c.entries[key] = sha2CacheEntry{pwd: bytes.Clone(pwd), digest: digest}
+}
+
+// sha2RSAKey is the RSA key pair used to exchange the password of
+// caching_sha2_password on the insecure connection.
+var sha2RSAKey struct {
+	sync.Mutex
+	key *rsa.PrivateKey
+	// the public key in PEM format sent to the client
+	publicKey []byte
+}
+
+// getSha2RSAKey loads the private key from the file, or generates one if the
+// file is not set.
+func getSha2RSAKey(ctx context.Context, file string) (*rsa.PrivateKey, []byte, error) {
+	sha2RSAKey.Lock()
+	defer sha2RSAKey.Unlock()
+	if sha2RSAKey.key != nil {
+		return sha2RSAKey.key, sha2RSAKey.publicKey, nil
+	}
+
+	var key *rsa.PrivateKey
+	var err error
+	if file == "" {
+		if key, err = rsa.GenerateKey(rand.Reader, sha2RSAKeyBits); err != nil {
+			return nil, nil, err
+		}
+	} else if key, err = loadRSAPrivateKey(ctx, file); err != nil {
+		return nil, nil, err
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	sha2RSAKey.key = key
+	sha2RSAKey.publicKey = pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	return sha2RSAKey.key, sha2RSAKey.publicKey, nil
+}
+
+func loadRSAPrivateKey(ctx context.Context, file string) (*rsa.PrivateKey, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, moerr.NewInternalError(ctx, "no PEM data is found in %s", file)
+	}
+	if block.Type == "RSA PRIVATE KEY" {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, moerr.NewInternalError(ctx, "%s is not a RSA private key", file)
+	}
+	return rsaKey, nil
+}
+
+// ScrambleCachingSha2Password computes the auth data of caching_sha2_password
+// XOR(SHA256(password), SHA256(SHA256(SHA256(password)) + salt))
+func ScrambleCachingSha2Password(password, salt []byte) []byte {
+	hash1 := sha256.Sum256(password)
+	hash2 := sha256.Sum256(hash1[:])
+	hash3 := sha256.Sum256(append(hash2[:], salt...))
+	for i := range hash1 {
+		hash1[i] ^= hash3[i]
+	}
+	return hash1[:]
+}
+
+// EncryptCachingSha2Password encrypts the password with the RSA public key of
+// the server for the full authentication on the insecure connection.
+func EncryptCachingSha2Password(password, salt, publicKey []byte) ([]byte, error) {
+	block, _ := pem.Decode(publicKey)
+	if block == nil {
+		return nil, moerr.NewInternalErrorNoCtx("invalid public key")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, moerr.NewInternalErrorNoCtx("the public key is not a RSA key")
+	}
+	plain := append(bytes.Clone(password), 0)
+	for i := range plain {
+		plain[i] ^= salt[i%len(salt)]
+	}
+	return rsa.EncryptOAEP(sha1.New(), rand.Reader, rsaKey, plain, nil)
+}
+
+// sha2Digest returns SHA256(SHA256(password)).
+func sha2Digest(password []byte) []byte {
+	hash1 := sha256.Sum256(password)
+	hash2 := sha256.Sum256(hash1[:])
+	return hash2[:]
+}
+
+// checkSha2Scramble checks the scramble with the digest SHA256(SHA256(password)).
+func checkSha2Scramble(digest, salt, auth []byte) bool {
+	if len(auth) != sha256.Size {
+		return false
+	}
+	hash := sha256.Sum256(append(bytes.Clone(digest), salt...))
+	for i := range hash {
+		hash[i] ^= auth[i]
+	}
+	hash = sha256.Sum256(hash[:])
+	return bytes.Equal(digest, hash[:])
+}
+
+// isSecureConnection returns true if the password can be sent in clear text.
+func (mp *MysqlProtocolImpl) isSecureConnection() bool {
+	if mp.IsTlsEstablished() {
+		return true
+	}
+	conn := mp.tcpConn.RawConn()
+	return conn != nil && conn.RemoteAddr() != nil && conn.RemoteAddr().Network() == "unix"
+}
+
+// checkCachingSha2Password checks the auth data of caching_sha2_password.
+// pwd is SHA1(SHA1(password)) stored in mo_user. The scramble is checked with
+// the cached digest. If the user is not cached, the server asks the client for
+// the full authentication, the password is sent in clear text on the secure
+// connection or encrypted with the RSA public key of the server. The digest
+// is cached after the full authentication succeeds.
+func (mp *MysqlProtocolImpl) checkCachingSha2Password(ctx context.Context, pwd, salt, auth []byte) bool {
+	ses := mp.GetSession()
+	if len(auth) == 0 {
+		return false
+	}
+
+	key := sha2CacheKey(ses.GetTenantInfo())
+	if digest, ok := globalSha2Cache.get(key, pwd); ok {
+		if !checkSha2Scramble(digest, salt, auth) {
+			return false
+		}
+		if err := mp.writePackets([]byte{AuthMoreDataHeader, CachingSha2FastAuthSuccess}); err != nil {
+			ses.Errorf(ctx, "send fast auth success failed. error:%v", err)
+			return false
+		}
+		return true
+	}
+
+	password, err := mp.readSha2Password(ctx, salt)
+	if err != nil {
+		ses.Errorf(ctx, "full authentication of caching_sha2_password failed. error:%v", err)
+		return false
+	}
+	if !bytes.Equal(HashSha1(HashSha1(password)), pwd) {
+		return false
+	}
+	globalSha2Cache.set(key, pwd, sha2Digest(password))
+	return true
+}
+
+// readSha2Password asks the client for the password in the full authentication.
+func (mp *MysqlProtocolImpl) readSha2Password(ctx context.Context, salt []byte) ([]byte, error) {
+	if err := mp.writePackets([]byte{AuthMoreDataHeader, CachingSha2PerformFullAuth}); err != nil {
+		return nil, err
+	}
+	data, err := mp.tcpConn.Read()
+	if err != nil {
+		return nil, err
+	}
+	if mp.isSecureConnection() {
+		return bytes.Clone(bytes.TrimSuffix(data, []byte{0})), nil
+	}
+
+	privateKey, publicKey, err := getSha2RSAKey(ctx, mp.SV.CachingSha2PasswordPrivateKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 1 && data[0] == CachingSha2RequestPublicKey {
+		if err = mp.writePackets(append([]byte{AuthMoreDataHeader}, publicKey...)); err != nil {
+			return nil, err
+		}
+		if data, err = mp.tcpConn.Read(); err != nil {
+			return nil, err
+		}
+	}
+	plain, err := rsa.DecryptOAEP(sha1.New(), rand.Reader, privateKey, data, nil)
+	if err != nil {
+		return nil, moerr.NewInternalError(ctx, "decrypt the password failed. the password can not be sent in clear text on the insecure connection")
+	}
+	for i := range plain {
+		plain[i] ^= salt[i%len(salt)]
+	}
+	return bytes.TrimSuffix(plain, []byte{0}), nil
+}
+
+// passwordChecker returns the function that checks the auth data with the
+// authentication method of the connection. The result of caching_sha2_password
+// is kept since the auth data is checked more than once in one authentication
+// and the full authentication can not be repeated.
+func (mp *MysqlProtocolImpl) passwordChecker(ctx context.Context) func(pwd, salt, auth []byte) bool {
+	if mp.authPlugin != AuthCachingSha2Password {
+		return mp.checkPassword
+	}
+	var checked, passed bool
+	var checkedPwd []byte
+	return func(pwd, salt, auth []byte) bool {
+		if checked && bytes.Equal(pwd, checkedPwd) {
+			return passed
+		}
+		checked, checkedPwd = true, bytes.Clone(pwd)
+		passed = mp.checkCachingSha2Password(ctx, pwd, salt, auth)
+		return passed
+	}
+}
+
+// defaultAuthPlugin returns the authentication method advertised in the
+// handshake, it's also used when the method of the client is not supported.
+func (mp *MysqlProtocolImpl) defaultAuthPlugin() string {
+	if mp.SV != nil && mp.SV.DefaultAuthenticationPlugin == AuthCachingSha2Password {
+		return AuthCachingSha2Password
+	}
+	return AuthNativePassword
+}
@@ -0,0 +1,148 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (c *cursorTestClient) writePacket(payload []byte) {
+	packet := []byte{byte(len(payload)), byte(len(payload) >> 8), byte(len(payload) >> 16), 0}
+	_, err := c.conn.Write(append(packet, payload...))
+	require.NoError(c.t, err)
+}
+
+func TestCheckSha2Scramble(t *testing.T) {
+	salt := []byte("01234567890123456789")
+	auth := ScrambleCachingSha2Password([]byte("111"), salt)
+
+	cache := &sha2Cache{entries: make(map[string]sha2CacheEntry)}
+	pwd := HashSha1(HashSha1([]byte("111")))
+	cache.set("sys:u1", pwd, sha2Digest([]byte("111")))
+	d, ok := cache.get("sys:u1", pwd)
+	require.True(t, ok)
+	assert.True(t, checkSha2Scramble(d, salt, auth))
+	assert.False(t, checkSha2Scramble(d, salt, ScrambleCachingSha2Password([]byte("222"), salt)))
+	assert.False(t, checkSha2Scramble(d, salt, auth[:20]))
+
+	// the entry is stale after the password is changed
+	_, ok = cache.get("sys:u1", HashSha1(HashSha1([]byte("222"))))
+	assert.False(t, ok)
+}
+
+func TestCachingSha2Password(t *testing.T) {
+	ses, client := newCursorTestSession(t)
+	proto := ses.GetResponser().MysqlRrWr().(*MysqlProtocolImpl)
+	ses.SetTenantInfo(&TenantInfo{Tenant: "sha2_test", User: "u1"})
+	proto.authPlugin = AuthCachingSha2Password
+	salt := proto.GetSalt()
+	pwd := HashSha1(HashSha1([]byte("111")))
+	ctx := context.TODO()
+
+	fullAuth := func(password string) chan struct{} {
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			assert.Equal(t, []byte{AuthMoreDataHeader, CachingSha2PerformFullAuth}, client.readPacket())
+			client.writePacket([]byte{CachingSha2RequestPublicKey})
+			publicKey := client.readPacket()
+			require.Equal(t, AuthMoreDataHeader, publicKey[0])
+			data, err := EncryptCachingSha2Password([]byte(password), salt, publicKey[1:])
+			require.NoError(t, err)
+			client.writePacket(data)
+		}()
+		return done
+	}
+
+	// full authentication with the RSA public key
+	done := fullAuth("111")
+	check := proto.passwordChecker(ctx)
+	assert.True(t, check(pwd, salt, ScrambleCachingSha2Password([]byte("111"), salt)))
+	<-done
+	// the result is kept in one authentication
+	assert.True(t, check(pwd, salt, ScrambleCachingSha2Password([]byte("111"), salt)))
+
+	// fast authentication with the cached digest
+	done = make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.Equal(t, []byte{AuthMoreDataHeader, CachingSha2FastAuthSuccess}, client.readPacket())
+	}()
+	assert.True(t, proto.checkCachingSha2Password(ctx, pwd, salt, ScrambleCachingSha2Password([]byte("111"), salt)))
+	<-done
+	assert.False(t, proto.checkCachingSha2Password(ctx, pwd, salt, ScrambleCachingSha2Password([]byte("222"), salt)))
+
+	// the password has been changed
+	pwd = HashSha1(HashSha1([]byte("222")))
+	done = fullAuth("111")
+	assert.False(t, proto.checkCachingSha2Password(ctx, pwd, salt, ScrambleCachingSha2Password([]byte("111"), salt)))
+	<-done
+	done = fullAuth("222")
+	assert.True(t, proto.checkCachingSha2Password(ctx, pwd, salt, ScrambleCachingSha2Password([]byte("222"), salt)))
+	<-done
+
+	// the password in clear text is rejected on the insecure connection
+	delete(globalSha2Cache.entries, sha2CacheKey(ses.GetTenantInfo()))
+	done = make(chan struct{})
+	go func() {
+		defer close(done)
+		client.readPacket()
+		client.writePacket([]byte("222\x00"))
+	}()
+	assert.False(t, proto.checkCachingSha2Password(ctx, pwd, salt, ScrambleCachingSha2Password([]byte("222"), salt)))
+	<-done
+
+	// the password in clear text on the secure connection
+	proto.tlsEstablished.Store(true)
+	done = make(chan struct{})
+	go func() {
+		defer close(done)
+		client.readPacket()
+		client.writePacket([]byte("222\x00"))
+	}()
+	assert.True(t, proto.checkCachingSha2Password(ctx, pwd, salt, ScrambleCachingSha2Password([]byte("222"), salt)))
+	<-done
+}
+
+func TestNegotiateCachingSha2Password(t *testing.T) {
+	ses, client := newCursorTestSession(t)
+	proto := ses.GetResponser().MysqlRrWr().(*MysqlProtocolImpl)
+	proto.SV.DefaultAuthenticationPlugin = AuthCachingSha2Password
+	assert.Equal(t, AuthCachingSha2Password, proto.defaultAuthPlugin())
+
+	info := changeUser{
+		username:         "u1",
+		authResponse:     make([]byte, 32),
+		clientPluginName: "sha256_password",
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		data := client.readPacket()
+		assert.Equal(t, byte(0xfe), data[0])
+		assert.Equal(t, AuthCachingSha2Password, string(data[1:1+len(AuthCachingSha2Password)]))
+		client.writePacket(ScrambleCachingSha2Password([]byte("111"), proto.GetSalt()))
+	}()
+	// the user is not checked with SkipCheckUser
+	proto.SV.SkipCheckUser = true
+	require.NoError(t, proto.changeUser(context.TODO(), info))
+	<-done
+	assert.Equal(t, AuthCachingSha2Password, proto.authPlugin)
+}
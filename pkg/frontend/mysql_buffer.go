@@ -89,6 +89,9 @@ type Conn struct {
 	timeout         time.Duration
 	allocator       *BufferAllocator
 	ses             *Session
+	// the payload put back by Unread, it is returned by the next Read
+	pending    []byte
+	pendingSeq uint8
 }
 
 // NewIOSession create a new io session
@@ -161,8 +164,34 @@ func (c *Conn) Close() error {
 	return nil
 }
 
+// Unread puts the payload which has been read back, the next Read returns it again.
+func (c *Conn) Unread(payload []byte) {
+	c.pending = payload
+	c.pendingSeq = c.sequenceId
+}
+
+// ReadWithTimeout reads the packet like Read, but waits at most timeout for it.
+func (c *Conn) ReadWithTimeout(timeout time.Duration) ([]byte, error) {
+	prev := c.timeout
+	c.timeout = timeout
+	defer func() {
+		c.timeout = prev
+		if prev == 0 {
+			_ = c.conn.SetReadDeadline(time.Time{})
+		}
+	}()
+	return c.Read()
+}
+
 // Read reads the complete packet including process the > 16MB packet. return the payload
 func (c *Conn) Read() ([]byte, error) {
+	if c.pending != nil {
+		payload := c.pending
+		c.pending = nil
+		c.sequenceId = c.pendingSeq
+		return payload, nil
+	}
+
 	// Requests > 16MB
 	payloads := make([][]byte, 0)
 	var finalPayload []byte
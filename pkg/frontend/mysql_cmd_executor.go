@@ -948,7 +948,11 @@ func handleAnalyzeStmt(ses *Session, execCtx *ExecCtx, stmt *tree.AnalyzeStmt) e
 		ses:    ses,
 		reqCtx: execCtx.reqCtx,
 	}
-	return doComQuery(ses, &tempExecCtx, &UserInput{sql: sql})
+	if err := doComQuery(ses, &tempExecCtx, &UserInput{sql: sql}); err != nil {
+		return err
+	}
+	// rebuild the extended statistics of the table as well
+	return doAnalyzeExtendedStats(execCtx.reqCtx, ses, stmt.Table)
 }
 
 func doExplainStmt(reqCtx context.Context, ses *Session, stmt *tree.ExplainStmt) error {
@@ -1191,6 +1195,14 @@ func handleRestorePitr(ses *Session, execCtx *ExecCtx, rp *tree.RestorePitr) err
 	return doRestorePitr(execCtx.reqCtx, ses, rp)
 }
 
+func handleCreateStatistics(ses *Session, execCtx *ExecCtx, cs *tree.CreateStatistics) error {
+	return doCreateStatistics(execCtx.reqCtx, ses, cs)
+}
+
+func handleDropStatistics(ses *Session, execCtx *ExecCtx, ds *tree.DropStatistics) error {
+	return doDropStatistics(execCtx.reqCtx, ses, ds)
+}
+
 // handleCreateAccount creates a new user-level tenant in the context of the tenant SYS
 // which has been initialized.
 func handleCreateAccount(ses FeSession, execCtx *ExecCtx, ca *tree.CreateAccount, proc *process.Process) error {
@@ -2038,6 +2050,10 @@ func incStatementErrorsCounter(tenant string, stmt tree.Statement) {
 func authenticateUserCanExecuteStatement(reqCtx context.Context, ses *Session, stmt tree.Statement) error {
 	reqCtx, span := trace.Debug(reqCtx, "authenticateUserCanExecuteStatement")
 	defer span.End()
+	// the user with the expired password must reset the password first
+	if ses.getRoutine() != nil && ses.getRoutine().isPasswordExpired() && !canExecWithExpiredPassword(stmt) {
+		return moerr.NewMustChangePassword(reqCtx)
+	}
 	if getGlobalPu().SV.SkipCheckPrivilege {
 		return nil
 	}
@@ -3014,7 +3030,19 @@ func ExecRequest(ses *Session, execCtx *ExecCtx, req *Request) (resp *Response,
 		if err != nil {
 			return NewGeneralErrorResponse(COM_STMT_EXECUTE, ses.GetTxnHandler().GetServerStatus(), err), nil
 		}
-		err = doComQuery(ses, execCtx, &UserInput{sql: sql})
+		prepareStmt.closeCursor()
+		var cursor *stmtCursor
+		if prepareStmt.cursorType&CURSOR_TYPE_READ_ONLY != 0 {
+			cursor = newStmtCursor(ses, binary.LittleEndian.Uint32(req.GetData().([]byte)[0:4]))
+		}
+		if cursor != nil {
+			err = executeWithCursor(ses, execCtx, &UserInput{sql: sql}, cursor)
+			if cursor.opened {
+				err = cursor.end(prepareStmt, err)
+			}
+		} else {
+			err = doComQuery(ses, execCtx, &UserInput{sql: sql})
+		}
 		if err != nil {
 			resp = NewGeneralErrorResponse(COM_STMT_EXECUTE, ses.GetTxnHandler().GetServerStatus(), err)
 		}
@@ -3070,6 +3098,7 @@ func ExecRequest(ses *Session, execCtx *ExecCtx, req *Request) (resp *Response,
 		if preStmt.IsCloudNonuser {
 			prefix = "/* cloud_nonuser */"
 		}
+		preStmt.closeCursor()
 		sql = fmt.Sprintf("%sreset prepare %s", prefix, stmtName)
 		ses.Debug(execCtx.reqCtx, "query trace", logutil.QueryField(sql))
 		err = doComQuery(ses, execCtx, &UserInput{sql: sql})
@@ -3078,6 +3107,13 @@ func ExecRequest(ses *Session, execCtx *ExecCtx, req *Request) (resp *Response,
 		}
 		return resp, nil
 
+	case COM_STMT_FETCH:
+		err = handleStmtFetch(ses, execCtx, req.GetData().([]byte))
+		if err != nil {
+			resp = NewGeneralErrorResponse(COM_STMT_FETCH, ses.GetTxnHandler().GetServerStatus(), err)
+		}
+		return resp, nil
+
 	case COM_SET_OPTION:
 		err = handleSetOption(ses, execCtx, req.GetData().([]byte))
 		if err != nil {
@@ -3085,6 +3121,21 @@ func ExecRequest(ses *Session, execCtx *ExecCtx, req *Request) (resp *Response,
 		}
 		return NewGeneralOkResponse(COM_SET_OPTION, ses.GetTxnHandler().GetServerStatus()), nil
 
+	case COM_RESET_CONNECTION:
+		err = ses.ResetConnection(execCtx)
+		if err != nil {
+			return NewGeneralErrorResponse(COM_RESET_CONNECTION, ses.GetTxnHandler().GetServerStatus(), err), nil
+		}
+		return NewGeneralOkResponse(COM_RESET_CONNECTION, ses.GetTxnHandler().GetServerStatus()), nil
+
+	case COM_CHANGE_USER:
+		err = ses.ChangeUser(execCtx, req.GetData().([]byte))
+		if err != nil {
+			// the session of the previous user has been reset, so the connection is closed.
+			return nil, err
+		}
+		return NewGeneralOkResponse(COM_CHANGE_USER, ses.GetTxnHandler().GetServerStatus()), nil
+
 	default:
 		resp = NewGeneralErrorResponse(req.GetCmd(), ses.GetTxnHandler().GetServerStatus(), moerr.NewInternalError(execCtx.reqCtx, "unsupported command. 0x%x", req.GetCmd()))
 	}
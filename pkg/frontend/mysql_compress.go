@@ -0,0 +1,283 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+)
+
+type compressionAlgorithm int
+
+const (
+	compressionNone compressionAlgorithm = iota
+	compressionZlib
+	compressionZstd
+)
+
+func (a compressionAlgorithm) String() string {
+	switch a {
+	case compressionZlib:
+		return "zlib"
+	case compressionZstd:
+		return "zstd"
+	}
+	return "none"
+}
+
+const (
+	// the length of the compressed packet header.
+	// int<3> length of the compressed payload
+	// int<1> compressed sequence id
+	// int<3> length of the payload before compression, 0 if not compressed
+	compressedHeaderLength = 7
+
+	// payloads shorter than minCompressLength are sent without compression,
+	// it's the same with MySQL.
+	minCompressLength = 50
+
+	// defaultZstdCompressionLevel is used when the client does not send the level.
+	defaultZstdCompressionLevel = 3
+
+	// maxOriginLength is the max length of the payload before compression,
+	// which is an int<3> in the header.
+	maxOriginLength = 1<<24 - 1
+)
+
+// compressedConn speaks the MySQL compressed protocol on the underlying conn.
+// The MySQL packets are the payload of compressed packets, and the compressed
+// packets have their own sequence id. It starts from the sequence id of the
+// command sent by the client and increases with each compressed packet sent.
+type compressedConn struct {
+	net.Conn
+	algorithm compressionAlgorithm
+	level     int
+
+	// the sequence id is shared by the read and write side
+	seqMu      sync.Mutex
+	sequenceId uint8
+
+	// read side
+	readHeader [compressedHeaderLength]byte
+	// decompressed data which is not read yet
+	pending []byte
+	zr      io.ReadCloser
+
+	// write side
+	writeBuf bytes.Buffer
+	zw       *zlib.Writer
+	zstdEnc  *zstd.Encoder
+	zstdDec  *zstd.Decoder
+}
+
+func newCompressedConn(conn net.Conn, algorithm compressionAlgorithm, level int) (*compressedConn, error) {
+	c := &compressedConn{
+		Conn:      conn,
+		algorithm: algorithm,
+		level:     level,
+	}
+	var err error
+	switch algorithm {
+	case compressionZlib:
+		c.zw, err = zlib.NewWriterLevel(&c.writeBuf, level)
+		if err != nil {
+			return nil, moerr.NewInvalidInputNoCtx("zlib compression level %d", level)
+		}
+	case compressionZstd:
+		if level < 1 || level > 22 {
+			return nil, moerr.NewInvalidInputNoCtx("zstd compression level %d", level)
+		}
+		c.zstdEnc, err = zstd.NewWriter(nil,
+			zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)),
+			zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+		c.zstdDec, err = zstd.NewReader(nil,
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderMaxMemory(maxOriginLength))
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, moerr.NewNotSupportedNoCtx("compression algorithm %s", algorithm)
+	}
+	return c, nil
+}
+
+// Read reads the decompressed data.
+func (c *compressedConn) Read(b []byte) (int, error) {
+	for len(c.pending) == 0 {
+		if err := c.readPacket(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(b, c.pending)
+	c.pending = c.pending[n:]
+	return n, nil
+}
+
+func (c *compressedConn) readPacket() error {
+	if _, err := io.ReadFull(c.Conn, c.readHeader[:]); err != nil {
+		return err
+	}
+	length := int(uint32(c.readHeader[0]) | uint32(c.readHeader[1])<<8 | uint32(c.readHeader[2])<<16)
+	c.seqMu.Lock()
+	c.sequenceId = c.readHeader[3] + 1
+	c.seqMu.Unlock()
+	originLength := int(uint32(c.readHeader[4]) | uint32(c.readHeader[5])<<8 | uint32(c.readHeader[6])<<16)
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.Conn, payload); err != nil {
+		return err
+	}
+	if originLength == 0 {
+		c.pending = payload
+		return nil
+	}
+
+	data, err := c.decompress(payload, originLength)
+	if err != nil {
+		return err
+	}
+	if len(data) < originLength {
+		return moerr.NewInvalidInputNoCtx("compressed packet length %d, expected %d", len(data), originLength)
+	}
+	c.pending = data
+	return nil
+}
+
+// decompress inflates the payload, the payloads inflated over originLength
+// are rejected without inflating them to the end.
+func (c *compressedConn) decompress(payload []byte, originLength int) ([]byte, error) {
+	data := make([]byte, 0, originLength)
+	var err error
+	switch c.algorithm {
+	case compressionZlib:
+		if c.zr == nil {
+			c.zr, err = zlib.NewReader(bytes.NewReader(payload))
+		} else {
+			err = c.zr.(zlib.Resetter).Reset(bytes.NewReader(payload), nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+		// one more byte is enough to tell the payloads inflated too long
+		buf := bytes.NewBuffer(data)
+		if _, err = buf.ReadFrom(io.LimitReader(c.zr, int64(originLength)+1)); err != nil {
+			return nil, err
+		}
+		data = buf.Bytes()
+	default:
+		// the decoder stops at maxOriginLength
+		if data, err = c.zstdDec.DecodeAll(payload, data); err != nil {
+			return nil, err
+		}
+	}
+	if len(data) > originLength {
+		return nil, moerr.NewInvalidInputNoCtx("compressed packet longer than %d", originLength)
+	}
+	return data, nil
+}
+
+// Write splits b into compressed packets and sends them.
+func (c *compressedConn) Write(b []byte) (int, error) {
+	written := 0
+	for written < len(b) {
+		n := Min(len(b)-written, int(MaxPayloadSize))
+		if err := c.writePacket(b[written : written+n]); err != nil {
+			return written, err
+		}
+		written += n
+	}
+	return written, nil
+}
+
+func (c *compressedConn) writePacket(data []byte) error {
+	payload, originLength, err := c.compress(data)
+	if err != nil {
+		return err
+	}
+	var header [compressedHeaderLength]byte
+	header[0] = byte(len(payload))
+	header[1] = byte(len(payload) >> 8)
+	header[2] = byte(len(payload) >> 16)
+	c.seqMu.Lock()
+	header[3] = c.sequenceId
+	c.sequenceId++
+	c.seqMu.Unlock()
+	header[4] = byte(originLength)
+	header[5] = byte(originLength >> 8)
+	header[6] = byte(originLength >> 16)
+
+	packet := make([]byte, 0, compressedHeaderLength+len(payload))
+	packet = append(packet, header[:]...)
+	packet = append(packet, payload...)
+	for len(packet) > 0 {
+		n, err := c.Conn.Write(packet)
+		if err != nil {
+			return err
+		}
+		packet = packet[n:]
+	}
+	return nil
+}
+
+// compress returns the payload of the compressed packet and the length before
+// compression. The length is 0 if the data is sent as it is.
+func (c *compressedConn) compress(data []byte) ([]byte, int, error) {
+	if len(data) < minCompressLength {
+		return data, 0, nil
+	}
+	var payload []byte
+	switch c.algorithm {
+	case compressionZlib:
+		c.writeBuf.Reset()
+		c.zw.Reset(&c.writeBuf)
+		if _, err := c.zw.Write(data); err != nil {
+			return nil, 0, err
+		}
+		if err := c.zw.Close(); err != nil {
+			return nil, 0, err
+		}
+		payload = c.writeBuf.Bytes()
+	default:
+		payload = c.zstdEnc.EncodeAll(data, nil)
+	}
+	// not worth it
+	if len(payload) >= len(data) {
+		return data, 0, nil
+	}
+	return payload, len(data), nil
+}
+
+func (c *compressedConn) Close() error {
+	if c.zr != nil {
+		_ = c.zr.Close()
+	}
+	if c.zstdEnc != nil {
+		_ = c.zstdEnc.Close()
+	}
+	if c.zstdDec != nil {
+		c.zstdDec.Close()
+	}
+	return c.Conn.Close()
+}
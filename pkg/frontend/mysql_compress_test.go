@@ -0,0 +1,171 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressedConn(t *testing.T) {
+	for _, algorithm := range []compressionAlgorithm{compressionZlib, compressionZstd} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			server, client := net.Pipe()
+			defer server.Close()
+			defer client.Close()
+			sc, err := newCompressedConn(server, algorithm, 3)
+			require.NoError(t, err)
+			cc, err := newCompressedConn(client, algorithm, 3)
+			require.NoError(t, err)
+
+			payloads := [][]byte{
+				[]byte("select 1"),
+				bytes.Repeat([]byte("matrixone"), 10000),
+				generateRandomBytes(1000),
+			}
+			go func() {
+				for _, payload := range payloads {
+					_, err := cc.Write(payload)
+					require.NoError(t, err)
+				}
+			}()
+			for _, payload := range payloads {
+				data := make([]byte, len(payload))
+				_, err := io.ReadFull(sc, data)
+				require.NoError(t, err)
+				assert.Equal(t, payload, data)
+			}
+		})
+	}
+}
+
+func TestCompressedConnPacket(t *testing.T) {
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+	sc, err := newCompressedConn(server, compressionZlib, 6)
+	require.NoError(t, err)
+
+	// the client sends a command with compressed sequence id 3
+	go func() {
+		_, err := client.Write([]byte{4, 0, 0, 3, 0, 0, 0, 'p', 'i', 'n', 'g'})
+		require.NoError(t, err)
+	}()
+	data := make([]byte, 4)
+	_, err = io.ReadFull(sc, data)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("ping"), data)
+
+	// short payloads are not compressed
+	go func() {
+		_, err := sc.Write([]byte("ok"))
+		require.NoError(t, err)
+	}()
+	packet := make([]byte, compressedHeaderLength+2)
+	_, err = io.ReadFull(client, packet)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{2, 0, 0, 4, 0, 0, 0, 'o', 'k'}, packet)
+
+	// long payloads are compressed
+	payload := bytes.Repeat([]byte("a"), 1000)
+	go func() {
+		_, err := sc.Write(payload)
+		require.NoError(t, err)
+	}()
+	header := make([]byte, compressedHeaderLength)
+	_, err = io.ReadFull(client, header)
+	require.NoError(t, err)
+	length := int(header[0]) | int(header[1])<<8 | int(header[2])<<16
+	assert.Less(t, length, len(payload))
+	assert.Equal(t, uint8(5), header[3])
+	assert.Equal(t, []byte{0xe8, 0x03, 0}, header[4:])
+	_, err = io.ReadFull(client, make([]byte, length))
+	require.NoError(t, err)
+}
+
+func TestCompressConn(t *testing.T) {
+	sv := &config.FrontendParameters{EnableCompression: true}
+	sv.SetDefaultValues()
+	mp := NewMysqlClientProtocol("", 0, nil, 0, sv)
+	assert.NotZero(t, mp.capability&CLIENT_COMPRESS)
+	assert.NotZero(t, mp.capability&CLIENT_ZSTD_COMPRESSION_ALGORITHM)
+
+	server, client := net.Pipe()
+	defer server.Close()
+	defer client.Close()
+
+	mp.capability = DefaultCapability
+	conn, err := mp.CompressConn(server)
+	require.NoError(t, err)
+	assert.Equal(t, server, conn)
+
+	mp.capability = DefaultCapability | CLIENT_COMPRESS | CLIENT_ZSTD_COMPRESSION_ALGORITHM
+	conn, err = mp.CompressConn(server)
+	require.NoError(t, err)
+	assert.Equal(t, compressionZlib, conn.(*compressedConn).algorithm)
+	assert.Equal(t, 6, conn.(*compressedConn).level)
+
+	mp.capability = DefaultCapability | CLIENT_ZSTD_COMPRESSION_ALGORITHM
+	mp.zstdCompressionLevel = 9
+	conn, err = mp.CompressConn(server)
+	require.NoError(t, err)
+	assert.Equal(t, compressionZstd, conn.(*compressedConn).algorithm)
+	assert.Equal(t, 9, conn.(*compressedConn).level)
+
+	mp.zstdCompressionLevel = 100
+	_, err = mp.CompressConn(server)
+	assert.Error(t, err)
+}
+
+func TestCompressedConnBomb(t *testing.T) {
+	for _, algorithm := range []compressionAlgorithm{compressionZlib, compressionZstd} {
+		t.Run(algorithm.String(), func(t *testing.T) {
+			server, client := net.Pipe()
+			defer server.Close()
+			defer client.Close()
+			sc, err := newCompressedConn(server, algorithm, 3)
+			require.NoError(t, err)
+			cc, err := newCompressedConn(client, algorithm, 3)
+			require.NoError(t, err)
+
+			data := bytes.Repeat([]byte{0}, 1<<20)
+			payload, originLength, err := cc.compress(data)
+			require.NoError(t, err)
+			require.Equal(t, len(data), originLength)
+
+			// the payload inflated over the length in the header
+			_, err = sc.decompress(payload, 100)
+			assert.Error(t, err)
+			// and short of it
+			go func() {
+				header := []byte{byte(len(payload)), byte(len(payload) >> 8), byte(len(payload) >> 16), 0, 0, 0, 0x20}
+				_, _ = client.Write(append(header, payload...))
+			}()
+			_, err = sc.Read(make([]byte, 1))
+			assert.Error(t, err)
+
+			// the same payload with the right length is fine
+			out, err := sc.decompress(payload, originLength)
+			require.NoError(t, err)
+			assert.Equal(t, data, out)
+		})
+	}
+}
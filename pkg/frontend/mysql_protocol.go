@@ -64,7 +64,8 @@ var DefaultCapability = CLIENT_LONG_PASSWORD |
 	CLIENT_PLUGIN_AUTH |
 	CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA |
 	CLIENT_DEPRECATE_EOF |
-	CLIENT_CONNECT_ATTRS
+	CLIENT_CONNECT_ATTRS |
+	CLIENT_CAN_HANDLE_EXPIRED_PASSWORDS
 
 // DefaultClientConnStatus default server status
 var DefaultClientConnStatus = SERVER_STATUS_AUTOCOMMIT
@@ -154,6 +155,8 @@ const (
 
 	AuthNativePassword string = "mysql_native_password"
 
+	AuthCachingSha2Password string = "caching_sha2_password"
+
 	//the length of the mysql protocol header
 	HeaderLengthOfTheProtocol int = 4
 	HeaderOffset              int = 0
@@ -250,6 +253,9 @@ type MysqlProtocolImpl struct {
 	// indicated by the plugin name field.
 	authResponse []byte
 
+	// the authentication method of the authResponse
+	authPlugin string
+
 	//the default database for the client
 	database string
 
@@ -257,6 +263,9 @@ type MysqlProtocolImpl struct {
 	// can pass to the server at connect time.
 	connectAttrs map[string]string
 
+	// the compression level sent by the client with zstd compression
+	zstdCompressionLevel uint8
+
 	//for debug
 	debugStats
 
@@ -500,6 +509,17 @@ func (mp *MysqlProtocolImpl) SetUserName(s string) {
 	mp.username = s
 }
 
+// GetAuthPlugin returns the authentication method of the auth response.
+func (mp *MysqlProtocolImpl) GetAuthPlugin() string {
+	return mp.authPlugin
+}
+
+// GetAuthResponse returns the auth response from the client, which is
+// switched if the server asks for another authentication method.
+func (mp *MysqlProtocolImpl) GetAuthResponse() []byte {
+	return mp.authResponse
+}
+
 const bit4TcpWriteCopy = 12 // 1<<12 == 4096
 
 // CalculateOutTrafficBytes calculate the bytes of the last out traffic, the number of mysql packets
@@ -582,6 +602,17 @@ type response41 struct {
 	clientPluginName  string
 	isAskForTlsHeader bool
 	connectAttrs      map[string]string
+	zstdLevel         uint8
+}
+
+// the payload of COM_CHANGE_USER
+type changeUser struct {
+	username         string
+	authResponse     []byte
+	database         string
+	collationID      uint16
+	clientPluginName string
+	connectAttrs     map[string]string
 }
 
 // handshake response 320
@@ -730,10 +761,11 @@ func (mp *MysqlProtocolImpl) ParseExecuteData(ctx context.Context, proc *process
 		return moerr.NewInternalError(ctx, "malform packet")
 
 	}
-	if flag != 0 {
-		// TODO only support CURSOR_TYPE_NO_CURSOR flag now
+	if flag&^CURSOR_TYPE_READ_ONLY != 0 {
+		// only support CURSOR_TYPE_NO_CURSOR and CURSOR_TYPE_READ_ONLY flag now
 		return moerr.NewInvalidInput(ctx, "unsupported Prepare flag '%v'", flag)
 	}
+	stmt.cursorType = flag
 
 	// skip iteration-count, always 1
 	pos += 4
@@ -1360,14 +1392,15 @@ func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse
 	ses := mp.GetSession()
 	if !mp.SV.SkipCheckUser {
 		ses.Debugf(ctx, "authenticate user 1")
-		psw, err = ses.AuthenticateUser(ctx, mp.GetUserName(), mp.GetDatabaseName(), mp.authResponse, mp.GetSalt(), mp.checkPassword)
+		checkPassword := mp.passwordChecker(ctx)
+		psw, err = ses.AuthenticateUser(ctx, mp.GetUserName(), mp.GetDatabaseName(), mp.authResponse, mp.GetSalt(), checkPassword)
 		if err != nil {
 			return err
 		}
 		ses.Debugf(ctx, "authenticate user 2")
 
 		//TO Check password
-		if mp.checkPassword(psw, mp.GetSalt(), authResponse) {
+		if checkPassword(psw, mp.GetSalt(), authResponse) {
 			ses.Debugf(ctx, "check password succeeded")
 			if err = ses.InitSystemVariables(ctx); err != nil {
 				return err
@@ -1375,6 +1408,10 @@ func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse
 		} else {
 			return moerr.NewInternalError(ctx, "check password failed")
 		}
+
+		if err = mp.checkExpiredPassword(ctx); err != nil {
+			return err
+		}
 	} else {
 		ses.Debugf(ctx, "skip authenticate user")
 		//Get tenant info
@@ -1397,6 +1434,29 @@ func (mp *MysqlProtocolImpl) authenticateUser(ctx context.Context, authResponse
 	return nil
 }
 
+// checkExpiredPassword disconnects the user with the expired password if the
+// client can not handle it and disconnect_on_expired_password is on. Otherwise
+// the user can only reset the password.
+func (mp *MysqlProtocolImpl) checkExpiredPassword(ctx context.Context) error {
+	ses := mp.GetSession()
+	if ses.getRoutine() == nil || !ses.getRoutine().isPasswordExpired() ||
+		mp.capability&CLIENT_CAN_HANDLE_EXPIRED_PASSWORDS != 0 {
+		return nil
+	}
+	value, err := ses.GetGlobalSysVar("disconnect_on_expired_password")
+	if err != nil {
+		return err
+	}
+	disconnect, err := valueIsBoolTrue(value)
+	if err != nil {
+		return err
+	}
+	if disconnect {
+		return moerr.NewPasswordExpired(ctx)
+	}
+	return nil
+}
+
 func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte) (bool, error) {
 	var err error
 	if len(payload) < 2 {
@@ -1419,6 +1479,10 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		}
 
 		mp.authResponse = resp41.authResponse
+		mp.authPlugin = resp41.clientPluginName
+		if mp.authPlugin == "" {
+			mp.authPlugin = AuthNativePassword
+		}
 		mp.capability = mp.capability & resp41.capabilities
 
 		if nameAndCharset, ok3 := collationID2CharsetAndName[int(resp41.collationID)]; !ok3 {
@@ -1433,6 +1497,7 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		mp.username = resp41.username
 		mp.database = resp41.database
 		mp.connectAttrs = resp41.connectAttrs
+		mp.zstdCompressionLevel = resp41.zstdLevel
 	} else {
 		var resp320 response320
 		var ok2 bool
@@ -1446,6 +1511,7 @@ func (mp *MysqlProtocolImpl) HandleHandshake(ctx context.Context, payload []byte
 		}
 
 		mp.authResponse = resp320.authResponse
+		mp.authPlugin = AuthNativePassword
 		mp.capability = mp.capability & resp320.capabilities
 		mp.collationID = int(Utf8mb4CollationID)
 		mp.collationName = "utf8mb4_general_ci"
@@ -1490,9 +1556,51 @@ func (mp *MysqlProtocolImpl) Authenticate(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+
+	// the packets after the OK packet are compressed
+	if mp.compressionAlgorithm() != compressionNone {
+		conn, err := mp.CompressConn(mp.tcpConn.RawConn())
+		if err != nil {
+			return err
+		}
+		mp.tcpConn.UseConn(conn)
+		ses.Infof(ctx, "use %s compression", mp.compressionAlgorithm())
+	}
 	return nil
 }
 
+// compressionAlgorithm returns the compression algorithm negotiated with the
+// client, zlib is preferred if the client supports both.
+func (mp *MysqlProtocolImpl) compressionAlgorithm() compressionAlgorithm {
+	capability := mp.GetCapability()
+	if capability&CLIENT_COMPRESS != 0 {
+		return compressionZlib
+	}
+	if capability&CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0 {
+		return compressionZstd
+	}
+	return compressionNone
+}
+
+// CompressConn wraps the conn with the compressed protocol negotiated with
+// the client. The conn is returned as it is if no compression is negotiated.
+func (mp *MysqlProtocolImpl) CompressConn(conn net.Conn) (net.Conn, error) {
+	var level int
+	algorithm := mp.compressionAlgorithm()
+	switch algorithm {
+	case compressionNone:
+		return conn, nil
+	case compressionZlib:
+		level = mp.SV.ZlibCompressionLevel
+	case compressionZstd:
+		level = int(mp.zstdCompressionLevel)
+		if level == 0 {
+			level = defaultZstdCompressionLevel
+		}
+	}
+	return newCompressedConn(conn, algorithm, level)
+}
+
 // the server makes a handshake v10 packet
 // return handshake packet
 func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
@@ -1522,7 +1630,7 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 	pos = mp.io.WriteUint16(data, pos, DefaultClientConnStatus)
 
 	//int<2>              capabilities flags (upper 2 bytes)
-	pos = mp.io.WriteUint16(data, pos, uint16((DefaultCapability>>16)&0xFFFF))
+	pos = mp.io.WriteUint16(data, pos, uint16((mp.capability>>16)&0xFFFF))
 
 	if (DefaultCapability & CLIENT_PLUGIN_AUTH) != 0 {
 		//int<1>              length of auth-plugin-data
@@ -1545,7 +1653,7 @@ func (mp *MysqlProtocolImpl) makeHandshakeV10Payload() []byte {
 
 	if (DefaultCapability & CLIENT_PLUGIN_AUTH) != 0 {
 		//string[NUL]    auth-plugin name
-		pos = mp.writeStringNUL(data, pos, AuthNativePassword)
+		pos = mp.writeStringNUL(data, pos, mp.defaultAuthPlugin())
 	}
 
 	return data[:pos]
@@ -1655,42 +1763,166 @@ func (mp *MysqlProtocolImpl) analyseHandshakeResponse41(ctx context.Context, dat
 		}
 
 		//to switch authenticate method
-		if info.clientPluginName != AuthNativePassword {
+		if !isSupportedAuthPlugin(info.clientPluginName) {
 			var err error
-			if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx); err != nil {
+			info.clientPluginName = mp.defaultAuthPlugin()
+			if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx, info.clientPluginName); err != nil {
 				return false, info, moerr.NewInternalError(ctx, "negotiate authentication method failed. error:%v", err)
 			}
-			info.clientPluginName = AuthNativePassword
 		}
 	}
 
 	// client connection attributes
 	info.connectAttrs = make(map[string]string)
 	if info.capabilities&CLIENT_CONNECT_ATTRS != 0 {
-		var l uint64
-		var ok bool
-		l, pos, ok = mp.readIntLenEnc(data, pos)
-		if !ok {
-			return false, info, moerr.NewInternalError(ctx, "get length of client-connect-attrs failed")
+		var err error
+		if pos, err = mp.readConnectAttrs(ctx, data, pos, info.connectAttrs); err != nil {
+			return false, info, err
 		}
-		endPos := pos + int(l)
-		var key, value string
-		for pos < endPos {
-			key, pos, ok = mp.readStringLenEnc(data, pos)
-			if !ok {
-				return false, info, moerr.NewInternalError(ctx, "get connect-attrs key failed")
-			}
-			value, pos, ok = mp.readStringLenEnc(data, pos)
-			if !ok {
-				return false, info, moerr.NewInternalError(ctx, "get connect-attrs value failed")
-			}
-			info.connectAttrs[key] = value
+	}
+
+	//int<1>             zstd compression level
+	if info.capabilities&CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0 {
+		info.zstdLevel, pos, ok = mp.io.ReadUint8(data, pos)
+		if !ok {
+			return false, info, moerr.NewInternalError(ctx, "get zstd compression level failed")
 		}
 	}
 
 	return true, info, nil
 }
 
+// readConnectAttrs reads the client connection attributes into attrs
+func (mp *MysqlProtocolImpl) readConnectAttrs(ctx context.Context, data []byte, pos int, attrs map[string]string) (int, error) {
+	l, pos, ok := mp.readIntLenEnc(data, pos)
+	if !ok {
+		return pos, moerr.NewInternalError(ctx, "get length of client-connect-attrs failed")
+	}
+	endPos := pos + int(l)
+	var key, value string
+	for pos < endPos {
+		key, pos, ok = mp.readStringLenEnc(data, pos)
+		if !ok {
+			return pos, moerr.NewInternalError(ctx, "get connect-attrs key failed")
+		}
+		value, pos, ok = mp.readStringLenEnc(data, pos)
+		if !ok {
+			return pos, moerr.NewInternalError(ctx, "get connect-attrs value failed")
+		}
+		attrs[key] = value
+	}
+	return pos, nil
+}
+
+// the server analyses the payload of COM_CHANGE_USER from the client.
+// the client uses the salt of the handshake to make the auth-response.
+func (mp *MysqlProtocolImpl) analyseChangeUser(ctx context.Context, data []byte) (changeUser, error) {
+	var pos = 0
+	var ok bool
+	var info changeUser
+
+	//string[NUL]        user
+	info.username, pos, ok = mp.readStringNUL(data, pos)
+	if !ok {
+		return info, moerr.NewInternalError(ctx, "get username failed")
+	}
+
+	/*
+		if capabilities & CLIENT_SECURE_CONNECTION {
+			int<1>             length of auth-response
+			string[n]          auth-response
+		} else {
+			string[NUL]        auth-response
+		}
+	*/
+	if (mp.capability & CLIENT_SECURE_CONNECTION) != 0 {
+		var l uint8
+		l, pos, ok = mp.io.ReadUint8(data, pos)
+		if !ok {
+			return info, moerr.NewInternalError(ctx, "get length of auth-response failed")
+		}
+		info.authResponse, pos, ok = mp.readCountOfBytes(data, pos, int(l))
+		if !ok {
+			return info, moerr.NewInternalError(ctx, "get auth-response failed")
+		}
+	} else {
+		var auth string
+		auth, pos, ok = mp.readStringNUL(data, pos)
+		if !ok {
+			return info, moerr.NewInternalError(ctx, "get auth-response failed")
+		}
+		info.authResponse = []byte(auth)
+	}
+
+	//string[NUL]        schema-name
+	info.database, pos, ok = mp.readStringNUL(data, pos)
+	if !ok {
+		return info, moerr.NewInternalError(ctx, "get database failed")
+	}
+
+	// the fields below are optional
+	if pos >= len(data) {
+		return info, nil
+	}
+
+	//int<2>             character set
+	info.collationID, pos, ok = mp.io.ReadUint16(data, pos)
+	if !ok {
+		return info, moerr.NewInternalError(ctx, "get character set failed")
+	}
+
+	if (mp.capability & CLIENT_PLUGIN_AUTH) != 0 {
+		info.clientPluginName, pos, ok = mp.readStringNUL(data, pos)
+		if !ok {
+			return info, moerr.NewInternalError(ctx, "get auth plugin name failed")
+		}
+	}
+
+	if (mp.capability&CLIENT_CONNECT_ATTRS) != 0 && pos < len(data) {
+		info.connectAttrs = make(map[string]string)
+		var err error
+		if _, err = mp.readConnectAttrs(ctx, data, pos, info.connectAttrs); err != nil {
+			return info, err
+		}
+	}
+	return info, nil
+}
+
+// changeUser authenticates the user in the payload of COM_CHANGE_USER.
+func (mp *MysqlProtocolImpl) changeUser(ctx context.Context, info changeUser) error {
+	if info.collationID != 0 {
+		nameAndCharset, ok := collationID2CharsetAndName[int(info.collationID)]
+		if !ok {
+			return moerr.NewInternalError(ctx, "get collationName and charset failed")
+		}
+		mp.collationID = int(info.collationID)
+		mp.collationName = nameAndCharset.collationName
+		mp.charset = nameAndCharset.charset
+	}
+
+	//to switch authenticate method
+	if info.clientPluginName == "" {
+		info.clientPluginName = AuthNativePassword
+	} else if !isSupportedAuthPlugin(info.clientPluginName) {
+		var err error
+		info.clientPluginName = mp.defaultAuthPlugin()
+		if info.authResponse, err = mp.negotiateAuthenticationMethod(ctx, info.clientPluginName); err != nil {
+			return moerr.NewInternalError(ctx, "negotiate authentication method failed. error:%v", err)
+		}
+	}
+
+	mp.SetUserName(info.username)
+	mp.SetDatabaseName(info.database)
+	mp.authResponse = info.authResponse
+	mp.authPlugin = info.clientPluginName
+	if info.connectAttrs != nil {
+		mp.connectAttrs = info.connectAttrs
+	}
+
+	mp.GetSession().Debugf(ctx, "change user to %s", info.username)
+	return mp.authenticateUser(ctx, mp.authResponse)
+}
+
 /*
 //the server does something after receiving a handshake response41 from the client
 //like check user and password
@@ -1828,9 +2060,9 @@ func (mp *MysqlProtocolImpl) makeAuthSwitchRequestPayload(authMethodName string)
 // the server can send AuthSwitchRequest to ask client to use designated authentication method,
 // if both server and client support CLIENT_PLUGIN_AUTH capability.
 // return data authenticated with new method
-func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context) ([]byte, error) {
+func (mp *MysqlProtocolImpl) negotiateAuthenticationMethod(ctx context.Context, authMethodName string) ([]byte, error) {
 	var err error
-	aswPkt := mp.makeAuthSwitchRequestPayload(AuthNativePassword)
+	aswPkt := mp.makeAuthSwitchRequestPayload(authMethodName)
 	err = mp.writePackets(aswPkt)
 	if err != nil {
 		return nil, err
@@ -2642,8 +2874,8 @@ func (mp *MysqlProtocolImpl) WriteResultSetRow(mrs *MysqlResultSet, cnt uint64)
 	defer mp.m.Unlock()
 	var err error = nil
 
-	// XXX now we known COM_QUERY will use textRow, COM_STMT_EXECUTE use binaryRow
-	useBinaryRow := cmd == COM_STMT_EXECUTE
+	// XXX now we known COM_QUERY will use textRow, COM_STMT_EXECUTE and COM_STMT_FETCH use binaryRow
+	useBinaryRow := cmd == COM_STMT_EXECUTE || cmd == COM_STMT_FETCH
 
 	//make rows into the batch
 	for i := uint64(0); i < cnt; i++ {
@@ -3011,6 +3243,10 @@ func NewMysqlClientProtocol(sid string, connectionID uint32, tcp *Conn, maxBytes
 		mysql.capability = mysql.capability | CLIENT_SSL
 	}
 
+	if SV.EnableCompression {
+		mysql.capability = mysql.capability | CLIENT_COMPRESS | CLIENT_ZSTD_COMPRESSION_ALGORITHM
+	}
+
 	return mysql
 }
 
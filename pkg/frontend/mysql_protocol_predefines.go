@@ -324,6 +324,8 @@ const (
 	CLIENT_CAN_HANDLE_EXPIRED_PASSWORDS   uint32 = 0x00400000
 	CLIENT_SESSION_TRACK                  uint32 = 0x00800000
 	CLIENT_DEPRECATE_EOF                  uint32 = 0x01000000
+	CLIENT_OPTIONAL_RESULTSET_METADATA    uint32 = 0x02000000
+	CLIENT_ZSTD_COMPRESSION_ALGORITHM     uint32 = 0x04000000
 )
 
 // server status
@@ -344,6 +346,14 @@ const (
 	SERVER_SESSION_STATE_CHANGED       uint16 = 0x4000 // Session state change. see Session change type for more information
 )
 
+// cursor type in the flags of COM_STMT_EXECUTE
+const (
+	CURSOR_TYPE_NO_CURSOR  uint8 = 0x00
+	CURSOR_TYPE_READ_ONLY  uint8 = 0x01
+	CURSOR_TYPE_FOR_UPDATE uint8 = 0x02
+	CURSOR_TYPE_SCROLLABLE uint8 = 0x04
+)
+
 type CommandType uint8
 
 // text protocol in mysql client protocol
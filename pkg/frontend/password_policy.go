@@ -0,0 +1,603 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+)
+
+const (
+	secondsPerDay = 24 * 60 * 60
+
+	// the max value of FAILED_LOGIN_ATTEMPTS and PASSWORD_LOCK_TIME
+	maxFailedLoginAttempts = 32767
+	maxPasswordLockTime    = 32767
+	// the max value of PASSWORD EXPIRE INTERVAL n DAY
+	maxPasswordLifetime = 65535
+
+	// the value of password_lifetime, password_reuse_history and
+	// password_reuse_time when they take the value of the global system variable.
+	passwordPolicyDefault = -1
+	// the value of password_lock_time when the user is locked until it is
+	// unlocked by ALTER USER ... ACCOUNT UNLOCK.
+	passwordLockTimeUnbounded = -1
+)
+
+const (
+	// the NULL columns are the columns that have not been set since the user was
+	// created, or the columns that take the value of the global system variable.
+	getPasswordPolicyOfUserFormat = `select authentication_string, status,
+				unix_timestamp(ifnull(password_last_changed, created_time)),
+				ifnull(password_expired, false),
+				ifnull(password_lifetime, -1),
+				ifnull(password_reuse_history, -1),
+				ifnull(password_reuse_time, -1),
+				ifnull(password_history, ''),
+				ifnull(failed_login_attempts, 0),
+				ifnull(password_lock_time, 0),
+				ifnull(failed_login_count, 0),
+				ifnull(unix_timestamp(locked_time), 0)
+				from mo_catalog.mo_user where user_id = %d;`
+
+	updatePasswordPolicyOfUserFormat = `update mo_catalog.mo_user set %s where user_id = %d;`
+
+	updatePasswordHistoryOfUserFormat = `update mo_catalog.mo_user set password_last_changed = current_timestamp(), password_expired = false, password_history = '%s' where user_id = %d;`
+
+	updateLoginStatusOfUserFormat = `update mo_catalog.mo_user set status = "%s", failed_login_count = %d, locked_time = %s where user_id = %d;`
+
+	// the failed login is counted and the user is locked by one statement, so
+	// that the concurrent failed logins on all CNs are counted. %[1]s is the
+	// failed_login_count after the failed login, the expressions read the row
+	// before the update. The user locked by ACCOUNT LOCK is not changed.
+	updateFailedLoginOfUserFormat = `update mo_catalog.mo_user set
+				failed_login_count = %[1]s,
+				status = if(%[1]s >= failed_login_attempts, "%[2]s", "%[3]s"),
+				locked_time = if(%[1]s >= failed_login_attempts, if(%[4]s or locked_time is null, current_timestamp(), locked_time), NULL)
+				where user_id = %[5]d and (status != "%[2]s" or locked_time is not null);`
+
+	// the lock by the failed logins has passed at the unix time %[4]d, the
+	// same as passwordPolicy.lockExpired
+	lockExpiredOfUserFormat = `(status = "%[1]s" and locked_time is not null and password_lock_time != %[2]d and unix_timestamp(locked_time) + password_lock_time * %[3]d <= %[4]d)`
+
+	// locked_time is the last column added to mo_user by the upgrade of the
+	// password policies
+	getPasswordPolicyColumnOfUserSql = `select attname from mo_catalog.mo_columns where account_id = current_account_id() and att_database = "mo_catalog" and att_relname = "mo_user" and attname = "locked_time";`
+)
+
+func getSqlForPasswordPolicyOfUser(userID int64) string {
+	return fmt.Sprintf(getPasswordPolicyOfUserFormat, userID)
+}
+
+func getSqlForUpdatePasswordHistoryOfUser(history string, userID int64) string {
+	return fmt.Sprintf(updatePasswordHistoryOfUserFormat, history, userID)
+}
+
+// getSqlForUpdateLoginStatusOfUser updates the lock status of the user. the
+// locked_time is set only when the user is locked by the failed logins.
+func getSqlForUpdateLoginStatusOfUser(status string, failedLoginCount int64, lockedByFailedLogins bool, userID int64) string {
+	lockedTime := "NULL"
+	if lockedByFailedLogins {
+		lockedTime = "current_timestamp()"
+	}
+	return fmt.Sprintf(updateLoginStatusOfUserFormat, status, failedLoginCount, lockedTime, userID)
+}
+
+// getSqlForLoginFailedOfUser counts the failed login of the user at the unix
+// time now, the counting starts again after the lock time has passed.
+func getSqlForLoginFailedOfUser(userID, now int64) string {
+	expired := fmt.Sprintf(lockExpiredOfUserFormat, userStatusLock, passwordLockTimeUnbounded, secondsPerDay, now)
+	count := fmt.Sprintf("if(%s, 1, ifnull(failed_login_count, 0) + 1)", expired)
+	return fmt.Sprintf(updateFailedLoginOfUserFormat, count, userStatusLock, userStatusUnlock, expired, userID)
+}
+
+// getSqlForUpdatePasswordPolicyOfUser converts the password or lock option of
+// CREATE USER or ALTER USER into the update of mo_user.
+func getSqlForUpdatePasswordPolicyOfUser(ctx context.Context, opt tree.UserMiscOption, userID int64) (string, error) {
+	var set string
+	switch o := opt.(type) {
+	case *tree.UserMiscOptionAccountLock:
+		set = fmt.Sprintf(`status = "%s", locked_time = NULL`, userStatusLock)
+	case *tree.UserMiscOptionAccountUnlock:
+		set = fmt.Sprintf(`status = "%s", failed_login_count = 0, locked_time = NULL`, userStatusUnlock)
+	case *tree.UserMiscOptionPasswordExpireNone:
+		set = "password_expired = true"
+	case *tree.UserMiscOptionPasswordExpireDefault:
+		set = "password_lifetime = NULL"
+	case *tree.UserMiscOptionPasswordExpireNever:
+		set = "password_lifetime = 0"
+	case *tree.UserMiscOptionPasswordExpireInterval:
+		if o.Value <= 0 || o.Value > maxPasswordLifetime {
+			return "", moerr.NewInvalidInput(ctx, "password expire interval %d day is out of range [1, %d]", o.Value, maxPasswordLifetime)
+		}
+		set = fmt.Sprintf("password_lifetime = %d", o.Value)
+	case *tree.UserMiscOptionPasswordHistoryDefault:
+		set = "password_reuse_history = NULL"
+	case *tree.UserMiscOptionPasswordHistoryCount:
+		if o.Value < 0 {
+			return "", moerr.NewInvalidInput(ctx, "password history %d is negative", o.Value)
+		}
+		set = fmt.Sprintf("password_reuse_history = %d", o.Value)
+	case *tree.UserMiscOptionPasswordReuseIntervalDefault:
+		set = "password_reuse_time = NULL"
+	case *tree.UserMiscOptionPasswordReuseIntervalCount:
+		if o.Value < 0 {
+			return "", moerr.NewInvalidInput(ctx, "password reuse interval %d day is negative", o.Value)
+		}
+		set = fmt.Sprintf("password_reuse_time = %d", o.Value)
+	case *tree.UserMiscOptionFailedLoginAttempts:
+		if o.Value < 0 || o.Value > maxFailedLoginAttempts {
+			return "", moerr.NewInvalidInput(ctx, "failed_login_attempts %d is out of range [0, %d]", o.Value, maxFailedLoginAttempts)
+		}
+		set = fmt.Sprintf("failed_login_attempts = %d, failed_login_count = 0", o.Value)
+	case *tree.UserMiscOptionPasswordLockTimeCount:
+		if o.Value < 0 || o.Value > maxPasswordLockTime {
+			return "", moerr.NewInvalidInput(ctx, "password_lock_time %d is out of range [0, %d]", o.Value, maxPasswordLockTime)
+		}
+		set = fmt.Sprintf("password_lock_time = %d", o.Value)
+	case *tree.UserMiscOptionPasswordLockTimeUnbounded:
+		set = fmt.Sprintf("password_lock_time = %d", passwordLockTimeUnbounded)
+	default:
+		return "", moerr.NewNotSupported(ctx, "user option '%s'", tree.String(opt, 0))
+	}
+	return fmt.Sprintf(updatePasswordPolicyOfUserFormat, set, userID), nil
+}
+
+// passwordHistoryItem is a password that the user has used.
+type passwordHistoryItem struct {
+	// the encrypted password
+	Password string `json:"password"`
+	// the unix time when the password was set
+	Time int64 `json:"time"`
+}
+
+// passwordPolicy is the password and login state of the user in mo_user.
+type passwordPolicy struct {
+	userID int64
+	// the encrypted password of the user
+	password string
+	status   string
+	// the unix time when the password was changed
+	passwordLastChanged int64
+	// the password is expired manually by ALTER USER ... PASSWORD EXPIRE
+	passwordExpired bool
+	// the days the password is valid, 0 denotes the password never expires.
+	passwordLifetime int64
+	// the count of the recent passwords that can not be reused
+	passwordReuseHistory int64
+	// the days in which the passwords can not be reused
+	passwordReuseTime int64
+	// the passwords of the user, the latest one first
+	passwordHistory []passwordHistoryItem
+	// the consecutive failed logins that lock the user, 0 denotes the failed
+	// logins are not tracked.
+	failedLoginAttempts int64
+	// the days the user is locked after the failed logins
+	passwordLockTime int64
+	failedLoginCount int64
+	// the unix time when the user was locked by the failed logins. 0 denotes
+	// the user is locked by ALTER USER ... ACCOUNT LOCK.
+	lockedTime int64
+}
+
+// getPasswordPolicyOfUser gets the password and login state of the user. It
+// returns nil if mo_user of the tenant has not been upgraded yet, the policies
+// can not have been set on the user in that case.
+func getPasswordPolicyOfUser(ctx context.Context, bh BackgroundExec, userID int64) (*passwordPolicy, error) {
+	bh.ClearExecResultSet()
+	err := bh.Exec(ctx, getSqlForPasswordPolicyOfUser(userID))
+	if err != nil {
+		upgraded, err2 := hasPasswordPolicyColumns(ctx, bh)
+		if err2 != nil {
+			return nil, err2
+		}
+		if !upgraded {
+			return nil, nil
+		}
+		return nil, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, moerr.NewInternalError(ctx, "there is no user %d", userID)
+	}
+	return newPasswordPolicy(ctx, erArray[0], userID)
+}
+
+// hasPasswordPolicyColumns checks mo_user of the tenant has the columns of
+// the password policies.
+func hasPasswordPolicyColumns(ctx context.Context, bh BackgroundExec) (bool, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, getPasswordPolicyColumnOfUserSql); err != nil {
+		return false, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return false, err
+	}
+	return execResultArrayHasData(erArray), nil
+}
+
+func newPasswordPolicy(ctx context.Context, rs ExecResult, userID int64) (*passwordPolicy, error) {
+	var err error
+	var history string
+	p := &passwordPolicy{userID: userID}
+	if p.password, err = rs.GetString(ctx, 0, 0); err != nil {
+		return nil, err
+	}
+	if p.status, err = rs.GetString(ctx, 0, 1); err != nil {
+		return nil, err
+	}
+	ints := []*int64{
+		&p.passwordLastChanged,
+		nil,
+		&p.passwordLifetime,
+		&p.passwordReuseHistory,
+		&p.passwordReuseTime,
+		nil,
+		&p.failedLoginAttempts,
+		&p.passwordLockTime,
+		&p.failedLoginCount,
+		&p.lockedTime,
+	}
+	for i, v := range ints {
+		if v == nil {
+			continue
+		}
+		if *v, err = rs.GetInt64(ctx, 0, uint64(i+2)); err != nil {
+			return nil, err
+		}
+	}
+	expired, err := rs.GetInt64(ctx, 0, 3)
+	if err != nil {
+		return nil, err
+	}
+	p.passwordExpired = expired != 0
+	if history, err = rs.GetString(ctx, 0, 7); err != nil {
+		return nil, err
+	}
+	if history != "" {
+		if err = json.Unmarshal([]byte(history), &p.passwordHistory); err != nil {
+			return nil, moerr.NewInternalError(ctx, "invalid password history of the user %d. error:%v", userID, err)
+		}
+	}
+	return p, nil
+}
+
+// trackFailedLogins returns true if the user is locked after the failed logins.
+func (p *passwordPolicy) trackFailedLogins() bool {
+	return p.failedLoginAttempts > 0 && p.passwordLockTime != 0
+}
+
+// lockTimeString returns how long the user is locked by the failed logins.
+func (p *passwordPolicy) lockTimeString(now int64) string {
+	if p.passwordLockTime == passwordLockTimeUnbounded {
+		return "unlimited time"
+	}
+	remaining := (p.lockedTime + p.passwordLockTime*secondsPerDay - now + secondsPerDay - 1) / secondsPerDay
+	return fmt.Sprintf("%d day(s) (%d day(s) remaining)", p.passwordLockTime, remaining)
+}
+
+// lockExpired returns true if the user has been locked by the failed logins
+// and the lock time has passed.
+func (p *passwordPolicy) lockExpired(now int64) bool {
+	return p.status == userStatusLock && p.lockedTime != 0 &&
+		p.passwordLockTime != passwordLockTimeUnbounded &&
+		now >= p.lockedTime+p.passwordLockTime*secondsPerDay
+}
+
+// checkLocked checks the user can login or not before the password is checked.
+func (p *passwordPolicy) checkLocked(ctx context.Context, user string, now int64) error {
+	if p.status != userStatusLock || p.lockExpired(now) {
+		return nil
+	}
+	if p.lockedTime == 0 {
+		return moerr.NewUserLocked(ctx, user)
+	}
+	return moerr.NewUserBlocked(ctx, user, p.lockTimeString(now), p.failedLoginAttempts)
+}
+
+// loginFailed records the failed login. The user is locked when the consecutive
+// failed logins reach the limit, the error that tells the user is locked is
+// returned in that case.
+func (p *passwordPolicy) loginFailed(ctx context.Context, bh BackgroundExec, user string, now int64) error {
+	if !p.trackFailedLogins() {
+		return nil
+	}
+	if err := bh.Exec(ctx, getSqlForLoginFailedOfUser(p.userID, now)); err != nil {
+		return err
+	}
+	// the concurrent failed logins may have locked the user as well
+	latest, err := getPasswordPolicyOfUser(ctx, bh, p.userID)
+	if err != nil || latest == nil {
+		return err
+	}
+	*p = *latest
+	if p.status == userStatusLock && p.lockedTime != 0 {
+		return moerr.NewUserBlocked(ctx, user, p.lockTimeString(now), p.failedLoginAttempts)
+	}
+	return nil
+}
+
+// loginSucceeded resets the failed logins and the expired lock of the user.
+func (p *passwordPolicy) loginSucceeded(ctx context.Context, bh BackgroundExec) error {
+	if p.failedLoginCount == 0 && p.status != userStatusLock {
+		return nil
+	}
+	return bh.Exec(ctx, getSqlForUpdateLoginStatusOfUser(userStatusUnlock, 0, false, p.userID))
+}
+
+// isPasswordExpired checks the password has expired or not. defaultLifetime is
+// the value of default_password_lifetime.
+func (p *passwordPolicy) isPasswordExpired(defaultLifetime int64, now int64) bool {
+	if p.passwordExpired {
+		return true
+	}
+	lifetime := p.passwordLifetime
+	if lifetime == passwordPolicyDefault {
+		lifetime = defaultLifetime
+	}
+	return lifetime > 0 && now >= p.passwordLastChanged+lifetime*secondsPerDay
+}
+
+// changePassword checks the new password against the password history and
+// returns the history after the password is changed. reuseHistory and
+// reuseTime are the values of password_history and password_reuse_interval.
+func (p *passwordPolicy) changePassword(ctx context.Context, user, password string, reuseHistory, reuseTime, now int64) (string, error) {
+	if p.passwordReuseHistory != passwordPolicyDefault {
+		reuseHistory = p.passwordReuseHistory
+	}
+	if p.passwordReuseTime != passwordPolicyDefault {
+		reuseTime = p.passwordReuseTime
+	}
+	inHistory := func(i int, item passwordHistoryItem) bool {
+		return int64(i) < reuseHistory || (reuseTime > 0 && now < item.Time+reuseTime*secondsPerDay)
+	}
+
+	// the current password is not in the history if the user has not changed
+	// the password since the history was introduced.
+	history := p.passwordHistory
+	if len(history) == 0 || history[0].Password != p.password {
+		history = append([]passwordHistoryItem{{Password: p.password, Time: p.passwordLastChanged}}, history...)
+	}
+	for i, item := range history {
+		if item.Password == password && inHistory(i, item) {
+			return "", moerr.NewPasswordReused(ctx, user)
+		}
+	}
+
+	// keep the new password and the passwords that can not be reused
+	newHistory := []passwordHistoryItem{{Password: password, Time: now}}
+	for i, item := range history {
+		if inHistory(i+1, item) {
+			newHistory = append(newHistory, item)
+		}
+	}
+	data, err := json.Marshal(newHistory)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// passwordValidation is the settings of the password complexity in the global
+// system variables validate_password*.
+type passwordValidation struct {
+	enabled          bool
+	checkUserName    bool
+	length           int64
+	mixedCaseCount   int64
+	numberCount      int64
+	specialCharCount int64
+}
+
+func getPasswordValidation(ses *Session) (*passwordValidation, error) {
+	var err error
+	v := &passwordValidation{}
+	bools := map[string]*bool{
+		"validate_password":                 &v.enabled,
+		"validate_password_check_user_name": &v.checkUserName,
+	}
+	for name, b := range bools {
+		var value interface{}
+		if value, err = ses.GetGlobalSysVar(name); err != nil {
+			return nil, err
+		}
+		if *b, err = valueIsBoolTrue(value); err != nil {
+			return nil, err
+		}
+	}
+	ints := map[string]*int64{
+		"validate_password_length":             &v.length,
+		"validate_password_mixed_case_count":   &v.mixedCaseCount,
+		"validate_password_number_count":       &v.numberCount,
+		"validate_password_special_char_count": &v.specialCharCount,
+	}
+	for name, i := range ints {
+		if *i, err = getGlobalSysVarInt64(ses, name); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+// validate checks the password satisfies the complexity requirements.
+func (v *passwordValidation) validate(ctx context.Context, user, password string) error {
+	if !v.enabled {
+		return nil
+	}
+	var lower, upper, number, special int64
+	for _, c := range password {
+		switch {
+		case unicode.IsLower(c):
+			lower++
+		case unicode.IsUpper(c):
+			upper++
+		case unicode.IsDigit(c):
+			number++
+		case !unicode.IsLetter(c):
+			special++
+		}
+	}
+
+	// the length can not be less than the characters required
+	length := max(v.length, v.numberCount+v.specialCharCount+2*v.mixedCaseCount)
+	if int64(len([]rune(password))) < length {
+		return moerr.NewInvalidPassword(ctx, "the password is shorter than %d characters", length)
+	}
+	if lower < v.mixedCaseCount || upper < v.mixedCaseCount {
+		return moerr.NewInvalidPassword(ctx, "the password contains less than %d lowercase or uppercase characters", v.mixedCaseCount)
+	}
+	if number < v.numberCount {
+		return moerr.NewInvalidPassword(ctx, "the password contains less than %d numeric characters", v.numberCount)
+	}
+	if special < v.specialCharCount {
+		return moerr.NewInvalidPassword(ctx, "the password contains less than %d special characters", v.specialCharCount)
+	}
+	if v.checkUserName && user != "" {
+		reversed := []rune(user)
+		for i, j := 0, len(reversed)-1; i < j; i, j = i+1, j-1 {
+			reversed[i], reversed[j] = reversed[j], reversed[i]
+		}
+		if strings.EqualFold(password, user) || strings.EqualFold(password, string(reversed)) {
+			return moerr.NewInvalidPassword(ctx, "the password is the user name or the reversed user name")
+		}
+	}
+	return nil
+}
+
+// validatePassword checks the complexity of the new password of the user.
+func validatePassword(ctx context.Context, ses *Session, user, password string) error {
+	v, err := getPasswordValidation(ses)
+	if err != nil {
+		return err
+	}
+	return v.validate(ctx, user, password)
+}
+
+func getGlobalSysVarInt64(ses *Session, name string) (int64, error) {
+	value, err := ses.GetGlobalSysVar(name)
+	if err != nil {
+		return 0, err
+	}
+	switch v := value.(type) {
+	case int64:
+		return v, nil
+	case int8:
+		return int64(v), nil
+	case uint64:
+		return int64(v), nil
+	default:
+		return 0, moerr.NewInternalErrorNoCtx("invalid value %v of the system variable %s", value, name)
+	}
+}
+
+// changePasswordOfUser updates the password of the user. The new password is
+// checked against the password history of the user, and the history is kept
+// in mo_user.
+func changePasswordOfUser(ctx context.Context, ses *Session, bh BackgroundExec, userID int64, user, password string) error {
+	policy, err := getPasswordPolicyOfUser(ctx, bh, userID)
+	if err != nil {
+		return err
+	}
+
+	encryption := HashPassWord(password)
+	var history string
+	if policy != nil {
+		reuseHistory, err := getGlobalSysVarInt64(ses, "password_history")
+		if err != nil {
+			return err
+		}
+		reuseTime, err := getGlobalSysVarInt64(ses, "password_reuse_interval")
+		if err != nil {
+			return err
+		}
+		if history, err = policy.changePassword(ctx, user, encryption, reuseHistory, reuseTime, time.Now().Unix()); err != nil {
+			return err
+		}
+	}
+
+	sql, err := getSqlForUpdatePasswordOfUser(ctx, encryption, user)
+	if err != nil {
+		return err
+	}
+	if err = bh.Exec(ctx, sql); err != nil {
+		return err
+	}
+	if policy == nil {
+		return nil
+	}
+	return bh.Exec(ctx, getSqlForUpdatePasswordHistoryOfUser(history, userID))
+}
+
+// checkPasswordPolicyOnLogin checks the lock of the user and records the
+// result of checkPassword, which is called only when the user is not locked.
+// The login state is kept in mo_user, so the policies are enforced on all CNs.
+func checkPasswordPolicyOnLogin(ctx context.Context, ses *Session, userID int64, user string, checkPassword func() bool) (*passwordPolicy, bool, error) {
+	bh := NewBackgroundExec(ctx, ses)
+	defer bh.Close()
+
+	policy, err := getPasswordPolicyOfUser(ctx, bh, userID)
+	if err != nil {
+		return nil, false, err
+	}
+	if policy == nil {
+		return nil, checkPassword(), nil
+	}
+
+	now := time.Now().Unix()
+	if err = policy.checkLocked(ctx, user, now); err != nil {
+		return nil, false, err
+	}
+	if !checkPassword() {
+		return nil, false, policy.loginFailed(ctx, bh, user, now)
+	}
+	if err = policy.loginSucceeded(ctx, bh); err != nil {
+		return nil, false, err
+	}
+	return policy, true, nil
+}
+
+// passwordExpiredOnLogin checks the password of the user that has logged in
+// has expired or not. It needs the global system variables of the session.
+func passwordExpiredOnLogin(ses *Session, policy *passwordPolicy) (bool, error) {
+	if policy == nil {
+		return false, nil
+	}
+	defaultLifetime, err := getGlobalSysVarInt64(ses, "default_password_lifetime")
+	if err != nil {
+		return false, err
+	}
+	return policy.isPasswordExpired(defaultLifetime, time.Now().Unix()), nil
+}
+
+// canExecWithExpiredPassword returns true if the statement can be executed
+// after the user logs in with the expired password.
+func canExecWithExpiredPassword(stmt tree.Statement) bool {
+	switch stmt.(type) {
+	case *tree.AlterUser, *tree.SetPassword, *tree.SetVar:
+		return true
+	}
+	return false
+}
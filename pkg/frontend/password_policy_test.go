@@ -0,0 +1,380 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/config"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newMrsForPasswordPolicyOfUser(rows [][]interface{}) *MysqlResultSet {
+	mrs := &MysqlResultSet{}
+	names := []string{
+		"authentication_string",
+		"status",
+		"password_last_changed",
+		"password_expired",
+		"password_lifetime",
+		"password_reuse_history",
+		"password_reuse_time",
+		"password_history",
+		"failed_login_attempts",
+		"password_lock_time",
+		"failed_login_count",
+		"locked_time",
+	}
+	for _, name := range names {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		mrs.AddColumn(col)
+	}
+	for _, row := range rows {
+		mrs.AddRow(row)
+	}
+	return mrs
+}
+
+func TestGetSqlForUpdatePasswordPolicyOfUser(t *testing.T) {
+	ctx := context.TODO()
+	cases := []struct {
+		opt tree.UserMiscOption
+		set string
+	}{
+		{&tree.UserMiscOptionAccountLock{}, `status = "lock", locked_time = NULL`},
+		{&tree.UserMiscOptionAccountUnlock{}, `status = "unlock", failed_login_count = 0, locked_time = NULL`},
+		{&tree.UserMiscOptionPasswordExpireNone{}, "password_expired = true"},
+		{&tree.UserMiscOptionPasswordExpireDefault{}, "password_lifetime = NULL"},
+		{&tree.UserMiscOptionPasswordExpireNever{}, "password_lifetime = 0"},
+		{&tree.UserMiscOptionPasswordExpireInterval{Value: 90}, "password_lifetime = 90"},
+		{&tree.UserMiscOptionPasswordHistoryDefault{}, "password_reuse_history = NULL"},
+		{&tree.UserMiscOptionPasswordHistoryCount{Value: 3}, "password_reuse_history = 3"},
+		{&tree.UserMiscOptionPasswordReuseIntervalDefault{}, "password_reuse_time = NULL"},
+		{&tree.UserMiscOptionPasswordReuseIntervalCount{Value: 30}, "password_reuse_time = 30"},
+		{&tree.UserMiscOptionFailedLoginAttempts{Value: 3}, "failed_login_attempts = 3, failed_login_count = 0"},
+		{&tree.UserMiscOptionPasswordLockTimeCount{Value: 2}, "password_lock_time = 2"},
+		{&tree.UserMiscOptionPasswordLockTimeUnbounded{}, "password_lock_time = -1"},
+	}
+	for _, c := range cases {
+		sql, err := getSqlForUpdatePasswordPolicyOfUser(ctx, c.opt, 10)
+		require.NoError(t, err)
+		assert.Equal(t, "update mo_catalog.mo_user set "+c.set+" where user_id = 10;", sql)
+	}
+
+	for _, opt := range []tree.UserMiscOption{
+		&tree.UserMiscOptionPasswordExpireInterval{Value: 0},
+		&tree.UserMiscOptionPasswordExpireInterval{Value: maxPasswordLifetime + 1},
+		&tree.UserMiscOptionPasswordHistoryCount{Value: -1},
+		&tree.UserMiscOptionFailedLoginAttempts{Value: maxFailedLoginAttempts + 1},
+		&tree.UserMiscOptionPasswordLockTimeCount{Value: -1},
+		&tree.UserMiscOptionPasswordRequireCurrentNone{},
+	} {
+		_, err := getSqlForUpdatePasswordPolicyOfUser(ctx, opt, 10)
+		assert.Error(t, err)
+	}
+}
+
+func TestGetPasswordPolicyOfUser(t *testing.T) {
+	ctx := context.TODO()
+	bh := &backgroundExecTest{}
+	bh.init()
+
+	history, err := json.Marshal([]passwordHistoryItem{{Password: "*222", Time: 200}, {Password: "*111", Time: 100}})
+	require.NoError(t, err)
+	bh.sql2result[getSqlForPasswordPolicyOfUser(10)] = newMrsForPasswordPolicyOfUser([][]interface{}{
+		{"*222", userStatusLock, 200, true, 90, 3, -1, string(history), 3, 2, 3, 300},
+	})
+
+	p, err := getPasswordPolicyOfUser(ctx, bh, 10)
+	require.NoError(t, err)
+	assert.Equal(t, &passwordPolicy{
+		userID:               10,
+		password:             "*222",
+		status:               userStatusLock,
+		passwordLastChanged:  200,
+		passwordExpired:      true,
+		passwordLifetime:     90,
+		passwordReuseHistory: 3,
+		passwordReuseTime:    passwordPolicyDefault,
+		passwordHistory:      []passwordHistoryItem{{Password: "*222", Time: 200}, {Password: "*111", Time: 100}},
+		failedLoginAttempts:  3,
+		passwordLockTime:     2,
+		failedLoginCount:     3,
+		lockedTime:           300,
+	}, p)
+
+	bh.sql2result[getSqlForPasswordPolicyOfUser(11)] = newMrsForPasswordPolicyOfUser(nil)
+	_, err = getPasswordPolicyOfUser(ctx, bh, 11)
+	assert.Error(t, err)
+}
+
+// backgroundExecRecorder records the sqls executed by backgroundExecTest and
+// fails the sqls in errs.
+type backgroundExecRecorder struct {
+	backgroundExecTest
+	sqls []string
+	errs map[string]error
+}
+
+func (bh *backgroundExecRecorder) Exec(ctx context.Context, s string) error {
+	bh.sqls = append(bh.sqls, s)
+	if err := bh.errs[s]; err != nil {
+		return err
+	}
+	return bh.backgroundExecTest.Exec(ctx, s)
+}
+
+func TestPasswordPolicyNotUpgraded(t *testing.T) {
+	ctx := context.TODO()
+	bh := &backgroundExecRecorder{errs: map[string]error{
+		getSqlForPasswordPolicyOfUser(10): moerr.NewInternalError(ctx, "column failed_login_count does not exist"),
+	}}
+	bh.init()
+
+	// mo_user of the tenant has not been upgraded
+	bh.sql2result[getPasswordPolicyColumnOfUserSql] = newMrsForSqlForShowDatabases(nil)
+	p, err := getPasswordPolicyOfUser(ctx, bh, 10)
+	require.NoError(t, err)
+	assert.Nil(t, p)
+
+	// the error is returned after the upgrade
+	bh.sql2result[getPasswordPolicyColumnOfUserSql] = newMrsForSqlForShowDatabases([][]interface{}{{"locked_time"}})
+	_, err = getPasswordPolicyOfUser(ctx, bh, 10)
+	assert.Error(t, err)
+}
+
+func TestPasswordPolicyFailedLogins(t *testing.T) {
+	ctx := context.TODO()
+	bh := &backgroundExecRecorder{}
+	bh.init()
+	const now = int64(10 * secondsPerDay)
+	policyOfUser := func(status string, count, lockedTime int64) {
+		bh.sql2result[getSqlForPasswordPolicyOfUser(10)] = newMrsForPasswordPolicyOfUser([][]interface{}{
+			{"*111", status, 0, false, -1, -1, -1, "", 3, 2, count, lockedTime},
+		})
+	}
+
+	// the failed logins are not tracked
+	p := &passwordPolicy{userID: 10, status: userStatusUnlock, failedLoginAttempts: 3}
+	require.NoError(t, p.loginFailed(ctx, bh, "u1", now))
+	assert.Empty(t, bh.sqls)
+
+	// the failed login is counted by one statement, then the state of the
+	// user is read again
+	p.passwordLockTime = 2
+	policyOfUser(userStatusUnlock, 1, 0)
+	require.NoError(t, p.loginFailed(ctx, bh, "u1", now))
+	assert.Equal(t, []string{getSqlForLoginFailedOfUser(10, now), getSqlForPasswordPolicyOfUser(10)}, bh.sqls)
+	assert.Equal(t, int64(1), p.failedLoginCount)
+	sql := getSqlForLoginFailedOfUser(10, now)
+	assert.Contains(t, sql, "failed_login_count = if((status = \"lock\"")
+	assert.Contains(t, sql, fmt.Sprintf("password_lock_time * %d <= %d), 1, ifnull(failed_login_count, 0) + 1)", secondsPerDay, now))
+
+	// the user is locked when the failed logins reach the limit
+	policyOfUser(userStatusLock, 3, now)
+	err := p.loginFailed(ctx, bh, "u1", now)
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrUserBlocked))
+	assert.Contains(t, err.Error(), "2 day(s) (2 day(s) remaining)")
+
+	err = p.checkLocked(ctx, "u1", now+secondsPerDay/2)
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrUserBlocked))
+	assert.Contains(t, err.Error(), "2 day(s) (2 day(s) remaining)")
+	err = p.checkLocked(ctx, "u1", now+secondsPerDay*3/2)
+	assert.Contains(t, err.Error(), "2 day(s) (1 day(s) remaining)")
+
+	// the lock expires after the lock time
+	later := now + 2*secondsPerDay
+	require.NoError(t, p.checkLocked(ctx, "u1", later))
+	policyOfUser(userStatusUnlock, 1, 0)
+	require.NoError(t, p.loginFailed(ctx, bh, "u1", later))
+	assert.Equal(t, getSqlForLoginFailedOfUser(10, later), bh.sqls[len(bh.sqls)-2])
+	require.NoError(t, p.loginSucceeded(ctx, bh))
+	assert.Equal(t, getSqlForUpdateLoginStatusOfUser(userStatusUnlock, 0, false, 10), bh.currentSql)
+
+	// the unbounded lock
+	p.status = userStatusLock
+	p.lockedTime = now
+	p.passwordLockTime = passwordLockTimeUnbounded
+	err = p.checkLocked(ctx, "u1", later+365*secondsPerDay)
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrUserBlocked))
+	assert.Contains(t, err.Error(), "unlimited time")
+
+	// the user locked by ACCOUNT LOCK
+	p = &passwordPolicy{userID: 10, status: userStatusLock}
+	err = p.checkLocked(ctx, "u1", now)
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrUserLocked))
+
+	// nothing to reset after the successful login
+	bh.currentSql = ""
+	p = &passwordPolicy{userID: 10, status: userStatusUnlock}
+	require.NoError(t, p.loginSucceeded(ctx, bh))
+	assert.Equal(t, "", bh.currentSql)
+}
+
+func TestPasswordPolicyExpired(t *testing.T) {
+	const changed = int64(100 * secondsPerDay)
+	p := &passwordPolicy{passwordLastChanged: changed, passwordLifetime: passwordPolicyDefault}
+	assert.False(t, p.isPasswordExpired(0, changed+1000*secondsPerDay))
+	assert.False(t, p.isPasswordExpired(30, changed+29*secondsPerDay))
+	assert.True(t, p.isPasswordExpired(30, changed+30*secondsPerDay))
+
+	p.passwordLifetime = 0
+	assert.False(t, p.isPasswordExpired(30, changed+30*secondsPerDay))
+	p.passwordLifetime = 10
+	assert.True(t, p.isPasswordExpired(30, changed+10*secondsPerDay))
+
+	p.passwordLifetime = 0
+	p.passwordExpired = true
+	assert.True(t, p.isPasswordExpired(0, changed))
+}
+
+func TestPasswordPolicyChangePassword(t *testing.T) {
+	ctx := context.TODO()
+	const now = int64(100 * secondsPerDay)
+	p := &passwordPolicy{
+		password:             "*333",
+		passwordLastChanged:  now - 10*secondsPerDay,
+		passwordReuseHistory: passwordPolicyDefault,
+		passwordReuseTime:    passwordPolicyDefault,
+		passwordHistory: []passwordHistoryItem{
+			{Password: "*222", Time: now - 20*secondsPerDay},
+			{Password: "*111", Time: now - 40*secondsPerDay},
+		},
+	}
+
+	// no restriction
+	history, err := p.changePassword(ctx, "u1", "*333", 0, 0, now)
+	require.NoError(t, err)
+	assert.Equal(t, `[{"password":"*333","time":8640000}]`, history)
+
+	// the recent 2 passwords can not be reused
+	_, err = p.changePassword(ctx, "u1", "*333", 2, 0, now)
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrPasswordReused))
+	_, err = p.changePassword(ctx, "u1", "*222", 2, 0, now)
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrPasswordReused))
+	history, err = p.changePassword(ctx, "u1", "*111", 2, 0, now)
+	require.NoError(t, err)
+	var items []passwordHistoryItem
+	require.NoError(t, json.Unmarshal([]byte(history), &items))
+	assert.Equal(t, []passwordHistoryItem{
+		{Password: "*111", Time: now},
+		{Password: "*333", Time: now - 10*secondsPerDay},
+	}, items)
+
+	// the passwords used in 30 days can not be reused
+	p.passwordReuseHistory = 0
+	p.passwordReuseTime = 30
+	_, err = p.changePassword(ctx, "u1", "*222", 5, 0, now)
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrPasswordReused))
+	history, err = p.changePassword(ctx, "u1", "*111", 5, 0, now)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(history), &items))
+	assert.Len(t, items, 3)
+}
+
+func TestPasswordValidation(t *testing.T) {
+	ctx := context.TODO()
+	v := &passwordValidation{
+		checkUserName:    true,
+		length:           8,
+		mixedCaseCount:   1,
+		numberCount:      1,
+		specialCharCount: 1,
+	}
+	require.NoError(t, v.validate(ctx, "u1", "111"))
+
+	v.enabled = true
+	for _, password := range []string{"Ab1!", "abcdefg1!", "ABCDEFG1!", "Abcdefgh!", "Abcdefgh1"} {
+		err := v.validate(ctx, "u1", password)
+		assert.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidPassword), password)
+	}
+	require.NoError(t, v.validate(ctx, "u1", "Abcdefg1!"))
+
+	assert.Error(t, v.validate(ctx, "Abcdefg1!", "Abcdefg1!"))
+	assert.Error(t, v.validate(ctx, "!1gfedcbA", "Abcdefg1!"))
+	v.checkUserName = false
+	require.NoError(t, v.validate(ctx, "Abcdefg1!", "Abcdefg1!"))
+
+	// the length is at least the count of the required characters
+	v.length = 1
+	v.numberCount = 6
+	err := v.validate(ctx, "u1", "Ab1234!")
+	assert.Contains(t, err.Error(), "shorter than 9 characters")
+	require.NoError(t, v.validate(ctx, "u1", "Ab123456!"))
+}
+
+func TestCanExecWithExpiredPassword(t *testing.T) {
+	assert.True(t, canExecWithExpiredPassword(&tree.AlterUser{}))
+	assert.True(t, canExecWithExpiredPassword(&tree.SetVar{}))
+	assert.False(t, canExecWithExpiredPassword(&tree.Select{}))
+	assert.False(t, canExecWithExpiredPassword(&tree.CreateUser{}))
+}
+
+func TestDoAlterUserPasswordPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bh := &backgroundExecTest{}
+	bh.init()
+	bhStub := gostub.StubFunc(&NewBackgroundExec, bh)
+	defer bhStub.Reset()
+
+	ses := newSes(nil, ctrl)
+	pu := config.NewParameterUnit(&config.FrontendParameters{}, nil, nil, nil)
+	pu.SV.SetDefaultValues()
+	ctx := context.WithValue(context.TODO(), config.ParameterUnitKey, pu)
+	rm, _ := NewRoutineManager(ctx)
+	ses.rm = rm
+
+	sql, _ := getSqlForPasswordOfUser(ctx, "u1")
+	bh.sql2result[sql] = newMrsForPasswordOfUser([][]interface{}{{10, "*111", 0}})
+	sql, _ = getSqlForCheckUserHasRole(ctx, "root", moAdminRoleID)
+	bh.sql2result[sql] = newMrsForSqlForCheckUserHasRole([][]interface{}{{0, 0}})
+	bh.sql2result[getSqlForPasswordPolicyOfUser(10)] = newMrsForPasswordPolicyOfUser([][]interface{}{
+		{HashPassWord("111"), userStatusUnlock, 0, false, -1, 3, -1, "", 0, 0, 0, 0},
+	})
+
+	// only the password option
+	au := &alterUser{
+		Users:   []*user{{Username: "u1", Hostname: "%"}},
+		MiscOpt: &tree.UserMiscOptionFailedLoginAttempts{Value: 3},
+	}
+	require.NoError(t, doAlterUser(ctx, ses, au))
+	assert.Equal(t, "commit;", bh.currentSql)
+
+	// the current password can not be reused
+	au = &alterUser{
+		Users: []*user{{Username: "u1", Hostname: "%", AuthExist: true, IdentTyp: tree.AccountIdentifiedByPassword, IdentStr: "111"}},
+	}
+	err := doAlterUser(ctx, ses, au)
+	assert.True(t, moerr.IsMoErrCode(err, moerr.ErrPasswordReused))
+
+	assert.Equal(t, "rollback;", bh.currentSql)
+
+	au.Users[0].IdentStr = "222"
+	require.NoError(t, doAlterUser(ctx, ses, au))
+	assert.Equal(t, "commit;", bh.currentSql)
+}
@@ -34,7 +34,17 @@ var (
 				login_type  varchar(16),
 				creator int signed,
 				owner int signed,
-				default_role int signed
+				default_role int signed,
+				password_last_changed timestamp,
+				password_expired bool,
+				password_lifetime int signed,
+				password_reuse_history int signed,
+				password_reuse_time int signed,
+				password_history text,
+				failed_login_attempts int signed,
+				password_lock_time int signed,
+				failed_login_count int signed,
+				locked_time timestamp
     		)`
 
 	MoCatalogMoAccountDDL = `create table mo_catalog.mo_account (
@@ -150,6 +160,19 @@ var (
 			primary key(pitr_name, create_account)
 			)`, catalog.MO_CATALOG, catalog.MO_PITR)
 
+	MoCatalogMoStatisticsExtDDL = fmt.Sprintf(`CREATE TABLE %s.%s (
+			table_id bigint unsigned,
+			stats_name varchar(64),
+			database_name varchar(5000),
+			table_name varchar(5000),
+			column_list varchar(5000),
+			kinds varchar(64),
+			ndistinct bigint unsigned,
+			dependencies text,
+			created_time timestamp,
+			primary key(table_id, stats_name)
+			)`, catalog.MO_CATALOG, catalog.MO_STATISTICS_EXT)
+
 	MoCatalogMoPubsDDL = `create table mo_catalog.mo_pubs (
     		pub_name varchar(64) primary key,
     		database_name varchar(5000),
@@ -200,6 +223,7 @@ var (
 	MoCatalogMoVariablesDDL      = `CREATE VIEW mo_catalog.mo_variables AS SELECT configuration_id, account_id, account_name, dat_name, variable_name, variable_value, system_variables FROM mo_catalog.mo_mysql_compatibility_mode`
 	MoCatalogMoTransactionsDDL   = `CREATE VIEW mo_catalog.mo_transactions AS SELECT cn_id, txn_id, create_ts, snapshot_ts, prepared_ts, commit_ts, txn_mode, isolation, user_txn, txn_status, table_id, lock_key, lock_content, lock_mode FROM mo_transactions() AS mo_transactions_tmp`
 	MoCatalogMoCacheDDL          = `CREATE VIEW mo_catalog.mo_cache AS SELECT node_type, node_id, type, used, free, hit_ratio FROM mo_cache() AS mo_cache_tmp`
+	MoCatalogMoCNScalingDDL      = `CREATE VIEW mo_catalog.mo_cn_scaling AS SELECT labels, cns, cpu_usage, memory_usage, active_sessions, active_queries, action, count, reason, drain FROM mo_cn_scaling() AS mo_cn_scaling_tmp`
 )
 
 // `mo_catalog` database system tables
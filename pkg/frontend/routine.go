@@ -64,6 +64,10 @@ type Routine struct {
 
 	restricted atomic.Bool
 
+	// the password of the user has expired. only the statements that reset
+	// the password can be executed.
+	passwordExpired atomic.Bool
+
 	printInfoOnce bool
 
 	mc *migrateController
@@ -85,6 +89,14 @@ func (rt *Routine) isRestricted() bool {
 	return rt.restricted.Load()
 }
 
+func (rt *Routine) setPasswordExpired(val bool) {
+	rt.passwordExpired.Store(val)
+}
+
+func (rt *Routine) isPasswordExpired() bool {
+	return rt.passwordExpired.Load()
+}
+
 func (rt *Routine) increaseCount(counter func()) {
 	if rt.connectionBeCounted.CompareAndSwap(false, true) {
 		if counter != nil {
@@ -454,6 +454,18 @@ func execInFrontend(ses *Session, execCtx *ExecCtx) (err error) {
 		if err = handleRestorePitr(ses, execCtx, st); err != nil {
 			return
 		}
+	case *tree.CreateStatistics:
+		ses.EnterFPrint(124)
+		defer ses.ExitFPrint(124)
+		if err = handleCreateStatistics(ses, execCtx, st); err != nil {
+			return
+		}
+	case *tree.DropStatistics:
+		ses.EnterFPrint(125)
+		defer ses.ExitFPrint(125)
+		if err = handleDropStatistics(ses, execCtx, st); err != nil {
+			return
+		}
 	case *tree.CreateCDC:
 	case *tree.PauseCDC:
 	case *tree.DropCDC:
@@ -1077,6 +1077,82 @@ func (ses *Session) skipAuthForSpecialUser() bool {
 	return false
 }
 
+// ResetConnection resets the session state like the connection is just established
+// for COM_RESET_CONNECTION. The active txn is rolled back. The prepared statements,
+// the user variables and the temporary tables are dropped. The session variables
+// are restored to the global values. The user and the database are not changed.
+func (ses *Session) ResetConnection(execCtx *ExecCtx) error {
+	tempExecCtx := ExecCtx{
+		reqCtx: execCtx.reqCtx,
+		ses:    ses,
+		txnOpt: FeTxnOption{byRollback: true},
+	}
+	err := ses.GetTxnHandler().Reset(&tempExecCtx)
+	if err != nil {
+		return err
+	}
+
+	ses.mu.Lock()
+	for _, stmt := range ses.prepareStmts {
+		stmt.Close()
+	}
+	ses.prepareStmts = make(map[string]*PrepareStmt)
+	ses.userDefinedVars = make(map[string]*UserDefinedVar)
+	ses.seqCurValues = make(map[uint64]string)
+	*ses.seqLastValue = ""
+	ses.lastInsertID = 0
+	ses.errInfo.codes = ses.errInfo.codes[:0]
+	ses.errInfo.msgs = ses.errInfo.msgs[:0]
+	ses.planCache.clean()
+	ses.cache.invalidate()
+	ses.mu.Unlock()
+
+	if ses.gSysVars != nil {
+		ses.sesSysVars = ses.gSysVars.Clone()
+	}
+	ses.SetTimeZone(time.Local)
+	return nil
+}
+
+// ChangeUser resets the session and authenticates the user in the payload
+// of COM_CHANGE_USER. The ERR packet is sent to the client if it fails, and
+// the connection is not usable anymore, because the session of the previous
+// user may have been reset.
+func (ses *Session) ChangeUser(execCtx *ExecCtx, data []byte) (err error) {
+	proto, ok := ses.GetResponser().MysqlRrWr().(*MysqlProtocolImpl)
+	if !ok {
+		return moerr.NewInternalError(execCtx.reqCtx, "COM_CHANGE_USER is not supported by the protocol")
+	}
+	defer func() {
+		if err != nil {
+			ses.Errorf(execCtx.reqCtx, "change user failed.error:%v", err)
+			errorCode, sqlState, msg := RewriteError(err, proto.GetUserName())
+			if err2 := proto.sendErrPacket(errorCode, sqlState, msg); err2 != nil {
+				ses.Errorf(execCtx.reqCtx, "send err packet failed.error:%v", err2)
+			}
+		}
+	}()
+
+	info, err := proto.analyseChangeUser(execCtx.reqCtx, data)
+	if err != nil {
+		return err
+	}
+	if err = ses.ResetConnection(execCtx); err != nil {
+		return err
+	}
+
+	// the routine is recorded for the account of the new user in AuthenticateUser
+	if tenant := ses.GetTenantInfo(); tenant != nil && ses.getRoutineManager() != nil {
+		ses.getRoutineManager().accountRoutine.deleteRoutine(int64(tenant.GetTenantID()), ses.getRoutine())
+	}
+	if err = proto.changeUser(execCtx.reqCtx, info); err != nil {
+		return err
+	}
+	ses.SetDatabaseName(info.database)
+	ses.UpdateDebugString()
+	return nil
+}
+
 // AuthenticateUser Verify the user's password, and if the login information contains the database name, verify if the database exists
 func (ses *Session) AuthenticateUser(ctx context.Context, userInput string, dbName string, authResponse []byte, salt []byte, checkPassword func(pwd []byte, salt []byte, auth []byte) bool) ([]byte, error) {
 	var defaultRoleID int64
@@ -1276,7 +1352,13 @@ func (ses *Session) AuthenticateUser(ctx context.Context, userInput string, dbNa
 	}
 
 	// TO Check password
-	if checkPassword(psw, salt, authResponse) {
+	policy, passed, err := checkPasswordPolicyOnLogin(tenantCtx, ses, userID, tenant.GetUser(), func() bool {
+		return checkPassword(psw, salt, authResponse)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if passed {
 		ses.Debug(tenantCtx, "check password succeeded")
 		if err = ses.InitSystemVariables(ctx); err != nil {
 			return nil, err
@@ -1285,6 +1367,13 @@ func (ses *Session) AuthenticateUser(ctx context.Context, userInput string, dbNa
 		return nil, moerr.NewInternalError(tenantCtx, "check password failed")
 	}
 
+	// the user with the expired password can only reset the password
+	expired, err := passwordExpiredOnLogin(ses, policy)
+	if err != nil {
+		return nil, err
+	}
+	ses.getRoutine().setPasswordExpired(expired)
+
 	// If the login information contains the database name, verify if the database exists
 	if dbName != "" {
 		ses.timestampMap[TSCheckDbNameStart] = time.Now()
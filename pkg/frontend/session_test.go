@@ -603,3 +603,83 @@ func TestSession_Migrate(t *testing.T) {
 	assert.Equal(t, "d1", s.GetDatabaseName())
 	assert.Equal(t, 2, len(s.prepareStmts))
 }
+
+func makeChangeUserPayload(username, database string, attrs map[string]string) []byte {
+	payload := append([]byte(username), 0)
+	payload = append(payload, 20)
+	payload = append(payload, make([]byte, 20)...)
+	payload = append(payload, database...)
+	payload = append(payload, 0)
+	payload = append(payload, byte(Utf8mb4CollationID), 0)
+	payload = append(payload, AuthNativePassword...)
+	payload = append(payload, 0)
+	var kvs []byte
+	for k, v := range attrs {
+		kvs = append(kvs, byte(len(k)))
+		kvs = append(kvs, k...)
+		kvs = append(kvs, byte(len(v)))
+		kvs = append(kvs, v...)
+	}
+	payload = append(payload, byte(len(kvs)))
+	return append(payload, kvs...)
+}
+
+func TestSession_ResetConnection(t *testing.T) {
+	ses, _ := newCursorTestSession(t)
+	ses.gSysVars = &SystemVariables{sysVars: map[string]interface{}{"autocommit": int64(1)}}
+	ses.sesSysVars = ses.gSysVars.Clone()
+	ses.sesSysVars.Set("autocommit", int64(0))
+	ses.GetTxnHandler().SetOptionBits(OPTION_NOT_AUTOCOMMIT)
+	ses.prepareStmts[getPrepareStmtName(1)] = &PrepareStmt{}
+	assert.NoError(t, ses.SetUserDefinedVar("a", int64(1), "set @a = 1"))
+	ses.SetLastInsertID(10)
+	ses.GetErrInfo().push(moerr.ER_INTERNAL_ERROR, "error")
+	ses.SetTimeZone(time.UTC)
+
+	execCtx := &ExecCtx{reqCtx: context.TODO(), ses: ses}
+	assert.NoError(t, ses.ResetConnection(execCtx))
+	assert.Empty(t, ses.GetPrepareStmts())
+	v, err := ses.GetUserDefinedVar("a")
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+	assert.Zero(t, ses.GetLastInsertID())
+	assert.Zero(t, ses.GetErrInfo().length())
+	assert.Equal(t, int64(1), ses.GetSessionSysVars().Get("autocommit"))
+	assert.Equal(t, time.Local, ses.GetTimeZone())
+	assert.Equal(t, defaultOptionBits, ses.GetTxnHandler().GetOptionBits())
+	assert.False(t, ses.GetTxnHandler().HasTempEngine())
+
+	resp, err := ExecRequest(ses, execCtx, &Request{cmd: COM_RESET_CONNECTION})
+	assert.NoError(t, err)
+	assert.Equal(t, OkResponse, resp.category)
+}
+
+func TestSession_ChangeUser(t *testing.T) {
+	ses, client := newCursorTestSession(t)
+	proto := ses.GetResponser().MysqlRrWr().(*MysqlProtocolImpl)
+	proto.SV.SkipCheckUser = true
+	assert.NoError(t, ses.SetUserDefinedVar("a", int64(1), "set @a = 1"))
+
+	execCtx := &ExecCtx{reqCtx: context.TODO(), ses: ses}
+	payload := makeChangeUserPayload("tenant1:user1", "db1", map[string]string{"k": "v"})
+	resp, err := ExecRequest(ses, execCtx, &Request{cmd: COM_CHANGE_USER, data: payload})
+	assert.NoError(t, err)
+	assert.Equal(t, OkResponse, resp.category)
+	assert.Equal(t, "tenant1:user1", proto.GetUserName())
+	assert.Equal(t, "tenant1", ses.GetTenantInfo().GetTenant())
+	assert.Equal(t, "user1", ses.GetTenantInfo().GetUser())
+	assert.Equal(t, "db1", ses.GetDatabaseName())
+	assert.Equal(t, map[string]string{"k": "v"}, proto.GetConnectAttrs())
+	v, err := ses.GetUserDefinedVar("a")
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	// the broken payload closes the connection after the error is sent
+	errC := make(chan error, 1)
+	go func() {
+		_, err := ExecRequest(ses, execCtx, &Request{cmd: COM_CHANGE_USER, data: []byte("user")})
+		errC <- err
+	}()
+	assert.Equal(t, byte(0xff), client.readPacket()[0])
+	assert.Error(t, <-errC)
+}
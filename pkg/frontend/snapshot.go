@@ -117,8 +117,10 @@ var (
 		"mo_transactions":   1,
 		"mo_cache":          1,
 
-		catalog.MO_SNAPSHOTS: 1,
-		catalog.MO_PITR:      1,
+		catalog.MO_SNAPSHOTS:      1,
+		catalog.MO_PITR:           1,
+		catalog.MO_STATISTICS_EXT: 1,
+		catalog.MO_CN_SCALING:     1,
 	}
 )
 
@@ -0,0 +1,546 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"go.uber.org/zap"
+)
+
+var (
+	insertIntoMoStatisticsExtFormat = `insert into mo_catalog.mo_statistics_ext(
+		table_id,
+		stats_name,
+		database_name,
+		table_name,
+		column_list,
+		kinds,
+		ndistinct,
+		dependencies,
+		created_time) values (%d, '%s', '%s', '%s', '%s', '%s', %d, '%s', current_timestamp());`
+
+	checkStatisticsExtFormat = `select stats_name from mo_catalog.mo_statistics_ext where table_id = %d and stats_name = '%s';`
+
+	dropStatisticsExtFormat = `delete from mo_catalog.mo_statistics_ext where table_id = %d and stats_name = '%s';`
+
+	getStatisticsExtFormat = `select stats_name, column_list, kinds from mo_catalog.mo_statistics_ext where table_id = %d order by stats_name;`
+
+	updateStatisticsExtFormat = `update mo_catalog.mo_statistics_ext set ndistinct = %d, dependencies = '%s' where table_id = %d and stats_name = '%s';`
+
+	loadStatisticsExtFormat = `select stats_name, column_list, ndistinct, dependencies from mo_catalog.mo_statistics_ext where table_id = %d;`
+
+	// the number of rows of the table
+	statisticsRowCountFormat = "select count(*) from %s.%s;"
+
+	// the number of distinct groups of the columns
+	statisticsNDistinctFormat = "select count(*) from (select %s from %s.%s group by %s) as g;"
+
+	// the number of rows in the groups of the column `from`,
+	// in which the column `to` has exactly one value.
+	statisticsDependencyFormat = "select coalesce(sum(cnt), 0) from (select count(*) as cnt from %s.%s group by %s having count(distinct %s) = 1) as g;"
+)
+
+// quoteStatisticsIdent quotes the identifier in the background SQL, the
+// backticks in the identifier are doubled.
+func quoteStatisticsIdent(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// escapeStatisticsString escapes the value of a string literal in the
+// background SQL.
+func escapeStatisticsString(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `''`).Replace(s)
+}
+
+func getSqlForCheckStatisticsExt(ctx context.Context, tableID uint64, statsName string) (string, error) {
+	err := inputNameIsInvalid(ctx, statsName)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(checkStatisticsExtFormat, tableID, statsName), nil
+}
+
+func getSqlForDropStatisticsExt(tableID uint64, statsName string) string {
+	return fmt.Sprintf(dropStatisticsExtFormat, tableID, statsName)
+}
+
+func getSqlForStatisticsRowCount(dbName, tblName string) string {
+	return fmt.Sprintf(statisticsRowCountFormat, quoteStatisticsIdent(dbName), quoteStatisticsIdent(tblName))
+}
+
+func getSqlForStatisticsNDistinct(dbName, tblName string, cols []string) string {
+	quoted := make([]string, len(cols))
+	for i, col := range cols {
+		quoted[i] = quoteStatisticsIdent(col)
+	}
+	colList := strings.Join(quoted, ", ")
+	return fmt.Sprintf(statisticsNDistinctFormat, colList, quoteStatisticsIdent(dbName), quoteStatisticsIdent(tblName), colList)
+}
+
+func getSqlForStatisticsDependency(dbName, tblName string, from, to string) string {
+	return fmt.Sprintf(statisticsDependencyFormat,
+		quoteStatisticsIdent(dbName), quoteStatisticsIdent(tblName), quoteStatisticsIdent(from), quoteStatisticsIdent(to))
+}
+
+// checkStatisticsKinds returns the normalized kinds of the extended statistics.
+func checkStatisticsKinds(ctx context.Context, kinds tree.IdentifierList) ([]string, error) {
+	if len(kinds) == 0 {
+		return []string{plan2.ExtStatsKindNDistinct, plan2.ExtStatsKindDependencies}, nil
+	}
+	var ret []string
+	seen := make(map[string]bool, len(kinds))
+	for _, kind := range kinds {
+		k := strings.ToLower(string(kind))
+		switch k {
+		case plan2.ExtStatsKindNDistinct, plan2.ExtStatsKindDependencies:
+		default:
+			return nil, moerr.NewInvalidInput(ctx, "unrecognized statistics kind %s", string(kind))
+		}
+		if !seen[k] {
+			seen[k] = true
+			ret = append(ret, k)
+		}
+	}
+	return ret, nil
+}
+
+// checkStatisticsColumns returns the names of the columns of the extended statistics
+func checkStatisticsColumns(ctx context.Context, cols tree.IdentifierList, tableDef *plan2.TableDef) ([]string, error) {
+	if len(cols) < 2 {
+		return nil, moerr.NewInvalidInput(ctx, "extended statistics require at least 2 columns")
+	}
+	if len(cols) > plan2.ExtStatsMaxColumns {
+		return nil, moerr.NewInvalidInput(ctx, "cannot have more than %d columns in statistics", plan2.ExtStatsMaxColumns)
+	}
+	ret := make([]string, 0, len(cols))
+	seen := make(map[string]bool, len(cols))
+	for _, col := range cols {
+		name := strings.ToLower(string(col))
+		if seen[name] {
+			return nil, moerr.NewInvalidInput(ctx, "duplicate column name %s in statistics definition", string(col))
+		}
+		seen[name] = true
+
+		var colDef *plan2.ColDef
+		for _, def := range tableDef.Cols {
+			if strings.EqualFold(def.Name, name) && !def.Hidden {
+				colDef = def
+				break
+			}
+		}
+		if colDef == nil {
+			return nil, moerr.NewBadFieldError(ctx, string(col), tableDef.Name)
+		}
+		ret = append(ret, colDef.Name)
+	}
+	return ret, nil
+}
+
+func hasStatisticsKind(kinds []string, kind string) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func queryStatisticsInt64(ctx context.Context, bh BackgroundExec, sql string) (int64, error) {
+	bh.ClearExecResultSet()
+	if err := bh.Exec(ctx, sql); err != nil {
+		return 0, err
+	}
+	erArray, err := getResultSet(ctx, bh)
+	if err != nil {
+		return 0, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return 0, nil
+	}
+	return erArray[0].GetInt64(ctx, 0, 0)
+}
+
+// buildExtendedStats scans the table and builds the extended statistics of the columns
+func buildExtendedStats(ctx context.Context, bh BackgroundExec, dbName, tblName string, cols []string, kinds []string) (*plan2.ExtendedStats, error) {
+	stats := &plan2.ExtendedStats{Columns: cols}
+
+	rows, err := queryStatisticsInt64(ctx, bh, getSqlForStatisticsRowCount(dbName, tblName))
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return stats, nil
+	}
+
+	if hasStatisticsKind(kinds, plan2.ExtStatsKindNDistinct) {
+		ndv, err := queryStatisticsInt64(ctx, bh, getSqlForStatisticsNDistinct(dbName, tblName, cols))
+		if err != nil {
+			return nil, err
+		}
+		stats.NDistinct = float64(ndv)
+	}
+
+	if hasStatisticsKind(kinds, plan2.ExtStatsKindDependencies) {
+		for _, from := range cols {
+			for _, to := range cols {
+				if from == to {
+					continue
+				}
+				determined, err := queryStatisticsInt64(ctx, bh, getSqlForStatisticsDependency(dbName, tblName, from, to))
+				if err != nil {
+					return nil, err
+				}
+				stats.Dependencies = append(stats.Dependencies, &plan2.FuncDependency{
+					From:   from,
+					To:     to,
+					Degree: float64(determined) / float64(rows),
+				})
+			}
+		}
+	}
+	return stats, nil
+}
+
+func encodeStatisticsDependencies(deps []*plan2.FuncDependency) (string, error) {
+	if len(deps) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(deps)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func decodeStatisticsDependencies(data string) ([]*plan2.FuncDependency, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var deps []*plan2.FuncDependency
+	if err := json.Unmarshal([]byte(data), &deps); err != nil {
+		return nil, err
+	}
+	return deps, nil
+}
+
+func resolveStatisticsTable(ctx context.Context, ses *Session, table *tree.TableName) (string, *plan2.TableDef, error) {
+	dbName := string(table.SchemaName)
+	if len(dbName) == 0 {
+		dbName = ses.GetDatabaseName()
+	}
+	if len(dbName) == 0 {
+		return "", nil, moerr.NewNoDB(ctx)
+	}
+	tblName := string(table.ObjectName)
+	_, tableDef := ses.GetTxnCompileCtx().Resolve(dbName, tblName, plan2.Snapshot{TS: &timestamp.Timestamp{}})
+	if tableDef == nil {
+		return "", nil, moerr.NewNoSuchTable(ctx, dbName, tblName)
+	}
+	if tableDef.TableType != catalog.SystemOrdinaryRel {
+		return "", nil, moerr.NewNotSupported(ctx, "statistics on %s.%s which is not a normal table", dbName, tblName)
+	}
+	return dbName, tableDef, nil
+}
+
+func doCreateStatistics(ctx context.Context, ses *Session, stmt *tree.CreateStatistics) (err error) {
+	var sql string
+	var exists bool
+
+	dbName, tableDef, err := resolveStatisticsTable(ctx, ses, stmt.Table)
+	if err != nil {
+		return err
+	}
+	kinds, err := checkStatisticsKinds(ctx, stmt.Kinds)
+	if err != nil {
+		return err
+	}
+	cols, err := checkStatisticsColumns(ctx, stmt.Cols, tableDef)
+	if err != nil {
+		return err
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	statsName := string(stmt.Name)
+	exists, err = checkStatisticsExistOrNot(ctx, bh, tableDef.TblId, statsName)
+	if err != nil {
+		return err
+	}
+	if exists {
+		if !stmt.IfNotExists {
+			return moerr.NewInternalError(ctx, "statistics %s already exists", statsName)
+		}
+		return nil
+	}
+
+	stats, err := buildExtendedStats(ctx, bh, dbName, tableDef.Name, cols, kinds)
+	if err != nil {
+		return err
+	}
+	deps, err := encodeStatisticsDependencies(stats.Dependencies)
+	if err != nil {
+		return err
+	}
+
+	sql = fmt.Sprintf(insertIntoMoStatisticsExtFormat,
+		tableDef.TblId,
+		statsName,
+		escapeStatisticsString(dbName),
+		escapeStatisticsString(tableDef.Name),
+		escapeStatisticsString(strings.Join(cols, ",")),
+		strings.Join(kinds, ","),
+		uint64(stats.NDistinct),
+		deps)
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, sql); err != nil {
+		return err
+	}
+
+	// the other sessions load them with the table stats, unless they have found
+	// the table without extended statistics, see updateExtendedStatsInCache
+	return refreshExtendedStatsInCache(ctx, ses, bh, tableDef.TblId)
+}
+
+func doDropStatistics(ctx context.Context, ses *Session, stmt *tree.DropStatistics) (err error) {
+	var exists bool
+
+	_, tableDef, err := resolveStatisticsTable(ctx, ses, stmt.Table)
+	if err != nil {
+		return err
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	statsName := string(stmt.Name)
+	exists, err = checkStatisticsExistOrNot(ctx, bh, tableDef.TblId, statsName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if !stmt.IfExists {
+			return moerr.NewInternalError(ctx, "statistics %s does not exist", statsName)
+		}
+		return nil
+	}
+
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, getSqlForDropStatisticsExt(tableDef.TblId, statsName)); err != nil {
+		return err
+	}
+	return refreshExtendedStatsInCache(ctx, ses, bh, tableDef.TblId)
+}
+
+// doAnalyzeExtendedStats rebuilds all the extended statistics of the table
+func doAnalyzeExtendedStats(ctx context.Context, ses *Session, table *tree.TableName) (err error) {
+	var erArray []ExecResult
+
+	dbName, tableDef, err := resolveStatisticsTable(ctx, ses, table)
+	if err != nil {
+		return err
+	}
+
+	bh := ses.GetBackgroundExec(ctx)
+	defer bh.Close()
+
+	err = bh.Exec(ctx, "begin;")
+	defer func() {
+		err = finishTxn(ctx, bh, err)
+	}()
+	if err != nil {
+		return err
+	}
+
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, fmt.Sprintf(getStatisticsExtFormat, tableDef.TblId)); err != nil {
+		return err
+	}
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		return err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil
+	}
+
+	type statsDef struct {
+		name  string
+		cols  []string
+		kinds []string
+	}
+	defs := make([]statsDef, 0, erArray[0].GetRowCount())
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		var def statsDef
+		var cols, kinds string
+		if def.name, err = erArray[0].GetString(ctx, i, 0); err != nil {
+			return err
+		}
+		if cols, err = erArray[0].GetString(ctx, i, 1); err != nil {
+			return err
+		}
+		if kinds, err = erArray[0].GetString(ctx, i, 2); err != nil {
+			return err
+		}
+		def.cols = strings.Split(cols, ",")
+		def.kinds = strings.Split(kinds, ",")
+		defs = append(defs, def)
+	}
+
+	for _, def := range defs {
+		var stats *plan2.ExtendedStats
+		var deps string
+		if stats, err = buildExtendedStats(ctx, bh, dbName, tableDef.Name, def.cols, def.kinds); err != nil {
+			return err
+		}
+		if deps, err = encodeStatisticsDependencies(stats.Dependencies); err != nil {
+			return err
+		}
+		bh.ClearExecResultSet()
+		sql := fmt.Sprintf(updateStatisticsExtFormat, uint64(stats.NDistinct), deps, tableDef.TblId, def.name)
+		if err = bh.Exec(ctx, sql); err != nil {
+			return err
+		}
+	}
+	return refreshExtendedStatsInCache(ctx, ses, bh, tableDef.TblId)
+}
+
+func checkStatisticsExistOrNot(ctx context.Context, bh BackgroundExec, tableID uint64, statsName string) (bool, error) {
+	var sql string
+	var erArray []ExecResult
+	var err error
+
+	sql, err = getSqlForCheckStatisticsExt(ctx, tableID, statsName)
+	if err != nil {
+		return false, err
+	}
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, sql); err != nil {
+		return false, err
+	}
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		return false, err
+	}
+	return execResultArrayHasData(erArray), nil
+}
+
+// loadExtendedStats reads the extended statistics of the table from mo_statistics_ext
+func loadExtendedStats(ctx context.Context, bh BackgroundExec, tableID uint64) (ret []*plan2.ExtendedStats, err error) {
+	var erArray []ExecResult
+
+	bh.ClearExecResultSet()
+	if err = bh.Exec(ctx, fmt.Sprintf(loadStatisticsExtFormat, tableID)); err != nil {
+		return nil, err
+	}
+	erArray, err = getResultSet(ctx, bh)
+	if err != nil {
+		return nil, err
+	}
+	if !execResultArrayHasData(erArray) {
+		return nil, nil
+	}
+	for i := uint64(0); i < erArray[0].GetRowCount(); i++ {
+		var cols, deps string
+		var ndv uint64
+		stats := &plan2.ExtendedStats{}
+		if stats.Name, err = erArray[0].GetString(ctx, i, 0); err != nil {
+			return nil, err
+		}
+		if cols, err = erArray[0].GetString(ctx, i, 1); err != nil {
+			return nil, err
+		}
+		if ndv, err = erArray[0].GetUint64(ctx, i, 2); err != nil {
+			return nil, err
+		}
+		if deps, err = erArray[0].GetString(ctx, i, 3); err != nil {
+			return nil, err
+		}
+		stats.Columns = strings.Split(cols, ",")
+		stats.NDistinct = float64(ndv)
+		if stats.Dependencies, err = decodeStatisticsDependencies(deps); err != nil {
+			return nil, err
+		}
+		ret = append(ret, stats)
+	}
+	return ret, nil
+}
+
+func refreshExtendedStatsInCache(ctx context.Context, ses *Session, bh BackgroundExec, tableID uint64) error {
+	stats, err := loadExtendedStats(ctx, bh, tableID)
+	if err != nil {
+		return err
+	}
+	ses.GetStatsCache().SetExtendedStats(tableID, stats)
+	return nil
+}
+
+// updateExtendedStatsInCache reloads the extended statistics of the table into the stats cache
+// together with the base statistics. It reads mo_statistics_ext in the transaction of the
+// statement, so no extra transaction is opened and both statistics come from the same snapshot.
+// A table found without extended statistics is not read again by the session, the statistics
+// created later by other sessions are seen by the new sessions.
+// the failure only makes the estimation less accurate, so it is logged and ignored.
+func (tcc *TxnCompilerContext) updateExtendedStatsInCache(dbName string, tableID uint64) {
+	if dbName == catalog.MO_CATALOG {
+		return
+	}
+	cache := tcc.GetStatsCache()
+	if cache.ExtendedStatsLoaded(tableID) && len(cache.GetExtendedStats(tableID)) == 0 {
+		return
+	}
+	ses := tcc.GetSession()
+	if ses == nil || ses.IsBackgroundSession() {
+		return
+	}
+	ctx := tcc.execCtx.reqCtx
+	bh := ses.GetShareTxnBackgroundExec(ctx, false)
+	defer bh.Close()
+
+	stats, err := loadExtendedStats(ctx, bh, tableID)
+	if err != nil {
+		logutil.Warn("failed to load extended statistics",
+			zap.Uint64("table", tableID),
+			zap.Error(err))
+		return
+	}
+	cache.SetExtendedStats(tableID, stats)
+}
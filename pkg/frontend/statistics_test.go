@@ -0,0 +1,150 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/sql/parsers/tree"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_checkStatisticsKinds(t *testing.T) {
+	ctx := context.TODO()
+
+	kinds, err := checkStatisticsKinds(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{plan2.ExtStatsKindNDistinct, plan2.ExtStatsKindDependencies}, kinds)
+
+	kinds, err = checkStatisticsKinds(ctx, tree.IdentifierList{"NDISTINCT", "ndistinct"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{plan2.ExtStatsKindNDistinct}, kinds)
+
+	_, err = checkStatisticsKinds(ctx, tree.IdentifierList{"mcv"})
+	assert.Error(t, err)
+}
+
+func Test_checkStatisticsColumns(t *testing.T) {
+	ctx := context.TODO()
+	tableDef := &plan2.TableDef{
+		Name: "t1",
+		Cols: []*plan2.ColDef{
+			{Name: "country"},
+			{Name: "city"},
+			{Name: "zip"},
+			{Name: "__mo_cpkey_col", Hidden: true},
+		},
+	}
+
+	cols, err := checkStatisticsColumns(ctx, tree.IdentifierList{"Country", "city"}, tableDef)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"country", "city"}, cols)
+
+	_, err = checkStatisticsColumns(ctx, tree.IdentifierList{"country"}, tableDef)
+	assert.Error(t, err)
+
+	_, err = checkStatisticsColumns(ctx, tree.IdentifierList{"country", "country"}, tableDef)
+	assert.Error(t, err)
+
+	_, err = checkStatisticsColumns(ctx, tree.IdentifierList{"country", "street"}, tableDef)
+	assert.Error(t, err)
+
+	_, err = checkStatisticsColumns(ctx, tree.IdentifierList{"country", "__mo_cpkey_col"}, tableDef)
+	assert.Error(t, err)
+
+	_, err = checkStatisticsColumns(ctx, tree.IdentifierList{"a", "b", "c", "d", "e", "f", "g", "h", "i"}, tableDef)
+	assert.Error(t, err)
+}
+
+func Test_getSqlForStatistics(t *testing.T) {
+	assert.Equal(t,
+		"select count(*) from (select `country`, `city` from `db1`.`t1` group by `country`, `city`) as g;",
+		getSqlForStatisticsNDistinct("db1", "t1", []string{"country", "city"}))
+	assert.Equal(t,
+		"select coalesce(sum(cnt), 0) from (select count(*) as cnt from `db1`.`t1` group by `city` having count(distinct `country`) = 1) as g;",
+		getSqlForStatisticsDependency("db1", "t1", "city", "country"))
+
+	// the backticks in the identifiers are doubled
+	assert.Equal(t,
+		"select count(*) from `db``1`.`t1`;",
+		getSqlForStatisticsRowCount("db`1", "t1"))
+	assert.Equal(t,
+		"select count(*) from (select `a``b` from `db1`.`t``1` group by `a``b`) as g;",
+		getSqlForStatisticsNDistinct("db1", "t`1", []string{"a`b"}))
+	assert.Equal(t, `it''s a\\b`, escapeStatisticsString(`it's a\b`))
+
+	sql, err := getSqlForCheckStatisticsExt(context.TODO(), 272515, "s1")
+	assert.NoError(t, err)
+	assert.Equal(t, "select stats_name from mo_catalog.mo_statistics_ext where table_id = 272515 and stats_name = 's1';", sql)
+
+	_, err = getSqlForCheckStatisticsExt(context.TODO(), 272515, "s1'")
+	assert.Error(t, err)
+}
+
+func Test_encodeStatisticsDependencies(t *testing.T) {
+	data, err := encodeStatisticsDependencies(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "", data)
+
+	deps, err := decodeStatisticsDependencies(data)
+	assert.NoError(t, err)
+	assert.Nil(t, deps)
+
+	expected := []*plan2.FuncDependency{
+		{From: "city", To: "country", Degree: 1},
+		{From: "country", To: "city", Degree: 0.05},
+	}
+	data, err = encodeStatisticsDependencies(expected)
+	assert.NoError(t, err)
+	deps, err = decodeStatisticsDependencies(data)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, deps)
+
+	_, err = decodeStatisticsDependencies("{")
+	assert.Error(t, err)
+}
+
+func Test_loadExtendedStats(t *testing.T) {
+	ctx := context.TODO()
+	bh := &backgroundExecTest{}
+	bh.init()
+
+	bh.sql2result[fmt.Sprintf(loadStatisticsExtFormat, 272515)] = newMrsForSqlForCheckUserHasRole(nil)
+	stats, err := loadExtendedStats(ctx, bh, 272515)
+	assert.NoError(t, err)
+	assert.Nil(t, stats)
+
+	mrs := &MysqlResultSet{}
+	for _, name := range []string{"stats_name", "column_list", "ndistinct", "dependencies"} {
+		col := &MysqlColumn{}
+		col.SetName(name)
+		col.SetColumnType(defines.MYSQL_TYPE_VARCHAR)
+		mrs.AddColumn(col)
+	}
+	mrs.AddRow([]interface{}{"s1", "city,country", uint64(100), `[{"from":"city","to":"country","degree":1}]`})
+	bh.sql2result[fmt.Sprintf(loadStatisticsExtFormat, 272516)] = mrs
+	stats, err = loadExtendedStats(ctx, bh, 272516)
+	assert.NoError(t, err)
+	assert.Equal(t, []*plan2.ExtendedStats{{
+		Name:         "s1",
+		Columns:      []string{"city", "country"},
+		NDistinct:    100,
+		Dependencies: []*plan2.FuncDependency{{From: "city", To: "country", Degree: 1}},
+	}}, stats)
+}
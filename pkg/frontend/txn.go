@@ -669,6 +669,23 @@ func (th *TxnHandler) setAutocommitOn() {
 	setBits(&th.serverStatus, uint32(SERVER_STATUS_AUTOCOMMIT))
 }
 
+// Reset rolls back the active txn and restores the txn options to the
+// defaults. The temporary tables are dropped with the temporary storage.
+func (th *TxnHandler) Reset(execCtx *ExecCtx) error {
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	err := th.rollbackUnsafe(execCtx)
+	th.optionBits = defaultOptionBits
+	th.serverStatus = defaultServerStatus
+	th.tempStorage = nil
+	th.tempTnService = nil
+	th.tempEngine = nil
+	if entireEng, ok := th.storage.(*engine.EntireEngine); ok {
+		entireEng.TempEngine = nil
+	}
+	return err
+}
+
 func (th *TxnHandler) IsShareTxn() bool {
 	th.mu.Lock()
 	defer th.mu.Unlock()
@@ -127,6 +127,12 @@ type PrepareStmt struct {
 	getFromSendLongData map[int]struct{}
 
 	compile *compile.Compile
+
+	// cursorType is the cursor flag of the last COM_STMT_EXECUTE
+	cursorType uint8
+	// cursor is opened by COM_STMT_EXECUTE with CURSOR_TYPE_READ_ONLY and
+	// read by COM_STMT_FETCH
+	cursor *stmtCursor
 }
 
 /*
@@ -219,7 +225,16 @@ func getStatementType(stmt tree.Statement) tree.StatementType {
 //	tableInfos map[string][]ColumnInfo
 //}
 
+// closeCursor closes the cursor opened on the statement
+func (prepareStmt *PrepareStmt) closeCursor() {
+	if prepareStmt.cursor != nil {
+		prepareStmt.cursor.close()
+		prepareStmt.cursor = nil
+	}
+}
+
 func (prepareStmt *PrepareStmt) Close() {
+	prepareStmt.closeCursor()
 	if prepareStmt.params != nil {
 		prepareStmt.params.Free(prepareStmt.proc.Mp())
 	}
@@ -495,6 +495,8 @@ func logStatementStringStatus(ctx context.Context, ses FeSession, stmtStr string
 		if outBytes == 0 && outPacket == 0 {
 			ses.Warnf(ctx, "unexpected protocol closed")
 		}
+	case *stmtCursor:
+		outBytes, outPacket = resper.mysqlRrWr.CalculateOutTrafficBytes(true)
 	default:
 
 	}
@@ -3427,6 +3427,54 @@ var gSysVarsDefs = map[string]SystemVariable{
 		Type:              InitSystemSystemEnumType("use_secondary_engine", "OFF", "ON", "FORCED"),
 		Default:           "ON",
 	},
+	"validate_password": {
+		Name:              "validate_password",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("validate_password"),
+		Default:           int64(0),
+	},
+	"validate_password_check_user_name": {
+		Name:              "validate_password_check_user_name",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableBoolType("validate_password_check_user_name"),
+		Default:           int64(1),
+	},
+	"validate_password_length": {
+		Name:              "validate_password_length",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("validate_password_length", 0, 2147483647, false),
+		Default:           int64(8),
+	},
+	"validate_password_mixed_case_count": {
+		Name:              "validate_password_mixed_case_count",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("validate_password_mixed_case_count", 0, 2147483647, false),
+		Default:           int64(1),
+	},
+	"validate_password_number_count": {
+		Name:              "validate_password_number_count",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("validate_password_number_count", 0, 2147483647, false),
+		Default:           int64(1),
+	},
+	"validate_password_special_char_count": {
+		Name:              "validate_password_special_char_count",
+		Scope:             ScopeGlobal,
+		Dynamic:           true,
+		SetVarHintApplies: false,
+		Type:              InitSystemVariableIntType("validate_password_special_char_count", 0, 2147483647, false),
+		Default:           int64(1),
+	},
 	"version_compile_machine": {
 		Name:              "version_compile_machine",
 		Scope:             ScopeGlobal,
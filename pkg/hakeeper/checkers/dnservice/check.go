@@ -74,7 +74,7 @@ func Check(
 
 	// 1. check reported tn state
 	operators = append(operators,
-		checkReportedState(service, reportedShards, mapper, stores.WorkingStores(), idAlloc)...,
+		checkReportedState(service, reportedShards, mapper, stores.WorkingStores(), idAlloc, cfg.TNStandby)...,
 	)
 
 	// 2. check expected tn state
@@ -105,6 +105,22 @@ func checkShard(
 ) []operator.OpStep {
 	switch len(shard.workingReplicas()) {
 	case 0: // need add replica
+		// promote the hot-standby replica if there is one, which is much
+		// faster than replaying the log on a new replica.
+		if standby := promotableStandby(shard); standby != nil {
+			logShardID, err := mapper.getLogShardID(shard.shardID)
+			if err != nil {
+				runtime.ServiceRuntime(service).Logger().Warn("shard not registered", zap.Uint64("ShardID", shard.shardID))
+				return nil
+			}
+
+			s := newPromoteStep(
+				standby.storeID, standby.shardID, standby.replicaID, logShardID,
+			)
+			runtime.ServiceRuntime(service).Logger().Info(s.String())
+			return []operator.OpStep{s}
+		}
+
 		newReplicaID, ok := idAlloc.Next()
 		if !ok {
 			runtime.ServiceRuntime(service).Logger().Warn("fail to allocate replica ID")
@@ -153,6 +169,73 @@ func checkShard(
 	}
 }
 
+// checkStandby keeps one hot-standby replica for the shard, on a tn store
+// other than the one of the working replica.
+// NB: the returned order should be deterministic.
+func checkStandby(
+	service string,
+	shard *tnShard,
+	mapper ShardMapper,
+	workingStores []*util.Store,
+	idAlloc util.IDAllocator,
+) []operator.OpStep {
+	working := shard.workingReplicas()
+	if len(working) != 1 {
+		return nil
+	}
+
+	logShardID, err := mapper.getLogShardID(shard.shardID)
+	if err != nil {
+		runtime.ServiceRuntime(service).Logger().Warn("shard not registered", zap.Uint64("ShardID", shard.shardID))
+		return nil
+	}
+
+	switch len(shard.standbyReplicas()) {
+	case 0: // need start standby replica
+		candidates := make([]*util.Store, 0, len(workingStores))
+		for _, store := range workingStores {
+			if store.ID != working[0].storeID {
+				candidates = append(candidates, store)
+			}
+		}
+		if len(candidates) == 0 {
+			return nil
+		}
+
+		newReplicaID, ok := idAlloc.Next()
+		if !ok {
+			runtime.ServiceRuntime(service).Logger().Warn("fail to allocate replica ID")
+			return nil
+		}
+
+		target, err := consumeLeastSpareStore(candidates)
+		if err != nil {
+			return nil
+		}
+
+		s := newStandbyStep(
+			target, shard.shardID, newReplicaID, logShardID,
+		)
+		runtime.ServiceRuntime(service).Logger().Info(s.String())
+		return []operator.OpStep{s}
+
+	case 1:
+		return nil
+
+	default: // stop extra standby replicas
+		replicas := extraStandbyReplicas(shard)
+		steps := make([]operator.OpStep, 0, len(replicas))
+		for _, r := range replicas {
+			s := newStopStandbyStep(
+				r.storeID, r.shardID, r.replicaID, logShardID,
+			)
+			runtime.ServiceRuntime(service).Logger().Info(s.String())
+			steps = append(steps, s)
+		}
+		return steps
+	}
+}
+
 // newAddStep constructs operator to launch a tn shard replica
 func newAddStep(target string, shardID, replicaID, logShardID uint64) operator.OpStep {
 	return operator.AddTnReplica{
@@ -173,6 +256,64 @@ func newRemoveStep(target string, shardID, replicaID, logShardID uint64) operato
 	}
 }
 
+// newStandbyStep constructs operator to launch a hot-standby tn shard replica
+func newStandbyStep(target string, shardID, replicaID, logShardID uint64) operator.OpStep {
+	return operator.StartTnStandby{
+		StoreID:    target,
+		ShardID:    shardID,
+		ReplicaID:  replicaID,
+		LogShardID: logShardID,
+	}
+}
+
+// newPromoteStep constructs operator to promote a hot-standby tn shard replica
+func newPromoteStep(target string, shardID, replicaID, logShardID uint64) operator.OpStep {
+	return operator.PromoteTnStandby{
+		StoreID:    target,
+		ShardID:    shardID,
+		ReplicaID:  replicaID,
+		LogShardID: logShardID,
+	}
+}
+
+// newStopStandbyStep constructs operator to stop a hot-standby tn shard replica
+func newStopStandbyStep(target string, shardID, replicaID, logShardID uint64) operator.OpStep {
+	return operator.StopTnStandby{
+		StoreID:    target,
+		ShardID:    shardID,
+		ReplicaID:  replicaID,
+		LogShardID: logShardID,
+	}
+}
+
+// promotableStandby returns the working standby replica with the largest
+// replica ID, or nil if there is none.
+func promotableStandby(shard *tnShard) *tnReplica {
+	var standby *tnReplica
+	for _, r := range shard.standbyReplicas() {
+		if standby == nil || r.replicaID > standby.replicaID {
+			standby = r
+		}
+	}
+	return standby
+}
+
+// extraStandbyReplicas return all working standby replicas except the largest.
+// NB: the returned order should be deterministic.
+func extraStandbyReplicas(shard *tnShard) []*tnReplica {
+	standby := shard.standbyReplicas()
+	if len(standby) == 0 {
+		return standby
+	}
+
+	// less replica first
+	sort.Slice(standby, func(i, j int) bool {
+		return standby[i].replicaID < standby[j].replicaID
+	})
+
+	return standby[0 : len(standby)-1]
+}
+
 // expiredReplicas return all expired replicas.
 // NB: the returned order should be deterministic.
 func expiredReplicas(shard *tnShard) []*tnReplica {
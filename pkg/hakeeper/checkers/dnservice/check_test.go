@@ -170,6 +170,60 @@ func TestCheckShard(t *testing.T) {
 	}
 }
 
+func TestCheckStandby(t *testing.T) {
+	idAlloc := newMockIDAllocator(100, true)
+	mapper := mockShardMapper()
+
+	workingStores := []*util.Store{
+		util.NewStore("store1", 1, TnStoreCapacity),
+		util.NewStore("store2", 3, TnStoreCapacity),
+		util.NewStore("store3", 4, TnStoreCapacity),
+	}
+
+	shardID := uint64(10)
+	shard := newTnShard(shardID)
+	shard.register(newReplica(11, shardID, "store1"), false)
+
+	// no standby => start one on the least spare store except store1
+	steps := checkStandby("", shard, mapper, workingStores, idAlloc)
+	require.Equal(t, 1, len(steps))
+	start, ok := (steps[0]).(operator.StartTnStandby)
+	require.True(t, ok)
+	require.Equal(t, uint64(100), start.ReplicaID)
+	require.Equal(t, shardID, start.ShardID)
+	require.Equal(t, "store2", start.StoreID)
+
+	// one standby => no more step
+	shard.standby = append(shard.standby, newReplica(12, shardID, "store2"))
+	require.Equal(t, 0, len(checkStandby("", shard, mapper, workingStores, idAlloc)))
+
+	// extra standby => stop the smaller one
+	shard.standby = append(shard.standby, newReplica(13, shardID, "store3"))
+	steps = checkStandby("", shard, mapper, workingStores, idAlloc)
+	require.Equal(t, 1, len(steps))
+	stop, ok := (steps[0]).(operator.StopTnStandby)
+	require.True(t, ok)
+	require.Equal(t, uint64(12), stop.ReplicaID)
+	require.Equal(t, "store2", stop.StoreID)
+
+	// the only working store hosts the working replica => no standby
+	shard = newTnShard(shardID)
+	shard.register(newReplica(11, shardID, "store1"), false)
+	steps = checkStandby("", shard, mapper, workingStores[:1], idAlloc)
+	require.Equal(t, 0, len(steps))
+
+	// working replica expired => promote the standby rather than add one
+	shard = newTnShard(shardID)
+	shard.register(newReplica(11, shardID, "store1"), true)
+	shard.standby = append(shard.standby, newReplica(12, shardID, "store2"))
+	steps = checkShard("", shard, mapper, workingStores, idAlloc)
+	require.Equal(t, 1, len(steps))
+	promote, ok := (steps[0]).(operator.PromoteTnStandby)
+	require.True(t, ok)
+	require.Equal(t, uint64(12), promote.ReplicaID)
+	require.Equal(t, "store2", promote.StoreID)
+}
+
 func mockTnShard(
 	shardID uint64, workingReplicas, expiredReplica []uint64,
 ) *tnShard {
@@ -69,7 +69,8 @@ func parseTnState(cfg hakeeper.Config,
 			expired = true
 		}
 
-		store := util.NewStore(storeID, len(storeInfo.Shards), TnStoreCapacity)
+		store := util.NewStore(storeID,
+			len(storeInfo.Shards)+len(storeInfo.StandbyShards), TnStoreCapacity)
 		if expired {
 			stores.RegisterExpired(store)
 		} else {
@@ -80,6 +81,14 @@ func parseTnState(cfg hakeeper.Config,
 			replica := newReplica(shard.ReplicaID, shard.ShardID, storeID)
 			shards.registerReplica(replica, expired)
 		}
+
+		// expired standby replicas are useless for failover
+		if !expired {
+			for _, shard := range storeInfo.StandbyShards {
+				replica := newReplica(shard.ReplicaID, shard.ShardID, storeID)
+				shards.registerStandby(replica)
+			}
+		}
 	}
 
 	return stores, shards
@@ -93,6 +102,7 @@ func checkReportedState(
 	mapper ShardMapper,
 	workingStores []*util.Store,
 	idAlloc util.IDAllocator,
+	standby bool,
 ) []*operator.Operator {
 	var ops []*operator.Operator
 
@@ -110,6 +120,9 @@ func checkReportedState(
 		}
 
 		steps := checkShard(service, shard, mapper, workingStores, idAlloc)
+		if len(steps) == 0 && standby {
+			steps = checkStandby(service, shard, mapper, workingStores, idAlloc)
+		}
 		// avoid Operator with nil steps
 		if len(steps) > 0 {
 			ops = append(ops,
@@ -249,6 +262,16 @@ func (rs *reportedShards) registerReplica(replica *tnReplica, expired bool) {
 	rs.shards[shardID].register(replica, expired)
 }
 
+// registerStandby collects working hot-standby tn shard replicas.
+func (rs *reportedShards) registerStandby(replica *tnReplica) {
+	shardID := replica.shardID
+	if _, ok := rs.shards[shardID]; !ok {
+		rs.shardIDs = append(rs.shardIDs, shardID)
+		rs.shards[shardID] = newTnShard(shardID)
+	}
+	rs.shards[shardID].standby = append(rs.shards[shardID].standby, replica)
+}
+
 // listShards lists all the shard IDs.
 // NB: the returned order isn't deterministic.
 func (rs *reportedShards) listShards() []uint64 {
@@ -268,6 +291,7 @@ type tnShard struct {
 	shardID uint64
 	expired []*tnReplica
 	working []*tnReplica
+	standby []*tnReplica
 }
 
 func newTnShard(shardID uint64) *tnShard {
@@ -291,6 +315,12 @@ func (s *tnShard) workingReplicas() []*tnReplica {
 	return s.working
 }
 
+// standbyReplicas returns all working hot-standby replicas.
+// NB: the returned order isn't deterministic.
+func (s *tnShard) standbyReplicas() []*tnReplica {
+	return s.standby
+}
+
 // workingReplicas returns all expired replicas.
 // NB: the returned order isn't deterministic.
 func (s *tnShard) expiredReplicas() []*tnReplica {
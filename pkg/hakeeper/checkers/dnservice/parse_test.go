@@ -94,14 +94,14 @@ func TestCheckReportedState(t *testing.T) {
 	// register an expired replica => should add a new replica
 	rs := newReportedShards()
 	rs.registerReplica(newReplica(11, shardID, "store11"), true)
-	ops := checkReportedState("", rs, mapper, workingStores, idAlloc)
+	ops := checkReportedState("", rs, mapper, workingStores, idAlloc, false)
 	require.Equal(t, 1, len(ops))
 	require.Equal(t, shardID, ops[0].ShardID())
 
 	// register a working replica => no more step
 	rs = newReportedShards()
 	rs.registerReplica(newReplica(12, shardID, "store12"), false)
-	ops = checkReportedState("", rs, mapper, workingStores, idAlloc)
+	ops = checkReportedState("", rs, mapper, workingStores, idAlloc, false)
 	require.Equal(t, 0, len(ops))
 }
 
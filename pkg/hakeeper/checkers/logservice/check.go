@@ -37,7 +37,8 @@ func Check(
 	for _, node := range expired {
 		runtime.ServiceRuntime(service).Logger().Info("node is expired", zap.String("uuid", node))
 	}
-	stats := parseLogShards(cluster, infos, expired)
+	p := newPlacement(cfg, infos.Stores)
+	stats := parseLogShards(p, cluster, infos, expired)
 
 	removing := executing.Removing
 	adding := executing.Adding
@@ -45,7 +46,14 @@ func Check(
 
 	for shardID, toAdd := range stats.toAdd {
 		for toAdd > uint32(len(adding[shardID])) {
-			bestStore := selectStore(infos.Shards[shardID], working)
+			bestStore := selectStore(p, infos.Shards[shardID], working)
+			if bestStore == "" {
+				// no store for a new replica, promote a non-voting one
+				if op, ok := promoteNonVoting(infos, shardID, working, adding[shardID]); ok {
+					operators = append(operators, op)
+					break
+				}
+			}
 			newReplicaID, ok := alloc.Next()
 			if !ok {
 				return nil
@@ -91,6 +99,20 @@ func Check(
 			zombie.uuid, zombie.shardID, zombie.replicaID))
 	}
 
+	operators = append(operators, checkNonVoting(p, alloc, infos, working, expired, executing)...)
+
+	// Move replicas to spread them across failure domains, only when there
+	// is nothing else to fix.
+	if len(operators) == 0 && !isExecuting(executing) {
+		if op, err := rebalance(p, alloc, cluster, infos, working); err != nil {
+			runtime.ServiceRuntime(service).Logger().Error("create rebalance replica operator failed", zap.Error(err))
+		} else if op != nil {
+			runtime.ServiceRuntime(service).Logger().Info("rebalance log shard replicas across failure domains",
+				zap.Uint64("shard", op.ShardID()))
+			operators = append(operators, op)
+		}
+	}
+
 	if user.Username != "" {
 		for _, store := range working {
 			if !infos.Stores[store].TaskServiceCreated {
@@ -103,6 +125,24 @@ func Check(
 	return operators
 }
 
+// isExecuting returns true if there is any replica operation in progress.
+func isExecuting(executing operator.ExecutingReplicas) bool {
+	for _, replicas := range []map[uint64][]uint64{
+		executing.Adding,
+		executing.Removing,
+		executing.Starting,
+		executing.AddingNonVoting,
+		executing.RemovingNonVoting,
+	} {
+		for _, ids := range replicas {
+			if len(ids) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func contains[T comparable](slice []T, v T) bool {
 	for i := range slice {
 		if slice[i] == v {
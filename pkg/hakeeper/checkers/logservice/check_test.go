@@ -429,3 +429,77 @@ func TestCheck(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckRebalance(t *testing.T) {
+	newState := func(replicas map[uint64]string, epoch uint64) pb.LogState {
+		shard := pb.LogShardInfo{
+			ShardID:  1,
+			Replicas: replicas,
+			Epoch:    epoch,
+			LeaderID: 1,
+			Term:     1,
+		}
+		state := pb.LogState{
+			Shards: map[uint64]pb.LogShardInfo{1: shard},
+			Stores: map[string]pb.LogStoreInfo{},
+		}
+		zones := map[string]string{"a": "z1", "b": "z1", "c": "z1", "d": "z2"}
+		for uuid, zone := range zones {
+			info := pb.LogStoreInfo{Locality: map[string]string{"zone": zone}}
+			for replicaID, replicaUUID := range replicas {
+				if replicaUUID == uuid {
+					info.Replicas = append(info.Replicas, pb.LogReplicaInfo{
+						LogShardInfo: shard,
+						ReplicaID:    replicaID,
+					})
+				}
+			}
+			state.Stores[uuid] = info
+		}
+		return state
+	}
+	cluster := pb.ClusterInfo{
+		LogShards: []metadata.LogShardRecord{{
+			ShardID:          1,
+			NumberOfReplicas: 3,
+		}},
+	}
+	cfg := hakeeper.Config{}
+	cfg.Fill()
+
+	// all replicas in zone z1, a replica is added to zone z2
+	state := newState(map[uint64]string{1: "a", 2: "b", 3: "c"}, 1)
+	operators := Check("", util.NewTestIDAllocator(3), cfg, cluster, state,
+		operator.ExecutingReplicas{}, pb.TaskTableUser{}, 0)
+	assert.Equal(t, 1, len(operators))
+	assert.Equal(t, []operator.OpStep{operator.AddLogService{
+		Target: "a",
+		Replica: operator.Replica{
+			UUID:      "d",
+			ShardID:   1,
+			ReplicaID: 4,
+			Epoch:     1,
+		},
+	}}, operators[0].OpSteps())
+
+	// not while other operators are executing
+	operators = Check("", util.NewTestIDAllocator(3), cfg, cluster, state,
+		operator.ExecutingReplicas{Adding: map[uint64][]uint64{2: {5}}}, pb.TaskTableUser{}, 0)
+	assert.Equal(t, 0, len(operators))
+
+	// a non-leader replica in the crowded zone is removed
+	state = newState(map[uint64]string{1: "a", 2: "b", 3: "c", 4: "d"}, 2)
+	operators = Check("", util.NewTestIDAllocator(4), cfg, cluster, state,
+		operator.ExecutingReplicas{}, pb.TaskTableUser{}, 0)
+	assert.Equal(t, 1, len(operators))
+	assert.Equal(t, 1, len(operators[0].OpSteps()))
+	step, ok := operators[0].OpSteps()[0].(operator.RemoveLogService)
+	assert.True(t, ok)
+	assert.Equal(t, "b", step.Replica.UUID)
+
+	// replicas spread, nothing to do
+	state = newState(map[uint64]string{1: "a", 2: "b", 4: "d"}, 3)
+	operators = Check("", util.NewTestIDAllocator(4), cfg, cluster, state,
+		operator.ExecutingReplicas{}, pb.TaskTableUser{}, 0)
+	assert.Equal(t, 0, len(operators))
+}
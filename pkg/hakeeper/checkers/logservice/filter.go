@@ -17,19 +17,36 @@ package logservice
 import (
 	"sort"
 
+	"github.com/matrixorigin/matrixone/pkg/hakeeper"
 	"github.com/matrixorigin/matrixone/pkg/hakeeper/checkers/util"
 	"github.com/matrixorigin/matrixone/pkg/pb/logservice"
 )
 
-func selectStore(shardInfo logservice.LogShardInfo, workingIDs []string) string {
+// selectStore returns the working store to add a replica of the shard. The
+// store spreading the replicas across the most failure domains is preferred,
+// and the store with the smallest ID on ties. Stores hosting non-voting
+// replicas of the shard or dedicated to non-voting replicas are skipped.
+func selectStore(p placement, shardInfo logservice.LogShardInfo, workingIDs []string) string {
 	workingStores := make([]*util.Store, 0, len(workingIDs))
 	for _, id := range workingIDs {
 		workingStores = append(workingStores, &util.Store{ID: id})
 	}
 
-	excluded := make([]string, 0, len(shardInfo.Replicas))
+	excluded := make([]string, 0, len(shardInfo.Replicas)+len(shardInfo.NonVotingReplicas))
+	for _, storeID := range shardInfo.NonVotingReplicas {
+		excluded = append(excluded, storeID)
+	}
+	for _, id := range workingIDs {
+		if p.cfg.IsNonVotingStore(p.stores[id].Locality) {
+			excluded = append(excluded, id)
+		}
+	}
+	existing := make([]string, 0, len(shardInfo.Replicas))
 	for _, storeID := range shardInfo.Replicas {
 		excluded = append(excluded, storeID)
+		if contains(workingIDs, storeID) {
+			existing = append(existing, storeID)
+		}
 	}
 
 	candidates := util.FilterStore(workingStores, []util.IFilter{util.NewExcludedFilter(excluded...)})
@@ -41,5 +58,14 @@ func selectStore(shardInfo logservice.LogShardInfo, workingIDs []string) string
 		return candidates[i].ID < candidates[j].ID
 	})
 
-	return candidates[0].ID
+	var best string
+	var bestScore hakeeper.PlacementScore
+	for _, candidate := range candidates {
+		score := p.score(append(existing, candidate.ID))
+		if best == "" || score.Compare(bestScore) > 0 {
+			best = candidate.ID
+			bestScore = score
+		}
+	}
+	return best
 }
@@ -17,6 +17,7 @@ package logservice
 import (
 	"testing"
 
+	"github.com/matrixorigin/matrixone/pkg/hakeeper"
 	"github.com/matrixorigin/matrixone/pkg/pb/logservice"
 	"github.com/stretchr/testify/assert"
 )
@@ -53,7 +54,31 @@ func TestSelector(t *testing.T) {
 	}
 
 	for _, c := range cases {
-		output := selectStore(c.shardInfo, c.stores)
+		output := selectStore(placement{}, c.shardInfo, c.stores)
 		assert.Equal(t, c.expected, output)
 	}
 }
+
+func TestSelectorLocality(t *testing.T) {
+	cfg := hakeeper.Config{}
+	cfg.Fill()
+	p := newPlacement(cfg, map[string]logservice.LogStoreInfo{
+		"a": {Locality: map[string]string{"zone": "z1", "rack": "r1"}},
+		"b": {Locality: map[string]string{"zone": "z1", "rack": "r2"}},
+		"c": {Locality: map[string]string{"zone": "z1", "rack": "r1"}},
+		"d": {Locality: map[string]string{"zone": "z1", "rack": "r3"}},
+		"e": {Locality: map[string]string{"zone": "z2", "rack": "r1"}},
+	})
+
+	// a new zone is preferred
+	shardInfo := logservice.LogShardInfo{
+		Replicas: map[uint64]string{1: "a", 2: "b"},
+	}
+	assert.Equal(t, "e", selectStore(p, shardInfo, []string{"a", "b", "c", "d", "e"}))
+
+	// a new rack is preferred in the same zone
+	assert.Equal(t, "d", selectStore(p, shardInfo, []string{"a", "b", "c", "d"}))
+
+	// any store if the spread can't be improved
+	assert.Equal(t, "c", selectStore(p, shardInfo, []string{"a", "b", "c"}))
+}
@@ -0,0 +1,188 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logservice
+
+import (
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/hakeeper"
+	"github.com/matrixorigin/matrixone/pkg/hakeeper/checkers/util"
+	"github.com/matrixorigin/matrixone/pkg/hakeeper/operator"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+)
+
+// checkNonVoting returns the operators keeping the expected number of
+// non-voting replicas of each normal Log shard. Non-voting replicas on
+// expired stores are removed, the ones not running are started, and the
+// extra ones are removed.
+func checkNonVoting(
+	p placement,
+	alloc util.IDAllocator,
+	infos pb.LogState,
+	working []string,
+	expired []string,
+	executing operator.ExecutingReplicas,
+) (operators []*operator.Operator) {
+	shardIDs := make([]uint64, 0, len(infos.Shards))
+	for shardID := range infos.Shards {
+		if shardID != hakeeper.DefaultHAKeeperShardID {
+			shardIDs = append(shardIDs, shardID)
+		}
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	for _, shardID := range shardIDs {
+		shardInfo := infos.Shards[shardID]
+		// config changes are requested to the leader
+		if _, ok := shardInfo.Replicas[shardInfo.LeaderID]; !ok {
+			continue
+		}
+
+		replicaIDs := make([]uint64, 0, len(shardInfo.NonVotingReplicas))
+		for replicaID := range shardInfo.NonVotingReplicas {
+			replicaIDs = append(replicaIDs, replicaID)
+		}
+		sort.Slice(replicaIDs, func(i, j int) bool {
+			return replicaIDs[i] < replicaIDs[j]
+		})
+
+		live := make([]uint64, 0, len(replicaIDs))
+		for _, replicaID := range replicaIDs {
+			uuid := shardInfo.NonVotingReplicas[replicaID]
+			if contains(executing.RemovingNonVoting[shardID], replicaID) {
+				continue
+			}
+			if contains(expired, uuid) {
+				operators = append(operators,
+					operator.CreateRemoveNonVotingReplica(uuid, shardInfo, replicaID))
+				continue
+			}
+			if !replicaStarted(shardID, infos.Stores[uuid].Replicas) &&
+				!contains(executing.Starting[shardID], replicaID) {
+				operators = append(operators,
+					operator.CreateStartNonVotingReplica("", uuid, shardID, replicaID))
+			}
+			live = append(live, replicaID)
+		}
+
+		expected := int(p.cfg.NonVotingReplicas)
+		adding := len(executing.AddingNonVoting[shardID])
+		// remove the latest added ones first
+		for i := len(live) - 1; i >= 0 && len(live)+adding > expected; i-- {
+			replicaID := live[i]
+			operators = append(operators, operator.CreateRemoveNonVotingReplica(
+				shardInfo.NonVotingReplicas[replicaID], shardInfo, replicaID))
+			live = live[:i]
+		}
+
+		for _, uuid := range selectNonVotingStores(p, shardInfo, working, expected-len(live)-adding) {
+			replicaID, ok := alloc.Next()
+			if !ok {
+				return operators
+			}
+			operators = append(operators,
+				operator.CreateAddNonVotingReplica(uuid, shardInfo, replicaID))
+		}
+	}
+	return operators
+}
+
+// selectNonVotingStores returns at most n working stores to add non-voting
+// replicas of the shard. Stores hosting the shard are skipped, and only the
+// stores dedicated to non-voting replicas are selected if configured.
+func selectNonVotingStores(p placement, shardInfo pb.LogShardInfo, working []string, n int) []string {
+	if n <= 0 {
+		return nil
+	}
+
+	existing := make([]string, 0, len(shardInfo.NonVotingReplicas))
+	for _, uuid := range shardInfo.NonVotingReplicas {
+		existing = append(existing, uuid)
+	}
+	candidates := make([]string, 0, len(working))
+	for _, uuid := range working {
+		if contains(existing, uuid) {
+			continue
+		}
+		if _, ok := findReplica(shardInfo.Replicas, uuid); ok {
+			continue
+		}
+		if len(p.cfg.NonVotingLocality) > 0 && !p.cfg.IsNonVotingStore(p.stores[uuid].Locality) {
+			continue
+		}
+		candidates = append(candidates, uuid)
+	}
+	sort.Strings(candidates)
+
+	// spread the non-voting replicas across failure domains
+	selected := make([]string, 0, n)
+	for len(selected) < n && len(candidates) > 0 {
+		best := 0
+		var bestScore hakeeper.PlacementScore
+		for i, uuid := range candidates {
+			score := p.score(append(append([]string{}, existing...), uuid))
+			if i == 0 || score.Compare(bestScore) > 0 {
+				best = i
+				bestScore = score
+			}
+		}
+		selected = append(selected, candidates[best])
+		existing = append(existing, candidates[best])
+		candidates = append(candidates[:best], candidates[best+1:]...)
+	}
+	return selected
+}
+
+// promoteNonVoting returns an operator promoting a running non-voting replica
+// of the shard to a voting one.
+func promoteNonVoting(
+	infos pb.LogState,
+	shardID uint64,
+	working []string,
+	adding []uint64,
+) (*operator.Operator, bool) {
+	shardInfo := infos.Shards[shardID]
+	replicaIDs := make([]uint64, 0, len(shardInfo.NonVotingReplicas))
+	for replicaID := range shardInfo.NonVotingReplicas {
+		replicaIDs = append(replicaIDs, replicaID)
+	}
+	sort.Slice(replicaIDs, func(i, j int) bool {
+		return replicaIDs[i] < replicaIDs[j]
+	})
+
+	for _, replicaID := range replicaIDs {
+		uuid := shardInfo.NonVotingReplicas[replicaID]
+		if contains(adding, replicaID) || !contains(working, uuid) ||
+			!replicaStarted(shardID, infos.Stores[uuid].Replicas) {
+			continue
+		}
+		if op, err := operator.CreateAddReplica(uuid, shardInfo, replicaID); err == nil {
+			return op, true
+		}
+	}
+	return nil, false
+}
+
+// findReplica returns the ID of the replica on the store.
+func findReplica(replicas map[uint64]string, uuid string) (uint64, bool) {
+	for replicaID, storeID := range replicas {
+		if storeID == uuid {
+			return replicaID, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,187 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logservice
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/hakeeper"
+	"github.com/matrixorigin/matrixone/pkg/hakeeper/checkers/util"
+	"github.com/matrixorigin/matrixone/pkg/hakeeper/operator"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+)
+
+func TestCheckNonVoting(t *testing.T) {
+	newState := func(replicas, nonVotings map[uint64]string, started []string) pb.LogState {
+		shard := pb.LogShardInfo{
+			ShardID:           1,
+			Replicas:          replicas,
+			NonVotingReplicas: nonVotings,
+			Epoch:             1,
+			LeaderID:          1,
+			Term:              1,
+		}
+		state := pb.LogState{
+			Shards: map[uint64]pb.LogShardInfo{1: shard},
+			Stores: map[string]pb.LogStoreInfo{
+				"a": {}, "b": {}, "c": {},
+				"d": {Locality: map[string]string{"role": "learner"}},
+			},
+		}
+		all := make(map[uint64]string)
+		for id, uuid := range replicas {
+			all[id] = uuid
+		}
+		for id, uuid := range nonVotings {
+			if contains(started, uuid) {
+				all[id] = uuid
+			}
+		}
+		for id, uuid := range all {
+			info := state.Stores[uuid]
+			info.Replicas = append(info.Replicas, pb.LogReplicaInfo{
+				LogShardInfo: shard,
+				ReplicaID:    id,
+			})
+			state.Stores[uuid] = info
+		}
+		return state
+	}
+	cluster := pb.ClusterInfo{
+		LogShards: []metadata.LogShardRecord{{
+			ShardID:          1,
+			NumberOfReplicas: 3,
+		}},
+	}
+	cfg := hakeeper.Config{
+		NonVotingReplicas: 1,
+		NonVotingLocality: map[string]string{"role": "learner"},
+	}
+	cfg.Fill()
+	voters := map[uint64]string{1: "a", 2: "b", 3: "c"}
+
+	// a non-voting replica is added to the dedicated store
+	state := newState(voters, nil, nil)
+	operators := Check("", util.NewTestIDAllocator(3), cfg, cluster, state,
+		operator.ExecutingReplicas{}, pb.TaskTableUser{}, 0)
+	require.Equal(t, 1, len(operators))
+	assert.Equal(t, []operator.OpStep{operator.AddNonVotingLogService{
+		Target: "a",
+		Replica: operator.Replica{
+			UUID:      "d",
+			ShardID:   1,
+			ReplicaID: 4,
+			Epoch:     1,
+		},
+	}}, operators[0].OpSteps())
+
+	// not again while adding
+	operators = Check("", util.NewTestIDAllocator(3), cfg, cluster, state,
+		operator.ExecutingReplicas{AddingNonVoting: map[uint64][]uint64{1: {4}}}, pb.TaskTableUser{}, 0)
+	assert.Equal(t, 0, len(operators))
+
+	// the added non-voting replica is started
+	state = newState(voters, map[uint64]string{4: "d"}, nil)
+	operators = Check("", util.NewTestIDAllocator(4), cfg, cluster, state,
+		operator.ExecutingReplicas{}, pb.TaskTableUser{}, 0)
+	require.Equal(t, 1, len(operators))
+	assert.Equal(t, []operator.OpStep{operator.StartNonVotingLogService{
+		Replica: operator.Replica{UUID: "d", ShardID: 1, ReplicaID: 4},
+	}}, operators[0].OpSteps())
+
+	// running, nothing to do
+	state = newState(voters, map[uint64]string{4: "d"}, []string{"d"})
+	operators = Check("", util.NewTestIDAllocator(4), cfg, cluster, state,
+		operator.ExecutingReplicas{}, pb.TaskTableUser{}, 0)
+	assert.Equal(t, 0, len(operators))
+
+	// extra non-voting replicas are removed
+	noNonVoting := cfg
+	noNonVoting.NonVotingReplicas = 0
+	operators = Check("", util.NewTestIDAllocator(4), noNonVoting, cluster, state,
+		operator.ExecutingReplicas{}, pb.TaskTableUser{}, 0)
+	require.Equal(t, 1, len(operators))
+	assert.Equal(t, []operator.OpStep{operator.RemoveNonVotingLogService{
+		Target: "a",
+		Replica: operator.Replica{
+			UUID:      "d",
+			ShardID:   1,
+			ReplicaID: 4,
+			Epoch:     1,
+		},
+	}}, operators[0].OpSteps())
+
+	// no store for a new voting replica, the non-voting one is promoted
+	state = newState(map[uint64]string{1: "a", 2: "b"}, map[uint64]string{4: "d"}, []string{"d"})
+	delete(state.Stores, "c")
+	operators = Check("", util.NewTestIDAllocator(4), cfg, cluster, state,
+		operator.ExecutingReplicas{}, pb.TaskTableUser{}, 0)
+	require.Equal(t, 1, len(operators))
+	assert.Equal(t, []operator.OpStep{operator.AddLogService{
+		Target: "a",
+		Replica: operator.Replica{
+			UUID:      "d",
+			ShardID:   1,
+			ReplicaID: 4,
+			Epoch:     1,
+		},
+	}}, operators[0].OpSteps())
+
+	// a regular store is preferred for a new voting replica
+	state = newState(map[uint64]string{1: "a", 2: "b"}, map[uint64]string{4: "d"}, []string{"d"})
+	operators = Check("", util.NewTestIDAllocator(4), cfg, cluster, state,
+		operator.ExecutingReplicas{}, pb.TaskTableUser{}, 0)
+	require.Equal(t, 1, len(operators))
+	step, ok := operators[0].OpSteps()[0].(operator.AddLogService)
+	require.True(t, ok)
+	assert.Equal(t, "c", step.UUID)
+}
+
+func TestNonVotingReplicaNotZombie(t *testing.T) {
+	shard := pb.LogShardInfo{
+		ShardID:           1,
+		Replicas:          map[uint64]string{1: "a"},
+		NonVotingReplicas: map[uint64]string{2: "b"},
+		Epoch:             2,
+		LeaderID:          1,
+		Term:              1,
+	}
+	state := pb.LogState{
+		Shards: map[uint64]pb.LogShardInfo{1: shard},
+		Stores: map[string]pb.LogStoreInfo{
+			"a": {Replicas: []pb.LogReplicaInfo{{LogShardInfo: shard, ReplicaID: 1}}},
+			"b": {Replicas: []pb.LogReplicaInfo{{
+				LogShardInfo: pb.LogShardInfo{ShardID: 1, Epoch: 1},
+				ReplicaID:    2,
+				IsNonVoting:  true,
+			}}},
+		},
+	}
+	cluster := pb.ClusterInfo{
+		LogShards: []metadata.LogShardRecord{{ShardID: 1, NumberOfReplicas: 1}},
+	}
+	stats := parseLogShards(placement{}, cluster, state, nil)
+	assert.Empty(t, stats.zombies)
+
+	// removed from the shard
+	shard.NonVotingReplicas = nil
+	state.Shards[1] = shard
+	stats = parseLogShards(placement{}, cluster, state, nil)
+	assert.Equal(t, []replica{{uuid: "b", shardID: 1, replicaID: 2}}, stats.zombies)
+}
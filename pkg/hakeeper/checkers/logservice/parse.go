@@ -42,7 +42,7 @@ func newFixingShard(origin pb.LogShardInfo) *fixingShard {
 	return shard
 }
 
-func fixedLogShardInfo(record metadata.LogShardRecord, info pb.LogShardInfo,
+func fixedLogShardInfo(p placement, record metadata.LogShardRecord, info pb.LogShardInfo,
 	expiredStores []string) *fixingShard {
 	fixing := newFixingShard(info)
 	diff := len(fixing.replicas) - int(record.NumberOfReplicas)
@@ -64,12 +64,29 @@ func fixedLogShardInfo(record metadata.LogShardRecord, info pb.LogShardInfo,
 	}
 
 	// The number of replicas is more than expected.
-	// Remove some of them.
+	// Remove some of them, the ones leaving the best spread of the rest
+	// across failure domains first.
 	if diff > 0 {
 		idSlice := sortedReplicaID(fixing.replicas, info.LeaderID)
 
 		for i := 0; i < diff; i++ {
-			delete(fixing.replicas, idSlice[i])
+			toRemove := 0
+			var bestScore hakeeper.PlacementScore
+			for j := range idSlice {
+				rest := make([]string, 0, len(idSlice)-1)
+				for k, id := range idSlice {
+					if k != j {
+						rest = append(rest, fixing.replicas[id])
+					}
+				}
+				score := p.score(rest)
+				if j == 0 || score.Compare(bestScore) > 0 {
+					toRemove = j
+					bestScore = score
+				}
+			}
+			delete(fixing.replicas, idSlice[toRemove])
+			idSlice = append(idSlice[:toRemove], idSlice[toRemove+1:]...)
 		}
 	}
 
@@ -77,13 +94,13 @@ func fixedLogShardInfo(record metadata.LogShardRecord, info pb.LogShardInfo,
 }
 
 // parseLogShards collects stats for further use.
-func parseLogShards(cluster pb.ClusterInfo, infos pb.LogState, expired []string) *stats {
+func parseLogShards(p placement, cluster pb.ClusterInfo, infos pb.LogState, expired []string) *stats {
 	collect := newStats()
 
 	for _, shardInfo := range infos.Shards {
 		shardID := shardInfo.ShardID
 		record := getRecord(shardID, cluster.LogShards)
-		fixing := fixedLogShardInfo(record, shardInfo, expired)
+		fixing := fixedLogShardInfo(p, record, shardInfo, expired)
 
 		toRemove := make([]replica, 0, len(shardInfo.Replicas)-len(fixing.replicas))
 		for id, uuid := range shardInfo.Replicas {
@@ -127,8 +144,12 @@ func parseLogShards(cluster pb.ClusterInfo, infos pb.LogState, expired []string)
 		}
 		zombie := make([]replica, 0)
 		for _, replicaInfo := range storeInfo.Replicas {
-			_, ok := infos.Shards[replicaInfo.ShardID].Replicas[replicaInfo.ReplicaID]
-			if ok || replicaInfo.Epoch >= infos.Shards[replicaInfo.ShardID].Epoch {
+			shardInfo := infos.Shards[replicaInfo.ShardID]
+			_, ok := shardInfo.Replicas[replicaInfo.ReplicaID]
+			if !ok {
+				_, ok = shardInfo.NonVotingReplicas[replicaInfo.ReplicaID]
+			}
+			if ok || replicaInfo.Epoch >= shardInfo.Epoch {
 				continue
 			}
 			zombie = append(zombie, replica{uuid: uuid, shardID: replicaInfo.ShardID,
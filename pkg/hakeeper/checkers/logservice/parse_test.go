@@ -88,11 +88,42 @@ func TestFixedLogShardInfo(t *testing.T) {
 	}
 
 	for _, c := range cases {
-		output := fixedLogShardInfo(c.record, c.info, c.expiredStores)
+		output := fixedLogShardInfo(placement{}, c.record, c.info, c.expiredStores)
 		assert.Equal(t, c.expected, output)
 	}
 }
 
+func TestFixedLogShardInfoLocality(t *testing.T) {
+	cfg := hakeeper.Config{}
+	cfg.Fill()
+	p := newPlacement(cfg, map[string]pb.LogStoreInfo{
+		"a": {Locality: map[string]string{"zone": "z1"}},
+		"b": {Locality: map[string]string{"zone": "z2"}},
+		"c": {Locality: map[string]string{"zone": "z2"}},
+		"d": {Locality: map[string]string{"zone": "z3"}},
+	})
+	record := metadata.LogShardRecord{
+		ShardID:          1,
+		NumberOfReplicas: 3,
+	}
+
+	// the replica in the crowded zone is removed, the leader is kept
+	output := fixedLogShardInfo(p, record, pb.LogShardInfo{
+		ShardID:  1,
+		Replicas: map[uint64]string{1: "a", 2: "b", 3: "c", 4: "d"},
+		LeaderID: 3,
+	}, nil)
+	assert.Equal(t, map[uint64]string{1: "a", 3: "c", 4: "d"}, output.replicas)
+
+	// without a better choice, the replica with the smallest ID is removed
+	output = fixedLogShardInfo(p, record, pb.LogShardInfo{
+		ShardID:  1,
+		Replicas: map[uint64]string{1: "a", 2: "b", 4: "d", 5: "c"},
+		LeaderID: 1,
+	}, nil)
+	assert.Equal(t, map[uint64]string{1: "a", 4: "d", 5: "c"}, output.replicas)
+}
+
 func TestCollectStats(t *testing.T) {
 	cases := []struct {
 		desc     string
@@ -420,7 +451,7 @@ func TestCollectStats(t *testing.T) {
 
 	for i, c := range cases {
 		fmt.Printf("case %v: %s\n", i, c.desc)
-		stat := parseLogShards(c.cluster, c.infos, c.expired)
+		stat := parseLogShards(placement{}, c.cluster, c.infos, c.expired)
 		assert.Equal(t, c.expected, stat)
 	}
 }
@@ -0,0 +1,123 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logservice
+
+import (
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/hakeeper"
+	"github.com/matrixorigin/matrixone/pkg/hakeeper/checkers/util"
+	"github.com/matrixorigin/matrixone/pkg/hakeeper/operator"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+)
+
+// placement scores the spread of replicas across failure domains by the
+// locality labels of log stores. With no location labels, all placements
+// have the same score.
+type placement struct {
+	cfg    hakeeper.Config
+	stores map[string]pb.LogStoreInfo
+}
+
+func newPlacement(cfg hakeeper.Config, stores map[string]pb.LogStoreInfo) placement {
+	return placement{
+		cfg:    cfg,
+		stores: stores,
+	}
+}
+
+// score returns the placement score of replicas on the given stores.
+func (p placement) score(uuids []string) hakeeper.PlacementScore {
+	localities := make([]map[string]string, 0, len(uuids))
+	for _, uuid := range uuids {
+		localities = append(localities, p.stores[uuid].Locality)
+	}
+	return p.cfg.PlacementScore(localities)
+}
+
+// rebalance returns an operator adding a replica to the shard with the worst
+// spread which could be improved by moving one replica. The replica in the
+// crowded domain is removed later as the shard has more replicas than
+// expected. Shards not fully healthy are skipped, and only one shard is
+// rebalanced at a time.
+func rebalance(
+	p placement,
+	alloc util.IDAllocator,
+	cluster pb.ClusterInfo,
+	infos pb.LogState,
+	working []string,
+) (*operator.Operator, error) {
+	if len(p.cfg.LocationLabels) == 0 {
+		return nil, nil
+	}
+
+	shardIDs := make([]uint64, 0, len(infos.Shards))
+	for shardID := range infos.Shards {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	for _, shardID := range shardIDs {
+		shardInfo := infos.Shards[shardID]
+		record := getRecord(shardID, cluster.LogShards)
+		if record.NumberOfReplicas == 0 ||
+			uint64(len(shardInfo.Replicas)) != record.NumberOfReplicas {
+			continue
+		}
+
+		uuids := make([]string, 0, len(shardInfo.Replicas))
+		healthy := true
+		for _, uuid := range shardInfo.Replicas {
+			if !contains(working, uuid) || !replicaStarted(shardID, infos.Stores[uuid].Replicas) {
+				healthy = false
+				break
+			}
+			uuids = append(uuids, uuid)
+		}
+		if !healthy {
+			continue
+		}
+
+		target := selectStore(p, shardInfo, working)
+		if target == "" {
+			continue
+		}
+
+		// the best spread after moving one replica to the target
+		current := p.score(uuids)
+		improved := false
+		for i := range uuids {
+			moved := append([]string{target}, uuids[:i]...)
+			moved = append(moved, uuids[i+1:]...)
+			if p.score(moved).Compare(current) > 0 {
+				improved = true
+				break
+			}
+		}
+		if !improved {
+			continue
+		}
+
+		replicaID, ok := alloc.Next()
+		if !ok {
+			return nil, nil
+		}
+		return operator.CreateAddReplica(target, shardInfo, replicaID)
+	}
+
+	return nil, nil
+}
@@ -24,8 +24,17 @@ const (
 	DefaultTNStoreTimeout    = 10 * time.Second
 	DefaultCNStoreTimeout    = 30 * time.Second
 	DefaultProxyStoreTimeout = 30 * time.Second
+
+	DefaultCNScaleOutCPU    = 0.8
+	DefaultCNScaleInCPU     = 0.3
+	DefaultCNScaleOutMemory = 0.85
+	DefaultCNScaleInMemory  = 0.4
+	DefaultCNScaleMinCNs    = 1
 )
 
+// DefaultLocationLabels are the location labels used when not configured.
+var DefaultLocationLabels = []string{"zone", "rack"}
+
 type Config struct {
 	// TickPerSecond indicates how many ticks every second.
 	// In HAKeeper, we do not use actual time to measure time elapse.
@@ -51,6 +60,46 @@ type Config struct {
 	// If HAKeeper does not receive two heartbeat within ProxyStoreTimeout,
 	// it regards the proxy store as down.
 	ProxyStoreTimeout time.Duration
+
+	// LocationLabels are the keys of the locality labels of stores which
+	// define the failure domains, from the outermost to the innermost, e.g.
+	// zone and rack. Replicas of a Log shard are spread across the domains.
+	LocationLabels []string
+
+	// NonVotingReplicas is the number of non-voting replicas of each normal
+	// Log shard. Non-voting replicas replicate the log without voting, and
+	// serve read-only clients. The HAKeeper shard has no non-voting replicas.
+	NonVotingReplicas uint64
+
+	// NonVotingLocality is the locality labels of the log stores dedicated to
+	// non-voting replicas. Stores matching all the labels only host non-voting
+	// replicas. If empty, non-voting replicas are placed on any log store not
+	// hosting the shard.
+	NonVotingLocality map[string]string
+
+	// TNStandby enables a hot-standby replica for each TN shard. The standby
+	// tails the Log shard to keep its state warm, and is promoted when the
+	// working TN replica expires.
+	TNStandby bool
+
+	// CNScaling is the thresholds of the CN scale recommendations.
+	CNScaling CNScalingConfig
+}
+
+// CNScalingConfig is the thresholds of the CN scale recommendations, usages
+// are ratios in [0, 1]. A CN group is recommended to scale out if any usage
+// is above its scale-out threshold, and to scale in if all usages are below
+// the scale-in thresholds.
+type CNScalingConfig struct {
+	ScaleOutCPU    float64 `toml:"scale-out-cpu"`
+	ScaleInCPU     float64 `toml:"scale-in-cpu"`
+	ScaleOutMemory float64 `toml:"scale-out-memory"`
+	ScaleInMemory  float64 `toml:"scale-in-memory"`
+	// SessionsPerCN is the max active sessions each CN is expected to serve,
+	// sessions are not considered if it's 0.
+	SessionsPerCN uint64 `toml:"sessions-per-cn"`
+	// MinCNs is the min number of CNs of each group after scaling in.
+	MinCNs uint64 `toml:"min-cns"`
 }
 
 func (cfg Config) Validate() error {
@@ -73,6 +122,38 @@ func (cfg *Config) Fill() {
 	if cfg.ProxyStoreTimeout == 0 {
 		cfg.ProxyStoreTimeout = DefaultProxyStoreTimeout
 	}
+	if len(cfg.LocationLabels) == 0 {
+		cfg.LocationLabels = DefaultLocationLabels
+	}
+	if cfg.CNScaling.ScaleOutCPU == 0 {
+		cfg.CNScaling.ScaleOutCPU = DefaultCNScaleOutCPU
+	}
+	if cfg.CNScaling.ScaleInCPU == 0 {
+		cfg.CNScaling.ScaleInCPU = DefaultCNScaleInCPU
+	}
+	if cfg.CNScaling.ScaleOutMemory == 0 {
+		cfg.CNScaling.ScaleOutMemory = DefaultCNScaleOutMemory
+	}
+	if cfg.CNScaling.ScaleInMemory == 0 {
+		cfg.CNScaling.ScaleInMemory = DefaultCNScaleInMemory
+	}
+	if cfg.CNScaling.MinCNs == 0 {
+		cfg.CNScaling.MinCNs = DefaultCNScaleMinCNs
+	}
+}
+
+// IsNonVotingStore returns true if the log store is dedicated to non-voting
+// replicas.
+func (cfg Config) IsNonVotingStore(locality map[string]string) bool {
+	if len(cfg.NonVotingLocality) == 0 {
+		return false
+	}
+	for k, v := range cfg.NonVotingLocality {
+		if locality[k] != v {
+			return false
+		}
+	}
+	return true
 }
 
 func (cfg Config) LogStoreExpired(start, current uint64) bool {
@@ -68,6 +68,9 @@ type ExecutingReplicas struct {
 	Adding   map[uint64][]uint64
 	Removing map[uint64][]uint64
 	Starting map[uint64][]uint64
+
+	AddingNonVoting   map[uint64][]uint64
+	RemovingNonVoting map[uint64][]uint64
 }
 
 func (c *Controller) GetExecutingReplicas() ExecutingReplicas {
@@ -75,6 +78,9 @@ func (c *Controller) GetExecutingReplicas() ExecutingReplicas {
 		Adding:   make(map[uint64][]uint64),
 		Removing: make(map[uint64][]uint64),
 		Starting: make(map[uint64][]uint64),
+
+		AddingNonVoting:   make(map[uint64][]uint64),
+		RemovingNonVoting: make(map[uint64][]uint64),
 	}
 	for shardID, operators := range c.operators {
 		for _, op := range operators {
@@ -86,6 +92,12 @@ func (c *Controller) GetExecutingReplicas() ExecutingReplicas {
 					executing.Adding[shardID] = append(executing.Adding[shardID], step.ReplicaID)
 				case StartLogService:
 					executing.Starting[shardID] = append(executing.Starting[shardID], step.ReplicaID)
+				case AddNonVotingLogService:
+					executing.AddingNonVoting[shardID] = append(executing.AddingNonVoting[shardID], step.ReplicaID)
+				case RemoveNonVotingLogService:
+					executing.RemovingNonVoting[shardID] = append(executing.RemovingNonVoting[shardID], step.ReplicaID)
+				case StartNonVotingLogService:
+					executing.Starting[shardID] = append(executing.Starting[shardID], step.ReplicaID)
 				}
 			}
 		}
@@ -130,10 +142,22 @@ func generateScheduleCommand(step OpStep) pb.ScheduleCommand {
 		return stopLogService(st)
 	case KillLogZombie:
 		return killLogZombie(st)
+	case AddNonVotingLogService:
+		return addNonVotingLogService(st)
+	case RemoveNonVotingLogService:
+		return removeNonVotingLogService(st)
+	case StartNonVotingLogService:
+		return startNonVotingLogService(st)
 	case AddTnReplica:
 		return addTnReplica(st)
 	case RemoveTnReplica:
 		return removeTnReplica(st)
+	case StartTnStandby:
+		return tnStandbyCommand(st.StoreID, st.ShardID, st.ReplicaID, st.LogShardID, pb.StartStandbyReplica)
+	case PromoteTnStandby:
+		return tnStandbyCommand(st.StoreID, st.ShardID, st.ReplicaID, st.LogShardID, pb.PromoteStandbyReplica)
+	case StopTnStandby:
+		return tnStandbyCommand(st.StoreID, st.ShardID, st.ReplicaID, st.LogShardID, pb.StopStandbyReplica)
 	case StopTnStore:
 		return stopTnStore(st)
 	case StopLogStore:
@@ -197,6 +221,53 @@ func startLogService(st StartLogService) pb.ScheduleCommand {
 	}
 }
 
+func addNonVotingLogService(st AddNonVotingLogService) pb.ScheduleCommand {
+	return pb.ScheduleCommand{
+		UUID: st.Target,
+		ConfigChange: &pb.ConfigChange{
+			Replica: pb.Replica{
+				UUID:      st.UUID,
+				ShardID:   st.ShardID,
+				ReplicaID: st.ReplicaID,
+				Epoch:     st.Epoch,
+			},
+			ChangeType: pb.AddNonVotingReplica,
+		},
+		ServiceType: pb.LogService,
+	}
+}
+
+func removeNonVotingLogService(st RemoveNonVotingLogService) pb.ScheduleCommand {
+	return pb.ScheduleCommand{
+		UUID: st.Target,
+		ConfigChange: &pb.ConfigChange{
+			Replica: pb.Replica{
+				UUID:      st.UUID,
+				ShardID:   st.ShardID,
+				ReplicaID: st.ReplicaID,
+				Epoch:     st.Epoch,
+			},
+			ChangeType: pb.RemoveNonVotingReplica,
+		},
+		ServiceType: pb.LogService,
+	}
+}
+
+func startNonVotingLogService(st StartNonVotingLogService) pb.ScheduleCommand {
+	return pb.ScheduleCommand{
+		UUID: st.UUID,
+		ConfigChange: &pb.ConfigChange{
+			Replica: pb.Replica{
+				UUID:      st.UUID,
+				ShardID:   st.ShardID,
+				ReplicaID: st.ReplicaID,
+			},
+			ChangeType: pb.StartNonVotingReplica,
+		},
+		ServiceType: pb.LogService,
+	}
+}
+
 func stopLogService(st StopLogService) pb.ScheduleCommand {
 	return pb.ScheduleCommand{
 		UUID: st.UUID,
@@ -260,6 +331,24 @@ func removeTnReplica(st RemoveTnReplica) pb.ScheduleCommand {
 	}
 }
 
+func tnStandbyCommand(
+	storeID string, shardID, replicaID, logShardID uint64, changeType pb.ConfigChangeType,
+) pb.ScheduleCommand {
+	return pb.ScheduleCommand{
+		UUID: storeID,
+		ConfigChange: &pb.ConfigChange{
+			Replica: pb.Replica{
+				UUID:       storeID,
+				ShardID:    shardID,
+				ReplicaID:  replicaID,
+				LogShardID: logShardID,
+			},
+			ChangeType: changeType,
+		},
+		ServiceType: pb.TNService,
+	}
+}
+
 func stopTnStore(st StopTnStore) pb.ScheduleCommand {
 	return pb.ScheduleCommand{
 		UUID: st.StoreID,
@@ -21,6 +21,9 @@
 package operator
 
 import (
+	"fmt"
+	"sort"
+
 	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
 )
 
@@ -47,6 +50,60 @@ func CreateStartReplica(brief, uuid string, shardID, replicaID uint64) *Operator
 		StartLogService{Replica{UUID: uuid, ShardID: shardID, ReplicaID: replicaID}})
 }
 
+// CreateAddNonVotingReplica creates an operator adding a non-voting replica
+// on the log store.
+func CreateAddNonVotingReplica(uuid string, shardInfo pb.LogShardInfo, replicaID uint64) *Operator {
+	return NewOperator(fmt.Sprintf("add non-voting peer: store %s", uuid),
+		shardInfo.ShardID, shardInfo.Epoch,
+		AddNonVotingLogService{
+			Target: configChangeTarget(shardInfo),
+			Replica: Replica{
+				UUID:      uuid,
+				ShardID:   shardInfo.ShardID,
+				ReplicaID: replicaID,
+				Epoch:     shardInfo.Epoch,
+			},
+		})
+}
+
+// CreateRemoveNonVotingReplica creates an operator removing the non-voting
+// replica on the log store.
+func CreateRemoveNonVotingReplica(uuid string, shardInfo pb.LogShardInfo, replicaID uint64) *Operator {
+	return NewOperator(fmt.Sprintf("rm non-voting peer: store %s", uuid),
+		shardInfo.ShardID, shardInfo.Epoch,
+		RemoveNonVotingLogService{
+			Target: configChangeTarget(shardInfo),
+			Replica: Replica{
+				UUID:      uuid,
+				ShardID:   shardInfo.ShardID,
+				ReplicaID: replicaID,
+				Epoch:     shardInfo.Epoch,
+			},
+		})
+}
+
+func CreateStartNonVotingReplica(brief, uuid string, shardID, replicaID uint64) *Operator {
+	return NewOperator(brief, shardID, 0,
+		StartNonVotingLogService{Replica{UUID: uuid, ShardID: shardID, ReplicaID: replicaID}})
+}
+
+// configChangeTarget returns the log store to request the config change of
+// the shard, which is the leader if known.
+func configChangeTarget(shardInfo pb.LogShardInfo) string {
+	if uuid, ok := shardInfo.Replicas[shardInfo.LeaderID]; ok {
+		return uuid
+	}
+	targets := make([]string, 0, len(shardInfo.Replicas))
+	for _, uuid := range shardInfo.Replicas {
+		targets = append(targets, uuid)
+	}
+	sort.Strings(targets)
+	if len(targets) == 0 {
+		return ""
+	}
+	return targets[0]
+}
+
 func CreateTaskServiceOp(brief, uuid string, serviceType pb.ServiceType, user pb.TaskTableUser) *Operator {
 	return NewOperator(brief, 0, 0,
 		CreateTaskService{StoreID: uuid, StoreType: serviceType, TaskUser: user},
@@ -106,6 +106,66 @@ func (a StartLogService) IsFinish(state ClusterState) bool {
 	return false
 }
 
+type AddNonVotingLogService struct {
+	Target string
+	Replica
+}
+
+func (a AddNonVotingLogService) String() string {
+	return fmt.Sprintf("adding non-voting %v:%v(at epoch %v) to %s", a.ShardID, a.ReplicaID, a.Epoch, a.UUID)
+}
+
+func (a AddNonVotingLogService) IsFinish(state ClusterState) bool {
+	if _, ok := state.LogState.Shards[a.ShardID]; !ok {
+		return true
+	}
+	if _, ok := state.LogState.Shards[a.ShardID].NonVotingReplicas[a.ReplicaID]; ok {
+		return true
+	}
+
+	return false
+}
+
+type RemoveNonVotingLogService struct {
+	Target string
+	Replica
+}
+
+func (a RemoveNonVotingLogService) String() string {
+	return fmt.Sprintf("removing non-voting %v:%v(at epoch %v) on log store %s", a.ShardID, a.ReplicaID, a.Epoch, a.UUID)
+}
+
+func (a RemoveNonVotingLogService) IsFinish(state ClusterState) bool {
+	if shard, ok := state.LogState.Shards[a.ShardID]; ok {
+		if _, ok := shard.NonVotingReplicas[a.ReplicaID]; ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+type StartNonVotingLogService struct {
+	Replica
+}
+
+func (a StartNonVotingLogService) String() string {
+	return fmt.Sprintf("starting non-voting %v:%v on %s", a.ShardID, a.ReplicaID, a.UUID)
+}
+
+func (a StartNonVotingLogService) IsFinish(state ClusterState) bool {
+	if _, ok := state.LogState.Stores[a.UUID]; !ok {
+		return true
+	}
+	for _, replicaInfo := range state.LogState.Stores[a.UUID].Replicas {
+		if replicaInfo.ShardID == a.ShardID {
+			return true
+		}
+	}
+
+	return false
+}
+
 type StopLogService struct {
 	Replica
 }
@@ -188,6 +248,73 @@ func (a RemoveTnReplica) IsFinish(state ClusterState) bool {
 	return true
 }
 
+// StartTnStandby starts a hot-standby tn replica, which tails the log shard
+// to keep its state warm without serving transactions.
+type StartTnStandby struct {
+	StoreID            string
+	ShardID, ReplicaID uint64
+	LogShardID         uint64
+}
+
+func (a StartTnStandby) String() string {
+	return fmt.Sprintf("starting standby %v:%v on tn store %s (log shard %d)",
+		a.ShardID, a.ReplicaID, a.StoreID, a.LogShardID,
+	)
+}
+
+func (a StartTnStandby) IsFinish(state ClusterState) bool {
+	for _, info := range state.TNState.Stores[a.StoreID].StandbyShards {
+		if a.ShardID == info.GetShardID() && a.ReplicaID == info.GetReplicaID() {
+			return true
+		}
+	}
+	return false
+}
+
+// PromoteTnStandby promotes a hot-standby tn replica to the working replica
+// of the shard.
+type PromoteTnStandby struct {
+	StoreID            string
+	ShardID, ReplicaID uint64
+	LogShardID         uint64
+}
+
+func (a PromoteTnStandby) String() string {
+	return fmt.Sprintf("promoting standby %v:%v on tn store %s (log shard %d)",
+		a.ShardID, a.ReplicaID, a.StoreID, a.LogShardID,
+	)
+}
+
+func (a PromoteTnStandby) IsFinish(state ClusterState) bool {
+	for _, info := range state.TNState.Stores[a.StoreID].Shards {
+		if a.ShardID == info.GetShardID() && a.ReplicaID == info.GetReplicaID() {
+			return true
+		}
+	}
+	return false
+}
+
+type StopTnStandby struct {
+	StoreID            string
+	ShardID, ReplicaID uint64
+	LogShardID         uint64
+}
+
+func (a StopTnStandby) String() string {
+	return fmt.Sprintf("stopping standby %v:%v on tn store %s (log shard %d)",
+		a.ShardID, a.ReplicaID, a.StoreID, a.LogShardID,
+	)
+}
+
+func (a StopTnStandby) IsFinish(state ClusterState) bool {
+	for _, info := range state.TNState.Stores[a.StoreID].StandbyShards {
+		if a.ShardID == info.GetShardID() && a.ReplicaID == info.GetReplicaID() {
+			return false
+		}
+	}
+	return true
+}
+
 // StopTnStore corresponds to tn store shutdown command.
 type StopTnStore struct {
 	StoreID string
@@ -235,6 +235,43 @@ func TestStartLogService(t *testing.T) {
 	}
 }
 
+func TestNonVotingLogService(t *testing.T) {
+	replica := Replica{UUID: "b", ShardID: 1, ReplicaID: 2}
+	added := pb.LogState{
+		Shards: map[uint64]pb.LogShardInfo{1: {
+			ShardID:           1,
+			Replicas:          map[uint64]string{1: "a"},
+			NonVotingReplicas: map[uint64]string{2: "b"},
+		}},
+		Stores: map[string]pb.LogStoreInfo{"b": {}},
+	}
+	removed := pb.LogState{
+		Shards: map[uint64]pb.LogShardInfo{1: {
+			ShardID:  1,
+			Replicas: map[uint64]string{1: "a"},
+		}},
+		Stores: map[string]pb.LogStoreInfo{"b": {
+			Replicas: []pb.LogReplicaInfo{{
+				LogShardInfo: pb.LogShardInfo{ShardID: 1},
+				ReplicaID:    2,
+				IsNonVoting:  true,
+			}},
+		}},
+	}
+
+	add := AddNonVotingLogService{Target: "a", Replica: replica}
+	assert.True(t, add.IsFinish(ClusterState{LogState: added}))
+	assert.False(t, add.IsFinish(ClusterState{LogState: removed}))
+
+	remove := RemoveNonVotingLogService{Target: "a", Replica: replica}
+	assert.False(t, remove.IsFinish(ClusterState{LogState: added}))
+	assert.True(t, remove.IsFinish(ClusterState{LogState: removed}))
+
+	start := StartNonVotingLogService{Replica: replica}
+	assert.False(t, start.IsFinish(ClusterState{LogState: added}))
+	assert.True(t, start.IsFinish(ClusterState{LogState: removed}))
+}
+
 func TestStopLogService(t *testing.T) {
 	cases := []struct {
 		desc     string
@@ -397,6 +434,40 @@ func TestRemoveTnReplica(t *testing.T) {
 	}
 }
 
+func TestTnStandby(t *testing.T) {
+	standby := pb.TNState{
+		Stores: map[string]pb.TNStoreInfo{"a": {
+			StandbyShards: []pb.TNShardInfo{{
+				ShardID:   1,
+				ReplicaID: 1,
+			}},
+		}},
+	}
+	promoted := pb.TNState{
+		Stores: map[string]pb.TNStoreInfo{"a": {
+			Shards: []pb.TNShardInfo{{
+				ShardID:   1,
+				ReplicaID: 1,
+			}},
+		}},
+	}
+	state := func(tnState pb.TNState) ClusterState {
+		return ClusterState{TNState: tnState}
+	}
+
+	start := StartTnStandby{StoreID: "a", ShardID: 1, ReplicaID: 1}
+	assert.True(t, start.IsFinish(state(standby)))
+	assert.False(t, start.IsFinish(state(promoted)))
+
+	promote := PromoteTnStandby{StoreID: "a", ShardID: 1, ReplicaID: 1}
+	assert.False(t, promote.IsFinish(state(standby)))
+	assert.True(t, promote.IsFinish(state(promoted)))
+
+	stop := StopTnStandby{StoreID: "a", ShardID: 1, ReplicaID: 1}
+	assert.False(t, stop.IsFinish(state(standby)))
+	assert.True(t, stop.IsFinish(state(promoted)))
+}
+
 func TestDeleteProxyStore(t *testing.T) {
 	cases := []struct {
 		desc     string
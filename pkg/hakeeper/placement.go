@@ -0,0 +1,134 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hakeeper
+
+import (
+	"sort"
+	"strings"
+
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+)
+
+// PlacementScore describes how replicas are spread across failure domains.
+// The i-th element is the number of distinct domains of the replicas at the
+// i-th level of the location labels, outermost first. Scores are compared
+// lexicographically, so spreading across zones is preferred over spreading
+// across racks.
+type PlacementScore []int
+
+// Compare returns 1 if s is a better spread than o, -1 if it's worse, or 0.
+func (s PlacementScore) Compare(o PlacementScore) int {
+	for i := 0; i < len(s) && i < len(o); i++ {
+		if s[i] > o[i] {
+			return 1
+		}
+		if s[i] < o[i] {
+			return -1
+		}
+	}
+	return 0
+}
+
+// domain returns the failure domain of the locality at the specified level
+// of the location labels. Missing labels are treated as empty values, so
+// stores without locality labels are in the same domain.
+func (cfg Config) domain(locality map[string]string, level int) string {
+	values := make([]string, 0, level+1)
+	for _, label := range cfg.LocationLabels[:level+1] {
+		values = append(values, locality[label])
+	}
+	return strings.Join(values, "/")
+}
+
+// PlacementScore returns the score of replicas located by the localities.
+func (cfg Config) PlacementScore(localities []map[string]string) PlacementScore {
+	score := make(PlacementScore, len(cfg.LocationLabels))
+	for level := range cfg.LocationLabels {
+		domains := make(map[string]struct{})
+		for _, locality := range localities {
+			domains[cfg.domain(locality, level)] = struct{}{}
+		}
+		score[level] = len(domains)
+	}
+	return score
+}
+
+// LogPlacementViolations returns the Log shards with replicas sharing a
+// failure domain while the working Log stores span enough domains to
+// separate them. Only the outermost violated level of each shard is reported.
+func (cfg Config) LogPlacementViolations(state pb.LogState, currentTick uint64) []pb.PlacementViolation {
+	if len(cfg.LocationLabels) == 0 {
+		return nil
+	}
+
+	// available domains of each level
+	available := make([]map[string]struct{}, len(cfg.LocationLabels))
+	for level := range cfg.LocationLabels {
+		available[level] = make(map[string]struct{})
+	}
+	for _, store := range state.Stores {
+		if cfg.LogStoreExpired(store.Tick, currentTick) {
+			continue
+		}
+		for level := range cfg.LocationLabels {
+			available[level][cfg.domain(store.Locality, level)] = struct{}{}
+		}
+	}
+
+	shardIDs := make([]uint64, 0, len(state.Shards))
+	for shardID := range state.Shards {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	var violations []pb.PlacementViolation
+	for _, shardID := range shardIDs {
+		uuids := make([]string, 0, len(state.Shards[shardID].Replicas))
+		for _, uuid := range state.Shards[shardID].Replicas {
+			uuids = append(uuids, uuid)
+		}
+		sort.Strings(uuids)
+
+		for level, label := range cfg.LocationLabels {
+			groups := make(map[string][]string)
+			for _, uuid := range uuids {
+				domain := cfg.domain(state.Stores[uuid].Locality, level)
+				groups[domain] = append(groups[domain], uuid)
+			}
+			if len(groups) >= min(len(uuids), len(available[level])) {
+				continue
+			}
+			domains := make([]string, 0, len(groups))
+			for domain, stores := range groups {
+				if len(stores) > 1 {
+					domains = append(domains, domain)
+				}
+			}
+			sort.Strings(domains)
+			for _, domain := range domains {
+				violations = append(violations, pb.PlacementViolation{
+					ShardID: shardID,
+					Label:   label,
+					Domain:  domain,
+					Stores:  groups[domain],
+				})
+			}
+			break
+		}
+	}
+	return violations
+}
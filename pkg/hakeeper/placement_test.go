@@ -0,0 +1,93 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hakeeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+)
+
+func TestPlacementScore(t *testing.T) {
+	cfg := Config{}
+	cfg.Fill()
+
+	score := cfg.PlacementScore([]map[string]string{
+		{"zone": "z1", "rack": "r1"},
+		{"zone": "z1", "rack": "r2"},
+		{"zone": "z2", "rack": "r1"},
+	})
+	assert.Equal(t, PlacementScore{2, 3}, score)
+
+	// zones are compared before racks
+	assert.Equal(t, 1, PlacementScore{2, 2}.Compare(PlacementScore{1, 3}))
+	assert.Equal(t, -1, PlacementScore{2, 2}.Compare(PlacementScore{2, 3}))
+	assert.Equal(t, 0, PlacementScore{}.Compare(PlacementScore{}))
+
+	// stores without labels are in the same domain
+	assert.Equal(t, PlacementScore{1, 1}, cfg.PlacementScore([]map[string]string{nil, nil}))
+}
+
+func TestLogPlacementViolations(t *testing.T) {
+	cfg := Config{}
+	cfg.Fill()
+
+	newState := func(zones map[string]string, replicas map[uint64]string) pb.LogState {
+		state := pb.LogState{
+			Shards: map[uint64]pb.LogShardInfo{1: {ShardID: 1, Replicas: replicas}},
+			Stores: map[string]pb.LogStoreInfo{},
+		}
+		for uuid, zone := range zones {
+			state.Stores[uuid] = pb.LogStoreInfo{
+				Locality: map[string]string{"zone": zone, "rack": uuid},
+			}
+		}
+		return state
+	}
+
+	// two of three replicas in z1, while there are three zones
+	state := newState(
+		map[string]string{"a": "z1", "b": "z1", "c": "z2", "d": "z3"},
+		map[uint64]string{1: "a", 2: "b", 3: "c"},
+	)
+	assert.Equal(t, []pb.PlacementViolation{{
+		ShardID: 1,
+		Label:   "zone",
+		Domain:  "z1",
+		Stores:  []string{"a", "b"},
+	}}, cfg.LogPlacementViolations(state, 0))
+
+	// no violation if the third zone is down
+	currentTick := cfg.ExpiredTick(0, cfg.LogStoreTimeout) + 1
+	for _, uuid := range []string{"a", "b", "c"} {
+		store := state.Stores[uuid]
+		store.Tick = currentTick
+		state.Stores[uuid] = store
+	}
+	assert.Empty(t, cfg.LogPlacementViolations(state, currentTick))
+
+	// only two zones, replicas in distinct racks
+	state = newState(
+		map[string]string{"a": "z1", "b": "z1", "c": "z2"},
+		map[uint64]string{1: "a", 2: "b", 3: "c"},
+	)
+	assert.Empty(t, cfg.LogPlacementViolations(state, 0))
+
+	// stores without labels
+	state = newState(nil, map[uint64]string{1: "a", 2: "b", 3: "c"})
+	assert.Empty(t, cfg.LogPlacementViolations(state, 0))
+}
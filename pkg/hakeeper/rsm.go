@@ -727,12 +727,18 @@ func (s *stateMachine) handleClusterDetailsQuery(cfg Config) *pb.ClusterDetails
 			QueryAddress:        info.QueryAddress,
 			ConfigData:          info.ConfigData,
 			Resource:            info.Resource,
+			Load:                info.Load,
 			UpTime:              info.UpTime,
 			CommitID:            info.CommitID,
 		}
 		cd.CNStores = append(cd.CNStores, n)
 	}
 	for uuid, info := range s.state.TNState.Stores {
+		// stores only hosting hot-standby replicas serve no transactions,
+		// so they are not visible to others until promoted.
+		if len(info.Shards) == 0 && len(info.StandbyShards) > 0 {
+			continue
+		}
 		state := pb.NormalState
 		if cfg.TNStoreExpired(info.Tick, s.state.Tick) {
 			state = pb.TimeoutState
@@ -748,6 +754,7 @@ func (s *stateMachine) handleClusterDetailsQuery(cfg Config) *pb.ClusterDetails
 			ShardServiceAddress:  info.ShardServiceAddress,
 			ConfigData:           info.ConfigData,
 			QueryAddress:         info.QueryAddress,
+			Locality:             info.Locality,
 		}
 		cd.TNStores = append(cd.TNStores, n)
 	}
@@ -763,6 +770,7 @@ func (s *stateMachine) handleClusterDetailsQuery(cfg Config) *pb.ClusterDetails
 			ServiceAddress: info.ServiceAddress,
 			Replicas:       info.Replicas,
 			ConfigData:     info.ConfigData,
+			Locality:       info.Locality,
 		}
 		cd.LogStores = append(cd.LogStores, n)
 	}
@@ -783,6 +791,8 @@ func (s *stateMachine) handleClusterDetailsQuery(cfg Config) *pb.ClusterDetails
 			DownTime:  store.DownTime,
 		})
 	}
+	cd.PlacementViolations = cfg.LogPlacementViolations(s.state.LogState, s.state.Tick)
+	cd.ScaleRecommendations = cfg.CNScaleRecommendations(s.state.CNState, s.state.Tick)
 	return cd
 }
 
@@ -0,0 +1,167 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hakeeper
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+)
+
+// FormatCNLabels formats the labels of a CN store as k1=v1,v2;k2=v3, keys
+// and values are sorted so that CNs with the same labels share the string.
+func FormatCNLabels(labels map[string]metadata.LabelList) string {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	items := make([]string, 0, len(keys))
+	for _, key := range keys {
+		values := append([]string(nil), labels[key].Labels...)
+		sort.Strings(values)
+		items = append(items, key+"="+strings.Join(values, ","))
+	}
+	return strings.Join(items, ";")
+}
+
+// CNScaleRecommendations groups the working CN stores by their labels, and
+// recommends scaling out or in each group by the load reported in the CN
+// heartbeats. Expired CNs and CNs not in working state, e.g. draining ones,
+// are not counted as capacity. Groups are sorted by their labels.
+func (cfg Config) CNScaleRecommendations(state pb.CNState, currentTick uint64) []pb.CNScaleRecommendation {
+	groups := make(map[string][]string)
+	for uuid, store := range state.Stores {
+		if cfg.CNStoreExpired(store.Tick, currentTick) ||
+			store.WorkState != metadata.WorkState_Working {
+			continue
+		}
+		labels := FormatCNLabels(store.Labels)
+		groups[labels] = append(groups[labels], uuid)
+	}
+
+	keys := make([]string, 0, len(groups))
+	for labels := range groups {
+		keys = append(keys, labels)
+	}
+	sort.Strings(keys)
+
+	var recommendations []pb.CNScaleRecommendation
+	for _, labels := range keys {
+		uuids := groups[labels]
+		sort.Strings(uuids)
+		recommendations = append(recommendations,
+			cfg.CNScaling.recommend(labels, uuids, state))
+	}
+	return recommendations
+}
+
+func (c CNScalingConfig) recommend(
+	labels string, uuids []string, state pb.CNState,
+) pb.CNScaleRecommendation {
+	r := pb.CNScaleRecommendation{
+		Labels: labels,
+		CNs:    uuids,
+	}
+	for _, uuid := range uuids {
+		store := state.Stores[uuid]
+		r.CPUUsage += cpuUsage(store.Resource)
+		r.MemoryUsage += memoryUsage(store.Resource)
+		r.ActiveSessions += store.Load.ActiveSessions
+		r.ActiveQueries += store.Load.ActiveQueries
+	}
+	n := len(uuids)
+	r.CPUUsage /= float64(n)
+	r.MemoryUsage /= float64(n)
+
+	// the number of CNs keeping every usage below its scale-out threshold
+	desired := max(
+		needed(n, r.CPUUsage, c.ScaleOutCPU),
+		needed(n, r.MemoryUsage, c.ScaleOutMemory),
+		int(c.MinCNs),
+	)
+	if c.SessionsPerCN > 0 {
+		desired = max(desired,
+			int((r.ActiveSessions+c.SessionsPerCN-1)/c.SessionsPerCN))
+	}
+
+	var reasons []string
+	if r.CPUUsage > c.ScaleOutCPU {
+		reasons = append(reasons, fmt.Sprintf("cpu usage %.2f > %.2f", r.CPUUsage, c.ScaleOutCPU))
+	}
+	if r.MemoryUsage > c.ScaleOutMemory {
+		reasons = append(reasons, fmt.Sprintf("memory usage %.2f > %.2f", r.MemoryUsage, c.ScaleOutMemory))
+	}
+	if c.SessionsPerCN > 0 && r.ActiveSessions > c.SessionsPerCN*uint64(n) {
+		reasons = append(reasons, fmt.Sprintf("%d sessions > %d per cn", r.ActiveSessions, c.SessionsPerCN))
+	}
+	if len(reasons) > 0 && desired > n {
+		r.Action = pb.ScaleOut
+		r.Count = uint64(desired - n)
+		r.Reason = strings.Join(reasons, ", ")
+		return r
+	}
+
+	if r.CPUUsage < c.ScaleInCPU && r.MemoryUsage < c.ScaleInMemory && desired < n {
+		r.Action = pb.ScaleIn
+		r.Count = uint64(n - desired)
+		r.Reason = fmt.Sprintf("cpu usage %.2f < %.2f, memory usage %.2f < %.2f",
+			r.CPUUsage, c.ScaleInCPU, r.MemoryUsage, c.ScaleInMemory)
+		r.Drain = drainPlan(uuids, state, int(r.Count))
+	}
+	return r
+}
+
+// drainPlan returns the CNs to drain for scaling in, the ones serving the
+// least sessions are drained first.
+func drainPlan(uuids []string, state pb.CNState, count int) []string {
+	candidates := append([]string(nil), uuids...)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return state.Stores[candidates[i]].Load.ActiveSessions <
+			state.Stores[candidates[j]].Load.ActiveSessions
+	})
+	return candidates[:count]
+}
+
+// needed returns the number of CNs to bring the usage of the n CNs down to
+// the threshold.
+func needed(n int, usage, threshold float64) int {
+	if threshold <= 0 {
+		return n
+	}
+	return int(math.Ceil(float64(n) * usage / threshold))
+}
+
+func cpuUsage(r pb.Resource) float64 {
+	if r.CPUTotal == 0 {
+		return 0
+	}
+	return clampUsage(1 - r.CPUAvailable/float64(r.CPUTotal))
+}
+
+func memoryUsage(r pb.Resource) float64 {
+	if r.MemTotal == 0 {
+		return 0
+	}
+	return clampUsage(1 - float64(r.MemAvailable)/float64(r.MemTotal))
+}
+
+func clampUsage(v float64) float64 {
+	return math.Min(math.Max(v, 0), 1)
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hakeeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+)
+
+func TestFormatCNLabels(t *testing.T) {
+	assert.Equal(t, "", FormatCNLabels(nil))
+	assert.Equal(t, "account=a,b;role=ap", FormatCNLabels(map[string]metadata.LabelList{
+		"role":    {Labels: []string{"ap"}},
+		"account": {Labels: []string{"b", "a"}},
+	}))
+}
+
+func TestCNScaleRecommendations(t *testing.T) {
+	cfg := Config{}
+	cfg.Fill()
+	cfg.CNScaling.SessionsPerCN = 100
+
+	newStore := func(role string, cpuAvailable float64, memAvailable, sessions uint64) pb.CNStoreInfo {
+		return pb.CNStoreInfo{
+			Tick:      10,
+			WorkState: metadata.WorkState_Working,
+			Labels: map[string]metadata.LabelList{
+				"role": {Labels: []string{role}},
+			},
+			Resource: pb.Resource{
+				CPUTotal:     10,
+				CPUAvailable: cpuAvailable,
+				MemTotal:     100,
+				MemAvailable: memAvailable,
+			},
+			Load: pb.CNLoad{ActiveSessions: sessions},
+		}
+	}
+
+	state := pb.CNState{Stores: map[string]pb.CNStoreInfo{
+		// busy group
+		"cn1": newStore("ap", 1, 50, 10),
+		"cn2": newStore("ap", 1, 50, 10),
+		// idle group
+		"cn3": newStore("tp", 9, 90, 5),
+		"cn4": newStore("tp", 9, 90, 1),
+		"cn5": newStore("tp", 9, 90, 3),
+		// sessions only
+		"cn6": newStore("web", 5, 50, 250),
+	}}
+	// draining and expired CNs are not counted
+	draining := newStore("tp", 1, 10, 100)
+	draining.WorkState = metadata.WorkState_Draining
+	state.Stores["cn7"] = draining
+	expired := newStore("tp", 1, 10, 100)
+	expired.Tick = 0
+	state.Stores["cn8"] = expired
+
+	tick := cfg.ExpiredTick(5, cfg.CNStoreTimeout)
+	recommendations := cfg.CNScaleRecommendations(state, tick)
+	require.Equal(t, 3, len(recommendations))
+
+	ap := recommendations[0]
+	assert.Equal(t, "role=ap", ap.Labels)
+	assert.Equal(t, []string{"cn1", "cn2"}, ap.CNs)
+	assert.Equal(t, pb.ScaleOut, ap.Action)
+	// 2 CNs at 90% cpu need ceil(2*0.9/0.8) = 3 CNs
+	assert.Equal(t, uint64(1), ap.Count)
+	assert.Contains(t, ap.Reason, "cpu usage")
+	assert.Empty(t, ap.Drain)
+
+	tp := recommendations[1]
+	assert.Equal(t, "role=tp", tp.Labels)
+	assert.Equal(t, []string{"cn3", "cn4", "cn5"}, tp.CNs)
+	assert.Equal(t, pb.ScaleIn, tp.Action)
+	assert.Equal(t, uint64(2), tp.Count)
+	assert.Equal(t, uint64(9), tp.ActiveSessions)
+	// the CNs serving the least sessions are drained
+	assert.Equal(t, []string{"cn4", "cn5"}, tp.Drain)
+
+	web := recommendations[2]
+	assert.Equal(t, pb.ScaleOut, web.Action)
+	assert.Equal(t, uint64(2), web.Count)
+	assert.Contains(t, web.Reason, "sessions")
+
+	// scaling in never goes below the min CNs
+	cfg.CNScaling.MinCNs = 3
+	recommendations = cfg.CNScaleRecommendations(state, tick)
+	assert.Equal(t, pb.NoScale, recommendations[1].Action)
+}
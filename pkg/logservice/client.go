@@ -295,6 +295,12 @@ func connectToLogServiceByReverseProxy(
 		return nil, moerr.NewLogServiceNotReady(ctx)
 	}
 	addresses := make([]string, 0)
+	// read-only clients prefer non-voting replicas to offload the voting ones.
+	if cfg.ReadOnly {
+		for _, address := range si.NonVotingReplicas {
+			addresses = append(addresses, address)
+		}
+	}
 	leaderAddress, ok := si.Replicas[si.ReplicaID]
 	if ok {
 		addresses = append(addresses, leaderAddress)
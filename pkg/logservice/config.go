@@ -148,6 +148,9 @@ type Config struct {
 	HAKeeperTruncateInterval toml.Duration `toml:"hakeeper-truncate-interval"`
 	// ExplicitHostname is the hostname used in draogboat.
 	ExplicitHostname string `toml:"explicit-hostname"`
+	// Locality is the failure domain labels of the log store, such as
+	// zone = "az-1" and rack = "r1". See HAKeeperConfig.LocationLabels.
+	Locality map[string]string `toml:"locality"`
 
 	RPC struct {
 		// MaxMessageSize is the max size for RPC message. The default value is 10MiB.
@@ -218,6 +221,22 @@ type Config struct {
 		// If HAKeeper does not receive two heartbeat within CNStoreTimeout,
 		// it regards the tn store as down.
 		CNStoreTimeout toml.Duration `toml:"cn-store-timeout"`
+		// LocationLabels are the keys of the locality labels which define the
+		// failure domains, from the outermost to the innermost. Replicas of Log
+		// shards are spread across these domains. The default is zone and rack.
+		LocationLabels []string `toml:"location-labels"`
+		// NonVotingReplicas is the number of non-voting replicas of each Log
+		// shard. Non-voting replicas serve read-only clients and can be
+		// promoted to voting ones during repair.
+		NonVotingReplicas uint64 `toml:"non-voting-replicas"`
+		// NonVotingLocality is the locality labels of the log stores
+		// dedicated to non-voting replicas.
+		NonVotingLocality map[string]string `toml:"non-voting-locality"`
+		// TNStandby enables a hot-standby replica for each TN shard, which is
+		// promoted when the working TN replica is down.
+		TNStandby bool `toml:"tn-standby"`
+		// CNScaling is the thresholds of the CN scale-out/in recommendations.
+		CNScaling hakeeper.CNScalingConfig `toml:"cn-scaling"`
 	}
 
 	// HAKeeperClientConfig is the config for HAKeeperClient
@@ -242,6 +261,12 @@ func (c *Config) GetHAKeeperConfig() hakeeper.Config {
 		LogStoreTimeout: c.HAKeeperConfig.LogStoreTimeout.Duration,
 		TNStoreTimeout:  c.HAKeeperConfig.TNStoreTimeout.Duration,
 		CNStoreTimeout:  c.HAKeeperConfig.CNStoreTimeout.Duration,
+		LocationLabels:  c.HAKeeperConfig.LocationLabels,
+
+		NonVotingReplicas: c.HAKeeperConfig.NonVotingReplicas,
+		NonVotingLocality: c.HAKeeperConfig.NonVotingLocality,
+		TNStandby:         c.HAKeeperConfig.TNStandby,
+		CNScaling:         c.HAKeeperConfig.CNScaling,
 	}
 }
 
@@ -442,15 +467,25 @@ func DefaultConfig() Config {
 			},
 		}),
 		HAKeeperConfig: struct {
-			TickPerSecond   int           `toml:"tick-per-second"`
-			LogStoreTimeout toml.Duration `toml:"log-store-timeout"`
-			TNStoreTimeout  toml.Duration `toml:"tn-store-timeout"`
-			CNStoreTimeout  toml.Duration `toml:"cn-store-timeout"`
+			TickPerSecond     int                      `toml:"tick-per-second"`
+			LogStoreTimeout   toml.Duration            `toml:"log-store-timeout"`
+			TNStoreTimeout    toml.Duration            `toml:"tn-store-timeout"`
+			CNStoreTimeout    toml.Duration            `toml:"cn-store-timeout"`
+			LocationLabels    []string                 `toml:"location-labels"`
+			NonVotingReplicas uint64                   `toml:"non-voting-replicas"`
+			NonVotingLocality map[string]string        `toml:"non-voting-locality"`
+			TNStandby         bool                     `toml:"tn-standby"`
+			CNScaling         hakeeper.CNScalingConfig `toml:"cn-scaling"`
 		}(struct {
-			TickPerSecond   int
-			LogStoreTimeout toml.Duration
-			TNStoreTimeout  toml.Duration
-			CNStoreTimeout  toml.Duration
+			TickPerSecond     int
+			LogStoreTimeout   toml.Duration
+			TNStoreTimeout    toml.Duration
+			CNStoreTimeout    toml.Duration
+			LocationLabels    []string
+			NonVotingReplicas uint64
+			NonVotingLocality map[string]string
+			TNStandby         bool
+			CNScaling         hakeeper.CNScalingConfig
 		}{
 			TickPerSecond:   hakeeper.DefaultTickPerSecond,
 			LogStoreTimeout: toml.Duration{Duration: hakeeper.DefaultLogStoreTimeout},
@@ -0,0 +1,382 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logservice
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"hash/crc32"
+	"io"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+)
+
+// A log shard dump is a portable file of the records of a log shard:
+//
+//	magic | header length | JSON header
+//	(record length | CRC32 | LogRecord)*
+//	0 | record count | last Lsn
+//
+// Lengths are uint32 and counts are uint64, all in the byte order of the
+// log records. Record lengths are never 0 as records have at least the
+// header of the record type.
+const (
+	dumpMagic      = "MOLOGDMP"
+	dumpVersion    = uint16(1)
+	dumpReadSize   = 4 * 1024 * 1024
+	maxDumpRecSize = 1024 * 1024 * 1024
+)
+
+var dumpCRCTable = crc32.MakeTable(crc32.Castagnoli)
+
+// DumpHeader describes a log shard dump.
+type DumpHeader struct {
+	Version uint16 `json:"version"`
+	ShardID uint64 `json:"shard_id"`
+	// FirstLsn is the first Lsn requested to dump. Records before the
+	// truncated Lsn of the shard are not available.
+	FirstLsn uint64 `json:"first_lsn"`
+	// LastLsn is the last Lsn requested to dump, 0 means the latest one.
+	LastLsn uint64 `json:"last_lsn"`
+	// TruncatedLsn is the truncated Lsn of the shard when dumped.
+	TruncatedLsn uint64 `json:"truncated_lsn"`
+	// CreatedAt is the unix time in seconds when the dump is created.
+	CreatedAt int64 `json:"created_at"`
+}
+
+// DumpSummary is the summary of the records in a log shard dump.
+type DumpSummary struct {
+	Records uint64
+	LastLsn uint64
+}
+
+// DumpShard dumps the records of the shard of the client within
+// [firstLsn, lastLsn] to w. lastLsn 0 means all available records.
+func DumpShard(
+	ctx context.Context,
+	c Client,
+	w io.Writer,
+	firstLsn Lsn,
+	lastLsn Lsn,
+) (DumpSummary, error) {
+	truncated, err := c.GetTruncatedLsn(ctx)
+	if err != nil {
+		return DumpSummary{}, err
+	}
+	header := DumpHeader{
+		Version:      dumpVersion,
+		ShardID:      c.Config().LogShardID,
+		FirstLsn:     firstLsn,
+		LastLsn:      lastLsn,
+		TruncatedLsn: truncated,
+		CreatedAt:    time.Now().Unix(),
+	}
+	dw, err := newDumpWriter(w, header)
+	if err != nil {
+		return DumpSummary{}, err
+	}
+
+	next := firstLsn
+	if next <= truncated {
+		next = truncated + 1
+	}
+	for lastLsn == 0 || next <= lastLsn {
+		recs, lsn, err := c.Read(ctx, next, dumpReadSize)
+		if err != nil {
+			return DumpSummary{}, err
+		}
+		for _, rec := range recs {
+			if lastLsn != 0 && rec.Lsn > lastLsn {
+				break
+			}
+			if err := dw.write(rec); err != nil {
+				return DumpSummary{}, err
+			}
+		}
+		if lsn == next {
+			break
+		}
+		next = lsn
+	}
+	if err := dw.close(); err != nil {
+		return DumpSummary{}, err
+	}
+	return dw.summary, nil
+}
+
+// ImportShard appends the user records in the dump to the shard of the
+// client, which is usually a newly bootstrapped one. The shard is required
+// to have no user records unless force is true. Internal records, such as
+// lease updates, are not imported.
+func ImportShard(
+	ctx context.Context,
+	c Client,
+	r *DumpReader,
+	force bool,
+) (DumpSummary, error) {
+	if !force {
+		empty, err := isShardEmpty(ctx, c)
+		if err != nil {
+			return DumpSummary{}, err
+		}
+		if !empty {
+			return DumpSummary{}, moerr.NewInvalidInput(ctx,
+				"log shard %d has user records", c.Config().LogShardID)
+		}
+	}
+
+	var summary DumpSummary
+	for {
+		rec, err := r.Next()
+		if err == io.EOF {
+			return summary, nil
+		}
+		if err != nil {
+			return summary, err
+		}
+		if rec.Type != pb.UserRecord {
+			continue
+		}
+		payload := rec.Payload()
+		imported := c.GetLogRecord(len(payload))
+		copy(imported.Payload(), payload)
+		lsn, err := c.Append(ctx, imported)
+		if err != nil {
+			return summary, err
+		}
+		summary.Records++
+		summary.LastLsn = lsn
+	}
+}
+
+// isShardEmpty returns true if there is no user record after the truncated
+// Lsn of the shard.
+func isShardEmpty(ctx context.Context, c Client) (bool, error) {
+	truncated, err := c.GetTruncatedLsn(ctx)
+	if err != nil {
+		return false, err
+	}
+	next := truncated + 1
+	for {
+		recs, lsn, err := c.Read(ctx, next, dumpReadSize)
+		if err != nil {
+			return false, err
+		}
+		for _, rec := range recs {
+			if rec.Type == pb.UserRecord {
+				return false, nil
+			}
+		}
+		if lsn == next {
+			return true, nil
+		}
+		next = lsn
+	}
+}
+
+type dumpWriter struct {
+	w       *bufio.Writer
+	buf     []byte
+	summary DumpSummary
+}
+
+func newDumpWriter(w io.Writer, header DumpHeader) (*dumpWriter, error) {
+	dw := &dumpWriter{w: bufio.NewWriter(w)}
+	data, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dw.w.WriteString(dumpMagic); err != nil {
+		return nil, err
+	}
+	if err := dw.writeUint32(uint32(len(data))); err != nil {
+		return nil, err
+	}
+	if _, err := dw.w.Write(data); err != nil {
+		return nil, err
+	}
+	return dw, nil
+}
+
+func (dw *dumpWriter) write(rec pb.LogRecord) error {
+	size := rec.ProtoSize()
+	if cap(dw.buf) < size {
+		dw.buf = make([]byte, size)
+	}
+	data := dw.buf[:size]
+	if _, err := rec.MarshalToSizedBuffer(data); err != nil {
+		return err
+	}
+	if err := dw.writeUint32(uint32(size)); err != nil {
+		return err
+	}
+	if err := dw.writeUint32(crc32.Checksum(data, dumpCRCTable)); err != nil {
+		return err
+	}
+	if _, err := dw.w.Write(data); err != nil {
+		return err
+	}
+	dw.summary.Records++
+	dw.summary.LastLsn = rec.Lsn
+	return nil
+}
+
+func (dw *dumpWriter) close() error {
+	if err := dw.writeUint32(0); err != nil {
+		return err
+	}
+	if err := dw.writeUint64(dw.summary.Records); err != nil {
+		return err
+	}
+	if err := dw.writeUint64(dw.summary.LastLsn); err != nil {
+		return err
+	}
+	return dw.w.Flush()
+}
+
+func (dw *dumpWriter) writeUint32(v uint32) error {
+	var data [4]byte
+	binaryEnc.PutUint32(data[:], v)
+	_, err := dw.w.Write(data[:])
+	return err
+}
+
+func (dw *dumpWriter) writeUint64(v uint64) error {
+	var data [8]byte
+	binaryEnc.PutUint64(data[:], v)
+	_, err := dw.w.Write(data[:])
+	return err
+}
+
+// DumpReader reads the records of a log shard dump.
+type DumpReader struct {
+	r       *bufio.Reader
+	header  DumpHeader
+	summary DumpSummary
+	done    bool
+}
+
+// NewDumpReader creates a DumpReader, the header of the dump is read and
+// validated.
+func NewDumpReader(r io.Reader) (*DumpReader, error) {
+	dr := &DumpReader{r: bufio.NewReader(r)}
+	magic := make([]byte, len(dumpMagic))
+	if _, err := io.ReadFull(dr.r, magic); err != nil {
+		return nil, dr.corrupted(err)
+	}
+	if string(magic) != dumpMagic {
+		return nil, moerr.NewInvalidInputNoCtx("not a log shard dump")
+	}
+	size, err := dr.readUint32()
+	if err != nil {
+		return nil, dr.corrupted(err)
+	}
+	if size > maxDumpRecSize {
+		return nil, dr.corrupted(moerr.NewInternalErrorNoCtx("header size %d", size))
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(dr.r, data); err != nil {
+		return nil, dr.corrupted(err)
+	}
+	if err := json.Unmarshal(data, &dr.header); err != nil {
+		return nil, dr.corrupted(err)
+	}
+	if dr.header.Version != dumpVersion {
+		return nil, moerr.NewNotSupportedNoCtx("log shard dump version %d", dr.header.Version)
+	}
+	return dr, nil
+}
+
+// Header returns the header of the dump.
+func (dr *DumpReader) Header() DumpHeader {
+	return dr.header
+}
+
+// Next returns the next record of the dump, or io.EOF if all records are
+// read and the number of them is verified.
+func (dr *DumpReader) Next() (pb.LogRecord, error) {
+	if dr.done {
+		return pb.LogRecord{}, io.EOF
+	}
+	size, err := dr.readUint32()
+	if err != nil {
+		return pb.LogRecord{}, dr.corrupted(err)
+	}
+	if size == 0 {
+		return pb.LogRecord{}, dr.readFooter()
+	}
+	if size > maxDumpRecSize {
+		return pb.LogRecord{}, dr.corrupted(moerr.NewInternalErrorNoCtx("record size %d", size))
+	}
+	checksum, err := dr.readUint32()
+	if err != nil {
+		return pb.LogRecord{}, dr.corrupted(err)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(dr.r, data); err != nil {
+		return pb.LogRecord{}, dr.corrupted(err)
+	}
+	if crc32.Checksum(data, dumpCRCTable) != checksum {
+		return pb.LogRecord{}, dr.corrupted(moerr.NewInternalErrorNoCtx("checksum mismatch"))
+	}
+	var rec pb.LogRecord
+	if err := rec.Unmarshal(data); err != nil {
+		return pb.LogRecord{}, dr.corrupted(err)
+	}
+	dr.summary.Records++
+	dr.summary.LastLsn = rec.Lsn
+	return rec, nil
+}
+
+func (dr *DumpReader) readFooter() error {
+	records, err := dr.readUint64()
+	if err != nil {
+		return dr.corrupted(err)
+	}
+	lastLsn, err := dr.readUint64()
+	if err != nil {
+		return dr.corrupted(err)
+	}
+	if records != dr.summary.Records || lastLsn != dr.summary.LastLsn {
+		return dr.corrupted(moerr.NewInternalErrorNoCtx(
+			"%d records to %d expected, %d records to %d read",
+			records, lastLsn, dr.summary.Records, dr.summary.LastLsn))
+	}
+	dr.done = true
+	return io.EOF
+}
+
+func (dr *DumpReader) readUint32() (uint32, error) {
+	var data [4]byte
+	if _, err := io.ReadFull(dr.r, data[:]); err != nil {
+		return 0, err
+	}
+	return binaryEnc.Uint32(data[:]), nil
+}
+
+func (dr *DumpReader) readUint64() (uint64, error) {
+	var data [8]byte
+	if _, err := io.ReadFull(dr.r, data[:]); err != nil {
+		return 0, err
+	}
+	return binaryEnc.Uint64(data[:]), nil
+}
+
+func (dr *DumpReader) corrupted(err error) error {
+	return moerr.NewInternalErrorNoCtx("corrupted log shard dump: %v", err)
+}
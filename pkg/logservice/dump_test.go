@@ -0,0 +1,139 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+)
+
+func readDump(t *testing.T, data []byte) (DumpHeader, []pb.LogRecord) {
+	r, err := NewDumpReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	var recs []pb.LogRecord
+	for {
+		rec, err := r.Next()
+		if err == io.EOF {
+			return r.Header(), recs
+		}
+		require.NoError(t, err)
+		recs = append(recs, rec)
+	}
+}
+
+func TestDumpAndImportShard(t *testing.T) {
+	fn := func(t *testing.T, s *Service, cfg ClientConfig, c Client) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		payloads := make([][]byte, 0, 3)
+		for i := 0; i < 3; i++ {
+			rec := c.GetLogRecord(16)
+			_, err := rand.Read(rec.Payload())
+			require.NoError(t, err)
+			_, err = c.Append(ctx, rec)
+			require.NoError(t, err)
+			payloads = append(payloads, append([]byte(nil), rec.Payload()...))
+		}
+
+		var buf bytes.Buffer
+		summary, err := DumpShard(ctx, c, &buf, 0, 0)
+		require.NoError(t, err)
+		header, recs := readDump(t, buf.Bytes())
+		assert.Equal(t, cfg.LogShardID, header.ShardID)
+		assert.Equal(t, uint64(len(recs)), summary.Records)
+		assert.Equal(t, recs[len(recs)-1].Lsn, summary.LastLsn)
+		var user [][]byte
+		for _, rec := range recs {
+			if rec.Type == pb.UserRecord {
+				user = append(user, rec.Payload())
+			}
+		}
+		assert.Equal(t, payloads, user)
+
+		// dump a range
+		buf.Reset()
+		summary, err = DumpShard(ctx, c, &buf, 4, 5)
+		require.NoError(t, err)
+		_, recs = readDump(t, buf.Bytes())
+		require.Equal(t, 2, len(recs))
+		assert.Equal(t, uint64(4), recs[0].Lsn)
+		assert.Equal(t, uint64(5), summary.LastLsn)
+
+		// the shard is not empty
+		buf.Reset()
+		_, err = DumpShard(ctx, c, &buf, 0, 0)
+		require.NoError(t, err)
+		dump := buf.Bytes()
+		r, err := NewDumpReader(bytes.NewReader(dump))
+		require.NoError(t, err)
+		_, err = ImportShard(ctx, c, r, false)
+		assert.True(t, moerr.IsMoErrCode(err, moerr.ErrInvalidInput))
+
+		r, err = NewDumpReader(bytes.NewReader(dump))
+		require.NoError(t, err)
+		summary, err = ImportShard(ctx, c, r, true)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), summary.Records)
+		imported, _, err := c.Read(ctx, summary.LastLsn, dumpReadSize)
+		require.NoError(t, err)
+		require.NotEmpty(t, imported)
+		assert.Equal(t, payloads[2], imported[0].Payload())
+	}
+	runClientTest(t, false, nil, fn)
+}
+
+func TestCorruptedDump(t *testing.T) {
+	var buf bytes.Buffer
+	dw, err := newDumpWriter(&buf, DumpHeader{Version: dumpVersion, ShardID: 1})
+	require.NoError(t, err)
+	data := make([]byte, headerSize+8+4)
+	require.NoError(t, dw.write(pb.LogRecord{Lsn: 1, Data: data}))
+	require.NoError(t, dw.write(pb.LogRecord{Lsn: 2, Data: data}))
+	require.NoError(t, dw.close())
+	dump := buf.Bytes()
+	_, recs := readDump(t, dump)
+	assert.Equal(t, 2, len(recs))
+
+	_, err = NewDumpReader(bytes.NewReader([]byte("not a dump")))
+	assert.Error(t, err)
+
+	// truncated
+	r, err := NewDumpReader(bytes.NewReader(dump[:len(dump)-20]))
+	require.NoError(t, err)
+	for err == nil {
+		_, err = r.Next()
+	}
+	assert.NotEqual(t, io.EOF, err)
+
+	// flipped bit in the last record
+	corrupted := append([]byte(nil), dump...)
+	corrupted[len(corrupted)-21] ^= 0xff
+	r, err = NewDumpReader(bytes.NewReader(corrupted))
+	require.NoError(t, err)
+	for err == nil {
+		_, err = r.Next()
+	}
+	assert.NotEqual(t, io.EOF, err)
+}
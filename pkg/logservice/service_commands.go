@@ -43,6 +43,12 @@ func (s *Service) handleCommands(cmds []pb.ScheduleCommand) {
 				s.handleStopReplica(cmd)
 			case pb.KillZombie:
 				s.handleKillZombie(cmd)
+			case pb.AddNonVotingReplica:
+				s.handleAddNonVotingReplica(cmd)
+			case pb.RemoveNonVotingReplica:
+				s.handleRemoveNonVotingReplica(cmd)
+			case pb.StartNonVotingReplica:
+				s.handleStartNonVotingReplica(cmd)
 			default:
 				panic("unknown config change cmd type")
 			}
@@ -93,6 +99,33 @@ func (s *Service) handleStartReplica(cmd pb.ScheduleCommand) {
 	}
 }
 
+func (s *Service) handleAddNonVotingReplica(cmd pb.ScheduleCommand) {
+	shardID := cmd.ConfigChange.Replica.ShardID
+	replicaID := cmd.ConfigChange.Replica.ReplicaID
+	epoch := cmd.ConfigChange.Replica.Epoch
+	target := cmd.ConfigChange.Replica.UUID
+	if err := s.store.addNonVotingReplica(shardID, replicaID, target, epoch); err != nil {
+		s.runtime.Logger().Error("failed to add non-voting replica", zap.Error(err))
+	}
+}
+
+func (s *Service) handleRemoveNonVotingReplica(cmd pb.ScheduleCommand) {
+	shardID := cmd.ConfigChange.Replica.ShardID
+	replicaID := cmd.ConfigChange.Replica.ReplicaID
+	epoch := cmd.ConfigChange.Replica.Epoch
+	if err := s.store.removeReplica(shardID, replicaID, epoch); err != nil {
+		s.runtime.Logger().Error("failed to remove non-voting replica", zap.Error(err))
+	}
+}
+
+func (s *Service) handleStartNonVotingReplica(cmd pb.ScheduleCommand) {
+	shardID := cmd.ConfigChange.Replica.ShardID
+	replicaID := cmd.ConfigChange.Replica.ReplicaID
+	if err := s.store.startNonVotingReplica(shardID, replicaID); err != nil {
+		s.runtime.Logger().Error("failed to start non-voting replica", zap.Error(err))
+	}
+}
+
 func (s *Service) handleStopReplica(cmd pb.ScheduleCommand) {
 	shardID := cmd.ConfigChange.Replica.ShardID
 	replicaID := cmd.ConfigChange.Replica.ReplicaID
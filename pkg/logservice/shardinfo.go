@@ -32,6 +32,10 @@ type ShardInfo struct {
 	ReplicaID uint64
 	// Replicas is a map of replica ID to their service addresses
 	Replicas map[uint64]string
+	// NonVotingReplicas is a map of non-voting replica ID to their service
+	// addresses. It's only available when the queried Log Service node hosts
+	// a replica of the shard.
+	NonVotingReplicas map[uint64]string
 }
 
 // GetShardInfo is to be invoked when querying ShardInfo on a Log Service node.
@@ -105,12 +109,16 @@ func GetShardInfo(
 		return ShardInfo{}, false, nil
 	}
 	result := ShardInfo{
-		ReplicaID: si.LeaderID,
-		Replicas:  make(map[uint64]string),
+		ReplicaID:         si.LeaderID,
+		Replicas:          make(map[uint64]string),
+		NonVotingReplicas: make(map[uint64]string),
 	}
 	for replicaID, info := range si.Replicas {
 		result.Replicas[replicaID] = info.ServiceAddress
 	}
+	for replicaID, info := range si.NonVotingReplicas {
+		result.NonVotingReplicas[replicaID] = info.ServiceAddress
+	}
 	return result, true, nil
 }
 
@@ -142,5 +150,26 @@ func (s *Service) getShardInfo(shardID uint64) (pb.ShardInfoQueryResult, bool) {
 			ServiceAddress: md.serviceAddress,
 		}
 	}
+	// non-voting replicas are not gossiped, they are only known when the
+	// local store hosts a replica of the shard.
+	ctx, cancel := context.WithTimeout(context.Background(), membershipQueryTimeout)
+	defer cancel()
+	if m, err := s.store.nh.SyncGetShardMembership(ctx, shardID); err == nil {
+		for nodeID, uuid := range m.NonVotings {
+			data, ok := r.GetMeta(uuid)
+			if !ok {
+				continue
+			}
+			var md storeMeta
+			md.unmarshal(data)
+			if result.NonVotingReplicas == nil {
+				result.NonVotingReplicas = make(map[uint64]pb.ReplicaInfo)
+			}
+			result.NonVotingReplicas[nodeID] = pb.ReplicaInfo{
+				UUID:           uuid,
+				ServiceAddress: md.serviceAddress,
+			}
+		}
+	}
 	return result, true
 }
@@ -136,6 +136,9 @@ type store struct {
 	mu struct {
 		sync.Mutex
 		metadata metadata.LogStore
+		// nonVotings is the last known non-voting replicas of the shards led
+		// by the local replicas, keyed by shard ID.
+		nonVotings map[uint64]map[uint64]string
 	}
 	shardSnapshotInfo shardSnapshotInfo
 	snapshotMgr       *snapshotManager
@@ -168,6 +171,7 @@ func newLogStore(cfg Config,
 		snapshotMgr:       newSnapshotManager(&cfg),
 	}
 	ls.mu.metadata = metadata.LogStore{UUID: cfg.UUID}
+	ls.mu.nonVotings = make(map[uint64]map[uint64]string)
 	if err := ls.stopper.RunNamedTask("truncation-worker", func(ctx context.Context) {
 		rt.SubLogger(runtime.SystemInit).Info("logservice truncation worker started")
 		ls.truncationWorker(ctx)
@@ -201,6 +205,10 @@ func (l *store) startReplicas() error {
 			if err := l.startHAKeeperReplica(rec.ReplicaID, nil, false); err != nil {
 				return err
 			}
+		} else if rec.NonVoting {
+			if err := l.startNonVotingReplica(rec.ShardID, rec.ReplicaID); err != nil {
+				return err
+			}
 		} else {
 			if err := l.startReplica(rec.ShardID, rec.ReplicaID, nil, false); err != nil {
 				return err
@@ -246,6 +254,24 @@ func (l *store) startReplica(shardID uint64, replicaID uint64,
 	return nil
 }
 
+// startNonVotingReplica starts a non-voting replica which has been added to
+// the shard by addNonVotingReplica.
+func (l *store) startNonVotingReplica(shardID uint64, replicaID uint64) error {
+	if shardID == hakeeper.DefaultHAKeeperShardID {
+		return moerr.NewInvalidInputNoCtx("non-voting replica of the HAKeeper shard is not supported")
+	}
+	cfg := getRaftConfig(shardID, replicaID)
+	cfg.IsNonVoting = true
+	if err := l.snapshotMgr.Init(shardID, replicaID); err != nil {
+		panic(err)
+	}
+	if err := l.nh.StartReplica(nil, true, newStateMachine, cfg); err != nil {
+		return err
+	}
+	l.addNonVotingMetadata(shardID, replicaID)
+	return nil
+}
+
 func (l *store) stopReplica(shardID uint64, replicaID uint64) error {
 	if shardID == hakeeper.DefaultHAKeeperShardID {
 		defer func() {
@@ -283,6 +309,49 @@ func (l *store) addReplica(shardID uint64, replicaID uint64,
 	}
 }
 
+// addNonVotingReplica adds a non-voting replica to the shard. A non-voting
+// replica can be promoted to a voting one by addReplica with the same replica
+// ID and target.
+func (l *store) addNonVotingReplica(shardID uint64, replicaID uint64,
+	target dragonboat.Target, cci uint64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	count := 0
+	for {
+		count++
+		if err := l.nh.SyncRequestAddNonVoting(ctx, shardID, replicaID, target, cci); err != nil {
+			if errors.Is(err, dragonboat.ErrShardNotReady) {
+				l.retryWait()
+				continue
+			}
+			if errors.Is(err, dragonboat.ErrTimeoutTooSmall) && count > 1 {
+				return dragonboat.ErrTimeout
+			}
+			return err
+		}
+		return nil
+	}
+}
+
+// getNonVotingReplicas returns the non-voting replicas of the shard. The last
+// known ones are returned if the membership is not available.
+func (l *store) getNonVotingReplicas(shardID uint64) map[uint64]string {
+	ctx, cancel := context.WithTimeout(context.Background(), membershipQueryTimeout)
+	defer cancel()
+	m, err := l.nh.SyncGetShardMembership(ctx, shardID)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err != nil {
+		l.runtime.Logger().Warn("failed to get shard membership",
+			zap.Uint64("shard", shardID),
+			zap.Error(err))
+		return l.mu.nonVotings[shardID]
+	}
+	l.mu.nonVotings[shardID] = m.NonVotings
+	return m.NonVotings
+}
+
 func (l *store) removeReplica(shardID uint64, replicaID uint64, cci uint64) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
@@ -854,6 +923,7 @@ func (l *store) getHeartbeatMessage() pb.LogStoreHeartbeat {
 		ServiceAddress: l.cfg.LogServiceServiceAddr(),
 		GossipAddress:  l.cfg.GossipServiceAddr(),
 		Replicas:       make([]pb.LogReplicaInfo, 0),
+		Locality:       l.cfg.Locality,
 	}
 	opts := dragonboat.NodeHostInfoOption{
 		SkipLogInfo: true,
@@ -876,12 +946,20 @@ func (l *store) getHeartbeatMessage() pb.LogStoreHeartbeat {
 				LeaderID: ci.LeaderID,
 				Term:     ci.Term,
 			},
-			ReplicaID: ci.ReplicaID,
+			ReplicaID:   ci.ReplicaID,
+			IsNonVoting: ci.IsNonVoting,
 		}
 		// FIXME: why we need this?
 		if replicaInfo.Replicas == nil {
 			replicaInfo.Replicas = make(map[uint64]dragonboat.Target)
 		}
+		if ci.LeaderID == ci.ReplicaID && ci.ShardID != hakeeper.DefaultHAKeeperShardID {
+			replicaInfo.NonVotingReplicas = l.getNonVotingReplicas(ci.ShardID)
+		}
+		if !ci.IsNonVoting {
+			// the non-voting replica may have been promoted
+			l.promoteMetadata(ci.ShardID, ci.ReplicaID)
+		}
 		m.Replicas = append(m.Replicas, replicaInfo)
 	}
 	return m
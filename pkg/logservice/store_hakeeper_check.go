@@ -36,6 +36,9 @@ const (
 
 var (
 	hakeeperDefaultTimeout = 2 * time.Second
+	// membershipQueryTimeout is the timeout of querying the membership of a
+	// shard when sending heartbeats.
+	membershipQueryTimeout = 500 * time.Millisecond
 )
 
 type idAllocator struct {
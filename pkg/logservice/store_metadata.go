@@ -270,6 +270,20 @@ func (l *store) addMetadata(shardID uint64, replicaID uint64) {
 	rec := metadata.LogShard{}
 	rec.ShardID = shardID
 	rec.ReplicaID = replicaID
+	l.addShardMetadata(rec)
+}
+
+func (l *store) addNonVotingMetadata(shardID uint64, replicaID uint64) {
+	rec := metadata.LogShard{}
+	rec.ShardID = shardID
+	rec.ReplicaID = replicaID
+	rec.NonVoting = true
+	l.addShardMetadata(rec)
+}
+
+func (l *store) addShardMetadata(rec metadata.LogShard) {
+	shardID := rec.ShardID
+	replicaID := rec.ReplicaID
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
@@ -284,6 +298,21 @@ func (l *store) addMetadata(shardID uint64, replicaID uint64) {
 	l.mustSaveMetadata()
 }
 
+// promoteMetadata marks the replica as a voting one, if it's recorded as a
+// non-voting replica.
+func (l *store) promoteMetadata(shardID uint64, replicaID uint64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for i, rec := range l.mu.metadata.Shards {
+		if rec.ShardID == shardID && rec.ReplicaID == replicaID && rec.NonVoting {
+			l.mu.metadata.Shards[i].NonVoting = false
+			l.mustSaveMetadata()
+			return
+		}
+	}
+}
+
 func (l *store) removeMetadata(shardID uint64, replicaID uint64) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
@@ -81,6 +81,23 @@ func TestRemoveMetadata(t *testing.T) {
 	assert.Equal(t, uint64(2), ss.mu.metadata.Shards[0].ReplicaID)
 }
 
+func TestPromoteMetadata(t *testing.T) {
+	cfg := getStoreTestConfig()
+	defer vfs.ReportLeakedFD(cfg.FS, t)
+	s := store{cfg: cfg, runtime: runtime.DefaultRuntime()}
+	require.NoError(t, mkdirAll(s.cfg.DataDir, cfg.FS))
+	s.addNonVotingMetadata(10, 1)
+	require.Equal(t, 1, len(s.mu.metadata.Shards))
+	assert.True(t, s.mu.metadata.Shards[0].NonVoting)
+
+	s.promoteMetadata(10, 1)
+	ss := store{cfg: s.cfg, runtime: runtime.DefaultRuntime()}
+	ss.mu.metadata = metadata.LogStore{}
+	assert.NoError(t, ss.loadMetadata())
+	require.Equal(t, 1, len(ss.mu.metadata.Shards))
+	assert.False(t, ss.mu.metadata.Shards[0].NonVoting)
+}
+
 func TestStartReplicas(t *testing.T) {
 	cfg := getStoreTestConfig()
 	defer vfs.ReportLeakedFD(cfg.FS, t)
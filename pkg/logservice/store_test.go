@@ -547,6 +547,29 @@ func TestAddReplica(t *testing.T) {
 	runStoreTest(t, fn)
 }
 
+func TestAddNonVotingReplica(t *testing.T) {
+	fn := func(t *testing.T, store *store) {
+		for {
+			_, _, ok, err := store.nh.GetLeaderID(1)
+			require.NoError(t, err)
+			if ok {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		m, err := store.nh.SyncGetShardMembership(ctx, 1)
+		require.NoError(t, err)
+		target := uuid.New().String()
+		require.NoError(t, store.addNonVotingReplica(1, 100, target, m.ConfigChangeID))
+		hb := store.getHeartbeatMessage()
+		assert.Equal(t, 1, len(hb.Replicas[0].Replicas))
+		assert.Equal(t, map[uint64]string{100: target}, hb.Replicas[0].NonVotingReplicas)
+	}
+	runStoreTest(t, fn)
+}
+
 func getTestStores() (*store, *store, error) {
 	cfg1 := DefaultConfig()
 	cfg1.UUID = uuid.NewString()
@@ -43,6 +43,7 @@ const (
 const (
 	cacheKeyTypeMeta uint16 = iota
 	cacheKeyTypeBloomFilter
+	cacheKeyTypeColumnFilter
 )
 
 type CacheConfig struct {
@@ -218,6 +219,31 @@ func LoadBFWithMeta(
 	return bf, nil
 }
 
+// LoadColumnFiltersWithMeta loads the filters of the non sort key columns.
+// It returns nil if the object was written without any.
+func LoadColumnFiltersWithMeta(
+	ctx context.Context,
+	meta ObjectDataMeta,
+	location Location,
+	fs fileservice.FileService,
+) (ColumnFilters, error) {
+	extent := meta.BlockHeader().ColumnFilterExtent()
+	if extent.Length() == 0 {
+		return nil, nil
+	}
+	key := encodeCacheKey(*location.ShortName(), cacheKeyTypeColumnFilter)
+	v, ok := metaCache.Get(key)
+	if ok {
+		return v, nil
+	}
+	filters, err := ReadColumnFilters(ctx, location.Name().String(), &extent, fileservice.SkipMemoryCache|fileservice.SkipFullFilePreloads, fs)
+	if err != nil {
+		return nil, err
+	}
+	metaCache.Set(key, filters, int64(len(filters)))
+	return filters, nil
+}
+
 func FastLoadObjectMeta(
 	ctx context.Context,
 	location *Location,
@@ -25,6 +25,7 @@ const (
 	IOET_ColData = 2
 	IOET_BF      = 3
 	IOET_ZM      = 4
+	IOET_CF      = 5
 )
 
 const IOEntryHeaderSize = 4
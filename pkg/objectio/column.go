@@ -15,9 +15,20 @@
 package objectio
 
 import (
+	"hash/crc32"
+
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 )
 
+var checksumTable = crc32.MakeTable(crc32.Castagnoli)
+
+// ColumnChecksum is the checksum of the column data stored in the object,
+// it is computed on the compressed bytes so it can be verified without
+// decompressing.
+func ColumnChecksum(data []byte) uint32 {
+	return crc32.Checksum(data, checksumTable)
+}
+
 const (
 	dataTypeLen     = 1
 	idxOff          = dataTypeLen
@@ -98,10 +109,16 @@ func (cm ColumnMeta) SetZoneMap(zm ZoneMap) {
 	copy(cm[zoneMapOff:zoneMapOff+zoneMapLen], zm)
 }
 
+// Checksum returns the checksum of the column data. Columns written
+// without checksum return 0.
 func (cm ColumnMeta) Checksum() uint32 {
 	return types.DecodeUint32(cm[checkSumOff : checkSumOff+checkSumLen])
 }
 
+func (cm ColumnMeta) setChecksum(sum uint32) {
+	copy(cm[checkSumOff:checkSumOff+checkSumLen], types.EncodeUint32(&sum))
+}
+
 func (cm ColumnMeta) IsEmpty() bool {
 	return len(cm) == 0
 }
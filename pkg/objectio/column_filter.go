@@ -0,0 +1,104 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"bytes"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+// ColumnFilters is the area holding the filters of the non sort key
+// columns of all the blocks in an object:
+//
+// | BlockIndex | block 0 filters | block 1 filters | ... |
+//
+// and the filters of one block are laid out as
+//
+// | count(2) | [seqnum(2) type(1) offset(4) length(4)]... | data |
+//
+// where offset is relative to the start of the block filters and type is
+// the index.StaticFilter type of the filter.
+type ColumnFilters []byte
+
+const (
+	colFilterCountLen  = 2
+	colFilterSeqnumLen = 2
+	colFilterTypeLen   = 1
+	colFilterOffLen    = 4
+	colFilterLenLen    = 4
+	colFilterEntryLen  = colFilterSeqnumLen + colFilterTypeLen + colFilterOffLen + colFilterLenLen
+)
+
+type columnFilter struct {
+	seqnum uint16
+	typ    uint8
+	data   []byte
+}
+
+func (cf ColumnFilters) BlockCount() uint32 {
+	return BlockIndex(cf).BlockCount()
+}
+
+// GetFilter returns the filter of type typ built on column seqnum of block
+// blk. A column may have filters of different types.
+func (cf ColumnFilters) GetFilter(blk uint32, seqnum uint16, typ uint8) (data []byte, ok bool) {
+	if len(cf) == 0 || blk >= cf.BlockCount() {
+		return
+	}
+	offset, length := BlockIndex(cf).BlockMetaPos(blk)
+	if length == 0 {
+		return
+	}
+	buf := cf[offset : offset+length]
+	count := types.DecodeUint16(buf[:colFilterCountLen])
+	for i := uint16(0); i < count; i++ {
+		entry := buf[colFilterCountLen+uint32(i)*colFilterEntryLen:]
+		if types.DecodeUint16(entry[:colFilterSeqnumLen]) != seqnum {
+			continue
+		}
+		entry = entry[colFilterSeqnumLen:]
+		if types.DecodeUint8(entry[:colFilterTypeLen]) != typ {
+			continue
+		}
+		entry = entry[colFilterTypeLen:]
+		off := types.DecodeUint32(entry[:colFilterOffLen])
+		n := types.DecodeUint32(entry[colFilterOffLen : colFilterOffLen+colFilterLenLen])
+		return buf[off : off+n], true
+	}
+	return
+}
+
+func encodeColumnFilters(filters []columnFilter) []byte {
+	if len(filters) == 0 {
+		return nil
+	}
+	var buf bytes.Buffer
+	count := uint16(len(filters))
+	buf.Write(types.EncodeUint16(&count))
+	offset := uint32(colFilterCountLen + len(filters)*colFilterEntryLen)
+	for i := range filters {
+		n := uint32(len(filters[i].data))
+		buf.Write(types.EncodeUint16(&filters[i].seqnum))
+		buf.Write(types.EncodeUint8(&filters[i].typ))
+		buf.Write(types.EncodeUint32(&offset))
+		buf.Write(types.EncodeUint32(&n))
+		offset += n
+	}
+	for i := range filters {
+		buf.Write(filters[i].data)
+	}
+	return buf.Bytes()
+}
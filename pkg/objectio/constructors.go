@@ -42,9 +42,9 @@ func constructorFactory(size int64, algo uint8) CacheConstructor {
 			return cacheData, nil
 		}
 
-		// lz4 compress
+		// lz4 or zstd compress
 		decompressed := allocator.Alloc(int(size))
-		bs, err := compress.Decompress(data, decompressed.Bytes(), compress.Lz4)
+		bs, err := compress.Decompress(data, decompressed.Bytes(), int(algo))
 		if err != nil {
 			return
 		}
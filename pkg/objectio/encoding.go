@@ -0,0 +1,757 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/compute"
+)
+
+// ColumnEncoding is the lightweight encoding applied to a column block
+// before it is compressed. It is chosen per block and recorded both in
+// the column data entry and in the column meta.
+type ColumnEncoding = uint8
+
+const (
+	EncodingPlain ColumnEncoding = iota
+	EncodingDict
+	EncodingRLE
+	EncodingDelta
+	EncodingFOR
+)
+
+const (
+	// blocks with fewer rows are always written plain
+	encodingMinRows = 16
+)
+
+func EncodingString(enc ColumnEncoding) string {
+	switch enc {
+	case EncodingPlain:
+		return "Plain"
+	case EncodingDict:
+		return "Dict"
+	case EncodingRLE:
+		return "RLE"
+	case EncodingDelta:
+		return "Delta"
+	case EncodingFOR:
+		return "FOR"
+	}
+	return "Unknown"
+}
+
+// Layout of an IOET_ColumnData_V2 entry body:
+//
+//	Plain: | encoding(1) | vector.MarshalBinary |
+//	other: | encoding(1) | type | rows(4) | nspLen(4) | nsp | sorted(1) | payload |
+//
+// payload:
+//
+//	Dict:  | ndv(4) | width(1) | values | codes(rows*width) |
+//	RLE:   | runs(4) | values(runs*size) | ends(runs*4) |
+//	Delta: | base(8) | width(1) | deltas(rows*width) |
+//	FOR:   | base(8) | width(1) | offsets(rows*width) |
+//
+// Dictionary values are sorted ascending, so the order of the codes is the
+// order of the values. Varlen dictionary values are length prefixed.
+
+func EncodeColumnDataV2(ioe any) ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := encodeColumnData(ioe.(*vector.Vector), &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func DecodeColumnDataV2(buf []byte) (ioe any, err error) {
+	if len(buf) == 0 {
+		return nil, moerr.NewInternalErrorNoCtx("empty column data")
+	}
+	vec := vector.NewVec(types.Type{})
+	if buf[0] == EncodingPlain {
+		if err = vec.UnmarshalBinary(buf[1:]); err != nil {
+			return
+		}
+		return vec, nil
+	}
+	col, err := parseEncodedColumn(buf)
+	if err != nil {
+		return
+	}
+	data, area, err := col.decode()
+	if err != nil {
+		return
+	}
+	if err = vec.UnmarshalBinary(marshalPlainVector(&col.typ, col.rows, data, area, col.nsp, col.sorted)); err != nil {
+		return
+	}
+	return vec, nil
+}
+
+// encodeColumnData chooses an encoding for vec and writes the column data
+// entry body into buf
+func encodeColumnData(vec *vector.Vector, buf *bytes.Buffer) (enc ColumnEncoding, err error) {
+	e := newColumnEncoder(vec)
+	enc = e.choose()
+	buf.WriteByte(enc)
+	if enc == EncodingPlain {
+		err = vec.MarshalBinaryWithBuffer(buf)
+		return
+	}
+	nsp, err := vec.GetNulls().Show()
+	if err != nil {
+		return
+	}
+	rows := uint32(e.rows)
+	nspLen := uint32(len(nsp))
+	sorted := vec.GetSorted()
+	buf.Write(types.EncodeType(vec.GetType()))
+	buf.Write(types.EncodeUint32(&rows))
+	buf.Write(types.EncodeUint32(&nspLen))
+	buf.Write(nsp)
+	buf.Write(types.EncodeBool(&sorted))
+	switch enc {
+	case EncodingDict:
+		e.writeDict(buf)
+	case EncodingRLE:
+		e.writeRLE(buf)
+	case EncodingDelta, EncodingFOR:
+		e.writeInts(buf, enc)
+	}
+	return
+}
+
+type columnEncoder struct {
+	vec    *vector.Vector
+	rows   int
+	size   int
+	varlen bool
+	data   []byte
+
+	// integer types only
+	isInt  bool
+	signed bool
+
+	dict  [][]byte
+	codes []uint32
+
+	base  uint64
+	width int
+}
+
+func newColumnEncoder(vec *vector.Vector) *columnEncoder {
+	e := &columnEncoder{
+		vec:    vec,
+		rows:   vec.Length(),
+		size:   vec.GetType().TypeSize(),
+		varlen: vec.GetType().IsVarlen(),
+	}
+	e.isInt, e.signed = intEncodable(vec.GetType().Oid)
+	if !vec.IsConst() {
+		e.data = vec.UnsafeGetRawData()
+	}
+	return e
+}
+
+func (e *columnEncoder) value(i int) []byte {
+	if e.varlen {
+		return e.vec.GetBytesAt(i)
+	}
+	return e.data[i*e.size : (i+1)*e.size]
+}
+
+func (e *columnEncoder) intAt(i int) uint64 {
+	return readSlot(e.data[i*e.size:], e.size, e.signed)
+}
+
+func (e *columnEncoder) less(a, b uint64) bool {
+	if e.signed {
+		return int64(a) < int64(b)
+	}
+	return a < b
+}
+
+// choose returns the encoding with the smallest estimated size. An encoding
+// is only used if it saves at least a quarter of the plain size.
+func (e *columnEncoder) choose() ColumnEncoding {
+	if e.vec.IsConst() || e.rows < encodingMinRows || len(e.data) < e.rows*e.size {
+		return EncodingPlain
+	}
+	if e.vec.GetType().Oid == types.T_any {
+		return EncodingPlain
+	}
+	plain := e.rows*e.size + len(e.vec.GetArea())
+	best, bestSize := EncodingPlain, plain*3/4
+
+	if dictEncodable(e.vec.GetType().Oid) {
+		if size, ok := e.estimateDict(); ok && size < bestSize {
+			best, bestSize = EncodingDict, size
+		}
+	}
+	if !e.varlen {
+		if size := e.estimateRLE(); size < bestSize {
+			best, bestSize = EncodingRLE, size
+		}
+	}
+	if e.isInt {
+		minV, maxV := e.intAt(0), e.intAt(0)
+		var maxDelta uint64
+		ordered := true
+		prev := minV
+		for i := 1; i < e.rows; i++ {
+			v := e.intAt(i)
+			if e.less(v, minV) {
+				minV = v
+			}
+			if e.less(maxV, v) {
+				maxV = v
+			}
+			if ordered {
+				if e.less(v, prev) {
+					ordered = false
+				} else if d := v - prev; d > maxDelta {
+					maxDelta = d
+				}
+			}
+			prev = v
+		}
+		if width := byteWidth(maxV - minV); width < e.size {
+			if size := e.rows*width + 9; size < bestSize {
+				best, bestSize = EncodingFOR, size
+				e.base, e.width = minV, width
+			}
+		}
+		if width := byteWidth(maxDelta); ordered && width < e.size {
+			if size := e.rows*width + 9; size < bestSize {
+				best = EncodingDelta
+				e.base, e.width = e.intAt(0), width
+			}
+		}
+	}
+	if best != EncodingDict {
+		e.dict, e.codes = nil, nil
+	}
+	return best
+}
+
+func (e *columnEncoder) estimateDict() (size int, ok bool) {
+	limit := e.rows / 2
+	index := make(map[string]uint32)
+	nsp := e.vec.GetNulls()
+	e.codes = make([]uint32, e.rows)
+	for i := 0; i < e.rows; i++ {
+		if nsp.Contains(uint64(i)) {
+			continue
+		}
+		v := e.value(i)
+		code, found := index[string(v)]
+		if !found {
+			if len(e.dict) >= limit {
+				return 0, false
+			}
+			code = uint32(len(e.dict))
+			index[string(v)] = code
+			e.dict = append(e.dict, v)
+			size += len(v)
+			if e.varlen {
+				size += 4
+			}
+		}
+		e.codes[i] = code
+	}
+	if len(e.dict) == 0 {
+		return 0, false
+	}
+	size += 5 + e.rows*byteWidth(uint64(len(e.dict)-1))
+	return size, true
+}
+
+func (e *columnEncoder) estimateRLE() int {
+	runs := 1
+	for i := 1; i < e.rows; i++ {
+		if !bytes.Equal(e.value(i), e.value(i-1)) {
+			runs++
+		}
+	}
+	return 4 + runs*(e.size+4)
+}
+
+func (e *columnEncoder) writeDict(buf *bytes.Buffer) {
+	// sort the dictionary so that range filters on values keep working
+	// when they are evaluated on the dictionary, in the order of zonemaps
+	typ := e.vec.GetType()
+	order := make([]int64, len(e.dict))
+	for i := range order {
+		order[i] = int64(i)
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return compute.Compare(e.dict[order[i]], e.dict[order[j]], typ.Oid, typ.Scale, typ.Scale) < 0
+	})
+	remap := make([]uint32, len(order))
+	values := make([][]byte, len(order))
+	for pos, old := range order {
+		remap[old] = uint32(pos)
+		values[pos] = e.dict[old]
+	}
+
+	ndv := uint32(len(values))
+	width := byteWidth(uint64(ndv - 1))
+	buf.Write(types.EncodeUint32(&ndv))
+	buf.WriteByte(uint8(width))
+	for _, v := range values {
+		if e.varlen {
+			l := uint32(len(v))
+			buf.Write(types.EncodeUint32(&l))
+		}
+		buf.Write(v)
+	}
+	codes := make([]byte, e.rows*width)
+	for i, code := range e.codes {
+		putPacked(codes[i*width:], width, uint64(remap[code]))
+	}
+	buf.Write(codes)
+}
+
+func (e *columnEncoder) writeRLE(buf *bytes.Buffer) {
+	var values []byte
+	var ends []uint32
+	for i := 0; i < e.rows; i++ {
+		if i == 0 || !bytes.Equal(e.value(i), e.value(i-1)) {
+			values = append(values, e.value(i)...)
+			ends = append(ends, uint32(i+1))
+		} else {
+			ends[len(ends)-1] = uint32(i + 1)
+		}
+	}
+	runs := uint32(len(ends))
+	buf.Write(types.EncodeUint32(&runs))
+	buf.Write(values)
+	for i := range ends {
+		buf.Write(types.EncodeUint32(&ends[i]))
+	}
+}
+
+// writeInts writes the Delta and FOR payloads. Both store a base and one
+// packed unsigned value per row, they only differ in what is packed.
+func (e *columnEncoder) writeInts(buf *bytes.Buffer, enc ColumnEncoding) {
+	buf.Write(types.EncodeUint64(&e.base))
+	buf.WriteByte(uint8(e.width))
+	packed := make([]byte, e.rows*e.width)
+	prev := e.base
+	for i := 0; i < e.rows; i++ {
+		v := e.intAt(i)
+		var p uint64
+		if enc == EncodingDelta {
+			p = v - prev
+			prev = v
+		} else {
+			p = v - e.base
+		}
+		putPacked(packed[i*e.width:], e.width, p)
+	}
+	buf.Write(packed)
+}
+
+type encodedColumn struct {
+	enc     ColumnEncoding
+	typ     types.Type
+	rows    int
+	nsp     []byte
+	sorted  bool
+	payload []byte
+}
+
+func parseEncodedColumn(buf []byte) (col encodedColumn, err error) {
+	if len(buf) < 1+types.TSize+8 {
+		err = moerr.NewInternalErrorNoCtx("bad encoded column data")
+		return
+	}
+	col.enc = buf[0]
+	buf = buf[1:]
+	col.typ = types.DecodeType(buf[:types.TSize])
+	buf = buf[types.TSize:]
+	col.rows = int(types.DecodeUint32(buf[:4]))
+	nspLen := int(types.DecodeUint32(buf[4:8]))
+	buf = buf[8:]
+	if len(buf) < nspLen+1 {
+		err = moerr.NewInternalErrorNoCtx("bad encoded column data")
+		return
+	}
+	col.nsp = buf[:nspLen]
+	col.sorted = types.DecodeBool(buf[nspLen : nspLen+1])
+	col.payload = buf[nspLen+1:]
+	return
+}
+
+// decode returns the plain data and area of the column
+func (col *encodedColumn) decode() (data, area []byte, err error) {
+	size := col.typ.TypeSize()
+	data = make([]byte, col.rows*size)
+	switch col.enc {
+	case EncodingDict:
+		var values [][]byte
+		var codes []uint32
+		if values, codes, err = col.dict(); err != nil {
+			return
+		}
+		var slots []byte
+		slots, area = buildSlots(&col.typ, values)
+		for i, code := range codes {
+			copy(data[i*size:(i+1)*size], slots[int(code)*size:])
+		}
+	case EncodingRLE:
+		p := col.payload
+		if len(p) < 4 {
+			return nil, nil, moerr.NewInternalErrorNoCtx("bad rle column data")
+		}
+		runs := int(types.DecodeUint32(p[:4]))
+		p = p[4:]
+		if len(p) < runs*(size+4) {
+			return nil, nil, moerr.NewInternalErrorNoCtx("bad rle column data")
+		}
+		values, ends := p[:runs*size], p[runs*size:]
+		start := 0
+		for r := 0; r < runs; r++ {
+			end := int(types.DecodeUint32(ends[r*4 : r*4+4]))
+			if end > col.rows {
+				return nil, nil, moerr.NewInternalErrorNoCtx("bad rle column data")
+			}
+			for i := start; i < end; i++ {
+				copy(data[i*size:(i+1)*size], values[r*size:(r+1)*size])
+			}
+			start = end
+		}
+	case EncodingDelta, EncodingFOR:
+		p := col.payload
+		if len(p) < 9 {
+			return nil, nil, moerr.NewInternalErrorNoCtx("bad integer column data")
+		}
+		base := types.DecodeUint64(p[:8])
+		width := int(p[8])
+		p = p[9:]
+		if len(p) < col.rows*width {
+			return nil, nil, moerr.NewInternalErrorNoCtx("bad integer column data")
+		}
+		v := base
+		for i := 0; i < col.rows; i++ {
+			var packed uint64
+			if width > 0 {
+				packed = getPacked(p[i*width:], width)
+			}
+			if col.enc == EncodingDelta {
+				v += packed
+			} else {
+				v = base + packed
+			}
+			writeSlot(data[i*size:], size, v)
+		}
+	default:
+		err = moerr.NewInternalErrorNoCtx("unknown column encoding %d", col.enc)
+	}
+	return
+}
+
+// dict returns the dictionary values and the code of every row
+func (col *encodedColumn) dict() (values [][]byte, codes []uint32, err error) {
+	p := col.payload
+	if len(p) < 5 {
+		return nil, nil, moerr.NewInternalErrorNoCtx("bad dict column data")
+	}
+	ndv := int(types.DecodeUint32(p[:4]))
+	width := int(p[4])
+	p = p[5:]
+	values = make([][]byte, ndv)
+	varlen := col.typ.IsVarlen()
+	size := col.typ.TypeSize()
+	for i := range values {
+		l := size
+		if varlen {
+			if len(p) < 4 {
+				return nil, nil, moerr.NewInternalErrorNoCtx("bad dict column data")
+			}
+			l = int(types.DecodeUint32(p[:4]))
+			p = p[4:]
+		}
+		if len(p) < l {
+			return nil, nil, moerr.NewInternalErrorNoCtx("bad dict column data")
+		}
+		values[i] = p[:l]
+		p = p[l:]
+	}
+	if len(p) < col.rows*width {
+		return nil, nil, moerr.NewInternalErrorNoCtx("bad dict column data")
+	}
+	codes = make([]uint32, col.rows)
+	for i := range codes {
+		codes[i] = uint32(getPacked(p[i*width:], width))
+		if int(codes[i]) >= ndv {
+			return nil, nil, moerr.NewInternalErrorNoCtx("bad dict column data")
+		}
+	}
+	return
+}
+
+// DictColumn is a dictionary encoded column block that is not decoded.
+// Dict holds the distinct non-null values in ascending order and Codes[i]
+// is the position in Dict of the value of row i. The code of a null row is
+// undefined.
+type DictColumn struct {
+	Dict  *vector.Vector
+	Codes []uint32
+	Nulls *nulls.Nulls
+}
+
+// DecodeDictColumn returns the dictionary form of a column data entry read
+// from the file service. It returns nil if the entry is not dictionary
+// encoded.
+func DecodeDictColumn(buf []byte) (*DictColumn, error) {
+	h := DecodeIOEntryHeader(buf)
+	if h.Type != IOET_ColData || h.Version < IOET_ColumnData_V2 {
+		return nil, nil
+	}
+	buf = buf[IOEntryHeaderSize:]
+	if len(buf) == 0 || buf[0] != EncodingDict {
+		return nil, nil
+	}
+	col, err := parseEncodedColumn(buf)
+	if err != nil {
+		return nil, err
+	}
+	values, codes, err := col.dict()
+	if err != nil {
+		return nil, err
+	}
+	data, area := buildSlots(&col.typ, values)
+	dict := vector.NewVec(types.Type{})
+	if err = dict.UnmarshalBinary(marshalPlainVector(&col.typ, len(values), data, area, nil, true)); err != nil {
+		return nil, err
+	}
+	nsp := nulls.NewWithSize(0)
+	if len(col.nsp) > 0 {
+		if err = nsp.ReadNoCopy(col.nsp); err != nil {
+			return nil, err
+		}
+	}
+	return &DictColumn{
+		Dict:  dict,
+		Codes: codes,
+		Nulls: nsp,
+	}, nil
+}
+
+func (c *DictColumn) Length() int {
+	return len(c.Codes)
+}
+
+// Filter evaluates fn on the dictionary instead of the rows and returns the
+// offsets of the non-null rows whose value was selected by fn. fn must
+// return offsets into the vector it is given.
+func (c *DictColumn) Filter(fn func(*vector.Vector) []int64) []int64 {
+	hits := fn(c.Dict)
+	if len(hits) == 0 {
+		return nil
+	}
+	selected := make([]bool, c.Dict.Length())
+	for _, pos := range hits {
+		selected[pos] = true
+	}
+	var sels []int64
+	for i, code := range c.Codes {
+		if selected[code] && !c.Nulls.Contains(uint64(i)) {
+			sels = append(sels, int64(i))
+		}
+	}
+	return sels
+}
+
+// Groups returns the group of every row for a hash group-by on the
+// column. Rows with the same value share a group, the group of a non-null
+// row is its code and all null rows fall into group Dict.Length(). The
+// keys of the groups are the values of Dict.
+func (c *DictColumn) Groups() (groups []uint32, hasNull bool) {
+	groups = make([]uint32, len(c.Codes))
+	nullGroup := uint32(c.Dict.Length())
+	for i, code := range c.Codes {
+		if c.Nulls.Contains(uint64(i)) {
+			groups[i] = nullGroup
+			hasNull = true
+		} else {
+			groups[i] = code
+		}
+	}
+	return
+}
+
+// marshalPlainVector builds the vector.MarshalBinary form of a flat vector
+func marshalPlainVector(
+	typ *types.Type, rows int, data, area, nsp []byte, sorted bool,
+) []byte {
+	var buf bytes.Buffer
+	buf.Grow(1 + types.TSize + 16 + len(data) + len(area) + len(nsp) + 1)
+	buf.WriteByte(uint8(vector.FLAT))
+	buf.Write(types.EncodeType(typ))
+	length := uint32(rows)
+	buf.Write(types.EncodeUint32(&length))
+	dataLen := uint32(len(data))
+	buf.Write(types.EncodeUint32(&dataLen))
+	buf.Write(data)
+	areaLen := uint32(len(area))
+	buf.Write(types.EncodeUint32(&areaLen))
+	buf.Write(area)
+	nspLen := uint32(len(nsp))
+	buf.Write(types.EncodeUint32(&nspLen))
+	buf.Write(nsp)
+	buf.Write(types.EncodeBool(&sorted))
+	return buf.Bytes()
+}
+
+// buildSlots builds the fixed size slots and the area of values
+func buildSlots(typ *types.Type, values [][]byte) (data, area []byte) {
+	if !typ.IsVarlen() {
+		data = make([]byte, 0, len(values)*typ.TypeSize())
+		for _, v := range values {
+			data = append(data, v...)
+		}
+		return
+	}
+	data = make([]byte, len(values)*types.VarlenaSize)
+	for i, v := range values {
+		var va types.Varlena
+		if len(v) <= types.VarlenaInlineSize {
+			va[0] = byte(len(v))
+			copy(va[1:], v)
+		} else {
+			va.SetOffsetLen(uint32(len(area)), uint32(len(v)))
+			area = append(area, v...)
+		}
+		copy(data[i*types.VarlenaSize:], va[:])
+	}
+	return
+}
+
+func intEncodable(oid types.T) (ok, signed bool) {
+	switch oid {
+	case types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_date, types.T_datetime, types.T_time, types.T_timestamp:
+		return true, true
+	case types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64:
+		return true, false
+	}
+	return false, false
+}
+
+// dictEncodable returns true if the values of the type can be sorted
+// to build an ordered dictionary
+func dictEncodable(oid types.T) bool {
+	switch oid {
+	case types.T_bool, types.T_bit,
+		types.T_int8, types.T_int16, types.T_int32, types.T_int64,
+		types.T_uint8, types.T_uint16, types.T_uint32, types.T_uint64,
+		types.T_float32, types.T_float64,
+		types.T_date, types.T_datetime, types.T_time, types.T_timestamp,
+		types.T_enum, types.T_decimal64, types.T_decimal128, types.T_uuid,
+		types.T_char, types.T_varchar, types.T_blob, types.T_text,
+		types.T_binary, types.T_varbinary, types.T_datalink:
+		return true
+	}
+	return false
+}
+
+func byteWidth(v uint64) int {
+	switch {
+	case v == 0:
+		return 0
+	case v <= 0xff:
+		return 1
+	case v <= 0xffff:
+		return 2
+	case v <= 0xffffffff:
+		return 4
+	}
+	return 8
+}
+
+func putPacked(dst []byte, width int, v uint64) {
+	switch width {
+	case 1:
+		dst[0] = byte(v)
+	case 2:
+		binary.LittleEndian.PutUint16(dst, uint16(v))
+	case 4:
+		binary.LittleEndian.PutUint32(dst, uint32(v))
+	case 8:
+		binary.LittleEndian.PutUint64(dst, v)
+	}
+}
+
+func getPacked(src []byte, width int) uint64 {
+	switch width {
+	case 1:
+		return uint64(src[0])
+	case 2:
+		return uint64(binary.LittleEndian.Uint16(src))
+	case 4:
+		return uint64(binary.LittleEndian.Uint32(src))
+	case 8:
+		return binary.LittleEndian.Uint64(src)
+	}
+	return 0
+}
+
+// readSlot reads a little endian integer of size bytes, sign extended if
+// signed is true
+func readSlot(src []byte, size int, signed bool) uint64 {
+	switch size {
+	case 1:
+		if signed {
+			return uint64(int64(int8(src[0])))
+		}
+		return uint64(src[0])
+	case 2:
+		v := binary.LittleEndian.Uint16(src)
+		if signed {
+			return uint64(int64(int16(v)))
+		}
+		return uint64(v)
+	case 4:
+		v := binary.LittleEndian.Uint32(src)
+		if signed {
+			return uint64(int64(int32(v)))
+		}
+		return uint64(v)
+	}
+	return binary.LittleEndian.Uint64(src)
+}
+
+func writeSlot(dst []byte, size int, v uint64) {
+	switch size {
+	case 1:
+		dst[0] = byte(v)
+	case 2:
+		binary.LittleEndian.PutUint16(dst, uint16(v))
+	case 4:
+		binary.LittleEndian.PutUint32(dst, uint32(v))
+	default:
+		binary.LittleEndian.PutUint64(dst, v)
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeDecode(t *testing.T, vec *vector.Vector) (ColumnEncoding, *vector.Vector) {
+	var buf bytes.Buffer
+	enc, err := encodeColumnData(vec, &buf)
+	require.NoError(t, err)
+	obj, err := DecodeColumnDataV2(buf.Bytes())
+	require.NoError(t, err)
+	return enc, obj.(*vector.Vector)
+}
+
+func requireSameVector(t *testing.T, expected, actual *vector.Vector) {
+	require.Equal(t, *expected.GetType(), *actual.GetType())
+	require.Equal(t, expected.Length(), actual.Length())
+	for i := 0; i < expected.Length(); i++ {
+		require.Equal(t, expected.IsNull(uint64(i)), actual.IsNull(uint64(i)))
+		if !expected.IsNull(uint64(i)) {
+			require.Equal(t, expected.GetRawBytesAt(i), actual.GetRawBytesAt(i))
+		}
+	}
+}
+
+func TestColumnEncoding(t *testing.T) {
+	mp := mpool.MustNewZero()
+	rows := 1000
+
+	// low cardinality strings, some of them not inlined
+	dict := vector.NewVec(types.T_varchar.ToType())
+	for i := 0; i < rows; i++ {
+		v := []byte(fmt.Sprintf("city-%d", (i*7)%5))
+		if i%3 == 0 {
+			v = []byte(strings.Repeat("long", 10) + string(v))
+		}
+		require.NoError(t, vector.AppendBytes(dict, v, i%11 == 0, mp))
+	}
+	defer dict.Free(mp)
+
+	// long runs
+	rle := vector.NewVec(types.T_float64.ToType())
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendFixed(rle, float64(i/100)+0.5, false, mp))
+	}
+	defer rle.Free(mp)
+
+	// sorted with a wide range but small steps
+	delta := vector.NewVec(types.T_int64.ToType())
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendFixed(delta, int64(-1<<40+i*3), false, mp))
+	}
+	defer delta.Free(mp)
+
+	// unsorted in a narrow range
+	frame := vector.NewVec(types.T_int32.ToType())
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendFixed(frame, int32(-50000+(i*7919)%60000), i%13 == 0, mp))
+	}
+	defer frame.Free(mp)
+
+	unsigned := vector.NewVec(types.T_uint64.ToType())
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendFixed(unsigned, uint64(1<<63)+uint64((i*7919)%60000), false, mp))
+	}
+	defer unsigned.Free(mp)
+
+	// random values are left plain
+	plain := vector.NewVec(types.T_int64.ToType())
+	for i := 0; i < rows; i++ {
+		require.NoError(t, vector.AppendFixed(plain, int64(i)*0x1234567890123, false, mp))
+	}
+	defer plain.Free(mp)
+
+	short := vector.NewVec(types.T_int64.ToType())
+	require.NoError(t, vector.AppendFixed(short, int64(1), false, mp))
+	defer short.Free(mp)
+
+	cases := []struct {
+		vec *vector.Vector
+		enc ColumnEncoding
+	}{
+		{dict, EncodingDict},
+		{rle, EncodingRLE},
+		{delta, EncodingDelta},
+		{frame, EncodingFOR},
+		{unsigned, EncodingFOR},
+		{plain, EncodingPlain},
+		{short, EncodingPlain},
+		{vector.NewConstNull(types.T_int32.ToType(), rows, mp), EncodingPlain},
+	}
+	for i, c := range cases {
+		enc, vec := encodeDecode(t, c.vec)
+		require.Equal(t, EncodingString(c.enc), EncodingString(enc), "case %d", i)
+		requireSameVector(t, c.vec, vec)
+	}
+}
+
+func TestDictColumn(t *testing.T) {
+	mp := mpool.MustNewZero()
+	vec := vector.NewVec(types.T_varchar.ToType())
+	defer vec.Free(mp)
+	names := []string{"d", "b", "c", "a"}
+	for i := 0; i < 100; i++ {
+		require.NoError(t, vector.AppendBytes(vec, []byte(names[i%4]), i%10 == 9, mp))
+	}
+
+	var buf bytes.Buffer
+	h := IOEntryHeader{IOET_ColData, IOET_ColumnData_CurrVer}
+	buf.Write(EncodeIOEntryHeader(&h))
+	enc, err := encodeColumnData(vec, &buf)
+	require.NoError(t, err)
+	require.Equal(t, EncodingDict, enc)
+
+	col, err := DecodeDictColumn(buf.Bytes())
+	require.NoError(t, err)
+	require.NotNil(t, col)
+	require.Equal(t, 100, col.Length())
+	// the dictionary is sorted
+	require.Equal(t, 4, col.Dict.Length())
+	for i, v := range []string{"a", "b", "c", "d"} {
+		require.Equal(t, v, col.Dict.GetStringAt(i))
+	}
+
+	// filter on the dictionary
+	sels := col.Filter(func(dict *vector.Vector) []int64 {
+		var hits []int64
+		for i := 0; i < dict.Length(); i++ {
+			if dict.GetStringAt(i) >= "c" {
+				hits = append(hits, int64(i))
+			}
+		}
+		return hits
+	})
+	var expected []int64
+	for i := 0; i < 100; i++ {
+		if i%10 != 9 && names[i%4] >= "c" {
+			expected = append(expected, int64(i))
+		}
+	}
+	require.Equal(t, expected, sels)
+
+	// group by codes
+	groups, hasNull := col.Groups()
+	require.True(t, hasNull)
+	for i, g := range groups {
+		if i%10 == 9 {
+			require.Equal(t, uint32(4), g)
+		} else {
+			require.Equal(t, names[i%4], col.Dict.GetStringAt(int(g)))
+		}
+	}
+
+	// entries that are not dictionary encoded
+	buf.Reset()
+	buf.Write(EncodeIOEntryHeader(&h))
+	_, err = encodeColumnData(vector.NewConstNull(types.T_int32.ToType(), 10, mp), &buf)
+	require.NoError(t, err)
+	col, err = DecodeDictColumn(buf.Bytes())
+	require.NoError(t, err)
+	require.Nil(t, col)
+}
+
+func TestObjectWriterEncoding(t *testing.T) {
+	ctx := context.Background()
+
+	dir := InitTestEnv(ModuleName, t.Name())
+	dir = path.Join(dir, "/local")
+	mp := mpool.MustNewZero()
+	bat := batch.NewWithSize(2)
+	bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_varchar.ToType())
+	defer bat.Clean(mp)
+	for i := 0; i < 8192; i++ {
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], int64(i), false, mp))
+		require.NoError(t, vector.AppendBytes(bat.Vecs[1], []byte(fmt.Sprintf("v%d", i%3)), false, mp))
+	}
+	bat.SetRowCount(8192)
+
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+		Cache:   fileservice.DisabledCacheConfig,
+	}
+	service, err := fileservice.NewFileService(ctx, c, nil)
+	require.NoError(t, err)
+	defer service.Close()
+
+	objectWriter, err := NewObjectWriterSpecial(WriterNormal, "encoding.blk", service)
+	require.NoError(t, err)
+	_, err = objectWriter.Write(bat)
+	require.NoError(t, err)
+	_, err = objectWriter.WriteEnd(ctx)
+	require.NoError(t, err)
+
+	pool, err := mpool.NewMPool("objectio_test", 0, mpool.NoFixed)
+	require.NoError(t, err)
+	objectReader, err := NewObjectReaderWithStr("encoding.blk", service)
+	require.NoError(t, err)
+	meta, err := objectReader.ReadAllMeta(ctx, pool)
+	require.NoError(t, err)
+	require.Equal(t, EncodingDelta, meta.ColumnEncoding(0, 0))
+	require.Equal(t, EncodingDict, meta.ColumnEncoding(0, 1))
+	require.Equal(t, EncodingPlain, meta.ColumnEncoding(0, 2))
+	require.Equal(t, EncodingPlain, meta.ColumnEncoding(1, 0))
+	require.Equal(t, EncodingPlain, objectMetaV3(meta.(objectMetaV4)).ColumnEncoding(0, 1))
+
+	ioVec, err := objectReader.ReadOneBlock(
+		ctx, []uint16{0, 1}, []types.Type{types.T_int64.ToType(), types.T_varchar.ToType()}, 0, pool)
+	require.NoError(t, err)
+	defer ioVec.Release()
+	for i := range bat.Vecs {
+		obj, err := Decode(ioVec.Entries[i].CachedData.Bytes())
+		require.NoError(t, err)
+		requireSameVector(t, bat.Vecs[i], obj.(*vector.Vector))
+	}
+	col, err := DecodeDictColumn(ioVec.Entries[1].CachedData.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, 3, col.Dict.Length())
+}
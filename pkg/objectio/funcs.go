@@ -99,6 +99,34 @@ func ReadBloomFilter(
 	return
 }
 
+func ReadColumnFilters(
+	ctx context.Context,
+	name string,
+	extent *Extent,
+	policy fileservice.Policy,
+	fs fileservice.FileService,
+) (filters ColumnFilters, err error) {
+	var v []byte
+	if v, err = ReadExtent(
+		ctx,
+		name,
+		extent,
+		policy,
+		fs,
+		constructorFactory); err != nil {
+		return
+	}
+
+	var obj any
+	obj, err = Decode(v)
+	if err != nil {
+		return
+	}
+
+	filters = obj.([]byte)
+	return
+}
+
 func ReadObjectMeta(
 	ctx context.Context,
 	name string,
@@ -222,7 +250,7 @@ func ReadOneBlockWithMeta(
 					meta.BlockHeader().BlockID().String(), filledEntries[i].Size, typs[i])
 				buf := &bytes.Buffer{}
 				buf.Write(EncodeIOEntryHeader(&IOEntryHeader{Type: IOET_ColData, Version: IOET_ColumnData_CurrVer}))
-				if err = vector.NewConstNull(typs[i], length, m).MarshalBinaryWithBuffer(buf); err != nil {
+				if _, err = encodeColumnData(vector.NewConstNull(typs[i], length, m), buf); err != nil {
 					return
 				}
 				cacheData := fileservice.GetDefaultCacheDataAllocator().Alloc(buf.Len())
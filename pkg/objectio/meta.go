@@ -161,8 +161,10 @@ const (
 	sortKeyLen         = 2
 	bloomFilterTypeOff = sortKeyOff + sortKeyLen
 	bloomFilterTypeLen = 1
-	headerDummyOff     = bloomFilterTypeOff + bloomFilterTypeLen
-	headerDummyLen     = 29
+	columnFilterOff    = bloomFilterTypeOff + bloomFilterTypeLen
+	columnFilterLen    = ExtentSize
+	headerDummyOff     = columnFilterOff + columnFilterLen
+	headerDummyLen     = 16
 	headerLen          = headerDummyOff + headerDummyLen
 )
 
@@ -289,6 +291,16 @@ func (bh BlockHeader) BloomFilterType() uint8 {
 	return types.DecodeUint8(bh[bloomFilterTypeOff : bloomFilterTypeOff+bloomFilterTypeLen])
 }
 
+// ColumnFilterExtent is the location of the filters built on non sort key
+// columns. It is empty if the object has none.
+func (bh BlockHeader) ColumnFilterExtent() Extent {
+	return Extent(bh[columnFilterOff : columnFilterOff+columnFilterLen])
+}
+
+func (bh BlockHeader) SetColumnFilterExtent(location Extent) {
+	copy(bh[columnFilterOff:columnFilterOff+columnFilterLen], location)
+}
+
 func (bh BlockHeader) IsEmpty() bool {
 	return len(bh) == 0
 }
@@ -71,6 +71,10 @@ func (o objectMetaV1) SetTombstoneMetaOffset(offset uint32) {
 	panic("implement me")
 }
 
+func (o objectMetaV1) ColumnEncoding(blk uint32, seqnum uint16) ColumnEncoding {
+	return EncodingPlain
+}
+
 func (o objectMetaV1) SubMeta(pos uint16) (ObjectDataMeta, bool) {
 	return ObjectDataMeta(o), true
 }
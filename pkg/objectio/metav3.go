@@ -94,6 +94,10 @@ func (mh objectMetaV3) SetTombstoneMetaOffset(offset uint32) {
 	copy(mh[tombstoneMetaCountOff+tombstoneMetaCount:tombstoneMetaCountOff+tombstoneMetaCount+tombstoneMetaOffset], types.EncodeUint32(&offset))
 }
 
+func (mh objectMetaV3) ColumnEncoding(blk uint32, seqnum uint16) ColumnEncoding {
+	return EncodingPlain
+}
+
 func (mh objectMetaV3) SubMeta(pos uint16) (objectDataMetaV1, bool) {
 	offStart := schemaCountLen + uint32(pos)*typePosLen + schemaType + schemaBlockCount + metaHeaderLen
 	offEnd := schemaCountLen + uint32(pos)*typePosLen + typePosLen + metaHeaderLen
@@ -0,0 +1,151 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+)
+
+const (
+	encodingAreaOff = metaDummyOff
+	encodingAreaLen = 4
+)
+
+// objectMetaV4 extends objectMetaV3 with an encoding area placed after the
+// metas. The area keeps one ColumnEncoding per column of every data block,
+// in block order, and its offset is stored in the reserved bytes of the
+// header. Objects written with it may contain IOET_ColumnData_V2 entries.
+type objectMetaV4 []byte
+
+func buildObjectMetaV4() objectMetaV4 {
+	var buf [metaHeaderLen]byte
+	return buf[:]
+}
+
+func (mh objectMetaV4) MustGetMeta(metaType DataMetaType) objectDataMetaV1 {
+	if metaType == SchemaData {
+		return mh.MustDataMeta()
+	} else if metaType == SchemaTombstone {
+		return mh.MustTombstoneMeta()
+	}
+	return nil
+}
+
+func (mh objectMetaV4) HeaderLength() uint32 {
+	return metaHeaderLen
+}
+
+func (mh objectMetaV4) DataMetaCount() uint16 {
+	return types.DecodeUint16(mh[:dataMetaCount])
+}
+
+func (mh objectMetaV4) TombstoneMetaCount() uint16 {
+	return types.DecodeUint16(mh[tombstoneMetaCountOff : tombstoneMetaCountOff+tombstoneMetaCount])
+}
+
+func (mh objectMetaV4) DataMeta() (objectDataMetaV1, bool) {
+	if mh.DataMetaCount() == 0 {
+		return nil, false
+	}
+	offset := types.DecodeUint32(mh[dataMetaCount:tombstoneMetaCountOff])
+	return objectDataMetaV1(mh[offset:]), true
+}
+
+func (mh objectMetaV4) MustDataMeta() objectDataMetaV1 {
+	meta, ok := mh.DataMeta()
+	if !ok {
+		panic("no data meta")
+	}
+	return meta
+}
+
+func (mh objectMetaV4) TombstoneMeta() (objectDataMetaV1, bool) {
+	if mh.TombstoneMetaCount() == 0 {
+		return nil, false
+	}
+	offset := types.DecodeUint32(mh[tombstoneMetaCountOff+tombstoneMetaCount : metaDummyOff])
+	return objectDataMetaV1(mh[offset:]), true
+}
+
+func (mh objectMetaV4) MustTombstoneMeta() objectDataMetaV1 {
+	meta, ok := mh.TombstoneMeta()
+	if !ok {
+		panic("no tombstone meta")
+	}
+	return meta
+}
+
+func (mh objectMetaV4) SetDataMetaCount(count uint16) {
+	copy(mh[:dataMetaCount], types.EncodeUint16(&count))
+}
+
+func (mh objectMetaV4) SetDataMetaOffset(offset uint32) {
+	copy(mh[dataMetaCount:dataMetaCount+dataMetaOffset], types.EncodeUint32(&offset))
+}
+
+func (mh objectMetaV4) SetTombstoneMetaCount(count uint16) {
+	copy(mh[tombstoneMetaCountOff:tombstoneMetaCountOff+tombstoneMetaCount], types.EncodeUint16(&count))
+}
+
+func (mh objectMetaV4) SetTombstoneMetaOffset(offset uint32) {
+	copy(mh[tombstoneMetaCountOff+tombstoneMetaCount:tombstoneMetaCountOff+tombstoneMetaCount+tombstoneMetaOffset], types.EncodeUint32(&offset))
+}
+
+func (mh objectMetaV4) SetEncodingAreaOffset(offset uint32) {
+	copy(mh[encodingAreaOff:encodingAreaOff+encodingAreaLen], types.EncodeUint32(&offset))
+}
+
+// ColumnEncoding returns the encoding of the column data of the data block
+func (mh objectMetaV4) ColumnEncoding(blk uint32, seqnum uint16) ColumnEncoding {
+	offset := types.DecodeUint32(mh[encodingAreaOff : encodingAreaOff+encodingAreaLen])
+	if offset == 0 {
+		return EncodingPlain
+	}
+	meta, ok := mh.DataMeta()
+	if !ok || blk >= uint32(mh.DataMetaCount()) {
+		return EncodingPlain
+	}
+	colCnt := meta.BlockHeader().MetaColumnCount()
+	if seqnum >= colCnt {
+		return EncodingPlain
+	}
+	return mh[offset+blk*uint32(colCnt)+uint32(seqnum)]
+}
+
+func (mh objectMetaV4) SubMeta(pos uint16) (objectDataMetaV1, bool) {
+	offStart := schemaCountLen + uint32(pos)*typePosLen + schemaType + schemaBlockCount + metaHeaderLen
+	offEnd := schemaCountLen + uint32(pos)*typePosLen + typePosLen + metaHeaderLen
+	offset := types.DecodeUint32(mh[offStart:offEnd])
+	return objectDataMetaV1(mh[offset:]), true
+}
+
+func (mh objectMetaV4) SubMetaCount() uint16 {
+	return types.DecodeUint16(mh[metaHeaderLen : metaHeaderLen+schemaCountLen])
+}
+
+func (mh objectMetaV4) SubMetaIndex() SubMetaIndex {
+	return SubMetaIndex(mh[metaHeaderLen:])
+}
+
+func (mh objectMetaV4) SubMetaTypes() []uint16 {
+	cnt := mh.SubMetaCount()
+	subMetaTypes := make([]uint16, cnt)
+	for i := uint16(0); i < cnt; i++ {
+		offStart := schemaCountLen + i*typePosLen + metaHeaderLen
+		offEnd := schemaCountLen + i*typePosLen + schemaType + metaHeaderLen
+		subMetaTypes[i] = types.DecodeUint16(mh[offStart:offEnd])
+	}
+	return subMetaTypes
+}
@@ -135,6 +135,10 @@ type ObjectMeta interface {
 
 	SetTombstoneMetaOffset(offset uint32)
 
+	// ColumnEncoding returns the encoding of the column data of a data
+	// block, it is always EncodingPlain before IOET_ObjectMeta_V4
+	ColumnEncoding(blk uint32, seqnum uint16) ColumnEncoding
+
 	SubMeta(pos uint16) (ObjectDataMeta, bool)
 
 	SubMetaCount() uint16
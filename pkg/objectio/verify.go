@@ -0,0 +1,157 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectio
+
+import (
+	"context"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+)
+
+// VerifyObject reads every extent of the object bypassing the caches, so
+// the checksums of the file service are checked, and decodes them. The
+// column data is also checked with the checksum recorded in its meta.
+// It returns the number of extents verified.
+func VerifyObject(
+	ctx context.Context,
+	name string,
+	fs fileservice.FileService,
+) (extents int, err error) {
+	defer func() {
+		// the corrupted data may not be decoded safely
+		if r := recover(); r != nil {
+			err = moerr.NewInternalErrorNoCtx("object %s is corrupted: %v", name, r)
+		}
+	}()
+	reader, err := NewObjectReaderWithStr(
+		name,
+		fs,
+		WithMetaCachePolicyOption(fileservice.SkipAllCache),
+		WithDataCachePolicyOption(fileservice.SkipAllCache),
+	)
+	if err != nil {
+		return
+	}
+	meta, err := reader.ReadAllMeta(ctx, nil)
+	if err != nil {
+		return
+	}
+	extents++
+
+	seen := make(map[uint32]struct{})
+	verify := func(ext Extent, checksum uint32) error {
+		if ext.Length() == 0 {
+			return nil
+		}
+		if _, ok := seen[ext.Offset()]; ok {
+			return nil
+		}
+		seen[ext.Offset()] = struct{}{}
+		extents++
+		return verifyExtent(ctx, name, ext, checksum, fs)
+	}
+	metas := make([]ObjectDataMeta, 0, 2+meta.SubMetaCount())
+	if dataMeta, ok := meta.DataMeta(); ok {
+		metas = append(metas, dataMeta)
+	}
+	if tombstoneMeta, ok := meta.TombstoneMeta(); ok {
+		metas = append(metas, tombstoneMeta)
+	}
+	for pos := uint16(0); pos < meta.SubMetaCount(); pos++ {
+		if subMeta, ok := meta.SubMeta(pos); ok {
+			metas = append(metas, subMeta)
+		}
+	}
+	for _, dataMeta := range metas {
+		if dataMeta.IsEmpty() {
+			continue
+		}
+		header := dataMeta.BlockHeader()
+		for _, ext := range []Extent{header.BFExtent(), header.ZoneMapArea(), header.ColumnFilterExtent()} {
+			if len(ext) == 0 {
+				continue
+			}
+			if err = verify(ext, 0); err != nil {
+				return
+			}
+		}
+		start := uint32(header.StartID())
+		for blk := start; blk < start+dataMeta.BlockCount(); blk++ {
+			blkMeta := dataMeta.GetBlockMeta(blk)
+			for seqnum := uint16(0); seqnum < blkMeta.GetMetaColumnCount(); seqnum++ {
+				col := blkMeta.ColumnMeta(seqnum)
+				if col.DataType() == 0 {
+					continue
+				}
+				if err = verify(col.Location(), col.Checksum()); err != nil {
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+func verifyExtent(
+	ctx context.Context,
+	name string,
+	ext Extent,
+	checksum uint32,
+	fs fileservice.FileService,
+) error {
+	ioVec := &fileservice.IOVector{
+		FilePath: name,
+		Entries: []fileservice.IOEntry{
+			{
+				Offset: int64(ext.Offset()),
+				Size:   int64(ext.Length()),
+			},
+		},
+		Policy: fileservice.SkipAllCache,
+	}
+	if err := fs.Read(ctx, ioVec); err != nil {
+		return err
+	}
+	defer ioVec.Release()
+	data := ioVec.Entries[0].Data
+	if checksum != 0 && ColumnChecksum(data) != checksum {
+		return moerr.NewInternalErrorNoCtx("checksum of extent %s of object %s mismatched",
+			ext.String(), name)
+	}
+	buf := data
+	if ext.Alg() != compress.None {
+		buf = make([]byte, ext.OriginSize())
+		decompressed, err := compress.Decompress(data, buf, int(ext.Alg()))
+		if err != nil {
+			return moerr.NewInternalErrorNoCtx("failed to decompress extent %s of object %s: %v",
+				ext.String(), name, err)
+		}
+		if len(decompressed) != int(ext.OriginSize()) {
+			return moerr.NewInternalErrorNoCtx("size %d of extent %s of object %s is not equal to %d",
+				len(decompressed), ext.String(), name, ext.OriginSize())
+		}
+		buf = decompressed
+	}
+	if len(buf) < IOEntryHeaderSize {
+		return moerr.NewInternalErrorNoCtx("extent %s of object %s is too short", ext.String(), name)
+	}
+	if _, err := Decode(buf); err != nil {
+		return moerr.NewInternalErrorNoCtx("failed to decode extent %s of object %s: %v",
+			ext.String(), name, err)
+	}
+	return nil
+}
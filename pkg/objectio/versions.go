@@ -34,28 +34,35 @@ var (
 )
 
 const (
-	IOET_ObjectMeta_V1  = 1
-	IOET_ObjectMeta_V2  = 2
-	IOET_ObjectMeta_V3  = 3
-	IOET_ColumnData_V1  = 1
-	IOET_BloomFilter_V1 = 1
-	IOET_BloomFilter_V2 = 2
-	IOET_ZoneMap_V1     = 1
+	IOET_ObjectMeta_V1   = 1
+	IOET_ObjectMeta_V2   = 2
+	IOET_ObjectMeta_V3   = 3
+	IOET_ObjectMeta_V4   = 4
+	IOET_ColumnData_V1   = 1
+	IOET_ColumnData_V2   = 2
+	IOET_BloomFilter_V1  = 1
+	IOET_BloomFilter_V2  = 2
+	IOET_ZoneMap_V1      = 1
+	IOET_ColumnFilter_V1 = 1
 
-	IOET_ObjectMeta_CurrVer  = IOET_ObjectMeta_V3
-	IOET_ColumnData_CurrVer  = IOET_ColumnData_V1
-	IOET_BloomFilter_CurrVer = IOET_BloomFilter_V2
-	IOET_ZoneMap_CurrVer     = IOET_ZoneMap_V1
+	IOET_ObjectMeta_CurrVer   = IOET_ObjectMeta_V4
+	IOET_ColumnData_CurrVer   = IOET_ColumnData_V2
+	IOET_BloomFilter_CurrVer  = IOET_BloomFilter_V2
+	IOET_ZoneMap_CurrVer      = IOET_ZoneMap_V1
+	IOET_ColumnFilter_CurrVer = IOET_ColumnFilter_V1
 )
 
 func init() {
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ObjMeta, IOET_ObjectMeta_V1}, nil, DecodeObjectMetaV1)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ObjMeta, IOET_ObjectMeta_V2}, nil, DecodeObjectMetaV2)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ObjMeta, IOET_ObjectMeta_V3}, nil, DecodeObjectMetaV3)
+	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ObjMeta, IOET_ObjectMeta_V4}, nil, DecodeObjectMetaV4)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ColData, IOET_ColumnData_V1}, EncodeColumnDataV1, DecodeColumnDataV1)
+	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ColData, IOET_ColumnData_V2}, EncodeColumnDataV2, DecodeColumnDataV2)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_BF, IOET_BloomFilter_V1}, nil, nil)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_BF, IOET_BloomFilter_V2}, nil, nil)
 	RegisterIOEnrtyCodec(IOEntryHeader{IOET_ZM, IOET_ZoneMap_V1}, nil, nil)
+	RegisterIOEnrtyCodec(IOEntryHeader{IOET_CF, IOET_ColumnFilter_V1}, nil, nil)
 }
 
 func EncodeColumnDataV1(ioe any) (buf []byte, err error) {
@@ -81,3 +88,7 @@ func DecodeObjectMetaV2(buf []byte) (ioe any, err error) {
 func DecodeObjectMetaV3(buf []byte) (ioe any, err error) {
 	return objectMetaV3(buf), nil
 }
+
+func DecodeObjectMetaV4(buf []byte) (ioe any, err error) {
+	return objectMetaV4(buf), nil
+}
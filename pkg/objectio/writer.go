@@ -26,7 +26,6 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
-	"github.com/pierrec/lz4/v4"
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
@@ -47,6 +46,7 @@ type objectWriterV1 struct {
 	lastId            uint32
 	name              ObjectName
 	compressBuf       []byte
+	compressAlg       uint8
 	bloomFilter       []byte
 	objStats          []ObjectStats
 	sortKeySeqnum     uint16
@@ -60,6 +60,9 @@ type blockData struct {
 	seqnums     *Seqnums
 	data        [][]byte
 	bloomFilter []byte
+	filters     []columnFilter
+	// encodings of the column data, indexed by seqnum
+	encodings []ColumnEncoding
 }
 
 type WriterType int8
@@ -101,6 +104,7 @@ func newObjectWriterSpecialV1(wt WriterType, fileName string, fs fileservice.Fil
 		blocks:        make([][]blockData, 2),
 		lastId:        0,
 		sortKeySeqnum: math.MaxUint16,
+		compressAlg:   compress.Lz4,
 	}
 	writer.blocks[SchemaData] = make([]blockData, 0)
 	writer.blocks[SchemaTombstone] = make([]blockData, 0)
@@ -120,6 +124,7 @@ func newObjectWriterV1(name ObjectName, fs fileservice.FileService, schemaVersio
 		blocks:        make([][]blockData, 2),
 		lastId:        0,
 		sortKeySeqnum: math.MaxUint16,
+		compressAlg:   compress.Lz4,
 	}
 	writer.blocks[SchemaData] = make([]blockData, 0)
 	writer.blocks[SchemaTombstone] = make([]blockData, 0)
@@ -223,10 +228,23 @@ func (w *objectWriterV1) WriteBF(blkIdx int, seqnum uint16, buf []byte, typ uint
 	return
 }
 
+// WriteColumnFilter attaches a filter of type typ built on column seqnum
+// to the blkIdx-th data block
+func (w *objectWriterV1) WriteColumnFilter(blkIdx int, seqnum uint16, typ uint8, buf []byte) {
+	block := &w.blocks[SchemaData][blkIdx]
+	block.filters = append(block.filters, columnFilter{seqnum: seqnum, typ: typ, data: buf})
+}
+
 func (w *objectWriterV1) SetAppendable() {
 	w.appendable = true
 }
 
+// SetCompressAlg sets the compress algorithm of the column data, the algorithm
+// is recorded in the extent of each column, the meta is always compressed by lz4.
+func (w *objectWriterV1) SetCompressAlg(alg uint8) {
+	w.compressAlg = alg
+}
+
 func (w *objectWriterV1) SetSortKeySeqnum(seqnum uint16) {
 	w.sortKeySeqnum = seqnum
 }
@@ -383,6 +401,37 @@ func (w *objectWriterV1) prepareZoneMapArea(blocks []blockData, blockCount uint3
 	return w.WriteWithCompress(offset, buf.Bytes())
 }
 
+func (w *objectWriterV1) prepareColumnFilters(blocks []blockData, blockCount uint32, offset uint32) ([]byte, Extent, error) {
+	datas := make([][]byte, len(blocks))
+	empty := true
+	for i := range blocks {
+		datas[i] = encodeColumnFilters(blocks[i].filters)
+		empty = empty && len(datas[i]) == 0
+	}
+	if empty {
+		return nil, nil, nil
+	}
+	buf := new(bytes.Buffer)
+	h := IOEntryHeader{IOET_CF, IOET_ColumnFilter_CurrVer}
+	buf.Write(EncodeIOEntryHeader(&h))
+	start := uint32(0)
+	filterIndex := BuildBlockIndex(blockCount)
+	filterIndex.SetBlockCount(blockCount)
+	start += filterIndex.Length()
+	for i := range datas {
+		n := uint32(len(datas[i]))
+		filterIndex.SetBlockMetaPos(uint32(i), start, n)
+		start += n
+	}
+	buf.Write(filterIndex)
+	for i := range datas {
+		buf.Write(datas[i])
+	}
+	length := uint32(len(buf.Bytes()))
+	extent := NewExtent(compress.None, offset, length, length)
+	return buf.Bytes(), extent, nil
+}
+
 func (w *objectWriterV1) getMaxIndex(blocks []blockData) uint16 {
 	if len(blocks) == 0 {
 		return 0
@@ -397,6 +446,20 @@ func (w *objectWriterV1) getMaxIndex(blocks []blockData) uint16 {
 	return uint16(maxIndex)
 }
 
+// prepareEncodingArea lays out the encodings of the columns of the data
+// blocks, one byte per column of the object meta for every block
+func (w *objectWriterV1) prepareEncodingArea(blocks []blockData, meta objectDataMetaV1) []byte {
+	if len(blocks) == 0 {
+		return nil
+	}
+	colCnt := int(meta.BlockHeader().MetaColumnCount())
+	area := make([]byte, len(blocks)*colCnt)
+	for i, block := range blocks {
+		copy(area[i*colCnt:(i+1)*colCnt], block.encodings)
+	}
+	return area
+}
+
 // writerBlocks writes blocks to object file
 // If the compressed data exceeds 3G,
 // an error of limited writing is returned
@@ -436,12 +499,13 @@ func (w *objectWriterV1) WriteEnd(ctx context.Context, items ...WriteOptions) ([
 		offset = w.prepareBlockMeta(offset, w.blocks[i], w.tombstonesColmeta)
 	}
 
-	metaHeader := buildObjectMetaV3()
+	metaHeader := buildObjectMetaV4()
 	objectMetas := make([]objectDataMetaV1, len(w.blocks))
 	bloomFilterDatas := make([][]byte, len(w.blocks))
 	bloomFilterExtents := make([]Extent, len(w.blocks))
 	zoneMapAreaDatas := make([][]byte, len(w.blocks))
 	zoneMapAreaExtents := make([]Extent, len(w.blocks))
+	columnFilterDatas := make([][]byte, len(w.blocks))
 	metas := make([][]byte, len(w.blocks))
 	metaExtents := make([]Extent, len(w.blocks))
 	for i := range w.blocks {
@@ -469,6 +533,18 @@ func (w *objectWriterV1) WriteEnd(ctx context.Context, items ...WriteOptions) ([
 		objectMetas[i].BlockHeader().SetZoneMapArea(zoneMapAreaExtents[i])
 		offset += zoneMapAreaExtents[i].Length()
 		w.originSize += zoneMapAreaExtents[i].OriginSize()
+
+		// prepare the filters of non sort key columns
+		var columnFilterExtent Extent
+		columnFilterDatas[i], columnFilterExtent, err = w.prepareColumnFilters(w.blocks[i], uint32(len(w.blocks[i])), offset)
+		if err != nil {
+			return nil, err
+		}
+		if columnFilterExtent != nil {
+			objectMetas[i].BlockHeader().SetColumnFilterExtent(columnFilterExtent)
+			offset += columnFilterExtent.Length()
+			w.originSize += columnFilterExtent.OriginSize()
+		}
 	}
 	subMetaCount := uint16(len(w.blocks) - 2)
 	subMetachIndex := BuildSubMetaIndex(subMetaCount)
@@ -495,6 +571,10 @@ func (w *objectWriterV1) WriteEnd(ctx context.Context, items ...WriteOptions) ([
 		idxStart += metaExtents[i].OriginSize()
 		startID += uint16(len(w.blocks[i]))
 	}
+	encodingArea := w.prepareEncodingArea(w.blocks[SchemaData], objectMetas[SchemaData])
+	if len(encodingArea) > 0 {
+		metaHeader.SetEncodingAreaOffset(idxStart)
+	}
 	var buf bytes.Buffer
 	h := IOEntryHeader{IOET_ObjMeta, IOET_ObjectMeta_CurrVer}
 	buf.Write(EncodeIOEntryHeader(&h))
@@ -504,6 +584,7 @@ func (w *objectWriterV1) WriteEnd(ctx context.Context, items ...WriteOptions) ([
 	for i := range metas {
 		buf.Write(metas[i])
 	}
+	buf.Write(encodingArea)
 	objMeta, extent, err := w.WriteWithCompress(start, buf.Bytes())
 	objectHeader.SetExtent(extent)
 
@@ -523,6 +604,9 @@ func (w *objectWriterV1) WriteEnd(ctx context.Context, items ...WriteOptions) ([
 	for i := range bloomFilterDatas {
 		w.buffer.Write(bloomFilterDatas[i])
 		w.buffer.Write(zoneMapAreaDatas[i])
+		if len(columnFilterDatas[i]) > 0 {
+			w.buffer.Write(columnFilterDatas[i])
+		}
 	}
 	// writer object metadata
 	w.buffer.Write(objMeta)
@@ -608,19 +692,29 @@ func (w *objectWriterV1) GetDataStats() ObjectStats {
 }
 
 func (w *objectWriterV1) WriteWithCompress(offset uint32, buf []byte) (data []byte, extent Extent, err error) {
+	return w.writeWithCompressAlg(offset, buf, compress.Lz4)
+}
+
+func (w *objectWriterV1) writeWithCompressAlg(offset uint32, buf []byte, alg uint8) (data []byte, extent Extent, err error) {
 	var tmpData []byte
 	dataLen := len(buf)
-	compressBlockBound := lz4.CompressBlockBound(dataLen)
+	if alg == compress.None {
+		data = make([]byte, dataLen)
+		copy(data, buf)
+		extent = NewExtent(compress.None, offset, uint32(dataLen), uint32(dataLen))
+		return
+	}
+	compressBlockBound := compress.CompressBlockBound(dataLen, int(alg))
 	if len(w.compressBuf) < compressBlockBound {
 		w.compressBuf = make([]byte, compressBlockBound)
 	}
-	if tmpData, err = compress.Compress(buf, w.compressBuf[:compressBlockBound], compress.Lz4); err != nil {
+	if tmpData, err = compress.Compress(buf, w.compressBuf[:compressBlockBound], int(alg)); err != nil {
 		return
 	}
 	length := uint32(len(tmpData))
 	data = make([]byte, length)
 	copy(data, tmpData[:length])
-	extent = NewExtent(compress.Lz4, offset, length, uint32(dataLen))
+	extent = NewExtent(alg, offset, length, uint32(dataLen))
 	return
 }
 
@@ -629,7 +723,11 @@ func (w *objectWriterV1) addBlock(blocks *[]blockData, blockMeta BlockObject, ba
 	// block.BlockHeader()return w.WriteWithCompress(offset, buf.Bytes()).SetBlockID(w.lastId)
 	blockMeta.BlockHeader().SetSequence(uint16(w.lastId))
 
-	block := blockData{meta: blockMeta, seqnums: seqnums}
+	block := blockData{
+		meta:      blockMeta,
+		seqnums:   seqnums,
+		encodings: make([]ColumnEncoding, blockMeta.GetMetaColumnCount()),
+	}
 	var data []byte
 	var buf bytes.Buffer
 	var rows int
@@ -647,18 +745,20 @@ func (w *objectWriterV1) addBlock(blocks *[]blockData, blockMeta BlockObject, ba
 		buf.Reset()
 		h := IOEntryHeader{IOET_ColData, IOET_ColumnData_CurrVer}
 		buf.Write(EncodeIOEntryHeader(&h))
-		err := vec.MarshalBinaryWithBuffer(&buf)
+		enc, err := encodeColumnData(vec, &buf)
 		if err != nil {
 			return 0, err
 		}
 		var ext Extent
-		if data, ext, err = w.WriteWithCompress(0, buf.Bytes()); err != nil {
+		if data, ext, err = w.writeWithCompressAlg(0, buf.Bytes(), w.compressAlg); err != nil {
 			return 0, err
 		}
 		size += len(data)
 		block.data = append(block.data, data)
 		blockMeta.ColumnMeta(seqnums.Seqs[i]).setLocation(ext)
+		blockMeta.ColumnMeta(seqnums.Seqs[i]).setChecksum(ColumnChecksum(data))
 		blockMeta.ColumnMeta(seqnums.Seqs[i]).setDataType(uint8(vec.GetType().Oid))
+		block.encodings[seqnums.Seqs[i]] = enc
 		if vec.GetType().Oid == types.T_any {
 			panic("any type batch")
 		}
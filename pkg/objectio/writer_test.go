@@ -24,7 +24,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -343,6 +345,197 @@ func TestNewObjectReader(t *testing.T) {
 	assert.Equal(t, uint32(1), meta.BlockCount())
 }
 
+func TestObjectWriterCompressAlg(t *testing.T) {
+	ctx := context.Background()
+
+	dir := InitTestEnv(ModuleName, t.Name())
+	dir = path.Join(dir, "/local")
+	mp := mpool.MustNewZero()
+	bat := newBatch(mp)
+	defer bat.Clean(mp)
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+		Cache:   fileservice.DisabledCacheConfig,
+	}
+	service, err := fileservice.NewFileService(ctx, c, nil)
+	require.NoError(t, err)
+	defer service.Close()
+
+	pool, err := mpool.NewMPool("objectio_test", 0, mpool.NoFixed)
+	require.NoError(t, err)
+	typs := []types.Type{types.T_int8.ToType(), types.T_int32.ToType(), types.T_int64.ToType()}
+	idxs := []uint16{0, 2, 3}
+
+	for _, alg := range []uint8{compress.None, compress.Lz4, compress.Zstd} {
+		name := fmt.Sprintf("%d.blk", alg)
+		objectWriter, err := NewObjectWriterSpecial(WriterNormal, name, service)
+		require.NoError(t, err)
+		objectWriter.SetCompressAlg(alg)
+		_, err = objectWriter.Write(bat)
+		require.NoError(t, err)
+		blocks, err := objectWriter.WriteEnd(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(blocks))
+
+		// the algorithm is recorded in the extent of each column
+		for _, idx := range idxs {
+			require.Equal(t, alg, blocks[0].MustGetColumn(idx).Location().Alg())
+		}
+
+		objectReader, err := NewObjectReaderWithStr(name, service)
+		require.NoError(t, err)
+		_, err = objectReader.ReadAllMeta(ctx, pool)
+		require.NoError(t, err)
+		vec, err := objectReader.ReadOneBlock(ctx, idxs, typs, 0, pool)
+		require.NoError(t, err)
+
+		obj, err := Decode(vec.Entries[0].CachedData.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, int8(3), vector.MustFixedCol[int8](obj.(*vector.Vector))[3])
+		obj, err = Decode(vec.Entries[1].CachedData.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, int32(3), vector.MustFixedCol[int32](obj.(*vector.Vector))[3])
+		obj, err = Decode(vec.Entries[2].CachedData.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, int64(3), vector.GetFixedAt[int64](obj.(*vector.Vector), 3))
+		vec.Release()
+	}
+}
+
+func TestObjectWriterColumnFilter(t *testing.T) {
+	ctx := context.Background()
+
+	dir := InitTestEnv(ModuleName, t.Name())
+	dir = path.Join(dir, "/local")
+	mp := mpool.MustNewZero()
+	bat := newBatch(mp)
+	defer bat.Clean(mp)
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+		Cache:   fileservice.DisabledCacheConfig,
+	}
+	service, err := fileservice.NewFileService(ctx, c, nil)
+	require.NoError(t, err)
+	defer service.Close()
+	pool, err := mpool.NewMPool("objectio_test", 0, mpool.NoFixed)
+	require.NoError(t, err)
+
+	objectWriter, err := NewObjectWriterSpecial(WriterNormal, "filter.blk", service)
+	require.NoError(t, err)
+	_, err = objectWriter.Write(bat)
+	require.NoError(t, err)
+	_, err = objectWriter.Write(bat)
+	require.NoError(t, err)
+	objectWriter.WriteColumnFilter(0, 1, 0, []byte("block0-col1"))
+	objectWriter.WriteColumnFilter(0, 3, 3, []byte("block0-col3"))
+	objectWriter.WriteColumnFilter(0, 1, 3, []byte("block0-col1-type3"))
+	objectWriter.WriteColumnFilter(1, 2, 0, []byte("block1-col2"))
+	_, err = objectWriter.WriteEnd(ctx)
+	require.NoError(t, err)
+
+	objectReader, err := NewObjectReaderWithStr("filter.blk", service)
+	require.NoError(t, err)
+	meta, err := objectReader.ReadAllMeta(ctx, pool)
+	require.NoError(t, err)
+	extent := meta.MustDataMeta().BlockHeader().ColumnFilterExtent()
+	require.NotZero(t, extent.Length())
+	filters, err := ReadColumnFilters(ctx, "filter.blk", &extent, fileservice.SkipMemoryCache, service)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), filters.BlockCount())
+
+	data, ok := filters.GetFilter(0, 3, 3)
+	require.True(t, ok)
+	require.Equal(t, "block0-col3", string(data))
+	data, ok = filters.GetFilter(0, 1, 3)
+	require.True(t, ok)
+	require.Equal(t, "block0-col1-type3", string(data))
+	data, ok = filters.GetFilter(0, 1, 0)
+	require.True(t, ok)
+	require.Equal(t, "block0-col1", string(data))
+	_, ok = filters.GetFilter(0, 3, 0)
+	require.False(t, ok)
+	data, ok = filters.GetFilter(1, 2, 0)
+	require.True(t, ok)
+	require.Equal(t, "block1-col2", string(data))
+	_, ok = filters.GetFilter(1, 1, 0)
+	require.False(t, ok)
+	_, ok = filters.GetFilter(2, 1, 0)
+	require.False(t, ok)
+
+	// the data is still readable
+	vec, err := objectReader.ReadOneBlock(ctx, []uint16{0}, []types.Type{types.T_int8.ToType()}, 1, pool)
+	require.NoError(t, err)
+	obj, err := Decode(vec.Entries[0].CachedData.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, int8(3), vector.MustFixedCol[int8](obj.(*vector.Vector))[3])
+	vec.Release()
+
+	// objects without filters
+	objectWriter, err = NewObjectWriterSpecial(WriterNormal, "nofilter.blk", service)
+	require.NoError(t, err)
+	_, err = objectWriter.Write(bat)
+	require.NoError(t, err)
+	_, err = objectWriter.WriteEnd(ctx)
+	require.NoError(t, err)
+	objectReader, err = NewObjectReaderWithStr("nofilter.blk", service)
+	require.NoError(t, err)
+	meta, err = objectReader.ReadAllMeta(ctx, pool)
+	require.NoError(t, err)
+	require.Zero(t, meta.MustDataMeta().BlockHeader().ColumnFilterExtent().Length())
+	filters, err = LoadColumnFiltersWithMeta(ctx, meta.MustDataMeta(), nil, service)
+	require.NoError(t, err)
+	require.Nil(t, filters)
+	_, ok = filters.GetFilter(0, 0, 0)
+	require.False(t, ok)
+}
+
+func TestVerifyObject(t *testing.T) {
+	ctx := context.Background()
+	mp := mpool.MustNewZero()
+	bat := newBatch(mp)
+	defer bat.Clean(mp)
+	service, err := fileservice.NewMemoryFS(defines.LocalFileServiceName, fileservice.DisabledCacheConfig, nil)
+	require.NoError(t, err)
+
+	objectWriter, err := NewObjectWriterSpecial(WriterNormal, "scrub.blk", service)
+	require.NoError(t, err)
+	_, err = objectWriter.Write(bat)
+	require.NoError(t, err)
+	_, err = objectWriter.Write(bat)
+	require.NoError(t, err)
+	blocks, err := objectWriter.WriteEnd(ctx)
+	require.NoError(t, err)
+	col := blocks[1].MustGetColumn(2)
+	require.NotZero(t, col.Checksum())
+
+	extents, err := VerifyObject(ctx, "scrub.blk", service)
+	require.NoError(t, err)
+	// meta, bloom filter, zone map area and 2 blocks of 8 columns
+	require.Equal(t, 19, extents)
+
+	// flip a byte of a column extent
+	iov := &fileservice.IOVector{
+		FilePath: "scrub.blk",
+		Entries:  []fileservice.IOEntry{{Offset: 0, Size: -1}},
+	}
+	require.NoError(t, service.Read(ctx, iov))
+	data := iov.Entries[0].Data
+	data[col.Location().Offset()+col.Location().Length()/2] ^= 0xff
+	require.NoError(t, service.Write(ctx, fileservice.IOVector{
+		FilePath: "corrupted.blk",
+		Entries:  []fileservice.IOEntry{{Offset: 0, Size: int64(len(data)), Data: data}},
+	}))
+	_, err = VerifyObject(ctx, "corrupted.blk", service)
+	require.Error(t, err)
+
+	_, err = VerifyObject(ctx, "missing.blk", service)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrFileNotFound))
+}
+
 func newBatch(mp *mpool.MPool) *batch.Batch {
 	types := []types.Type{
 		types.T_int8.ToType(),
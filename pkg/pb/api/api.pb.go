@@ -1106,14 +1106,20 @@ func (m *MetadataCkp) GetBat() *Batch {
 }
 
 type AlterTablePolicy struct {
-	MinOsizeQuailifed    uint32      `protobuf:"varint,1,opt,name=min_osize_quailifed,json=minOsizeQuailifed,proto3" json:"min_osize_quailifed,omitempty"`
-	MaxObjOnerun         uint32      `protobuf:"varint,2,opt,name=max_obj_onerun,json=maxObjOnerun,proto3" json:"max_obj_onerun,omitempty"`
-	MaxOsizeMergedObj    uint32      `protobuf:"varint,3,opt,name=max_osize_merged_obj,json=maxOsizeMergedObj,proto3" json:"max_osize_merged_obj,omitempty"`
-	Hints                []MergeHint `protobuf:"varint,4,rep,packed,name=hints,proto3,enum=api.MergeHint" json:"hints,omitempty"`
-	MinCnMergeSize       uint64      `protobuf:"varint,5,opt,name=min_cn_merge_size,json=minCnMergeSize,proto3" json:"min_cn_merge_size,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	MinOsizeQuailifed uint32      `protobuf:"varint,1,opt,name=min_osize_quailifed,json=minOsizeQuailifed,proto3" json:"min_osize_quailifed,omitempty"`
+	MaxObjOnerun      uint32      `protobuf:"varint,2,opt,name=max_obj_onerun,json=maxObjOnerun,proto3" json:"max_obj_onerun,omitempty"`
+	MaxOsizeMergedObj uint32      `protobuf:"varint,3,opt,name=max_osize_merged_obj,json=maxOsizeMergedObj,proto3" json:"max_osize_merged_obj,omitempty"`
+	Hints             []MergeHint `protobuf:"varint,4,rep,packed,name=hints,proto3,enum=api.MergeHint" json:"hints,omitempty"`
+	MinCnMergeSize    uint64      `protobuf:"varint,5,opt,name=min_cn_merge_size,json=minCnMergeSize,proto3" json:"min_cn_merge_size,omitempty"`
+	// policy is the name of the merge policy, empty for basic
+	Policy     string `protobuf:"bytes,6,opt,name=policy,proto3" json:"policy,omitempty"`
+	SizeRatio  uint32 `protobuf:"varint,7,opt,name=size_ratio,json=sizeRatio,proto3" json:"size_ratio,omitempty"`
+	TimeColumn string `protobuf:"bytes,8,opt,name=time_column,json=timeColumn,proto3" json:"time_column,omitempty"`
+	// time_window is the bucket width of time_column in nanoseconds
+	TimeWindow           int64    `protobuf:"varint,9,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *AlterTablePolicy) Reset()         { *m = AlterTablePolicy{} }
@@ -1184,6 +1190,34 @@ func (m *AlterTablePolicy) GetMinCnMergeSize() uint64 {
 	return 0
 }
 
+func (m *AlterTablePolicy) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
+func (m *AlterTablePolicy) GetSizeRatio() uint32 {
+	if m != nil {
+		return m.SizeRatio
+	}
+	return 0
+}
+
+func (m *AlterTablePolicy) GetTimeColumn() string {
+	if m != nil {
+		return m.TimeColumn
+	}
+	return ""
+}
+
+func (m *AlterTablePolicy) GetTimeWindow() int64 {
+	if m != nil {
+		return m.TimeWindow
+	}
+	return 0
+}
+
 type AlterTableConstraint struct {
 	Constraints          []byte   `protobuf:"bytes,1,opt,name=constraints,proto3" json:"constraints,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1755,6 +1789,10 @@ type SchemaExtra struct {
 	MaxOsizeMergedObj    uint32      `protobuf:"varint,7,opt,name=max_osize_merged_obj,json=maxOsizeMergedObj,proto3" json:"max_osize_merged_obj,omitempty"`
 	Hints                []MergeHint `protobuf:"varint,8,rep,packed,name=hints,proto3,enum=api.MergeHint" json:"hints,omitempty"`
 	MinCnMergeSize       uint64      `protobuf:"varint,9,opt,name=min_cn_merge_size,json=minCnMergeSize,proto3" json:"min_cn_merge_size,omitempty"`
+	MergePolicy          string      `protobuf:"bytes,10,opt,name=merge_policy,json=mergePolicy,proto3" json:"merge_policy,omitempty"`
+	SizeRatio            uint32      `protobuf:"varint,11,opt,name=size_ratio,json=sizeRatio,proto3" json:"size_ratio,omitempty"`
+	TimeColumn           string      `protobuf:"bytes,12,opt,name=time_column,json=timeColumn,proto3" json:"time_column,omitempty"`
+	TimeWindow           int64       `protobuf:"varint,13,opt,name=time_window,json=timeWindow,proto3" json:"time_window,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -1856,6 +1894,34 @@ func (m *SchemaExtra) GetMinCnMergeSize() uint64 {
 	return 0
 }
 
+func (m *SchemaExtra) GetMergePolicy() string {
+	if m != nil {
+		return m.MergePolicy
+	}
+	return ""
+}
+
+func (m *SchemaExtra) GetSizeRatio() uint32 {
+	if m != nil {
+		return m.SizeRatio
+	}
+	return 0
+}
+
+func (m *SchemaExtra) GetTimeColumn() string {
+	if m != nil {
+		return m.TimeColumn
+	}
+	return ""
+}
+
+func (m *SchemaExtra) GetTimeWindow() int64 {
+	if m != nil {
+		return m.TimeWindow
+	}
+	return 0
+}
+
 // Int64Map mainly used in unit test
 type Int64Map struct {
 	M                    map[int64]int64 `protobuf:"bytes,1,rep,name=m,proto3" json:"m,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
@@ -2061,6 +2127,64 @@ func (m *BlkTransferBooking) GetMappings() []BlkTransMap {
 	return nil
 }
 
+// ExpiredRows are the rows of a merged block that are dropped by the merge
+// because they expired by the table TTL. They have no transfer mapping.
+type ExpiredRows struct {
+	BlkId []byte `protobuf:"bytes,1,opt,name=blk_id,json=blkId,proto3" json:"blk_id,omitempty"`
+	// the marshaled nulls.Nulls of the row offsets
+	Rows                 []byte   `protobuf:"bytes,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExpiredRows) Reset()         { *m = ExpiredRows{} }
+func (m *ExpiredRows) String() string { return proto.CompactTextString(m) }
+func (*ExpiredRows) ProtoMessage()    {}
+func (*ExpiredRows) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+}
+func (m *ExpiredRows) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpiredRows) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpiredRows.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpiredRows) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpiredRows.Merge(m, src)
+}
+func (m *ExpiredRows) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ExpiredRows) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpiredRows.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpiredRows proto.InternalMessageInfo
+
+func (m *ExpiredRows) GetBlkId() []byte {
+	if m != nil {
+		return m.BlkId
+	}
+	return nil
+}
+
+func (m *ExpiredRows) GetRows() []byte {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
 type MergeCommitEntry struct {
 	DbId                 uint64              `protobuf:"varint,1,opt,name=db_id,json=dbId,proto3" json:"db_id,omitempty"`
 	TblId                uint64              `protobuf:"varint,2,opt,name=tbl_id,json=tblId,proto3" json:"tbl_id,omitempty"`
@@ -2071,6 +2195,7 @@ type MergeCommitEntry struct {
 	Booking              *BlkTransferBooking `protobuf:"bytes,7,opt,name=booking,proto3" json:"booking,omitempty"`
 	BookingLoc           []string            `protobuf:"bytes,8,rep,name=booking_loc,json=bookingLoc,proto3" json:"booking_loc,omitempty"`
 	Err                  string              `protobuf:"bytes,9,opt,name=err,proto3" json:"err,omitempty"`
+	ExpiredRows          []ExpiredRows       `protobuf:"bytes,10,rep,name=expired_rows,json=expiredRows,proto3" json:"expired_rows"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -2080,7 +2205,7 @@ func (m *MergeCommitEntry) Reset()         { *m = MergeCommitEntry{} }
 func (m *MergeCommitEntry) String() string { return proto.CompactTextString(m) }
 func (*MergeCommitEntry) ProtoMessage()    {}
 func (*MergeCommitEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{27}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
 }
 func (m *MergeCommitEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2172,6 +2297,13 @@ func (m *MergeCommitEntry) GetErr() string {
 	return ""
 }
 
+func (m *MergeCommitEntry) GetExpiredRows() []ExpiredRows {
+	if m != nil {
+		return m.ExpiredRows
+	}
+	return nil
+}
+
 type MergeTaskEntry struct {
 	DbId      uint64 `protobuf:"varint,1,opt,name=db_id,json=dbId,proto3" json:"db_id,omitempty"`
 	TblId     uint64 `protobuf:"varint,2,opt,name=tbl_id,json=tblId,proto3" json:"tbl_id,omitempty"`
@@ -2192,7 +2324,7 @@ func (m *MergeTaskEntry) Reset()         { *m = MergeTaskEntry{} }
 func (m *MergeTaskEntry) String() string { return proto.CompactTextString(m) }
 func (*MergeTaskEntry) ProtoMessage()    {}
 func (*MergeTaskEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{28}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
 }
 func (m *MergeTaskEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2295,7 +2427,7 @@ func (m *HashPageMap) Reset()         { *m = HashPageMap{} }
 func (m *HashPageMap) String() string { return proto.CompactTextString(m) }
 func (*HashPageMap) ProtoMessage()    {}
 func (*HashPageMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00212fb1f9d3bf1c, []int{29}
+	return fileDescriptor_00212fb1f9d3bf1c, []int{30}
 }
 func (m *HashPageMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2365,6 +2497,7 @@ func init() {
 	proto.RegisterType((*BlkTransMap)(nil), "api.BlkTransMap")
 	proto.RegisterMapType((map[int32]TransDestPos)(nil), "api.BlkTransMap.MEntry")
 	proto.RegisterType((*BlkTransferBooking)(nil), "api.BlkTransferBooking")
+	proto.RegisterType((*ExpiredRows)(nil), "api.ExpiredRows")
 	proto.RegisterType((*MergeCommitEntry)(nil), "api.MergeCommitEntry")
 	proto.RegisterType((*MergeTaskEntry)(nil), "api.MergeTaskEntry")
 	proto.RegisterType((*HashPageMap)(nil), "api.HashPageMap")
@@ -2374,157 +2507,165 @@ func init() {
 func init() { proto.RegisterFile("api.proto", fileDescriptor_00212fb1f9d3bf1c) }
 
 var fileDescriptor_00212fb1f9d3bf1c = []byte{
-	// 2395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcb, 0x6f, 0x24, 0x47,
-	0x19, 0x77, 0xcf, 0x7b, 0xbe, 0x9e, 0x47, 0xbb, 0xd6, 0xbb, 0x99, 0x38, 0x61, 0xd7, 0x74, 0x5e,
-	0x4e, 0x42, 0xbc, 0xc2, 0x09, 0x90, 0x44, 0x51, 0x22, 0x7b, 0x9c, 0xac, 0x07, 0xd6, 0x1e, 0xd3,
-	0x9e, 0x4d, 0xa4, 0x08, 0xa9, 0x55, 0xd3, 0x5d, 0x1e, 0xf7, 0x4e, 0x77, 0x55, 0x6d, 0x75, 0x8d,
-	0xd7, 0xce, 0x15, 0xf8, 0x07, 0xb8, 0x71, 0x40, 0x4a, 0x4e, 0x1c, 0xb8, 0x72, 0xe6, 0x88, 0x72,
-	0x0c, 0xe2, 0xfd, 0x10, 0x8a, 0x82, 0x84, 0x78, 0x5c, 0xf9, 0x03, 0x50, 0x55, 0x75, 0xcf, 0xb4,
-	0x1f, 0x09, 0x04, 0x21, 0xe5, 0x60, 0xab, 0xea, 0xf7, 0x7d, 0x5f, 0xf5, 0xf7, 0xac, 0xef, 0xab,
-	0x81, 0x26, 0xe6, 0xd1, 0x06, 0x17, 0x4c, 0x32, 0x54, 0xc6, 0x3c, 0x5a, 0x7d, 0x61, 0x12, 0xc9,
-	0xe3, 0xd9, 0x78, 0x23, 0x60, 0xc9, 0xed, 0x09, 0x9b, 0xb0, 0xdb, 0x9a, 0x36, 0x9e, 0x1d, 0xe9,
-	0x9d, 0xde, 0xe8, 0x95, 0x91, 0x59, 0xed, 0xca, 0x28, 0x21, 0xa9, 0xc4, 0x09, 0xcf, 0x00, 0xe0,
-	0x31, 0xa6, 0x66, 0xed, 0x7e, 0x03, 0xda, 0xa3, 0xfd, 0x83, 0x88, 0x4e, 0x3c, 0xf2, 0x60, 0x46,
-	0x52, 0x89, 0x1e, 0x87, 0x26, 0xc7, 0x02, 0x27, 0x44, 0x12, 0xd1, 0xb3, 0xd6, 0xac, 0xf5, 0xa6,
-	0xb7, 0x00, 0x5e, 0x6d, 0xbc, 0xff, 0xc1, 0x2d, 0xeb, 0xe3, 0x0f, 0x6e, 0x2d, 0xb9, 0x3f, 0xb5,
-	0xa0, 0x93, 0x4b, 0xa6, 0x9c, 0xd1, 0x94, 0xa0, 0x1e, 0xd4, 0x53, 0xc9, 0x04, 0x19, 0xec, 0x64,
-	0x82, 0xf9, 0x16, 0x3d, 0x0d, 0x9d, 0x94, 0x88, 0x93, 0x28, 0x20, 0x5b, 0x61, 0x28, 0x48, 0x9a,
-	0xf6, 0x4a, 0x9a, 0xe1, 0x02, 0xaa, 0x4f, 0x38, 0xc6, 0x22, 0x1c, 0xec, 0xf4, 0xca, 0x6b, 0xd6,
-	0x7a, 0xc5, 0xcb, 0xb7, 0x4a, 0x2d, 0x41, 0x78, 0x1c, 0x05, 0x78, 0xb0, 0xd3, 0xab, 0x68, 0xda,
-	0x02, 0x40, 0x37, 0x01, 0x62, 0x36, 0x39, 0xcc, 0x44, 0xab, 0x9a, 0x5c, 0x40, 0x0a, 0x6a, 0xbf,
-	0x0a, 0xce, 0x68, 0xff, 0x50, 0x8a, 0xa2, 0xde, 0xfa, 0x6c, 0x39, 0x13, 0xf4, 0x50, 0xce, 0x4d,
-	0x9e, 0x03, 0x05, 0xd9, 0x9f, 0x58, 0x50, 0x7b, 0x9b, 0x04, 0x92, 0x09, 0x84, 0xa0, 0x12, 0x62,
-	0x89, 0x35, 0x77, 0xcb, 0xd3, 0x6b, 0x74, 0x13, 0x2a, 0xf2, 0x8c, 0x13, 0x6d, 0x9a, 0xbd, 0x09,
-	0x1b, 0xda, 0xcb, 0xa3, 0x33, 0x4e, 0x3c, 0x8d, 0xa3, 0x55, 0x68, 0xd0, 0x59, 0x1c, 0xe3, 0x71,
-	0x4c, 0xb4, 0x75, 0x0d, 0x6f, 0xbe, 0x47, 0x0e, 0x94, 0x69, 0xca, 0xb5, 0x61, 0x2d, 0x4f, 0x2d,
-	0xd1, 0xa3, 0xd0, 0x88, 0x52, 0x3f, 0x60, 0x34, 0x95, 0xda, 0xa0, 0x86, 0x57, 0x8f, 0xd2, 0xbe,
-	0xda, 0x2a, 0xe6, 0x98, 0xd0, 0x5e, 0x6d, 0xcd, 0x5a, 0x6f, 0x7b, 0x6a, 0xa9, 0xd4, 0xc1, 0x82,
-	0xe0, 0x5e, 0xdd, 0xa8, 0xa3, 0xd6, 0xee, 0x37, 0xa1, 0xba, 0x8d, 0x65, 0x70, 0x8c, 0x56, 0xa1,
-	0x8a, 0xa5, 0x14, 0x69, 0xcf, 0x5a, 0x2b, 0xaf, 0x37, 0xb7, 0x2b, 0x1f, 0xfe, 0xf9, 0xd6, 0x92,
-	0x67, 0x20, 0xf4, 0x14, 0x54, 0x4e, 0x48, 0xa0, 0xc2, 0x51, 0x5e, 0xb7, 0x37, 0xed, 0x0d, 0x95,
-	0x69, 0xc6, 0xc4, 0x8c, 0x4f, 0x93, 0xdd, 0x9f, 0x5b, 0x50, 0x1f, 0x29, 0x45, 0x07, 0x3b, 0xe8,
-	0x1a, 0x54, 0xc3, 0xb1, 0x1f, 0x85, 0xda, 0xf6, 0x8a, 0x57, 0x09, 0xc7, 0x83, 0x50, 0x81, 0x52,
-	0x83, 0x25, 0x03, 0x4a, 0x05, 0x7e, 0x19, 0x5a, 0x1c, 0x0b, 0x19, 0xc9, 0x88, 0x51, 0x45, 0x33,
-	0x21, 0xb5, 0xe7, 0xd8, 0x20, 0x44, 0xd7, 0xa1, 0x86, 0x83, 0x40, 0x11, 0x2b, 0xda, 0x9a, 0x2a,
-	0x0e, 0x82, 0x41, 0x88, 0x1e, 0x81, 0x7a, 0x38, 0xf6, 0x29, 0x4e, 0x88, 0xb6, 0xbd, 0xe9, 0xd5,
-	0xc2, 0xf1, 0x3e, 0x4e, 0x88, 0x22, 0xc8, 0x8c, 0x50, 0x33, 0x04, 0x69, 0x08, 0x4f, 0x41, 0x87,
-	0x8b, 0x28, 0xc1, 0xe2, 0xcc, 0x4f, 0xc9, 0x03, 0x3a, 0x4b, 0xb4, 0x2f, 0xda, 0x5e, 0x3b, 0x43,
-	0x0f, 0x35, 0xe8, 0xfe, 0xc0, 0x82, 0xce, 0xe1, 0x19, 0x0d, 0xee, 0xb2, 0xc9, 0x08, 0x47, 0xb1,
-	0x47, 0x1e, 0xa0, 0x17, 0xa0, 0x1e, 0x50, 0xff, 0x18, 0x9f, 0x10, 0x6d, 0x91, 0xbd, 0xb9, 0xb2,
-	0xb1, 0x28, 0x98, 0x51, 0xbe, 0xf2, 0x6a, 0x01, 0xdd, 0xc5, 0x27, 0x24, 0x63, 0x7f, 0x88, 0xa9,
-	0xcc, 0x02, 0xfd, 0xa9, 0xec, 0xef, 0x60, 0x2a, 0x91, 0x0b, 0x55, 0x39, 0x8f, 0xb8, 0xbd, 0xd9,
-	0xd2, 0x1e, 0xce, 0x5c, 0xe9, 0x19, 0x92, 0xfb, 0x1d, 0xe8, 0x9e, 0xd3, 0x29, 0xe5, 0xca, 0x75,
-	0xc1, 0x94, 0xfb, 0x31, 0x0b, 0xb0, 0xf2, 0x54, 0x96, 0x95, 0x76, 0x30, 0xe5, 0x77, 0x33, 0x08,
-	0x3d, 0x0d, 0x8d, 0x80, 0x25, 0x09, 0xa6, 0x61, 0x1e, 0x3e, 0xd0, 0x87, 0xbf, 0x49, 0xa5, 0x38,
-	0xf3, 0xe6, 0x34, 0xf7, 0x75, 0x58, 0x3e, 0x10, 0x44, 0x6d, 0x23, 0xf9, 0x8e, 0x88, 0x24, 0xe9,
-	0x27, 0x21, 0x7a, 0x16, 0x80, 0x28, 0x3e, 0x3f, 0x8e, 0x52, 0xa9, 0x13, 0xe3, 0xbc, 0x78, 0x53,
-	0x53, 0xef, 0x46, 0xa9, 0x74, 0xff, 0x59, 0x82, 0xaa, 0x06, 0xd1, 0x8b, 0xb9, 0x90, 0x4e, 0x73,
-	0xa5, 0x52, 0x67, 0x73, 0x65, 0x21, 0x64, 0xfe, 0xeb, 0x84, 0x37, 0xe2, 0x6a, 0xa9, 0xf2, 0x58,
-	0x5b, 0xb9, 0x48, 0x8e, 0xba, 0xde, 0x0f, 0x42, 0x74, 0x0b, 0x6c, 0x55, 0x38, 0x63, 0x9c, 0x92,
-	0x45, 0x7a, 0x40, 0x0e, 0x0d, 0x42, 0xf4, 0x25, 0x00, 0x23, 0xab, 0x03, 0x5e, 0x31, 0x95, 0xa9,
-	0x11, 0x1d, 0xf3, 0x27, 0xa0, 0x3d, 0x97, 0x2f, 0xe4, 0x4a, 0x2b, 0x07, 0x35, 0xd3, 0x63, 0xd0,
-	0x3c, 0x8a, 0xf2, 0x23, 0x4c, 0xce, 0x34, 0x14, 0xa0, 0x89, 0x8f, 0x43, 0x79, 0x8c, 0xa5, 0x4e,
-	0x95, 0xdc, 0x7e, 0x5d, 0x33, 0x9e, 0x82, 0xd1, 0x13, 0xd0, 0xe1, 0x53, 0x3f, 0x38, 0x26, 0xc1,
-	0xd4, 0x1f, 0x9f, 0xf9, 0x92, 0xf6, 0x1a, 0x6b, 0xd6, 0x7a, 0xd5, 0xb3, 0xf9, 0xb4, 0xaf, 0xc0,
-	0xed, 0xb3, 0x11, 0x75, 0xf7, 0xa0, 0x39, 0xb7, 0x1b, 0x01, 0xd4, 0x06, 0x34, 0x25, 0x42, 0x3a,
-	0x4b, 0x6a, 0xbd, 0x43, 0x62, 0x22, 0x89, 0x63, 0xa9, 0xf5, 0x3d, 0x1e, 0x62, 0x49, 0x9c, 0x12,
-	0x6a, 0x42, 0x75, 0x2b, 0x96, 0x44, 0x38, 0x65, 0xb4, 0x0c, 0xed, 0x43, 0x4e, 0x82, 0x08, 0xc7,
-	0x19, 0x67, 0xc5, 0xfd, 0x9e, 0x05, 0xa0, 0x0f, 0xe7, 0x2c, 0xa2, 0x12, 0x3d, 0x0f, 0xb5, 0x24,
-	0xa2, 0xbe, 0x4c, 0x3f, 0x33, 0x37, 0xab, 0x49, 0x44, 0x47, 0xa9, 0x66, 0xc6, 0xa7, 0x8a, 0xb9,
-	0xf4, 0x99, 0xcc, 0xf8, 0x74, 0x94, 0xe6, 0xa6, 0x97, 0xaf, 0x34, 0xdd, 0xa8, 0x81, 0x25, 0x8e,
-	0xd9, 0xa4, 0x3f, 0xe5, 0x5f, 0x98, 0x1a, 0xdf, 0xb7, 0xc0, 0xde, 0x23, 0x12, 0xab, 0x88, 0x7e,
-	0x91, 0x7a, 0xfc, 0xc3, 0x02, 0x47, 0x07, 0x4d, 0x57, 0xee, 0x01, 0x8b, 0xa3, 0xe0, 0x0c, 0x6d,
-	0xc0, 0x35, 0xa5, 0x0c, 0x4b, 0xa3, 0xf7, 0x88, 0xff, 0x60, 0x86, 0xa3, 0x38, 0x3a, 0x22, 0xe6,
-	0x5a, 0x6c, 0x7b, 0xcb, 0x49, 0x44, 0x87, 0x8a, 0xf2, 0xed, 0x9c, 0x80, 0x9e, 0x84, 0x8e, 0xd2,
-	0x87, 0x8d, 0xef, 0xfb, 0x8c, 0x12, 0x31, 0xa3, 0x5a, 0xaf, 0xb6, 0xd7, 0x4a, 0xf0, 0xe9, 0x70,
-	0x7c, 0x7f, 0xa8, 0x31, 0x74, 0x1b, 0x56, 0x34, 0x97, 0x3e, 0x35, 0x21, 0x62, 0x42, 0x42, 0x25,
-	0xa2, 0x35, 0x53, 0xc7, 0xe2, 0x53, 0x7d, 0xec, 0x9e, 0xa6, 0x0c, 0xc7, 0xf7, 0xd1, 0x93, 0x50,
-	0x3d, 0x8e, 0xa8, 0x4c, 0x7b, 0x95, 0xb5, 0xf2, 0x7a, 0x67, 0xb3, 0xa3, 0x75, 0xd7, 0xe4, 0xdd,
-	0x88, 0x4a, 0xcf, 0x10, 0xd1, 0xb3, 0xa0, 0x34, 0xf2, 0x03, 0x6a, 0xce, 0xf4, 0xd5, 0x19, 0x59,
-	0xa3, 0xec, 0x24, 0x11, 0xed, 0x53, 0x2d, 0x71, 0x18, 0xbd, 0x47, 0xdc, 0x97, 0x61, 0x65, 0x61,
-	0xab, 0xee, 0x38, 0x02, 0xab, 0x5c, 0x5c, 0x03, 0x3b, 0x98, 0xef, 0xd2, 0xac, 0xf5, 0x15, 0x21,
-	0xf7, 0x05, 0x58, 0x2e, 0x4a, 0x26, 0x09, 0xa1, 0x52, 0xf5, 0xf4, 0xc0, 0x2c, 0xf3, 0xa9, 0x20,
-	0xdb, 0xba, 0x7b, 0x70, 0x7d, 0xc1, 0xee, 0x11, 0x55, 0xa1, 0x7a, 0xa9, 0xee, 0x0c, 0x16, 0x87,
-	0xa6, 0x64, 0x33, 0x19, 0x16, 0x87, 0xba, 0x62, 0x1f, 0x85, 0x06, 0x25, 0x0f, 0x0d, 0xc9, 0xcc,
-	0x10, 0x75, 0x4a, 0x1e, 0x2a, 0x92, 0x4b, 0xe1, 0xda, 0xc5, 0xe3, 0xfa, 0x2c, 0xfe, 0xdf, 0x0e,
-	0x53, 0x17, 0x70, 0xaa, 0x26, 0x22, 0x1a, 0x10, 0x5f, 0x75, 0x13, 0xe3, 0x7e, 0x3b, 0xc7, 0xf6,
-	0x67, 0x89, 0x1b, 0x16, 0xbf, 0xb7, 0x15, 0x86, 0x7d, 0x16, 0xcf, 0x12, 0x8a, 0x9e, 0x84, 0x5a,
-	0xa0, 0x57, 0x59, 0x8e, 0xb6, 0xcc, 0x20, 0xd0, 0x67, 0xf1, 0x0e, 0x39, 0xf2, 0x32, 0x1a, 0x7a,
-	0x06, 0xba, 0x91, 0xbe, 0x29, 0x7c, 0xce, 0x52, 0xdd, 0x0d, 0xb5, 0x06, 0x55, 0xaf, 0x63, 0xe0,
-	0x83, 0x0c, 0x75, 0x0f, 0xe1, 0xc6, 0xb9, 0xaf, 0x1c, 0xe4, 0xdd, 0x13, 0xbd, 0x02, 0xed, 0x45,
-	0x7b, 0x0d, 0xc9, 0xd1, 0xbc, 0x26, 0xf4, 0xf7, 0xe6, 0x7c, 0xdb, 0x67, 0xea, 0xbb, 0x8b, 0x4e,
-	0xbc, 0x43, 0x8e, 0xdc, 0x77, 0x8b, 0x21, 0xde, 0x11, 0x8c, 0x67, 0xba, 0xdf, 0x02, 0x3b, 0x66,
-	0x93, 0x28, 0xc0, 0xb1, 0x1f, 0x85, 0xa7, 0x59, 0x2a, 0x43, 0x06, 0x0d, 0xc2, 0xd3, 0x4b, 0x6e,
-	0x29, 0x5d, 0x76, 0xcb, 0x5f, 0x2b, 0xd0, 0x2e, 0xc6, 0xe1, 0xc1, 0xb9, 0x16, 0x60, 0x9d, 0x6f,
-	0x01, 0xf3, 0x61, 0xa2, 0x54, 0x18, 0x26, 0x5c, 0xa8, 0x4c, 0x23, 0x6a, 0x1a, 0x42, 0x9e, 0xd0,
-	0xfa, 0xc4, 0x6f, 0x45, 0x34, 0xf4, 0x34, 0x0d, 0xbd, 0x02, 0x80, 0xc3, 0xd0, 0xcf, 0x3c, 0x5d,
-	0xd1, 0x96, 0xf7, 0x16, 0x9c, 0xe7, 0x63, 0xb2, 0xbb, 0xe4, 0x35, 0xf1, 0x3c, 0x40, 0xaf, 0x81,
-	0x1d, 0x0a, 0xc6, 0x73, 0xd9, 0xaa, 0x96, 0x7d, 0xf4, 0x82, 0xec, 0xc2, 0x29, 0xbb, 0x4b, 0x1e,
-	0x84, 0x0b, 0x17, 0xbd, 0x01, 0x2d, 0xa1, 0x73, 0xcb, 0x37, 0x7d, 0xbd, 0xa6, 0xc5, 0x57, 0x2f,
-	0x88, 0x17, 0xb2, 0x79, 0x77, 0xc9, 0xb3, 0x45, 0x21, 0xb9, 0xdf, 0x80, 0xce, 0x4c, 0xf7, 0x02,
-	0x3f, 0x2f, 0x0b, 0xd3, 0x7e, 0x6e, 0x5c, 0x38, 0x22, 0xab, 0x9f, 0xdd, 0x25, 0xaf, 0x6d, 0xf8,
-	0xf3, 0x82, 0x7a, 0x0d, 0xec, 0xfc, 0x80, 0x54, 0x0a, 0xdd, 0x93, 0x2e, 0xeb, 0xbf, 0xa8, 0x5b,
-	0xa5, 0x7f, 0x76, 0x40, 0x2a, 0x05, 0x7a, 0x0d, 0xb2, 0xe3, 0x7c, 0xae, 0xaf, 0xb1, 0x5e, 0x53,
-	0xcb, 0x5f, 0xbf, 0x20, 0x6f, 0xee, 0xb8, 0xdd, 0x25, 0xaf, 0x65, 0xb8, 0xb3, 0x3b, 0x6f, 0x1b,
-	0xda, 0xca, 0xed, 0xf3, 0x64, 0xea, 0x81, 0x96, 0x7e, 0xec, 0xb2, 0xe7, 0xe7, 0xf9, 0xa7, 0xce,
-	0xc0, 0xe7, 0xf3, 0x16, 0x32, 0x0f, 0x06, 0x2c, 0xee, 0xd9, 0x57, 0x86, 0x6e, 0x5e, 0xbe, 0x2a,
-	0x74, 0x22, 0xdf, 0x6c, 0xdb, 0xd0, 0x64, 0x9c, 0x08, 0x3d, 0x00, 0xb9, 0xff, 0x2a, 0x81, 0x7d,
-	0x18, 0x1c, 0x93, 0x04, 0xbf, 0x79, 0x2a, 0x05, 0x46, 0x4f, 0x43, 0x97, 0x92, 0x53, 0xa9, 0x4e,
-	0xcd, 0x67, 0x40, 0x93, 0xc0, 0x6d, 0x05, 0xf7, 0x59, 0x6c, 0x66, 0x40, 0x3d, 0x36, 0x08, 0xc6,
-	0x39, 0x09, 0x7d, 0x33, 0x17, 0xab, 0xe9, 0x49, 0x8d, 0x0d, 0x06, 0xdc, 0xca, 0x06, 0xe3, 0x8e,
-	0xc9, 0x0f, 0x3f, 0x38, 0xc6, 0x74, 0x42, 0xc2, 0x6c, 0x64, 0x6f, 0x1b, 0xb4, 0x6f, 0xc0, 0x73,
-	0x97, 0x4b, 0xe5, 0xfc, 0xe5, 0xf2, 0x29, 0xed, 0xa1, 0xfa, 0xdf, 0xb7, 0x87, 0xda, 0xe7, 0x68,
-	0x0f, 0xf5, 0xff, 0xd8, 0x1e, 0x1a, 0x9f, 0xbb, 0x3d, 0x34, 0xaf, 0x6c, 0x0f, 0x21, 0x34, 0x06,
-	0x54, 0x7e, 0xfd, 0xa5, 0x3d, 0xcc, 0x91, 0x0b, 0x56, 0x92, 0x4d, 0x8f, 0x66, 0x10, 0xcc, 0x29,
-	0x1b, 0x7b, 0x66, 0x8e, 0xb4, 0x92, 0xd5, 0x97, 0xa0, 0x66, 0x36, 0xea, 0xdd, 0x32, 0x25, 0x67,
-	0x3a, 0x28, 0x65, 0x4f, 0x2d, 0xd1, 0x0a, 0x54, 0x4f, 0x70, 0x3c, 0x33, 0xb7, 0x6f, 0xd9, 0x33,
-	0x9b, 0x57, 0x4b, 0x2f, 0x5b, 0xee, 0xdb, 0xd0, 0x1a, 0x09, 0x4c, 0xd3, 0x1d, 0x92, 0xaa, 0xbb,
-	0x10, 0xdd, 0x80, 0x1a, 0x1b, 0xdf, 0x1f, 0x64, 0x97, 0x52, 0xd5, 0xcb, 0x76, 0x0a, 0x1f, 0xc7,
-	0x53, 0x85, 0x9b, 0xeb, 0x33, 0xdb, 0x29, 0x5c, 0xb0, 0x87, 0x0a, 0x2f, 0x1b, 0xdc, 0xec, 0xdc,
-	0xef, 0x5a, 0x60, 0x6f, 0xc7, 0x53, 0x7d, 0xb6, 0xb2, 0xe0, 0xf9, 0x85, 0x05, 0x8f, 0x98, 0xae,
-	0xbf, 0x20, 0x66, 0x46, 0x64, 0x2f, 0x21, 0x2b, 0x59, 0xbd, 0x73, 0x95, 0x29, 0x55, 0x63, 0xca,
-	0x33, 0x45, 0x53, 0xec, 0xcd, 0x65, 0x33, 0xe8, 0x17, 0x4c, 0x28, 0x5a, 0xb7, 0x0b, 0x28, 0xff,
-	0xce, 0x11, 0x11, 0xdb, 0x8c, 0x4d, 0x23, 0x3a, 0x41, 0x9b, 0xd0, 0x48, 0x30, 0xe7, 0x11, 0x9d,
-	0xa4, 0x99, 0x4a, 0xce, 0x45, 0x95, 0x32, 0x5d, 0xe6, 0x7c, 0xee, 0xcf, 0x4a, 0xe0, 0xe8, 0xd8,
-	0xf4, 0xf5, 0x80, 0x6f, 0xb4, 0xbb, 0xf2, 0x89, 0x76, 0x1d, 0x6a, 0x72, 0x1c, 0x2f, 0xee, 0xda,
-	0xaa, 0x1c, 0xc7, 0x97, 0x66, 0xec, 0xf2, 0xc5, 0x19, 0xfb, 0x6b, 0xd0, 0x48, 0x25, 0x16, 0xd2,
-	0xd7, 0x03, 0xc6, 0xa7, 0x8e, 0x51, 0x99, 0x5e, 0x75, 0xcd, 0x3b, 0x4a, 0x55, 0x23, 0x59, 0x24,
-	0x67, 0xda, 0xab, 0xae, 0x95, 0xd7, 0x5b, 0x1e, 0x24, 0x79, 0x56, 0xa6, 0xfa, 0x81, 0x23, 0x08,
-	0x96, 0x39, 0x47, 0x4d, 0x73, 0xd8, 0x19, 0xa6, 0x59, 0xbe, 0x0a, 0xf5, 0xb1, 0xf1, 0x4c, 0x76,
-	0x43, 0x9e, 0x0f, 0xd0, 0xc2, 0x71, 0x5e, 0xce, 0xa7, 0x3e, 0x9b, 0x2d, 0xd5, 0xd3, 0x49, 0xa7,
-	0x7c, 0xd3, 0x83, 0x0c, 0xba, 0xcb, 0x02, 0x15, 0x37, 0x22, 0x84, 0xce, 0xec, 0xa6, 0xa7, 0x96,
-	0xee, 0x0f, 0x4b, 0xd0, 0xd1, 0x0e, 0x1c, 0xe1, 0x74, 0xfa, 0x7f, 0x77, 0x5f, 0xe1, 0x21, 0x5b,
-	0x39, 0xf7, 0x90, 0x75, 0xa1, 0x2d, 0x59, 0x56, 0x6c, 0x05, 0x17, 0xd9, 0x92, 0x69, 0x65, 0xb4,
-	0x03, 0x36, 0xe0, 0x1a, 0x49, 0x65, 0x94, 0x68, 0x2f, 0x25, 0x24, 0xf1, 0x67, 0x29, 0x9e, 0x98,
-	0x8e, 0x53, 0xf1, 0x96, 0xe7, 0xa4, 0x3d, 0x92, 0xdc, 0x53, 0x04, 0xa5, 0x0b, 0x0e, 0x02, 0x36,
-	0xa3, 0x52, 0xa9, 0x69, 0x6e, 0x84, 0x66, 0x86, 0x98, 0x47, 0xf5, 0x2c, 0x25, 0x42, 0xd1, 0x1a,
-	0x9a, 0x56, 0x53, 0x5b, 0x43, 0x10, 0xcc, 0xb4, 0xe7, 0xa6, 0x21, 0xa8, 0xed, 0x20, 0x74, 0x39,
-	0xd8, 0xbb, 0x38, 0x3d, 0x3e, 0xc0, 0x13, 0x72, 0x65, 0xad, 0x14, 0x88, 0x97, 0x6a, 0xe5, 0xca,
-	0xb2, 0x6f, 0x5f, 0x51, 0xf6, 0xad, 0x42, 0x61, 0x3c, 0xf7, 0xa3, 0x12, 0xd4, 0x86, 0xbc, 0xcf,
-	0x42, 0x82, 0xea, 0x50, 0xde, 0x67, 0xdc, 0x59, 0x42, 0xcb, 0xd0, 0x1a, 0xf2, 0x3b, 0x44, 0x66,
-	0xef, 0x63, 0xe7, 0x6f, 0x75, 0xe4, 0x80, 0x3d, 0xe4, 0x07, 0x22, 0x4b, 0x7a, 0xe7, 0xef, 0x75,
-	0x64, 0x2b, 0xb9, 0x83, 0x88, 0x4e, 0x9c, 0x8f, 0xba, 0xa8, 0x05, 0xf5, 0x21, 0x7f, 0x2b, 0x9e,
-	0xa5, 0xc7, 0xce, 0x2f, 0xba, 0x46, 0x7e, 0xf1, 0xa6, 0x72, 0x7e, 0xd9, 0x45, 0x1d, 0x68, 0x0e,
-	0xf9, 0x80, 0xa6, 0x9c, 0x04, 0xd2, 0xf9, 0x55, 0x17, 0xad, 0x40, 0x77, 0xc8, 0xb7, 0xc2, 0xf0,
-	0x2d, 0x3c, 0x8b, 0xe5, 0x81, 0xe6, 0xfa, 0x75, 0x17, 0xb5, 0xa1, 0x31, 0xe4, 0xdb, 0x38, 0x98,
-	0xce, 0xb8, 0xf3, 0x9b, 0xae, 0xf9, 0xe8, 0x48, 0xe0, 0x80, 0x1c, 0x72, 0x4c, 0x9d, 0xdf, 0x76,
-	0xd1, 0x35, 0xe8, 0x0c, 0xf9, 0xa1, 0x64, 0x02, 0x4f, 0x88, 0x0e, 0x81, 0xf3, 0xbb, 0x2e, 0x7a,
-	0x04, 0xd0, 0x90, 0xdf, 0x89, 0xd9, 0x18, 0xc7, 0x85, 0x8f, 0xfe, 0xbe, 0x8b, 0x6e, 0xc0, 0xb2,
-	0xfa, 0xa8, 0x24, 0x22, 0x20, 0x5c, 0x66, 0xaa, 0xff, 0xa1, 0x8b, 0x10, 0xb4, 0x95, 0xc9, 0x6a,
-	0xab, 0x63, 0xef, 0xfc, 0x31, 0xe3, 0xdd, 0x89, 0xd2, 0xa9, 0xfa, 0xeb, 0xc7, 0x04, 0x53, 0x22,
-	0x9c, 0x3f, 0x75, 0x9f, 0xfb, 0xb1, 0x05, 0xcd, 0xf9, 0x28, 0x84, 0x6c, 0xa8, 0x0f, 0xe8, 0x09,
-	0x8e, 0xa3, 0xd0, 0x59, 0x42, 0x6d, 0x68, 0xce, 0x07, 0x1e, 0xc7, 0x42, 0x1d, 0x80, 0xc5, 0x0c,
-	0xe3, 0x94, 0x50, 0x17, 0xec, 0xc2, 0x50, 0x62, 0x1e, 0x9f, 0xf7, 0x8a, 0x73, 0x85, 0x53, 0x41,
-	0x2b, 0xe0, 0xe4, 0x50, 0x3e, 0x3d, 0x38, 0x55, 0xe4, 0x40, 0xeb, 0x5e, 0x61, 0x06, 0x70, 0x6a,
-	0x0a, 0x29, 0x76, 0x78, 0x47, 0x05, 0xa4, 0x35, 0x6f, 0xd9, 0xea, 0x7b, 0x8d, 0xe7, 0xee, 0x40,
-	0x73, 0xde, 0x65, 0x50, 0x03, 0x2a, 0x5b, 0x33, 0xc9, 0x8c, 0x96, 0xfb, 0xcc, 0xbc, 0x76, 0x53,
-	0xc7, 0x42, 0x2d, 0x68, 0x6c, 0x47, 0x13, 0xa3, 0x52, 0x09, 0x5d, 0x83, 0x6e, 0x9f, 0x51, 0x19,
-	0xd1, 0x19, 0x9b, 0xa5, 0xfa, 0xb7, 0x0a, 0xa7, 0xbc, 0xfd, 0xfa, 0x87, 0x9f, 0xdc, 0xb4, 0x3e,
-	0xfa, 0xe4, 0xa6, 0xf5, 0xf1, 0x27, 0x37, 0x97, 0xde, 0xff, 0xcb, 0x4d, 0xeb, 0xdd, 0xaf, 0x14,
-	0x7e, 0xff, 0x4c, 0xb0, 0x14, 0xd1, 0x29, 0x13, 0xd1, 0x24, 0xa2, 0xf9, 0x86, 0x92, 0xdb, 0x7c,
-	0x3a, 0xb9, 0xcd, 0xc7, 0xb7, 0x31, 0x8f, 0xc6, 0x35, 0xfd, 0x43, 0xe7, 0x8b, 0xff, 0x0e, 0x00,
-	0x00, 0xff, 0xff, 0x1e, 0x92, 0x50, 0x61, 0x46, 0x15, 0x00, 0x00,
+	// 2526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x5b, 0x6f, 0x24, 0xc5,
+	0x15, 0x76, 0xcf, 0x7d, 0x4e, 0xcf, 0xa5, 0x5d, 0xeb, 0x5d, 0x06, 0x43, 0x76, 0x4d, 0x73, 0x33,
+	0x10, 0xbc, 0x8a, 0x21, 0x09, 0x20, 0x04, 0x5a, 0x8f, 0x61, 0x3d, 0xc9, 0x7a, 0xc7, 0x69, 0xcf,
+	0x82, 0x84, 0x22, 0xb5, 0x6a, 0xba, 0xcb, 0xe3, 0xde, 0xe9, 0xae, 0xea, 0xad, 0xae, 0xf1, 0x85,
+	0xd7, 0x24, 0x7f, 0x20, 0x6f, 0x79, 0x88, 0x04, 0x4f, 0x79, 0xc8, 0x6b, 0x7e, 0x43, 0xc4, 0x23,
+	0x51, 0xee, 0x17, 0x45, 0x08, 0xa4, 0x28, 0x51, 0xfe, 0x40, 0xf2, 0x16, 0xd5, 0xa5, 0x67, 0xda,
+	0x17, 0xd8, 0x10, 0x45, 0xe2, 0xc1, 0x56, 0xd5, 0x77, 0xce, 0xa9, 0x3e, 0xe7, 0xd4, 0xb9, 0xd5,
+	0x40, 0x13, 0xa7, 0xd1, 0x46, 0xca, 0x99, 0x60, 0xa8, 0x8c, 0xd3, 0x68, 0xf5, 0xc5, 0x49, 0x24,
+	0x0e, 0x67, 0xe3, 0x8d, 0x80, 0x25, 0x37, 0x27, 0x6c, 0xc2, 0x6e, 0x2a, 0xda, 0x78, 0x76, 0xa0,
+	0x76, 0x6a, 0xa3, 0x56, 0x5a, 0x66, 0xb5, 0x2b, 0xa2, 0x84, 0x64, 0x02, 0x27, 0xa9, 0x01, 0x20,
+	0x8d, 0x31, 0xd5, 0x6b, 0xf7, 0xdb, 0xd0, 0x1e, 0xdd, 0xdd, 0x8b, 0xe8, 0xc4, 0x23, 0x0f, 0x66,
+	0x24, 0x13, 0xe8, 0x71, 0x68, 0xa6, 0x98, 0xe3, 0x84, 0x08, 0xc2, 0x7b, 0xd6, 0x9a, 0xb5, 0xde,
+	0xf4, 0x16, 0xc0, 0x6b, 0x8d, 0x0f, 0x3e, 0xbc, 0x61, 0x7d, 0xf2, 0xe1, 0x8d, 0x25, 0xf7, 0x17,
+	0x16, 0x74, 0x72, 0xc9, 0x2c, 0x65, 0x34, 0x23, 0xa8, 0x07, 0xf5, 0x4c, 0x30, 0x4e, 0x06, 0xdb,
+	0x46, 0x30, 0xdf, 0xa2, 0x67, 0xa0, 0x93, 0x11, 0x7e, 0x14, 0x05, 0xe4, 0x56, 0x18, 0x72, 0x92,
+	0x65, 0xbd, 0x92, 0x62, 0x38, 0x87, 0xaa, 0x13, 0x0e, 0x31, 0x0f, 0x07, 0xdb, 0xbd, 0xf2, 0x9a,
+	0xb5, 0x5e, 0xf1, 0xf2, 0xad, 0x54, 0x8b, 0x93, 0x34, 0x8e, 0x02, 0x3c, 0xd8, 0xee, 0x55, 0x14,
+	0x6d, 0x01, 0xa0, 0xeb, 0x00, 0x31, 0x9b, 0xec, 0x1b, 0xd1, 0xaa, 0x22, 0x17, 0x90, 0x82, 0xda,
+	0xaf, 0x81, 0x33, 0xba, 0xbb, 0x2f, 0x78, 0x51, 0x6f, 0x75, 0xb6, 0x98, 0x71, 0xba, 0x2f, 0xe6,
+	0x26, 0xcf, 0x81, 0x82, 0xec, 0xcf, 0x2d, 0xa8, 0xbd, 0x43, 0x02, 0xc1, 0x38, 0x42, 0x50, 0x09,
+	0xb1, 0xc0, 0x8a, 0xbb, 0xe5, 0xa9, 0x35, 0xba, 0x0e, 0x15, 0x71, 0x9a, 0x12, 0x65, 0x9a, 0xbd,
+	0x09, 0x1b, 0xca, 0xcb, 0xa3, 0xd3, 0x94, 0x78, 0x0a, 0x47, 0xab, 0xd0, 0xa0, 0xb3, 0x38, 0xc6,
+	0xe3, 0x98, 0x28, 0xeb, 0x1a, 0xde, 0x7c, 0x8f, 0x1c, 0x28, 0xd3, 0x2c, 0x55, 0x86, 0xb5, 0x3c,
+	0xb9, 0x44, 0x8f, 0x42, 0x23, 0xca, 0xfc, 0x80, 0xd1, 0x4c, 0x28, 0x83, 0x1a, 0x5e, 0x3d, 0xca,
+	0xfa, 0x72, 0x2b, 0x99, 0x63, 0x42, 0x7b, 0xb5, 0x35, 0x6b, 0xbd, 0xed, 0xc9, 0xa5, 0x54, 0x07,
+	0x73, 0x82, 0x7b, 0x75, 0xad, 0x8e, 0x5c, 0xbb, 0xdf, 0x81, 0xea, 0x16, 0x16, 0xc1, 0x21, 0x5a,
+	0x85, 0x2a, 0x16, 0x82, 0x67, 0x3d, 0x6b, 0xad, 0xbc, 0xde, 0xdc, 0xaa, 0x7c, 0xf4, 0xd7, 0x1b,
+	0x4b, 0x9e, 0x86, 0xd0, 0xd3, 0x50, 0x39, 0x22, 0x81, 0xbc, 0x8e, 0xf2, 0xba, 0xbd, 0x69, 0x6f,
+	0xc8, 0x48, 0xd3, 0x26, 0x1a, 0x3e, 0x45, 0x76, 0x7f, 0x69, 0x41, 0x7d, 0x24, 0x15, 0x1d, 0x6c,
+	0xa3, 0x2b, 0x50, 0x0d, 0xc7, 0x7e, 0x14, 0x2a, 0xdb, 0x2b, 0x5e, 0x25, 0x1c, 0x0f, 0x42, 0x09,
+	0x0a, 0x05, 0x96, 0x34, 0x28, 0x24, 0xf8, 0x04, 0xb4, 0x52, 0xcc, 0x45, 0x24, 0x22, 0x46, 0x25,
+	0x4d, 0x5f, 0xa9, 0x3d, 0xc7, 0x06, 0x21, 0xba, 0x0a, 0x35, 0x1c, 0x04, 0x92, 0x58, 0x51, 0xd6,
+	0x54, 0x71, 0x10, 0x0c, 0x42, 0xf4, 0x08, 0xd4, 0xc3, 0xb1, 0x4f, 0x71, 0x42, 0x94, 0xed, 0x4d,
+	0xaf, 0x16, 0x8e, 0xef, 0xe2, 0x84, 0x48, 0x82, 0x30, 0x84, 0x9a, 0x26, 0x08, 0x4d, 0x78, 0x1a,
+	0x3a, 0x29, 0x8f, 0x12, 0xcc, 0x4f, 0xfd, 0x8c, 0x3c, 0xa0, 0xb3, 0x44, 0xf9, 0xa2, 0xed, 0xb5,
+	0x0d, 0xba, 0xaf, 0x40, 0xf7, 0xc7, 0x16, 0x74, 0xf6, 0x4f, 0x69, 0x70, 0x87, 0x4d, 0x46, 0x38,
+	0x8a, 0x3d, 0xf2, 0x00, 0xbd, 0x08, 0xf5, 0x80, 0xfa, 0x87, 0xf8, 0x88, 0x28, 0x8b, 0xec, 0xcd,
+	0x95, 0x8d, 0x45, 0xc2, 0x8c, 0xf2, 0x95, 0x57, 0x0b, 0xe8, 0x0e, 0x3e, 0x22, 0x86, 0xfd, 0x18,
+	0x53, 0x61, 0x2e, 0xfa, 0x73, 0xd9, 0xdf, 0xc5, 0x54, 0x20, 0x17, 0xaa, 0x62, 0x7e, 0xe3, 0xf6,
+	0x66, 0x4b, 0x79, 0xd8, 0xb8, 0xd2, 0xd3, 0x24, 0xf7, 0xfb, 0xd0, 0x3d, 0xa3, 0x53, 0x96, 0x4a,
+	0xd7, 0x05, 0xd3, 0xd4, 0x8f, 0x59, 0x80, 0xa5, 0xa7, 0x4c, 0x54, 0xda, 0xc1, 0x34, 0xbd, 0x63,
+	0x20, 0xf4, 0x0c, 0x34, 0x02, 0x96, 0x24, 0x98, 0x86, 0xf9, 0xf5, 0x81, 0x3a, 0xfc, 0x2d, 0x2a,
+	0xf8, 0xa9, 0x37, 0xa7, 0xb9, 0x6f, 0xc0, 0xf2, 0x1e, 0x27, 0x72, 0x1b, 0x89, 0x77, 0x79, 0x24,
+	0x48, 0x3f, 0x09, 0xd1, 0x73, 0x00, 0x44, 0xf2, 0xf9, 0x71, 0x94, 0x09, 0x15, 0x18, 0x67, 0xc5,
+	0x9b, 0x8a, 0x7a, 0x27, 0xca, 0x84, 0xfb, 0xcf, 0x12, 0x54, 0x15, 0x88, 0x5e, 0xca, 0x85, 0x54,
+	0x98, 0x4b, 0x95, 0x3a, 0x9b, 0x2b, 0x0b, 0x21, 0xfd, 0x5f, 0x05, 0xbc, 0x16, 0x97, 0x4b, 0x19,
+	0xc7, 0xca, 0xca, 0x45, 0x70, 0xd4, 0xd5, 0x7e, 0x10, 0xa2, 0x1b, 0x60, 0xcb, 0xc4, 0x19, 0xe3,
+	0x8c, 0x2c, 0xc2, 0x03, 0x72, 0x68, 0x10, 0xa2, 0xaf, 0x01, 0x68, 0x59, 0x75, 0xe1, 0x15, 0x9d,
+	0x99, 0x0a, 0x51, 0x77, 0xfe, 0x24, 0xb4, 0xe7, 0xf2, 0x85, 0x58, 0x69, 0xe5, 0xa0, 0x62, 0x7a,
+	0x0c, 0x9a, 0x07, 0x51, 0x7e, 0x84, 0x8e, 0x99, 0x86, 0x04, 0x14, 0xf1, 0x71, 0x28, 0x8f, 0xb1,
+	0x50, 0xa1, 0x92, 0xdb, 0xaf, 0x72, 0xc6, 0x93, 0x30, 0x7a, 0x12, 0x3a, 0xe9, 0xd4, 0x0f, 0x0e,
+	0x49, 0x30, 0xf5, 0xc7, 0xa7, 0xbe, 0xa0, 0xbd, 0xc6, 0x9a, 0xb5, 0x5e, 0xf5, 0xec, 0x74, 0xda,
+	0x97, 0xe0, 0xd6, 0xe9, 0x88, 0xba, 0xbb, 0xd0, 0x9c, 0xdb, 0x8d, 0x00, 0x6a, 0x03, 0x9a, 0x11,
+	0x2e, 0x9c, 0x25, 0xb9, 0xde, 0x26, 0x31, 0x11, 0xc4, 0xb1, 0xe4, 0xfa, 0x5e, 0x1a, 0x62, 0x41,
+	0x9c, 0x12, 0x6a, 0x42, 0xf5, 0x56, 0x2c, 0x08, 0x77, 0xca, 0x68, 0x19, 0xda, 0xfb, 0x29, 0x09,
+	0x22, 0x1c, 0x1b, 0xce, 0x8a, 0xfb, 0x43, 0x0b, 0x40, 0x1d, 0x9e, 0xb2, 0x88, 0x0a, 0xf4, 0x02,
+	0xd4, 0x92, 0x88, 0xfa, 0x22, 0xfb, 0xc2, 0xd8, 0xac, 0x26, 0x11, 0x1d, 0x65, 0x8a, 0x19, 0x9f,
+	0x48, 0xe6, 0xd2, 0x17, 0x32, 0xe3, 0x93, 0x51, 0x96, 0x9b, 0x5e, 0xbe, 0xd4, 0x74, 0xad, 0x06,
+	0x16, 0x38, 0x66, 0x93, 0xfe, 0x34, 0xfd, 0xca, 0xd4, 0xf8, 0x91, 0x05, 0xf6, 0x2e, 0x11, 0x58,
+	0xde, 0xe8, 0x57, 0xa9, 0xc7, 0x67, 0x25, 0x70, 0xd4, 0xa5, 0xa9, 0xcc, 0xdd, 0x63, 0x71, 0x14,
+	0x9c, 0xa2, 0x0d, 0xb8, 0x22, 0x95, 0x61, 0x59, 0xf4, 0x3e, 0xf1, 0x1f, 0xcc, 0x70, 0x14, 0x47,
+	0x07, 0x44, 0x97, 0xc5, 0xb6, 0xb7, 0x9c, 0x44, 0x74, 0x28, 0x29, 0xdf, 0xcb, 0x09, 0xe8, 0x29,
+	0xe8, 0x48, 0x7d, 0xd8, 0xf8, 0xbe, 0xcf, 0x28, 0xe1, 0x33, 0xaa, 0xf4, 0x6a, 0x7b, 0xad, 0x04,
+	0x9f, 0x0c, 0xc7, 0xf7, 0x87, 0x0a, 0x43, 0x37, 0x61, 0x45, 0x71, 0xa9, 0x53, 0x13, 0xc2, 0x27,
+	0x24, 0x94, 0x22, 0x4a, 0x33, 0x79, 0x2c, 0x3e, 0x51, 0xc7, 0xee, 0x2a, 0xca, 0x70, 0x7c, 0x1f,
+	0x3d, 0x05, 0xd5, 0xc3, 0x88, 0x8a, 0xac, 0x57, 0x59, 0x2b, 0xaf, 0x77, 0x36, 0x3b, 0x4a, 0x77,
+	0x45, 0xde, 0x89, 0xa8, 0xf0, 0x34, 0x11, 0x3d, 0x07, 0x52, 0x23, 0x3f, 0xa0, 0xfa, 0x4c, 0x5f,
+	0x9e, 0x61, 0x1a, 0x65, 0x27, 0x89, 0x68, 0x9f, 0x2a, 0x89, 0xfd, 0xe8, 0x7d, 0x82, 0xae, 0x41,
+	0x2d, 0x55, 0x16, 0xe6, 0x25, 0x56, 0xef, 0x64, 0x36, 0x2a, 0xa5, 0xb8, 0xac, 0x3f, 0xa6, 0xbc,
+	0x36, 0x25, 0xe2, 0x49, 0x40, 0x66, 0xb3, 0xf4, 0xaf, 0x1f, 0xb0, 0x78, 0x96, 0xe8, 0x54, 0x69,
+	0x7a, 0x20, 0xa1, 0xbe, 0x42, 0xe6, 0x0c, 0xc7, 0x11, 0x0d, 0xd9, 0x71, 0xaf, 0xb9, 0x66, 0xad,
+	0x97, 0x35, 0xc3, 0xbb, 0x0a, 0x71, 0x5f, 0x81, 0x95, 0x85, 0x93, 0x55, 0xab, 0xe3, 0x58, 0x26,
+	0xc1, 0x1a, 0xd8, 0xc1, 0x7c, 0x97, 0x99, 0x9e, 0x5b, 0x84, 0xdc, 0x17, 0x61, 0xb9, 0x28, 0x99,
+	0x24, 0x84, 0x0a, 0x39, 0x4c, 0x04, 0x7a, 0x99, 0x8f, 0x23, 0x66, 0xeb, 0xee, 0xc2, 0xd5, 0x05,
+	0xbb, 0x47, 0x64, 0x69, 0x50, 0x4b, 0x59, 0xac, 0x58, 0x1c, 0xea, 0x5a, 0x61, 0x64, 0x58, 0x1c,
+	0xaa, 0x52, 0xf1, 0x28, 0x34, 0x28, 0x39, 0xd6, 0x24, 0x3d, 0xbc, 0xd4, 0x29, 0x39, 0x96, 0x24,
+	0x97, 0xc2, 0x95, 0xf3, 0xc7, 0xf5, 0x59, 0xfc, 0xbf, 0x1d, 0x26, 0x2b, 0x7f, 0x26, 0x47, 0x31,
+	0x1a, 0x10, 0x5f, 0xb6, 0x31, 0x7d, 0xef, 0x76, 0x8e, 0xdd, 0x9d, 0x25, 0x6e, 0x58, 0xfc, 0xde,
+	0xad, 0x30, 0x34, 0xfe, 0x7d, 0x0a, 0x6a, 0xc6, 0xf7, 0x96, 0xe9, 0x35, 0x6a, 0x02, 0xe9, 0xb3,
+	0x78, 0x9b, 0x1c, 0x78, 0x86, 0x86, 0x9e, 0x85, 0x6e, 0xa4, 0x4a, 0x94, 0x9f, 0xb2, 0x4c, 0xb5,
+	0x61, 0xa5, 0x41, 0xd5, 0xeb, 0x68, 0x78, 0xcf, 0xa0, 0xee, 0x3e, 0x5c, 0x3b, 0xf3, 0x95, 0xbd,
+	0xbc, 0x6d, 0xa3, 0x57, 0xa1, 0xbd, 0xe8, 0xeb, 0x21, 0x39, 0x98, 0x27, 0xa3, 0xfa, 0xde, 0x9c,
+	0x6f, 0xeb, 0x54, 0x7e, 0x77, 0x31, 0x02, 0x6c, 0x93, 0x03, 0xf7, 0xbd, 0xe2, 0x15, 0x6f, 0x73,
+	0x96, 0x2e, 0x62, 0x23, 0x66, 0x93, 0x28, 0xc0, 0xb1, 0x1f, 0x85, 0x27, 0x26, 0x87, 0xc0, 0x40,
+	0x83, 0xf0, 0xe4, 0x82, 0x5b, 0x4a, 0x17, 0xdd, 0xf2, 0xb7, 0x0a, 0xb4, 0x8b, 0xf7, 0xf0, 0xe0,
+	0x4c, 0xef, 0xb1, 0xce, 0xf6, 0x9e, 0xf9, 0x14, 0x53, 0x2a, 0x4c, 0x31, 0x2e, 0x54, 0xa6, 0x11,
+	0xd5, 0x9d, 0x28, 0xcf, 0x24, 0x75, 0xe2, 0x77, 0x23, 0x1a, 0x7a, 0x8a, 0x86, 0x5e, 0x05, 0xc0,
+	0x61, 0x98, 0x47, 0x79, 0x45, 0x59, 0xde, 0x5b, 0x70, 0x9e, 0xbd, 0x93, 0x9d, 0x25, 0xaf, 0x89,
+	0xe7, 0x17, 0xf4, 0x3a, 0xd8, 0x21, 0x67, 0x69, 0x2e, 0x5b, 0x55, 0xb2, 0x8f, 0x9e, 0x93, 0x5d,
+	0x38, 0x65, 0x67, 0xc9, 0x83, 0x70, 0xe1, 0xa2, 0x37, 0xa1, 0xc5, 0x55, 0x6c, 0xf9, 0x7a, 0xa0,
+	0xa8, 0x29, 0xf1, 0xd5, 0x73, 0xe2, 0x85, 0x68, 0xde, 0x59, 0xf2, 0x6c, 0x5e, 0x08, 0xee, 0x37,
+	0xa1, 0x33, 0x53, 0x4d, 0xc8, 0xcf, 0xd3, 0x42, 0xf7, 0xbd, 0x6b, 0xe7, 0x8e, 0x30, 0xf9, 0xb3,
+	0xb3, 0xe4, 0xb5, 0x35, 0x7f, 0x9e, 0x50, 0xaf, 0x83, 0x9d, 0x1f, 0x90, 0x09, 0xae, 0x32, 0xfc,
+	0xa2, 0xfe, 0x8b, 0xbc, 0x95, 0xfa, 0x9b, 0x03, 0x32, 0xc1, 0xd1, 0xeb, 0x60, 0x8e, 0xf3, 0x4d,
+	0x75, 0x69, 0x2a, 0xf9, 0xab, 0xe7, 0xe4, 0x75, 0x71, 0xdd, 0x59, 0xf2, 0x5a, 0x9a, 0xdb, 0x14,
+	0xdb, 0x2d, 0x68, 0x4b, 0xb7, 0xcf, 0x83, 0xa9, 0x07, 0x4a, 0xfa, 0xb1, 0x8b, 0x9e, 0x9f, 0xc7,
+	0x9f, 0x3c, 0x03, 0x9f, 0x8d, 0x5b, 0x30, 0x1e, 0x0c, 0x58, 0xdc, 0xb3, 0x2f, 0xbd, 0xba, 0x79,
+	0xfa, 0xca, 0xab, 0xe3, 0xf9, 0x66, 0xcb, 0x86, 0x26, 0x4b, 0x89, 0xaa, 0x7c, 0xd4, 0xfd, 0x57,
+	0x19, 0xec, 0xfd, 0xe0, 0x90, 0x24, 0xf8, 0xad, 0x13, 0xc1, 0x31, 0x7a, 0x06, 0xba, 0x94, 0x9c,
+	0x08, 0x79, 0x6a, 0x3e, 0x7c, 0xea, 0x00, 0x6e, 0x4b, 0xb8, 0xcf, 0x62, 0x3d, 0x7c, 0xaa, 0x79,
+	0x85, 0xb3, 0x34, 0x25, 0xa1, 0xaf, 0x07, 0x72, 0x39, 0xb6, 0xc9, 0x79, 0x45, 0x83, 0xb7, 0xcc,
+	0x44, 0xde, 0xd1, 0xf1, 0xe1, 0x07, 0x87, 0x98, 0x4e, 0x48, 0x68, 0xde, 0x0a, 0x6d, 0x8d, 0xf6,
+	0x35, 0x78, 0xa6, 0xb8, 0x54, 0xce, 0x16, 0x97, 0xcf, 0xe9, 0x4b, 0xd5, 0xff, 0xbe, 0x2f, 0xd5,
+	0xbe, 0x44, 0x5f, 0xaa, 0x3f, 0xb4, 0x2f, 0x35, 0xbe, 0x74, 0x5f, 0x6a, 0x5e, 0xda, 0x97, 0x9e,
+	0x80, 0x96, 0xe6, 0x31, 0xf1, 0x03, 0x7a, 0x26, 0x56, 0xd8, 0xde, 0x65, 0x2d, 0xca, 0x7e, 0x48,
+	0x8b, 0x6a, 0x3d, 0xac, 0x45, 0xb5, 0x2f, 0xb4, 0xa8, 0x10, 0x1a, 0x03, 0x2a, 0xbe, 0xf5, 0xf2,
+	0x2e, 0x4e, 0x91, 0x0b, 0x56, 0x62, 0x46, 0x67, 0x3d, 0x05, 0xe7, 0x94, 0x8d, 0x5d, 0x3d, 0x44,
+	0x5b, 0xc9, 0xea, 0xcb, 0x50, 0xd3, 0x1b, 0xf9, 0x68, 0x9b, 0x92, 0x53, 0x15, 0x18, 0x65, 0x4f,
+	0x2e, 0xd1, 0x0a, 0x54, 0x8f, 0x70, 0x3c, 0xd3, 0x1d, 0xa0, 0xec, 0xe9, 0xcd, 0x6b, 0xa5, 0x57,
+	0x2c, 0xf7, 0x1d, 0x68, 0x8d, 0x38, 0xa6, 0xd9, 0x36, 0xc9, 0x64, 0x3d, 0x96, 0x1d, 0x99, 0x8d,
+	0xef, 0x0f, 0x4c, 0x61, 0xac, 0x7a, 0x66, 0x27, 0xf1, 0x71, 0x3c, 0x95, 0xb8, 0x2e, 0xe1, 0x66,
+	0x27, 0x71, 0xce, 0x8e, 0x25, 0x5e, 0xd6, 0xb8, 0xde, 0xb9, 0x3f, 0xb0, 0xc0, 0xde, 0x8a, 0xa7,
+	0xea, 0x6c, 0x69, 0xc1, 0x0b, 0x0b, 0x0b, 0x1e, 0xd1, 0x23, 0xcf, 0x82, 0x68, 0x8c, 0x30, 0xcf,
+	0x40, 0x2b, 0x59, 0xbd, 0x7d, 0x99, 0x29, 0x55, 0x6d, 0xca, 0xb3, 0x45, 0x53, 0xec, 0xcd, 0x65,
+	0xfd, 0xca, 0x29, 0x98, 0x50, 0xb4, 0x6e, 0x07, 0x50, 0xfe, 0x9d, 0x03, 0xc2, 0xb7, 0x18, 0x9b,
+	0x46, 0x74, 0x82, 0x36, 0xa1, 0x91, 0xe0, 0x34, 0x8d, 0xe8, 0x24, 0x33, 0x2a, 0x39, 0xe7, 0x55,
+	0x32, 0xba, 0xcc, 0xf9, 0xdc, 0x57, 0xc0, 0x7e, 0xeb, 0x24, 0x8d, 0x38, 0x09, 0x3d, 0x76, 0x9c,
+	0xc9, 0xc7, 0xe4, 0x38, 0x9e, 0xe6, 0xc5, 0xbe, 0xe5, 0x55, 0x95, 0x3b, 0xe4, 0xe3, 0x98, 0xb3,
+	0x63, 0x3d, 0x05, 0xb6, 0x3c, 0xb5, 0x76, 0xff, 0x5d, 0x02, 0x47, 0x45, 0x56, 0x5f, 0xbd, 0x8b,
+	0xb4, 0x5d, 0x97, 0xbe, 0x6c, 0xaf, 0x42, 0x4d, 0x8c, 0xe3, 0x45, 0xa7, 0xa8, 0x8a, 0x71, 0x7c,
+	0xe1, 0x69, 0x52, 0x3e, 0xff, 0x34, 0xf9, 0x26, 0x34, 0x32, 0x81, 0xb9, 0xf0, 0xd5, 0x5c, 0xf6,
+	0xb9, 0xd3, 0xa7, 0xb1, 0xa8, 0xae, 0x78, 0x47, 0x99, 0x8c, 0xbf, 0x45, 0x6a, 0x65, 0xbd, 0xea,
+	0x5a, 0x79, 0xbd, 0xe5, 0x41, 0x92, 0xe7, 0x54, 0xa6, 0xde, 0x85, 0x9c, 0x60, 0x91, 0x73, 0xd4,
+	0x14, 0x87, 0x6d, 0x30, 0xc5, 0xf2, 0x0d, 0xa8, 0x8f, 0xb5, 0x4f, 0x4d, 0x7d, 0x3f, 0x7b, 0xb5,
+	0x0b, 0x97, 0x7b, 0x39, 0x9f, 0xfc, 0xac, 0x59, 0xca, 0x17, 0xa7, 0x4a, 0xd8, 0xa6, 0x07, 0x06,
+	0xba, 0xc3, 0x02, 0x79, 0xe3, 0x84, 0x73, 0x95, 0x97, 0x4d, 0x4f, 0x2e, 0xd1, 0xab, 0xd0, 0x22,
+	0xda, 0xf5, 0xbe, 0x72, 0x2e, 0x14, 0xae, 0xac, 0x70, 0x27, 0xc6, 0x40, 0x9b, 0x2c, 0x20, 0xf7,
+	0x27, 0x25, 0xe8, 0x28, 0xdf, 0x8f, 0x70, 0x36, 0xfd, 0xbf, 0x7b, 0xbe, 0xf0, 0xd3, 0x41, 0xe5,
+	0xcc, 0x4f, 0x07, 0x2e, 0xb4, 0x05, 0x33, 0x55, 0xa6, 0xe0, 0x5d, 0x5b, 0x30, 0xa5, 0x8c, 0xf2,
+	0xdd, 0x06, 0x5c, 0x21, 0x99, 0x88, 0x12, 0xe5, 0xe0, 0x84, 0x24, 0xfe, 0x2c, 0xc3, 0x13, 0xdd,
+	0x6a, 0x2b, 0xde, 0xf2, 0x9c, 0xb4, 0x4b, 0x92, 0x7b, 0x92, 0x20, 0x75, 0xc1, 0x41, 0xc0, 0x66,
+	0x54, 0x48, 0x35, 0xcd, 0x48, 0x6c, 0x10, 0xfd, 0x33, 0xc6, 0x2c, 0x23, 0x5c, 0xd2, 0x1a, 0x8a,
+	0x56, 0x93, 0x5b, 0x4d, 0xe0, 0x4c, 0xcf, 0x25, 0x4d, 0x4d, 0x90, 0xdb, 0x41, 0xe8, 0xa6, 0x60,
+	0xef, 0xe0, 0xec, 0x70, 0x0f, 0x4f, 0xc8, 0xa5, 0x09, 0x5a, 0x20, 0x5e, 0x48, 0xd0, 0x4b, 0x6b,
+	0x4d, 0xfb, 0x92, 0x5a, 0xd3, 0x2a, 0x64, 0xe3, 0xf3, 0x3f, 0x2d, 0x41, 0x6d, 0x98, 0xf6, 0x59,
+	0x48, 0x50, 0x1d, 0xca, 0x77, 0x59, 0xea, 0x2c, 0xa1, 0x65, 0x68, 0x0d, 0xd3, 0xdb, 0x44, 0x98,
+	0x5f, 0x24, 0x9c, 0xbf, 0xd7, 0x91, 0x03, 0xf6, 0x30, 0xdd, 0xe3, 0x26, 0x5f, 0x9c, 0x7f, 0xd4,
+	0x91, 0x2d, 0xe5, 0xf6, 0x22, 0x3a, 0x71, 0x3e, 0xee, 0xa2, 0x16, 0xd4, 0x87, 0xe9, 0xdb, 0xf1,
+	0x2c, 0x3b, 0x74, 0x7e, 0xd5, 0xd5, 0xf2, 0x8b, 0x57, 0xac, 0xf3, 0xeb, 0x2e, 0xea, 0x40, 0x73,
+	0x98, 0x0e, 0x68, 0x96, 0x92, 0x40, 0x38, 0xbf, 0xe9, 0xa2, 0x15, 0xe8, 0x0e, 0xd3, 0x5b, 0x61,
+	0xf8, 0x36, 0x9e, 0xc5, 0x62, 0x4f, 0x71, 0xfd, 0xb6, 0x8b, 0xda, 0xd0, 0x18, 0xa6, 0x5b, 0x38,
+	0x98, 0xce, 0x52, 0xe7, 0x77, 0x5d, 0xfd, 0xd1, 0x11, 0xc7, 0x01, 0xd9, 0x4f, 0x31, 0x75, 0x7e,
+	0xdf, 0x45, 0x57, 0xa0, 0x33, 0x4c, 0xf7, 0x05, 0xe3, 0x78, 0x42, 0xd4, 0x15, 0x38, 0x7f, 0xe8,
+	0xa2, 0x47, 0x00, 0x0d, 0xd3, 0xdb, 0x31, 0x1b, 0xe3, 0xb8, 0xf0, 0xd1, 0x3f, 0x76, 0xd1, 0x35,
+	0x58, 0x96, 0x1f, 0x15, 0x84, 0x07, 0x24, 0x15, 0x46, 0xf5, 0x3f, 0x75, 0x11, 0x82, 0xb6, 0x34,
+	0x59, 0x6e, 0xd5, 0xdd, 0x3b, 0x7f, 0x36, 0xbc, 0xdb, 0x51, 0x36, 0x95, 0x7f, 0xfd, 0x98, 0x60,
+	0x4a, 0xb8, 0xf3, 0x97, 0xee, 0xf3, 0x3f, 0xb3, 0xa0, 0x39, 0x9f, 0x01, 0x91, 0x0d, 0xf5, 0x01,
+	0x3d, 0xc2, 0x71, 0x14, 0x3a, 0x4b, 0xa8, 0x0d, 0xcd, 0xf9, 0xa4, 0xe7, 0x58, 0xa8, 0x03, 0xb0,
+	0x18, 0xde, 0x9c, 0x12, 0xea, 0x82, 0x5d, 0x98, 0xc6, 0xf4, 0x73, 0xff, 0x5e, 0x71, 0xa0, 0x72,
+	0x2a, 0x68, 0x05, 0x9c, 0x1c, 0xca, 0xc7, 0x26, 0xa7, 0x8a, 0x1c, 0x68, 0xdd, 0x2b, 0x0c, 0x3f,
+	0x4e, 0x4d, 0x22, 0xc5, 0xd1, 0xc6, 0x91, 0x17, 0xd2, 0x9a, 0xcf, 0x2a, 0xf2, 0x7b, 0x8d, 0xe7,
+	0x6f, 0x43, 0x73, 0xde, 0x5e, 0x51, 0x03, 0x2a, 0xb7, 0x66, 0x82, 0x69, 0x2d, 0xef, 0x32, 0xfd,
+	0xfb, 0x42, 0xe6, 0x58, 0xa8, 0x05, 0x8d, 0xad, 0x68, 0xa2, 0x55, 0x2a, 0xa1, 0x2b, 0xd0, 0xed,
+	0x33, 0x2a, 0x22, 0x3a, 0x63, 0xb3, 0x4c, 0xfd, 0x3a, 0xe4, 0x94, 0xb7, 0xde, 0xf8, 0xe8, 0xd3,
+	0xeb, 0xd6, 0xc7, 0x9f, 0x5e, 0xb7, 0x3e, 0xf9, 0xf4, 0xfa, 0xd2, 0x07, 0x9f, 0x5d, 0xb7, 0xde,
+	0xfb, 0x7a, 0xe1, 0x17, 0xe7, 0x04, 0x0b, 0x1e, 0x9d, 0x30, 0x1e, 0x4d, 0x22, 0x9a, 0x6f, 0x28,
+	0xb9, 0x99, 0x4e, 0x27, 0x37, 0xd3, 0xf1, 0x4d, 0x9c, 0x46, 0xe3, 0x9a, 0xfa, 0x69, 0xf9, 0xa5,
+	0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x26, 0x36, 0x73, 0x85, 0xb8, 0x16, 0x00, 0x00,
 }
 
 func (m *TNPingRequest) Marshal() (dAtA []byte, err error) {
@@ -3297,6 +3438,30 @@ func (m *AlterTablePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.TimeWindow != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.TimeWindow))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.TimeColumn) > 0 {
+		i -= len(m.TimeColumn)
+		copy(dAtA[i:], m.TimeColumn)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.TimeColumn)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.SizeRatio != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.SizeRatio))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Policy) > 0 {
+		i -= len(m.Policy)
+		copy(dAtA[i:], m.Policy)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Policy)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.MinCnMergeSize != 0 {
 		i = encodeVarintApi(dAtA, i, uint64(m.MinCnMergeSize))
 		i--
@@ -3856,6 +4021,30 @@ func (m *SchemaExtra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.TimeWindow != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.TimeWindow))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.TimeColumn) > 0 {
+		i -= len(m.TimeColumn)
+		copy(dAtA[i:], m.TimeColumn)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.TimeColumn)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.SizeRatio != 0 {
+		i = encodeVarintApi(dAtA, i, uint64(m.SizeRatio))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.MergePolicy) > 0 {
+		i -= len(m.MergePolicy)
+		copy(dAtA[i:], m.MergePolicy)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.MergePolicy)))
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.MinCnMergeSize != 0 {
 		i = encodeVarintApi(dAtA, i, uint64(m.MinCnMergeSize))
 		i--
@@ -4102,6 +4291,47 @@ func (m *BlkTransferBooking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExpiredRows) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpiredRows) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpiredRows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Rows) > 0 {
+		i -= len(m.Rows)
+		copy(dAtA[i:], m.Rows)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.Rows)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlkId) > 0 {
+		i -= len(m.BlkId)
+		copy(dAtA[i:], m.BlkId)
+		i = encodeVarintApi(dAtA, i, uint64(len(m.BlkId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MergeCommitEntry) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -4126,6 +4356,20 @@ func (m *MergeCommitEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ExpiredRows) > 0 {
+		for iNdEx := len(m.ExpiredRows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExpiredRows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Err) > 0 {
 		i -= len(m.Err)
 		copy(dAtA[i:], m.Err)
@@ -4697,6 +4941,20 @@ func (m *AlterTablePolicy) ProtoSize() (n int) {
 	if m.MinCnMergeSize != 0 {
 		n += 1 + sovApi(uint64(m.MinCnMergeSize))
 	}
+	l = len(m.Policy)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.SizeRatio != 0 {
+		n += 1 + sovApi(uint64(m.SizeRatio))
+	}
+	l = len(m.TimeColumn)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.TimeWindow != 0 {
+		n += 1 + sovApi(uint64(m.TimeWindow))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4992,6 +5250,20 @@ func (m *SchemaExtra) ProtoSize() (n int) {
 	if m.MinCnMergeSize != 0 {
 		n += 1 + sovApi(uint64(m.MinCnMergeSize))
 	}
+	l = len(m.MergePolicy)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.SizeRatio != 0 {
+		n += 1 + sovApi(uint64(m.SizeRatio))
+	}
+	l = len(m.TimeColumn)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.TimeWindow != 0 {
+		n += 1 + sovApi(uint64(m.TimeWindow))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5078,6 +5350,26 @@ func (m *BlkTransferBooking) ProtoSize() (n int) {
 	return n
 }
 
+func (m *ExpiredRows) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlkId)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	l = len(m.Rows)
+	if l > 0 {
+		n += 1 + l + sovApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *MergeCommitEntry) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -5122,6 +5414,12 @@ func (m *MergeCommitEntry) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovApi(uint64(l))
 	}
+	if len(m.ExpiredRows) > 0 {
+		for _, e := range m.ExpiredRows {
+			l = e.ProtoSize()
+			n += 1 + l + sovApi(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7386,6 +7684,108 @@ func (m *AlterTablePolicy) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeRatio", wireType)
+			}
+			m.SizeRatio = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeRatio |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeColumn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeColumn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeWindow", wireType)
+			}
+			m.TimeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -8772,6 +9172,108 @@ func (m *SchemaExtra) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MergePolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MergePolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeRatio", wireType)
+			}
+			m.SizeRatio = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeRatio |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeColumn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeColumn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeWindow", wireType)
+			}
+			m.TimeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
@@ -9303,6 +9805,125 @@ func (m *BlkTransferBooking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExpiredRows) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpiredRows: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpiredRows: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlkId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlkId = append(m.BlkId[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlkId == nil {
+				m.BlkId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rows", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rows = append(m.Rows[:0], dAtA[iNdEx:postIndex]...)
+			if m.Rows == nil {
+				m.Rows = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MergeCommitEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9599,6 +10220,40 @@ func (m *MergeCommitEntry) Unmarshal(dAtA []byte) error {
 			}
 			m.Err = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredRows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiredRows = append(m.ExpiredRows, ExpiredRows{})
+			if err := m.ExpiredRows[len(m.ExpiredRows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipApi(dAtA[iNdEx:])
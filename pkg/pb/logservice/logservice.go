@@ -100,6 +100,7 @@ func (s *CNState) Update(hb CNStoreHeartbeat, tick uint64) {
 		storeInfo.ConfigData = hb.ConfigData
 	}
 	storeInfo.Resource = hb.Resource
+	storeInfo.Load = hb.Load
 	storeInfo.CommitID = hb.CommitID
 	s.Stores[hb.UUID] = storeInfo
 }
@@ -165,6 +166,7 @@ func (s *TNState) Update(hb TNStoreHeartbeat, tick uint64) {
 	}
 	storeInfo.Tick = tick
 	storeInfo.Shards = hb.Shards
+	storeInfo.StandbyShards = hb.StandbyShards
 	storeInfo.ServiceAddress = hb.ServiceAddress
 	storeInfo.LogtailServerAddress = hb.LogtailServerAddress
 	storeInfo.LockServiceAddress = hb.LockServiceAddress
@@ -174,6 +176,7 @@ func (s *TNState) Update(hb TNStoreHeartbeat, tick uint64) {
 		storeInfo.ConfigData = hb.ConfigData
 	}
 	storeInfo.QueryAddress = hb.QueryAddress
+	storeInfo.Locality = hb.Locality
 	s.Stores[hb.UUID] = storeInfo
 }
 
@@ -206,6 +209,7 @@ func (s *LogState) updateStores(hb LogStoreHeartbeat, tick uint64) {
 	if hb.ConfigData != nil {
 		storeInfo.ConfigData = hb.ConfigData
 	}
+	storeInfo.Locality = hb.Locality
 	s.Stores[hb.UUID] = storeInfo
 }
 
@@ -229,6 +233,12 @@ func (s *LogState) updateShards(hb LogStoreHeartbeat) {
 			}
 		}
 
+		// only the leader replica reports the non-voting replicas
+		if incoming.LeaderID == incoming.ReplicaID &&
+			incoming.Epoch >= recorded.Epoch && incoming.Term >= recorded.Term {
+			recorded.NonVotingReplicas = incoming.NonVotingReplicas
+		}
+
 		if incoming.Term > recorded.Term && incoming.LeaderID != NoLeader {
 			recorded.Term = incoming.Term
 			recorded.LeaderID = incoming.LeaderID
@@ -280,6 +290,14 @@ func (m *ScheduleCommand) LogString() string {
 			StartReplica:  "Start",
 			StopReplica:   "Stop",
 			KillZombie:    "Kill",
+
+			AddNonVotingReplica:    "AddNonVoting",
+			RemoveNonVotingReplica: "RemoveNonVoting",
+			StartNonVotingReplica:  "StartNonVoting",
+
+			StartStandbyReplica:   "StartStandby",
+			PromoteStandbyReplica: "PromoteStandby",
+			StopStandbyReplica:    "StopStandby",
 		}[m.ConfigChange.ChangeType]
 	}
 
@@ -381,22 +381,46 @@ const (
 	StartReplica  ConfigChangeType = 2
 	StopReplica   ConfigChangeType = 3
 	KillZombie    ConfigChangeType = 4
+	// AddNonVotingReplica adds a non-voting replica to the shard, or keeps the
+	// replica, such as in a remote region, in sync without joining the quorum.
+	AddNonVotingReplica    ConfigChangeType = 5
+	RemoveNonVotingReplica ConfigChangeType = 6
+	StartNonVotingReplica  ConfigChangeType = 7
+	// StartStandbyReplica starts a hot-standby TN replica which tails the log
+	// shard without serving transactions.
+	StartStandbyReplica ConfigChangeType = 8
+	// PromoteStandbyReplica promotes a hot-standby TN replica to the working
+	// TN replica of the shard.
+	PromoteStandbyReplica ConfigChangeType = 9
+	StopStandbyReplica    ConfigChangeType = 10
 )
 
 var ConfigChangeType_name = map[int32]string{
-	0: "AddReplica",
-	1: "RemoveReplica",
-	2: "StartReplica",
-	3: "StopReplica",
-	4: "KillZombie",
+	0:  "AddReplica",
+	1:  "RemoveReplica",
+	2:  "StartReplica",
+	3:  "StopReplica",
+	4:  "KillZombie",
+	5:  "AddNonVotingReplica",
+	6:  "RemoveNonVotingReplica",
+	7:  "StartNonVotingReplica",
+	8:  "StartStandbyReplica",
+	9:  "PromoteStandbyReplica",
+	10: "StopStandbyReplica",
 }
 
 var ConfigChangeType_value = map[string]int32{
-	"AddReplica":    0,
-	"RemoveReplica": 1,
-	"StartReplica":  2,
-	"StopReplica":   3,
-	"KillZombie":    4,
+	"AddReplica":             0,
+	"RemoveReplica":          1,
+	"StartReplica":           2,
+	"StopReplica":            3,
+	"KillZombie":             4,
+	"AddNonVotingReplica":    5,
+	"RemoveNonVotingReplica": 6,
+	"StartNonVotingReplica":  7,
+	"StartStandbyReplica":    8,
+	"PromoteStandbyReplica":  9,
+	"StopStandbyReplica":     10,
 }
 
 func (x ConfigChangeType) String() string {
@@ -439,6 +463,34 @@ func (ServiceType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_fd1040c5381ab5a7, []int{9}
 }
 
+type ScaleAction int32
+
+const (
+	NoScale  ScaleAction = 0
+	ScaleOut ScaleAction = 1
+	ScaleIn  ScaleAction = 2
+)
+
+var ScaleAction_name = map[int32]string{
+	0: "NoScale",
+	1: "ScaleOut",
+	2: "ScaleIn",
+}
+
+var ScaleAction_value = map[string]int32{
+	"NoScale":  0,
+	"ScaleOut": 1,
+	"ScaleIn":  2,
+}
+
+func (x ScaleAction) String() string {
+	return proto.EnumName(ScaleAction_name, int32(x))
+}
+
+func (ScaleAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fd1040c5381ab5a7, []int{10}
+}
+
 type CNStore struct {
 	UUID                 string                        `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
 	ServiceAddress       string                        `protobuf:"bytes,2,opt,name=ServiceAddress,proto3" json:"ServiceAddress,omitempty"`
@@ -455,6 +507,7 @@ type CNStore struct {
 	UpTime               int64                         `protobuf:"varint,14,opt,name=UpTime,proto3" json:"UpTime,omitempty"`
 	ShardServiceAddress  string                        `protobuf:"bytes,15,opt,name=ShardServiceAddress,proto3" json:"ShardServiceAddress,omitempty"`
 	CommitID             string                        `protobuf:"bytes,16,opt,name=CommitID,proto3" json:"CommitID,omitempty"`
+	Load                 CNLoad                        `protobuf:"bytes,17,opt,name=Load,proto3" json:"Load"`
 	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
 	XXX_unrecognized     []byte                        `json:"-"`
 	XXX_sizecache        int32                         `json:"-"`
@@ -598,6 +651,13 @@ func (m *CNStore) GetCommitID() string {
 	return ""
 }
 
+func (m *CNStore) GetLoad() CNLoad {
+	if m != nil {
+		return m.Load
+	}
+	return CNLoad{}
+}
+
 type TNStore struct {
 	UUID           string        `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
 	ServiceAddress string        `protobuf:"bytes,2,opt,name=ServiceAddress,proto3" json:"ServiceAddress,omitempty"`
@@ -610,11 +670,13 @@ type TNStore struct {
 	LockServiceAddress string      `protobuf:"bytes,7,opt,name=LockServiceAddress,proto3" json:"LockServiceAddress,omitempty"`
 	ConfigData         *ConfigData `protobuf:"bytes,9,opt,name=ConfigData,proto3" json:"ConfigData,omitempty"`
 	// QueryAddress is the address of the queryservice on tn
-	QueryAddress         string   `protobuf:"bytes,10,opt,name=QueryAddress,proto3" json:"QueryAddress,omitempty"`
-	ShardServiceAddress  string   `protobuf:"bytes,11,opt,name=ShardServiceAddress,proto3" json:"ShardServiceAddress,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	QueryAddress        string `protobuf:"bytes,10,opt,name=QueryAddress,proto3" json:"QueryAddress,omitempty"`
+	ShardServiceAddress string `protobuf:"bytes,11,opt,name=ShardServiceAddress,proto3" json:"ShardServiceAddress,omitempty"`
+	// Locality is the failure domain labels of the store.
+	Locality             map[string]string `protobuf:"bytes,12,rep,name=Locality,proto3" json:"Locality,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *TNStore) Reset()         { *m = TNStore{} }
@@ -720,16 +782,25 @@ func (m *TNStore) GetShardServiceAddress() string {
 	return ""
 }
 
+func (m *TNStore) GetLocality() map[string]string {
+	if m != nil {
+		return m.Locality
+	}
+	return nil
+}
+
 type LogStore struct {
-	UUID                 string           `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
-	ServiceAddress       string           `protobuf:"bytes,2,opt,name=ServiceAddress,proto3" json:"ServiceAddress,omitempty"`
-	Tick                 uint64           `protobuf:"varint,3,opt,name=Tick,proto3" json:"Tick,omitempty"`
-	State                NodeState        `protobuf:"varint,4,opt,name=State,proto3,enum=logservice.NodeState" json:"State,omitempty"`
-	Replicas             []LogReplicaInfo `protobuf:"bytes,5,rep,name=Replicas,proto3" json:"Replicas"`
-	ConfigData           *ConfigData      `protobuf:"bytes,6,opt,name=ConfigData,proto3" json:"ConfigData,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	UUID           string           `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	ServiceAddress string           `protobuf:"bytes,2,opt,name=ServiceAddress,proto3" json:"ServiceAddress,omitempty"`
+	Tick           uint64           `protobuf:"varint,3,opt,name=Tick,proto3" json:"Tick,omitempty"`
+	State          NodeState        `protobuf:"varint,4,opt,name=State,proto3,enum=logservice.NodeState" json:"State,omitempty"`
+	Replicas       []LogReplicaInfo `protobuf:"bytes,5,rep,name=Replicas,proto3" json:"Replicas"`
+	ConfigData     *ConfigData      `protobuf:"bytes,6,opt,name=ConfigData,proto3" json:"ConfigData,omitempty"`
+	// Locality is the failure domain labels of the store.
+	Locality             map[string]string `protobuf:"bytes,7,rep,name=Locality,proto3" json:"Locality,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *LogStore) Reset()         { *m = LogStore{} }
@@ -807,6 +878,13 @@ func (m *LogStore) GetConfigData() *ConfigData {
 	return nil
 }
 
+func (m *LogStore) GetLocality() map[string]string {
+	if m != nil {
+		return m.Locality
+	}
+	return nil
+}
+
 // LogShardInfo contains information a log shard.
 type LogShardInfo struct {
 	// ShardID is the ID of a Log shard.
@@ -821,10 +899,13 @@ type LogShardInfo struct {
 	// LeaderID is 0, it means there is no leader or the leader is unknown.
 	LeaderID uint64 `protobuf:"varint,4,opt,name=LeaderID,proto3" json:"LeaderID,omitempty"`
 	// Term is the Raft term value.
-	Term                 uint64   `protobuf:"varint,5,opt,name=Term,proto3" json:"Term,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Term uint64 `protobuf:"varint,5,opt,name=Term,proto3" json:"Term,omitempty"`
+	// NonVotingReplicas is a map of ReplicaID to LogStore UUID of the non-voting
+	// replicas, aka learners. It's only reported by the leader replica.
+	NonVotingReplicas    map[uint64]string `protobuf:"bytes,6,rep,name=NonVotingReplicas,proto3" json:"NonVotingReplicas,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *LogShardInfo) Reset()         { *m = LogShardInfo{} }
@@ -895,11 +976,20 @@ func (m *LogShardInfo) GetTerm() uint64 {
 	return 0
 }
 
+func (m *LogShardInfo) GetNonVotingReplicas() map[uint64]string {
+	if m != nil {
+		return m.NonVotingReplicas
+	}
+	return nil
+}
+
 // LogReplicaInfo contains information of a log replica.
 type LogReplicaInfo struct {
 	LogShardInfo `protobuf:"bytes,1,opt,name=LogShardInfo,proto3,embedded=LogShardInfo" json:"LogShardInfo"`
 	// ReplicaID is the ID of a replica within the Log shard.
-	ReplicaID            uint64   `protobuf:"varint,2,opt,name=ReplicaID,proto3" json:"ReplicaID,omitempty"`
+	ReplicaID uint64 `protobuf:"varint,2,opt,name=ReplicaID,proto3" json:"ReplicaID,omitempty"`
+	// IsNonVoting indicates whether the replica is a non-voting replica.
+	IsNonVoting          bool     `protobuf:"varint,3,opt,name=IsNonVoting,proto3" json:"IsNonVoting,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -945,6 +1035,13 @@ func (m *LogReplicaInfo) GetReplicaID() uint64 {
 	return 0
 }
 
+func (m *LogReplicaInfo) GetIsNonVoting() bool {
+	if m != nil {
+		return m.IsNonVoting
+	}
+	return false
+}
+
 type Resource struct {
 	CPUTotal             uint64   `protobuf:"varint,1,opt,name=CPUTotal,proto3" json:"CPUTotal,omitempty"`
 	CPUAvailable         float64  `protobuf:"fixed64,2,opt,name=CPUAvailable,proto3" json:"CPUAvailable,omitempty"`
@@ -1016,6 +1113,64 @@ func (m *Resource) GetMemAvailable() uint64 {
 	return 0
 }
 
+// CNLoad is the workload of a CN store.
+type CNLoad struct {
+	// ActiveSessions is the number of the sessions connected to the CN.
+	ActiveSessions uint64 `protobuf:"varint,1,opt,name=ActiveSessions,proto3" json:"ActiveSessions,omitempty"`
+	// ActiveQueries is the number of the sessions running a query.
+	ActiveQueries        uint64   `protobuf:"varint,2,opt,name=ActiveQueries,proto3" json:"ActiveQueries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CNLoad) Reset()         { *m = CNLoad{} }
+func (m *CNLoad) String() string { return proto.CompactTextString(m) }
+func (*CNLoad) ProtoMessage()    {}
+func (*CNLoad) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd1040c5381ab5a7, []int{6}
+}
+func (m *CNLoad) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CNLoad) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CNLoad.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CNLoad) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CNLoad.Merge(m, src)
+}
+func (m *CNLoad) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CNLoad) XXX_DiscardUnknown() {
+	xxx_messageInfo_CNLoad.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CNLoad proto.InternalMessageInfo
+
+func (m *CNLoad) GetActiveSessions() uint64 {
+	if m != nil {
+		return m.ActiveSessions
+	}
+	return 0
+}
+
+func (m *CNLoad) GetActiveQueries() uint64 {
+	if m != nil {
+		return m.ActiveQueries
+	}
+	return 0
+}
+
 // CNStoreHeartbeat is the periodic message sent tp the HAKeeper by CN stores.
 type CNStoreHeartbeat struct {
 	UUID                 string          `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
@@ -1032,6 +1187,7 @@ type CNStoreHeartbeat struct {
 	Resource             Resource        `protobuf:"bytes,13,opt,name=Resource,proto3" json:"Resource"`
 	ShardServiceAddress  string          `protobuf:"bytes,14,opt,name=ShardServiceAddress,proto3" json:"ShardServiceAddress,omitempty"`
 	CommitID             string          `protobuf:"bytes,15,opt,name=CommitID,proto3" json:"CommitID,omitempty"`
+	Load                 CNLoad          `protobuf:"bytes,16,opt,name=Load,proto3" json:"Load"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -1041,7 +1197,7 @@ func (m *CNStoreHeartbeat) Reset()         { *m = CNStoreHeartbeat{} }
 func (m *CNStoreHeartbeat) String() string { return proto.CompactTextString(m) }
 func (*CNStoreHeartbeat) ProtoMessage()    {}
 func (*CNStoreHeartbeat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{6}
+	return fileDescriptor_fd1040c5381ab5a7, []int{7}
 }
 func (m *CNStoreHeartbeat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1168,6 +1324,13 @@ func (m *CNStoreHeartbeat) GetCommitID() string {
 	return ""
 }
 
+func (m *CNStoreHeartbeat) GetLoad() CNLoad {
+	if m != nil {
+		return m.Load
+	}
+	return CNLoad{}
+}
+
 // CNAllocateID is the periodic message sent tp the HAKeeper by CN stores.
 type CNAllocateID struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -1181,7 +1344,7 @@ func (m *CNAllocateID) Reset()         { *m = CNAllocateID{} }
 func (m *CNAllocateID) String() string { return proto.CompactTextString(m) }
 func (*CNAllocateID) ProtoMessage()    {}
 func (*CNAllocateID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{7}
+	return fileDescriptor_fd1040c5381ab5a7, []int{8}
 }
 func (m *CNAllocateID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1237,18 +1400,21 @@ type LogStoreHeartbeat struct {
 	// update to date due to various reasons.
 	Replicas []LogReplicaInfo `protobuf:"bytes,5,rep,name=Replicas,proto3" json:"Replicas"`
 	// TaskServiceCreated task service is created at the current log node
-	TaskServiceCreated   bool        `protobuf:"varint,6,opt,name=TaskServiceCreated,proto3" json:"TaskServiceCreated,omitempty"`
-	ConfigData           *ConfigData `protobuf:"bytes,7,opt,name=ConfigData,proto3" json:"ConfigData,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	TaskServiceCreated bool        `protobuf:"varint,6,opt,name=TaskServiceCreated,proto3" json:"TaskServiceCreated,omitempty"`
+	ConfigData         *ConfigData `protobuf:"bytes,7,opt,name=ConfigData,proto3" json:"ConfigData,omitempty"`
+	// Locality is the failure domain labels of the Log Store, such as the zone
+	// and the rack it's located in.
+	Locality             map[string]string `protobuf:"bytes,8,rep,name=Locality,proto3" json:"Locality,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *LogStoreHeartbeat) Reset()         { *m = LogStoreHeartbeat{} }
 func (m *LogStoreHeartbeat) String() string { return proto.CompactTextString(m) }
 func (*LogStoreHeartbeat) ProtoMessage()    {}
 func (*LogStoreHeartbeat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{8}
+	return fileDescriptor_fd1040c5381ab5a7, []int{9}
 }
 func (m *LogStoreHeartbeat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1326,6 +1492,13 @@ func (m *LogStoreHeartbeat) GetConfigData() *ConfigData {
 	return nil
 }
 
+func (m *LogStoreHeartbeat) GetLocality() map[string]string {
+	if m != nil {
+		return m.Locality
+	}
+	return nil
+}
+
 // TNShardInfo contains information of a launched TN shard.
 type TNShardInfo struct {
 	// ShardID uniquely identifies a TN shard. Each TN shard manages a Primary
@@ -1344,7 +1517,7 @@ func (m *TNShardInfo) Reset()         { *m = TNShardInfo{} }
 func (m *TNShardInfo) String() string { return proto.CompactTextString(m) }
 func (*TNShardInfo) ProtoMessage()    {}
 func (*TNShardInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{9}
+	return fileDescriptor_fd1040c5381ab5a7, []int{10}
 }
 func (m *TNShardInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1402,18 +1575,23 @@ type TNStoreHeartbeat struct {
 	LockServiceAddress string      `protobuf:"bytes,6,opt,name=LockServiceAddress,proto3" json:"LockServiceAddress,omitempty"`
 	ConfigData         *ConfigData `protobuf:"bytes,8,opt,name=ConfigData,proto3" json:"ConfigData,omitempty"`
 	// QueryAddress is the address of queryservice on tn
-	QueryAddress         string   `protobuf:"bytes,9,opt,name=QueryAddress,proto3" json:"QueryAddress,omitempty"`
-	ShardServiceAddress  string   `protobuf:"bytes,10,opt,name=ShardServiceAddress,proto3" json:"ShardServiceAddress,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	QueryAddress        string `protobuf:"bytes,9,opt,name=QueryAddress,proto3" json:"QueryAddress,omitempty"`
+	ShardServiceAddress string `protobuf:"bytes,10,opt,name=ShardServiceAddress,proto3" json:"ShardServiceAddress,omitempty"`
+	// Locality is the failure domain labels of the TN Store.
+	Locality map[string]string `protobuf:"bytes,11,rep,name=Locality,proto3" json:"Locality,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// StandbyShards is a list of TNShardInfo instances of the hot-standby
+	// replicas on the specified TN store.
+	StandbyShards        []TNShardInfo `protobuf:"bytes,12,rep,name=StandbyShards,proto3" json:"StandbyShards"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *TNStoreHeartbeat) Reset()         { *m = TNStoreHeartbeat{} }
 func (m *TNStoreHeartbeat) String() string { return proto.CompactTextString(m) }
 func (*TNStoreHeartbeat) ProtoMessage()    {}
 func (*TNStoreHeartbeat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{10}
+	return fileDescriptor_fd1040c5381ab5a7, []int{11}
 }
 func (m *TNStoreHeartbeat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1505,6 +1683,20 @@ func (m *TNStoreHeartbeat) GetShardServiceAddress() string {
 	return ""
 }
 
+func (m *TNStoreHeartbeat) GetLocality() map[string]string {
+	if m != nil {
+		return m.Locality
+	}
+	return nil
+}
+
+func (m *TNStoreHeartbeat) GetStandbyShards() []TNShardInfo {
+	if m != nil {
+		return m.StandbyShards
+	}
+	return nil
+}
+
 type RSMState struct {
 	Tso                  uint64            `protobuf:"varint,1,opt,name=Tso,proto3" json:"Tso,omitempty"`
 	Index                uint64            `protobuf:"varint,2,opt,name=Index,proto3" json:"Index,omitempty"`
@@ -1520,7 +1712,7 @@ func (m *RSMState) Reset()         { *m = RSMState{} }
 func (m *RSMState) String() string { return proto.CompactTextString(m) }
 func (*RSMState) ProtoMessage()    {}
 func (*RSMState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{11}
+	return fileDescriptor_fd1040c5381ab5a7, []int{12}
 }
 func (m *RSMState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1598,7 +1790,7 @@ func (m *LogRecord) Reset()         { *m = LogRecord{} }
 func (m *LogRecord) String() string { return proto.CompactTextString(m) }
 func (*LogRecord) ProtoMessage()    {}
 func (*LogRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{12}
+	return fileDescriptor_fd1040c5381ab5a7, []int{13}
 }
 func (m *LogRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1664,7 +1856,7 @@ func (m *LogRequest) Reset()         { *m = LogRequest{} }
 func (m *LogRequest) String() string { return proto.CompactTextString(m) }
 func (*LogRequest) ProtoMessage()    {}
 func (*LogRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{13}
+	return fileDescriptor_fd1040c5381ab5a7, []int{14}
 }
 func (m *LogRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1746,7 +1938,7 @@ func (m *TsoRequest) Reset()         { *m = TsoRequest{} }
 func (m *TsoRequest) String() string { return proto.CompactTextString(m) }
 func (*TsoRequest) ProtoMessage()    {}
 func (*TsoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{14}
+	return fileDescriptor_fd1040c5381ab5a7, []int{15}
 }
 func (m *TsoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1799,7 +1991,7 @@ func (m *CNStoreLabel) Reset()         { *m = CNStoreLabel{} }
 func (m *CNStoreLabel) String() string { return proto.CompactTextString(m) }
 func (*CNStoreLabel) ProtoMessage()    {}
 func (*CNStoreLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{15}
+	return fileDescriptor_fd1040c5381ab5a7, []int{16}
 }
 func (m *CNStoreLabel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1863,7 +2055,7 @@ func (m *CNWorkState) Reset()         { *m = CNWorkState{} }
 func (m *CNWorkState) String() string { return proto.CompactTextString(m) }
 func (*CNWorkState) ProtoMessage()    {}
 func (*CNWorkState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{16}
+	return fileDescriptor_fd1040c5381ab5a7, []int{17}
 }
 func (m *CNWorkState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1922,7 +2114,7 @@ func (m *CNStateLabel) Reset()         { *m = CNStateLabel{} }
 func (m *CNStateLabel) String() string { return proto.CompactTextString(m) }
 func (*CNStateLabel) ProtoMessage()    {}
 func (*CNStateLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{17}
+	return fileDescriptor_fd1040c5381ab5a7, []int{18}
 }
 func (m *CNStateLabel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1995,7 +2187,7 @@ func (m *Request) Reset()         { *m = Request{} }
 func (m *Request) String() string { return proto.CompactTextString(m) }
 func (*Request) ProtoMessage()    {}
 func (*Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{18}
+	return fileDescriptor_fd1040c5381ab5a7, []int{19}
 }
 func (m *Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2128,7 +2320,7 @@ func (m *LogResponse) Reset()         { *m = LogResponse{} }
 func (m *LogResponse) String() string { return proto.CompactTextString(m) }
 func (*LogResponse) ProtoMessage()    {}
 func (*LogResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{19}
+	return fileDescriptor_fd1040c5381ab5a7, []int{20}
 }
 func (m *LogResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2189,7 +2381,7 @@ func (m *AllocateIDResponse) Reset()         { *m = AllocateIDResponse{} }
 func (m *AllocateIDResponse) String() string { return proto.CompactTextString(m) }
 func (*AllocateIDResponse) ProtoMessage()    {}
 func (*AllocateIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{20}
+	return fileDescriptor_fd1040c5381ab5a7, []int{21}
 }
 func (m *AllocateIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2247,7 +2439,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{21}
+	return fileDescriptor_fd1040c5381ab5a7, []int{22}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2371,7 +2563,7 @@ func (m *LogRecordResponse) Reset()         { *m = LogRecordResponse{} }
 func (m *LogRecordResponse) String() string { return proto.CompactTextString(m) }
 func (*LogRecordResponse) ProtoMessage()    {}
 func (*LogRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{22}
+	return fileDescriptor_fd1040c5381ab5a7, []int{23}
 }
 func (m *LogRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2418,7 +2610,7 @@ func (m *TsoResponse) Reset()         { *m = TsoResponse{} }
 func (m *TsoResponse) String() string { return proto.CompactTextString(m) }
 func (*TsoResponse) ProtoMessage()    {}
 func (*TsoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{23}
+	return fileDescriptor_fd1040c5381ab5a7, []int{24}
 }
 func (m *TsoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2468,7 +2660,7 @@ func (m *TaskTableUser) Reset()         { *m = TaskTableUser{} }
 func (m *TaskTableUser) String() string { return proto.CompactTextString(m) }
 func (*TaskTableUser) ProtoMessage()    {}
 func (*TaskTableUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{24}
+	return fileDescriptor_fd1040c5381ab5a7, []int{25}
 }
 func (m *TaskTableUser) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2529,7 +2721,7 @@ func (m *Replica) Reset()         { *m = Replica{} }
 func (m *Replica) String() string { return proto.CompactTextString(m) }
 func (*Replica) ProtoMessage()    {}
 func (*Replica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{25}
+	return fileDescriptor_fd1040c5381ab5a7, []int{26}
 }
 func (m *Replica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2608,7 +2800,7 @@ func (m *ConfigChange) Reset()         { *m = ConfigChange{} }
 func (m *ConfigChange) String() string { return proto.CompactTextString(m) }
 func (*ConfigChange) ProtoMessage()    {}
 func (*ConfigChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{26}
+	return fileDescriptor_fd1040c5381ab5a7, []int{27}
 }
 func (m *ConfigChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2670,7 +2862,7 @@ func (m *ShutdownStore) Reset()         { *m = ShutdownStore{} }
 func (m *ShutdownStore) String() string { return proto.CompactTextString(m) }
 func (*ShutdownStore) ProtoMessage()    {}
 func (*ShutdownStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{27}
+	return fileDescriptor_fd1040c5381ab5a7, []int{28}
 }
 func (m *ShutdownStore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2727,7 +2919,7 @@ func (m *ScheduleCommand) Reset()         { *m = ScheduleCommand{} }
 func (m *ScheduleCommand) String() string { return proto.CompactTextString(m) }
 func (*ScheduleCommand) ProtoMessage()    {}
 func (*ScheduleCommand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{28}
+	return fileDescriptor_fd1040c5381ab5a7, []int{29}
 }
 func (m *ScheduleCommand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2830,7 +3022,7 @@ func (m *JoinGossipCluster) Reset()         { *m = JoinGossipCluster{} }
 func (m *JoinGossipCluster) String() string { return proto.CompactTextString(m) }
 func (*JoinGossipCluster) ProtoMessage()    {}
 func (*JoinGossipCluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{29}
+	return fileDescriptor_fd1040c5381ab5a7, []int{30}
 }
 func (m *JoinGossipCluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2881,7 +3073,7 @@ func (m *CreateTaskService) Reset()         { *m = CreateTaskService{} }
 func (m *CreateTaskService) String() string { return proto.CompactTextString(m) }
 func (*CreateTaskService) ProtoMessage()    {}
 func (*CreateTaskService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{30}
+	return fileDescriptor_fd1040c5381ab5a7, []int{31}
 }
 func (m *CreateTaskService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2936,7 +3128,7 @@ func (m *DeleteCNStore) Reset()         { *m = DeleteCNStore{} }
 func (m *DeleteCNStore) String() string { return proto.CompactTextString(m) }
 func (*DeleteCNStore) ProtoMessage()    {}
 func (*DeleteCNStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{31}
+	return fileDescriptor_fd1040c5381ab5a7, []int{32}
 }
 func (m *DeleteCNStore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2984,7 +3176,7 @@ func (m *DeleteProxyStore) Reset()         { *m = DeleteProxyStore{} }
 func (m *DeleteProxyStore) String() string { return proto.CompactTextString(m) }
 func (*DeleteProxyStore) ProtoMessage()    {}
 func (*DeleteProxyStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{32}
+	return fileDescriptor_fd1040c5381ab5a7, []int{33}
 }
 func (m *DeleteProxyStore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3032,7 +3224,7 @@ func (m *CommandBatch) Reset()         { *m = CommandBatch{} }
 func (m *CommandBatch) String() string { return proto.CompactTextString(m) }
 func (*CommandBatch) ProtoMessage()    {}
 func (*CommandBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{33}
+	return fileDescriptor_fd1040c5381ab5a7, []int{34}
 }
 func (m *CommandBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3093,6 +3285,7 @@ type CNStoreInfo struct {
 	UpTime               int64                         `protobuf:"varint,15,opt,name=UpTime,proto3" json:"UpTime,omitempty"`
 	ShardServiceAddress  string                        `protobuf:"bytes,16,opt,name=ShardServiceAddress,proto3" json:"ShardServiceAddress,omitempty"`
 	CommitID             string                        `protobuf:"bytes,17,opt,name=CommitID,proto3" json:"CommitID,omitempty"`
+	Load                 CNLoad                        `protobuf:"bytes,18,opt,name=Load,proto3" json:"Load"`
 	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
 	XXX_unrecognized     []byte                        `json:"-"`
 	XXX_sizecache        int32                         `json:"-"`
@@ -3102,7 +3295,7 @@ func (m *CNStoreInfo) Reset()         { *m = CNStoreInfo{} }
 func (m *CNStoreInfo) String() string { return proto.CompactTextString(m) }
 func (*CNStoreInfo) ProtoMessage()    {}
 func (*CNStoreInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{34}
+	return fileDescriptor_fd1040c5381ab5a7, []int{35}
 }
 func (m *CNStoreInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3243,6 +3436,13 @@ func (m *CNStoreInfo) GetCommitID() string {
 	return ""
 }
 
+func (m *CNStoreInfo) GetLoad() CNLoad {
+	if m != nil {
+		return m.Load
+	}
+	return CNLoad{}
+}
+
 // CNState contains all CN details known to the HAKeeper.
 type CNState struct {
 	// Stores is keyed by CN store UUID.
@@ -3256,7 +3456,7 @@ func (m *CNState) Reset()         { *m = CNState{} }
 func (m *CNState) String() string { return proto.CompactTextString(m) }
 func (*CNState) ProtoMessage()    {}
 func (*CNState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{35}
+	return fileDescriptor_fd1040c5381ab5a7, []int{36}
 }
 func (m *CNState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3304,18 +3504,20 @@ type TNStoreInfo struct {
 	LockServiceAddress string      `protobuf:"bytes,6,opt,name=LockServiceAddress,proto3" json:"LockServiceAddress,omitempty"`
 	ConfigData         *ConfigData `protobuf:"bytes,8,opt,name=ConfigData,proto3" json:"ConfigData,omitempty"`
 	// QueryAddress is the address of queryservice on tn
-	QueryAddress         string   `protobuf:"bytes,9,opt,name=QueryAddress,proto3" json:"QueryAddress,omitempty"`
-	ShardServiceAddress  string   `protobuf:"bytes,10,opt,name=ShardServiceAddress,proto3" json:"ShardServiceAddress,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	QueryAddress         string            `protobuf:"bytes,9,opt,name=QueryAddress,proto3" json:"QueryAddress,omitempty"`
+	ShardServiceAddress  string            `protobuf:"bytes,10,opt,name=ShardServiceAddress,proto3" json:"ShardServiceAddress,omitempty"`
+	Locality             map[string]string `protobuf:"bytes,11,rep,name=Locality,proto3" json:"Locality,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	StandbyShards        []TNShardInfo     `protobuf:"bytes,12,rep,name=StandbyShards,proto3" json:"StandbyShards"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *TNStoreInfo) Reset()         { *m = TNStoreInfo{} }
 func (m *TNStoreInfo) String() string { return proto.CompactTextString(m) }
 func (*TNStoreInfo) ProtoMessage()    {}
 func (*TNStoreInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{36}
+	return fileDescriptor_fd1040c5381ab5a7, []int{37}
 }
 func (m *TNStoreInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3407,6 +3609,20 @@ func (m *TNStoreInfo) GetShardServiceAddress() string {
 	return ""
 }
 
+func (m *TNStoreInfo) GetLocality() map[string]string {
+	if m != nil {
+		return m.Locality
+	}
+	return nil
+}
+
+func (m *TNStoreInfo) GetStandbyShards() []TNShardInfo {
+	if m != nil {
+		return m.StandbyShards
+	}
+	return nil
+}
+
 // TNState contains all TN details known to the HAKeeper.
 type TNState struct {
 	// Stores is keyed by TN store UUID.
@@ -3420,7 +3636,7 @@ func (m *TNState) Reset()         { *m = TNState{} }
 func (m *TNState) String() string { return proto.CompactTextString(m) }
 func (*TNState) ProtoMessage()    {}
 func (*TNState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{37}
+	return fileDescriptor_fd1040c5381ab5a7, []int{38}
 }
 func (m *TNState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3470,7 +3686,7 @@ func (m *ProxyStore) Reset()         { *m = ProxyStore{} }
 func (m *ProxyStore) String() string { return proto.CompactTextString(m) }
 func (*ProxyStore) ProtoMessage()    {}
 func (*ProxyStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{38}
+	return fileDescriptor_fd1040c5381ab5a7, []int{39}
 }
 func (m *ProxyStore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3538,7 +3754,7 @@ func (m *ProxyState) Reset()         { *m = ProxyState{} }
 func (m *ProxyState) String() string { return proto.CompactTextString(m) }
 func (*ProxyState) ProtoMessage()    {}
 func (*ProxyState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{39}
+	return fileDescriptor_fd1040c5381ab5a7, []int{40}
 }
 func (m *ProxyState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3587,7 +3803,7 @@ func (m *ProxyHeartbeat) Reset()         { *m = ProxyHeartbeat{} }
 func (m *ProxyHeartbeat) String() string { return proto.CompactTextString(m) }
 func (*ProxyHeartbeat) ProtoMessage()    {}
 func (*ProxyHeartbeat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{40}
+	return fileDescriptor_fd1040c5381ab5a7, []int{41}
 }
 func (m *ProxyHeartbeat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3638,21 +3854,27 @@ func (m *ProxyHeartbeat) GetConfigData() *ConfigData {
 }
 
 type ClusterDetails struct {
-	TNStores             []TNStore      `protobuf:"bytes,1,rep,name=TNStores,proto3" json:"TNStores"`
-	CNStores             []CNStore      `protobuf:"bytes,2,rep,name=CNStores,proto3" json:"CNStores"`
-	LogStores            []LogStore     `protobuf:"bytes,3,rep,name=LogStores,proto3" json:"LogStores"`
-	ProxyStores          []ProxyStore   `protobuf:"bytes,4,rep,name=ProxyStores,proto3" json:"ProxyStores"`
-	DeletedStores        []DeletedStore `protobuf:"bytes,5,rep,name=DeletedStores,proto3" json:"DeletedStores"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	TNStores      []TNStore      `protobuf:"bytes,1,rep,name=TNStores,proto3" json:"TNStores"`
+	CNStores      []CNStore      `protobuf:"bytes,2,rep,name=CNStores,proto3" json:"CNStores"`
+	LogStores     []LogStore     `protobuf:"bytes,3,rep,name=LogStores,proto3" json:"LogStores"`
+	ProxyStores   []ProxyStore   `protobuf:"bytes,4,rep,name=ProxyStores,proto3" json:"ProxyStores"`
+	DeletedStores []DeletedStore `protobuf:"bytes,5,rep,name=DeletedStores,proto3" json:"DeletedStores"`
+	// PlacementViolations are the Log shards with replicas not spread across
+	// the failure domains as expected.
+	PlacementViolations []PlacementViolation `protobuf:"bytes,6,rep,name=PlacementViolations,proto3" json:"PlacementViolations"`
+	// ScaleRecommendations are the scale-out/in recommendations of the CN
+	// stores grouped by their labels.
+	ScaleRecommendations []CNScaleRecommendation `protobuf:"bytes,7,rep,name=ScaleRecommendations,proto3" json:"ScaleRecommendations"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *ClusterDetails) Reset()         { *m = ClusterDetails{} }
 func (m *ClusterDetails) String() string { return proto.CompactTextString(m) }
 func (*ClusterDetails) ProtoMessage()    {}
 func (*ClusterDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{41}
+	return fileDescriptor_fd1040c5381ab5a7, []int{42}
 }
 func (m *ClusterDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3716,29 +3938,48 @@ func (m *ClusterDetails) GetDeletedStores() []DeletedStore {
 	return nil
 }
 
-// ClusterInfo provides a global view of all shards in the cluster. It
-// describes the logical sharding of the system, rather than physical
-// distribution of all replicas that belong to those shards.
-type ClusterInfo struct {
-	TNShards             []metadata.TNShardRecord  `protobuf:"bytes,1,rep,name=TNShards,proto3" json:"TNShards"`
-	LogShards            []metadata.LogShardRecord `protobuf:"bytes,2,rep,name=LogShards,proto3" json:"LogShards"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+func (m *ClusterDetails) GetPlacementViolations() []PlacementViolation {
+	if m != nil {
+		return m.PlacementViolations
+	}
+	return nil
 }
 
-func (m *ClusterInfo) Reset()         { *m = ClusterInfo{} }
-func (m *ClusterInfo) String() string { return proto.CompactTextString(m) }
-func (*ClusterInfo) ProtoMessage()    {}
-func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{42}
+func (m *ClusterDetails) GetScaleRecommendations() []CNScaleRecommendation {
+	if m != nil {
+		return m.ScaleRecommendations
+	}
+	return nil
 }
-func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
+
+// PlacementViolation describes the replicas of a Log shard located in the same
+// failure domain, while there are enough domains to spread them.
+type PlacementViolation struct {
+	ShardID uint64 `protobuf:"varint,1,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	// Label is the location label of the violated level, e.g. zone.
+	Label string `protobuf:"bytes,2,opt,name=Label,proto3" json:"Label,omitempty"`
+	// Domain is the failure domain shared by the replicas, it's the values of
+	// the location labels down to Label, joined by "/".
+	Domain string `protobuf:"bytes,3,opt,name=Domain,proto3" json:"Domain,omitempty"`
+	// Stores are the UUIDs of the Log stores hosting the replicas.
+	Stores               []string `protobuf:"bytes,4,rep,name=Stores,proto3" json:"Stores,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlacementViolation) Reset()         { *m = PlacementViolation{} }
+func (m *PlacementViolation) String() string { return proto.CompactTextString(m) }
+func (*PlacementViolation) ProtoMessage()    {}
+func (*PlacementViolation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd1040c5381ab5a7, []int{43}
+}
+func (m *PlacementViolation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ClusterInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PlacementViolation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ClusterInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PlacementViolation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3748,28 +3989,228 @@ func (m *ClusterInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ClusterInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClusterInfo.Merge(m, src)
+func (m *PlacementViolation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlacementViolation.Merge(m, src)
 }
-func (m *ClusterInfo) XXX_Size() int {
+func (m *PlacementViolation) XXX_Size() int {
 	return m.ProtoSize()
 }
-func (m *ClusterInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClusterInfo.DiscardUnknown(m)
+func (m *PlacementViolation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlacementViolation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
+var xxx_messageInfo_PlacementViolation proto.InternalMessageInfo
 
-func (m *ClusterInfo) GetTNShards() []metadata.TNShardRecord {
+func (m *PlacementViolation) GetShardID() uint64 {
 	if m != nil {
-		return m.TNShards
+		return m.ShardID
 	}
-	return nil
+	return 0
 }
 
-func (m *ClusterInfo) GetLogShards() []metadata.LogShardRecord {
+func (m *PlacementViolation) GetLabel() string {
 	if m != nil {
-		return m.LogShards
+		return m.Label
+	}
+	return ""
+}
+
+func (m *PlacementViolation) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *PlacementViolation) GetStores() []string {
+	if m != nil {
+		return m.Stores
+	}
+	return nil
+}
+
+// CNScaleRecommendation is the capacity signal of the working CN stores
+// sharing the same labels.
+type CNScaleRecommendation struct {
+	// Labels is the label set of the CN group, formatted as k1=v1,v2;k2=v3.
+	Labels string `protobuf:"bytes,1,opt,name=Labels,proto3" json:"Labels,omitempty"`
+	// CNs are the UUIDs of the working CN stores in the group.
+	CNs []string `protobuf:"bytes,2,rep,name=CNs,proto3" json:"CNs,omitempty"`
+	// CPUUsage is the average CPU usage ratio of the CN stores.
+	CPUUsage float64 `protobuf:"fixed64,3,opt,name=CPUUsage,proto3" json:"CPUUsage,omitempty"`
+	// MemoryUsage is the average memory usage ratio of the CN stores.
+	MemoryUsage    float64     `protobuf:"fixed64,4,opt,name=MemoryUsage,proto3" json:"MemoryUsage,omitempty"`
+	ActiveSessions uint64      `protobuf:"varint,5,opt,name=ActiveSessions,proto3" json:"ActiveSessions,omitempty"`
+	ActiveQueries  uint64      `protobuf:"varint,6,opt,name=ActiveQueries,proto3" json:"ActiveQueries,omitempty"`
+	Action         ScaleAction `protobuf:"varint,7,opt,name=Action,proto3,enum=logservice.ScaleAction" json:"Action,omitempty"`
+	// Count is the number of the CN stores to add or to remove.
+	Count  uint64 `protobuf:"varint,8,opt,name=Count,proto3" json:"Count,omitempty"`
+	Reason string `protobuf:"bytes,9,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	// Drain are the CN stores to drain before scaling in, which serve the
+	// least sessions.
+	Drain                []string `protobuf:"bytes,10,rep,name=Drain,proto3" json:"Drain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CNScaleRecommendation) Reset()         { *m = CNScaleRecommendation{} }
+func (m *CNScaleRecommendation) String() string { return proto.CompactTextString(m) }
+func (*CNScaleRecommendation) ProtoMessage()    {}
+func (*CNScaleRecommendation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd1040c5381ab5a7, []int{44}
+}
+func (m *CNScaleRecommendation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CNScaleRecommendation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CNScaleRecommendation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CNScaleRecommendation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CNScaleRecommendation.Merge(m, src)
+}
+func (m *CNScaleRecommendation) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *CNScaleRecommendation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CNScaleRecommendation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CNScaleRecommendation proto.InternalMessageInfo
+
+func (m *CNScaleRecommendation) GetLabels() string {
+	if m != nil {
+		return m.Labels
+	}
+	return ""
+}
+
+func (m *CNScaleRecommendation) GetCNs() []string {
+	if m != nil {
+		return m.CNs
+	}
+	return nil
+}
+
+func (m *CNScaleRecommendation) GetCPUUsage() float64 {
+	if m != nil {
+		return m.CPUUsage
+	}
+	return 0
+}
+
+func (m *CNScaleRecommendation) GetMemoryUsage() float64 {
+	if m != nil {
+		return m.MemoryUsage
+	}
+	return 0
+}
+
+func (m *CNScaleRecommendation) GetActiveSessions() uint64 {
+	if m != nil {
+		return m.ActiveSessions
+	}
+	return 0
+}
+
+func (m *CNScaleRecommendation) GetActiveQueries() uint64 {
+	if m != nil {
+		return m.ActiveQueries
+	}
+	return 0
+}
+
+func (m *CNScaleRecommendation) GetAction() ScaleAction {
+	if m != nil {
+		return m.Action
+	}
+	return NoScale
+}
+
+func (m *CNScaleRecommendation) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *CNScaleRecommendation) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *CNScaleRecommendation) GetDrain() []string {
+	if m != nil {
+		return m.Drain
+	}
+	return nil
+}
+
+// ClusterInfo provides a global view of all shards in the cluster. It
+// describes the logical sharding of the system, rather than physical
+// distribution of all replicas that belong to those shards.
+type ClusterInfo struct {
+	TNShards             []metadata.TNShardRecord  `protobuf:"bytes,1,rep,name=TNShards,proto3" json:"TNShards"`
+	LogShards            []metadata.LogShardRecord `protobuf:"bytes,2,rep,name=LogShards,proto3" json:"LogShards"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ClusterInfo) Reset()         { *m = ClusterInfo{} }
+func (m *ClusterInfo) String() string { return proto.CompactTextString(m) }
+func (*ClusterInfo) ProtoMessage()    {}
+func (*ClusterInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd1040c5381ab5a7, []int{45}
+}
+func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterInfo.Merge(m, src)
+}
+func (m *ClusterInfo) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *ClusterInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
+
+func (m *ClusterInfo) GetTNShards() []metadata.TNShardRecord {
+	if m != nil {
+		return m.TNShards
+	}
+	return nil
+}
+
+func (m *ClusterInfo) GetLogShards() []metadata.LogShardRecord {
+	if m != nil {
+		return m.LogShards
 	}
 	return nil
 }
@@ -3789,7 +4230,7 @@ func (m *InitialClusterRequest) Reset()         { *m = InitialClusterRequest{} }
 func (m *InitialClusterRequest) String() string { return proto.CompactTextString(m) }
 func (*InitialClusterRequest) ProtoMessage()    {}
 func (*InitialClusterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{43}
+	return fileDescriptor_fd1040c5381ab5a7, []int{46}
 }
 func (m *InitialClusterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3855,23 +4296,24 @@ func (m *InitialClusterRequest) GetNextIDByKey() map[string]uint64 {
 
 // LogStoreInfo contains information of all replicas found on a Log store.
 type LogStoreInfo struct {
-	Tick                 uint64           `protobuf:"varint,1,opt,name=Tick,proto3" json:"Tick,omitempty"`
-	RaftAddress          string           `protobuf:"bytes,2,opt,name=RaftAddress,proto3" json:"RaftAddress,omitempty"`
-	ServiceAddress       string           `protobuf:"bytes,3,opt,name=ServiceAddress,proto3" json:"ServiceAddress,omitempty"`
-	GossipAddress        string           `protobuf:"bytes,4,opt,name=GossipAddress,proto3" json:"GossipAddress,omitempty"`
-	Replicas             []LogReplicaInfo `protobuf:"bytes,5,rep,name=Replicas,proto3" json:"Replicas"`
-	TaskServiceCreated   bool             `protobuf:"varint,6,opt,name=TaskServiceCreated,proto3" json:"TaskServiceCreated,omitempty"`
-	ConfigData           *ConfigData      `protobuf:"bytes,7,opt,name=ConfigData,proto3" json:"ConfigData,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Tick                 uint64            `protobuf:"varint,1,opt,name=Tick,proto3" json:"Tick,omitempty"`
+	RaftAddress          string            `protobuf:"bytes,2,opt,name=RaftAddress,proto3" json:"RaftAddress,omitempty"`
+	ServiceAddress       string            `protobuf:"bytes,3,opt,name=ServiceAddress,proto3" json:"ServiceAddress,omitempty"`
+	GossipAddress        string            `protobuf:"bytes,4,opt,name=GossipAddress,proto3" json:"GossipAddress,omitempty"`
+	Replicas             []LogReplicaInfo  `protobuf:"bytes,5,rep,name=Replicas,proto3" json:"Replicas"`
+	TaskServiceCreated   bool              `protobuf:"varint,6,opt,name=TaskServiceCreated,proto3" json:"TaskServiceCreated,omitempty"`
+	ConfigData           *ConfigData       `protobuf:"bytes,7,opt,name=ConfigData,proto3" json:"ConfigData,omitempty"`
+	Locality             map[string]string `protobuf:"bytes,8,rep,name=Locality,proto3" json:"Locality,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *LogStoreInfo) Reset()         { *m = LogStoreInfo{} }
 func (m *LogStoreInfo) String() string { return proto.CompactTextString(m) }
 func (*LogStoreInfo) ProtoMessage()    {}
 func (*LogStoreInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{44}
+	return fileDescriptor_fd1040c5381ab5a7, []int{47}
 }
 func (m *LogStoreInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3949,6 +4391,13 @@ func (m *LogStoreInfo) GetConfigData() *ConfigData {
 	return nil
 }
 
+func (m *LogStoreInfo) GetLocality() map[string]string {
+	if m != nil {
+		return m.Locality
+	}
+	return nil
+}
+
 type LogState struct {
 	// Shards is keyed by ShardID, it contains details aggregated from all Log
 	// stores. Each pb.LogShardInfo here contains data aggregated from
@@ -3968,7 +4417,7 @@ func (m *LogState) Reset()         { *m = LogState{} }
 func (m *LogState) String() string { return proto.CompactTextString(m) }
 func (*LogState) ProtoMessage()    {}
 func (*LogState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{45}
+	return fileDescriptor_fd1040c5381ab5a7, []int{48}
 }
 func (m *LogState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4035,7 +4484,7 @@ func (m *CheckerState) Reset()         { *m = CheckerState{} }
 func (m *CheckerState) String() string { return proto.CompactTextString(m) }
 func (*CheckerState) ProtoMessage()    {}
 func (*CheckerState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{46}
+	return fileDescriptor_fd1040c5381ab5a7, []int{49}
 }
 func (m *CheckerState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4164,7 +4613,7 @@ func (m *DeletedStore) Reset()         { *m = DeletedStore{} }
 func (m *DeletedStore) String() string { return proto.CompactTextString(m) }
 func (*DeletedStore) ProtoMessage()    {}
 func (*DeletedStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{47}
+	return fileDescriptor_fd1040c5381ab5a7, []int{50}
 }
 func (m *DeletedStore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4257,7 +4706,7 @@ func (m *HAKeeperRSMState) Reset()         { *m = HAKeeperRSMState{} }
 func (m *HAKeeperRSMState) String() string { return proto.CompactTextString(m) }
 func (*HAKeeperRSMState) ProtoMessage()    {}
 func (*HAKeeperRSMState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{48}
+	return fileDescriptor_fd1040c5381ab5a7, []int{51}
 }
 func (m *HAKeeperRSMState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4411,7 +4860,7 @@ func (m *ReplicaInfo) Reset()         { *m = ReplicaInfo{} }
 func (m *ReplicaInfo) String() string { return proto.CompactTextString(m) }
 func (*ReplicaInfo) ProtoMessage()    {}
 func (*ReplicaInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{49}
+	return fileDescriptor_fd1040c5381ab5a7, []int{52}
 }
 func (m *ReplicaInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4456,11 +4905,14 @@ func (m *ReplicaInfo) GetServiceAddress() string {
 
 // ShardInfoQueryResult contains the result of the shard info query.
 type ShardInfoQueryResult struct {
-	ShardID              uint64                 `protobuf:"varint,1,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
-	Replicas             map[uint64]ReplicaInfo `protobuf:"bytes,2,rep,name=Replicas,proto3" json:"Replicas" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Epoch                uint64                 `protobuf:"varint,3,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
-	LeaderID             uint64                 `protobuf:"varint,4,opt,name=LeaderID,proto3" json:"LeaderID,omitempty"`
-	Term                 uint64                 `protobuf:"varint,5,opt,name=Term,proto3" json:"Term,omitempty"`
+	ShardID  uint64                 `protobuf:"varint,1,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	Replicas map[uint64]ReplicaInfo `protobuf:"bytes,2,rep,name=Replicas,proto3" json:"Replicas" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Epoch    uint64                 `protobuf:"varint,3,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	LeaderID uint64                 `protobuf:"varint,4,opt,name=LeaderID,proto3" json:"LeaderID,omitempty"`
+	Term     uint64                 `protobuf:"varint,5,opt,name=Term,proto3" json:"Term,omitempty"`
+	// NonVotingReplicas are the non-voting replicas of the shard, only known
+	// when the queried store hosts a replica of the shard.
+	NonVotingReplicas    map[uint64]ReplicaInfo `protobuf:"bytes,6,rep,name=NonVotingReplicas,proto3" json:"NonVotingReplicas" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
 	XXX_unrecognized     []byte                 `json:"-"`
 	XXX_sizecache        int32                  `json:"-"`
@@ -4470,7 +4922,7 @@ func (m *ShardInfoQueryResult) Reset()         { *m = ShardInfoQueryResult{} }
 func (m *ShardInfoQueryResult) String() string { return proto.CompactTextString(m) }
 func (*ShardInfoQueryResult) ProtoMessage()    {}
 func (*ShardInfoQueryResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{50}
+	return fileDescriptor_fd1040c5381ab5a7, []int{53}
 }
 func (m *ShardInfoQueryResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4534,6 +4986,13 @@ func (m *ShardInfoQueryResult) GetTerm() uint64 {
 	return 0
 }
 
+func (m *ShardInfoQueryResult) GetNonVotingReplicas() map[uint64]ReplicaInfo {
+	if m != nil {
+		return m.NonVotingReplicas
+	}
+	return nil
+}
+
 // BackupData is the information that needs to backup, including NextID and
 // NextIDByKey in HAKeeperRSMState.
 type BackupData struct {
@@ -4550,7 +5009,7 @@ func (m *BackupData) Reset()         { *m = BackupData{} }
 func (m *BackupData) String() string { return proto.CompactTextString(m) }
 func (*BackupData) ProtoMessage()    {}
 func (*BackupData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{51}
+	return fileDescriptor_fd1040c5381ab5a7, []int{54}
 }
 func (m *BackupData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4611,7 +5070,7 @@ func (m *ConfigItem) Reset()         { *m = ConfigItem{} }
 func (m *ConfigItem) String() string { return proto.CompactTextString(m) }
 func (*ConfigItem) ProtoMessage()    {}
 func (*ConfigItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{52}
+	return fileDescriptor_fd1040c5381ab5a7, []int{55}
 }
 func (m *ConfigItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4681,7 +5140,7 @@ func (m *ConfigData) Reset()         { *m = ConfigData{} }
 func (m *ConfigData) String() string { return proto.CompactTextString(m) }
 func (*ConfigData) ProtoMessage()    {}
 func (*ConfigData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd1040c5381ab5a7, []int{53}
+	return fileDescriptor_fd1040c5381ab5a7, []int{56}
 }
 func (m *ConfigData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4728,19 +5187,26 @@ func init() {
 	proto.RegisterEnum("logservice.TaskSchedulerState", TaskSchedulerState_name, TaskSchedulerState_value)
 	proto.RegisterEnum("logservice.ConfigChangeType", ConfigChangeType_name, ConfigChangeType_value)
 	proto.RegisterEnum("logservice.ServiceType", ServiceType_name, ServiceType_value)
+	proto.RegisterEnum("logservice.ScaleAction", ScaleAction_name, ScaleAction_value)
 	proto.RegisterType((*CNStore)(nil), "logservice.CNStore")
 	proto.RegisterMapType((map[string]metadata.LabelList)(nil), "logservice.CNStore.LabelsEntry")
 	proto.RegisterType((*TNStore)(nil), "logservice.TNStore")
+	proto.RegisterMapType((map[string]string)(nil), "logservice.TNStore.LocalityEntry")
 	proto.RegisterType((*LogStore)(nil), "logservice.LogStore")
+	proto.RegisterMapType((map[string]string)(nil), "logservice.LogStore.LocalityEntry")
 	proto.RegisterType((*LogShardInfo)(nil), "logservice.LogShardInfo")
+	proto.RegisterMapType((map[uint64]string)(nil), "logservice.LogShardInfo.NonVotingReplicasEntry")
 	proto.RegisterMapType((map[uint64]string)(nil), "logservice.LogShardInfo.ReplicasEntry")
 	proto.RegisterType((*LogReplicaInfo)(nil), "logservice.LogReplicaInfo")
 	proto.RegisterType((*Resource)(nil), "logservice.Resource")
+	proto.RegisterType((*CNLoad)(nil), "logservice.CNLoad")
 	proto.RegisterType((*CNStoreHeartbeat)(nil), "logservice.CNStoreHeartbeat")
 	proto.RegisterType((*CNAllocateID)(nil), "logservice.CNAllocateID")
 	proto.RegisterType((*LogStoreHeartbeat)(nil), "logservice.LogStoreHeartbeat")
+	proto.RegisterMapType((map[string]string)(nil), "logservice.LogStoreHeartbeat.LocalityEntry")
 	proto.RegisterType((*TNShardInfo)(nil), "logservice.TNShardInfo")
 	proto.RegisterType((*TNStoreHeartbeat)(nil), "logservice.TNStoreHeartbeat")
+	proto.RegisterMapType((map[string]string)(nil), "logservice.TNStoreHeartbeat.LocalityEntry")
 	proto.RegisterType((*RSMState)(nil), "logservice.RSMState")
 	proto.RegisterMapType((map[uint64]uint64)(nil), "logservice.RSMState.LeaseHistoryEntry")
 	proto.RegisterType((*LogRecord)(nil), "logservice.LogRecord")
@@ -4773,6 +5239,7 @@ func init() {
 	proto.RegisterType((*CNState)(nil), "logservice.CNState")
 	proto.RegisterMapType((map[string]CNStoreInfo)(nil), "logservice.CNState.StoresEntry")
 	proto.RegisterType((*TNStoreInfo)(nil), "logservice.TNStoreInfo")
+	proto.RegisterMapType((map[string]string)(nil), "logservice.TNStoreInfo.LocalityEntry")
 	proto.RegisterType((*TNState)(nil), "logservice.TNState")
 	proto.RegisterMapType((map[string]TNStoreInfo)(nil), "logservice.TNState.StoresEntry")
 	proto.RegisterType((*ProxyStore)(nil), "logservice.ProxyStore")
@@ -4780,10 +5247,13 @@ func init() {
 	proto.RegisterMapType((map[string]ProxyStore)(nil), "logservice.ProxyState.StoresEntry")
 	proto.RegisterType((*ProxyHeartbeat)(nil), "logservice.ProxyHeartbeat")
 	proto.RegisterType((*ClusterDetails)(nil), "logservice.ClusterDetails")
+	proto.RegisterType((*PlacementViolation)(nil), "logservice.PlacementViolation")
+	proto.RegisterType((*CNScaleRecommendation)(nil), "logservice.CNScaleRecommendation")
 	proto.RegisterType((*ClusterInfo)(nil), "logservice.ClusterInfo")
 	proto.RegisterType((*InitialClusterRequest)(nil), "logservice.InitialClusterRequest")
 	proto.RegisterMapType((map[string]uint64)(nil), "logservice.InitialClusterRequest.NextIDByKeyEntry")
 	proto.RegisterType((*LogStoreInfo)(nil), "logservice.LogStoreInfo")
+	proto.RegisterMapType((map[string]string)(nil), "logservice.LogStoreInfo.LocalityEntry")
 	proto.RegisterType((*LogState)(nil), "logservice.LogState")
 	proto.RegisterMapType((map[uint64]LogShardInfo)(nil), "logservice.LogState.ShardsEntry")
 	proto.RegisterMapType((map[string]LogStoreInfo)(nil), "logservice.LogState.StoresEntry")
@@ -4796,6 +5266,7 @@ func init() {
 	proto.RegisterMapType((map[string]CommandBatch)(nil), "logservice.HAKeeperRSMState.ScheduleCommandsEntry")
 	proto.RegisterType((*ReplicaInfo)(nil), "logservice.ReplicaInfo")
 	proto.RegisterType((*ShardInfoQueryResult)(nil), "logservice.ShardInfoQueryResult")
+	proto.RegisterMapType((map[uint64]ReplicaInfo)(nil), "logservice.ShardInfoQueryResult.NonVotingReplicasEntry")
 	proto.RegisterMapType((map[uint64]ReplicaInfo)(nil), "logservice.ShardInfoQueryResult.ReplicasEntry")
 	proto.RegisterType((*BackupData)(nil), "logservice.BackupData")
 	proto.RegisterMapType((map[string]uint64)(nil), "logservice.BackupData.NextIDByKeyEntry")
@@ -4807,252 +5278,285 @@ func init() {
 func init() { proto.RegisterFile("logservice.proto", fileDescriptor_fd1040c5381ab5a7) }
 
 var fileDescriptor_fd1040c5381ab5a7 = []byte{
-	// 3908 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0xcd, 0x6f, 0x23, 0x59,
-	0x5e, 0x29, 0xdb, 0x89, 0xed, 0x9f, 0x63, 0x77, 0xe5, 0x25, 0xdd, 0xed, 0xc9, 0x34, 0xe9, 0x50,
-	0xdb, 0x0c, 0x3d, 0xd9, 0x19, 0x37, 0x74, 0x6b, 0x46, 0xbb, 0x90, 0xe9, 0xc6, 0xb1, 0x3d, 0x1d,
-	0x77, 0x1c, 0x27, 0xf3, 0x5c, 0xd9, 0x85, 0x95, 0x56, 0xa1, 0x62, 0xbf, 0x4e, 0x4c, 0x6c, 0x97,
-	0xa9, 0x2a, 0xf7, 0x74, 0x73, 0x44, 0x08, 0x89, 0x45, 0xe2, 0xc0, 0x69, 0x85, 0x10, 0x1f, 0x37,
-	0x84, 0x84, 0xb8, 0x80, 0xc4, 0x85, 0xe5, 0xba, 0x07, 0x0e, 0xf3, 0x17, 0xac, 0xd8, 0xe1, 0x82,
-	0xe0, 0xc0, 0x6d, 0x6f, 0x68, 0xd1, 0xfb, 0xaa, 0x7a, 0xcf, 0x55, 0x76, 0x9c, 0x9e, 0x9e, 0xe1,
-	0x43, 0x7b, 0x8a, 0xdf, 0xef, 0xe3, 0xbd, 0x57, 0xbf, 0xf7, 0xfb, 0x7e, 0x2f, 0x60, 0x0e, 0xdc,
-	0x73, 0x9f, 0x78, 0x2f, 0xfa, 0x5d, 0x52, 0x19, 0x7b, 0x6e, 0xe0, 0x22, 0x88, 0x20, 0x9b, 0xef,
-	0x9f, 0xf7, 0x83, 0x8b, 0xc9, 0x59, 0xa5, 0xeb, 0x0e, 0x1f, 0x9c, 0xbb, 0xe7, 0xee, 0x03, 0x46,
-	0x72, 0x36, 0x79, 0xce, 0x46, 0x6c, 0xc0, 0x7e, 0x71, 0xd6, 0xcd, 0xd2, 0x90, 0x04, 0x4e, 0xcf,
-	0x09, 0x1c, 0x3e, 0xb6, 0x7e, 0xb0, 0x0c, 0xd9, 0x5a, 0xbb, 0x13, 0xb8, 0x1e, 0x41, 0x08, 0x32,
-	0x27, 0x27, 0xcd, 0x7a, 0xd9, 0xd8, 0x36, 0xee, 0xe7, 0x31, 0xfb, 0x8d, 0xde, 0x81, 0x52, 0x87,
-	0xaf, 0x54, 0xed, 0xf5, 0x3c, 0xe2, 0xfb, 0xe5, 0x14, 0xc3, 0x4e, 0x41, 0xd1, 0x16, 0x40, 0xe7,
-	0x93, 0x96, 0xa4, 0x49, 0x33, 0x1a, 0x05, 0x82, 0x2a, 0x80, 0x5a, 0x6e, 0xf7, 0x72, 0x6a, 0xae,
-	0x0c, 0xa3, 0x4b, 0xc0, 0xa0, 0x7b, 0x90, 0xc1, 0xee, 0x80, 0x94, 0x57, 0xb6, 0x8d, 0xfb, 0xa5,
-	0x87, 0x66, 0x25, 0xdc, 0x76, 0xad, 0x4d, 0xe1, 0x98, 0x61, 0xe9, 0x8e, 0xed, 0x7e, 0xf7, 0xb2,
-	0x9c, 0xdd, 0x36, 0xee, 0x67, 0x30, 0xfb, 0x8d, 0xbe, 0x0e, 0xcb, 0x9d, 0xc0, 0x09, 0x48, 0x39,
-	0xc7, 0x58, 0x6f, 0x56, 0x14, 0xf1, 0xb5, 0xdd, 0x1e, 0x61, 0x48, 0xcc, 0x69, 0xd0, 0x47, 0xb0,
-	0xd2, 0x72, 0xce, 0xc8, 0xc0, 0x2f, 0xe7, 0xb7, 0xd3, 0xf7, 0x0b, 0x0f, 0xef, 0xaa, 0xd4, 0x42,
-	0x2e, 0x15, 0x4e, 0xd1, 0x18, 0x05, 0xde, 0xab, 0xbd, 0xcc, 0x0f, 0x7f, 0x74, 0x77, 0x09, 0x0b,
-	0x26, 0xf4, 0xcb, 0x90, 0xff, 0xb6, 0xeb, 0x5d, 0xf2, 0xf5, 0x80, 0xad, 0xb7, 0x1e, 0x6d, 0x35,
-	0x44, 0xe1, 0x88, 0x0a, 0x59, 0xb0, 0xfa, 0xc9, 0x84, 0x78, 0xaf, 0xa4, 0x08, 0x0a, 0x4c, 0x04,
-	0x1a, 0x0c, 0x7d, 0x08, 0x50, 0x73, 0x47, 0xcf, 0xfb, 0xe7, 0x75, 0x27, 0x70, 0xca, 0xab, 0xdb,
-	0xc6, 0xfd, 0xc2, 0xc3, 0x5b, 0xda, 0xce, 0x42, 0x2c, 0x56, 0x28, 0xd1, 0x87, 0x90, 0xc3, 0xc4,
-	0x77, 0x27, 0x5e, 0x97, 0x94, 0x8b, 0x8c, 0x6b, 0x43, 0xe5, 0x92, 0x38, 0xf1, 0x11, 0x21, 0x2d,
-	0xba, 0x05, 0x2b, 0x27, 0x63, 0xbb, 0x3f, 0x24, 0xe5, 0xd2, 0xb6, 0x71, 0x3f, 0x8d, 0xc5, 0x08,
-	0xfd, 0x12, 0xac, 0x77, 0x2e, 0x1c, 0xaf, 0x37, 0x75, 0x6a, 0x37, 0xd8, 0x96, 0x93, 0x50, 0x68,
-	0x13, 0x72, 0x35, 0x77, 0x38, 0xec, 0x07, 0xcd, 0x7a, 0xd9, 0x64, 0x64, 0xe1, 0x78, 0xb3, 0x0d,
-	0x05, 0x45, 0x92, 0xc8, 0x84, 0xf4, 0x25, 0x79, 0x25, 0x94, 0x8d, 0xfe, 0x44, 0xef, 0xc2, 0xf2,
-	0x0b, 0x67, 0x30, 0x21, 0x4c, 0xc5, 0x0a, 0xaa, 0x24, 0x19, 0x5f, 0xab, 0xef, 0x07, 0x98, 0x53,
-	0xfc, 0x4a, 0xea, 0x1b, 0xc6, 0xb3, 0x4c, 0x6e, 0xd9, 0x5c, 0xb1, 0xfe, 0x3e, 0x0d, 0x59, 0xfb,
-	0x0d, 0x28, 0xb0, 0x54, 0xa5, 0x74, 0x92, 0x2a, 0x65, 0x16, 0x50, 0xa5, 0x0f, 0x60, 0x85, 0x49,
-	0xc4, 0x2f, 0x2f, 0x33, 0x55, 0xba, 0xad, 0x52, 0xdb, 0x6d, 0x86, 0x6b, 0x8e, 0x9e, 0xbb, 0x52,
-	0x85, 0x38, 0x31, 0x7a, 0x08, 0x1b, 0x2d, 0xf7, 0x3c, 0x70, 0xfa, 0x03, 0xba, 0x21, 0xe2, 0xc9,
-	0x5d, 0xae, 0xb0, 0x5d, 0x26, 0xe2, 0x66, 0x18, 0x53, 0x76, 0xa6, 0x31, 0xe9, 0xfa, 0x94, 0x5f,
-	0x58, 0x9f, 0xa6, 0x75, 0x15, 0x12, 0x74, 0x75, 0x86, 0x8e, 0x14, 0x66, 0xea, 0xc8, 0xb3, 0x4c,
-	0x2e, 0x67, 0xe6, 0xad, 0xff, 0x32, 0x20, 0xd7, 0x72, 0xcf, 0xff, 0x17, 0x1c, 0xdc, 0x2e, 0xb5,
-	0x9a, 0xf1, 0xa0, 0xdf, 0x75, 0xe4, 0xd1, 0x6d, 0xaa, 0xf4, 0x2d, 0xf7, 0x5c, 0xa0, 0x95, 0xd3,
-	0x0b, 0x39, 0xa6, 0x64, 0xbb, 0xb2, 0xa8, 0x6c, 0xad, 0xff, 0x34, 0x60, 0x95, 0x7e, 0xbf, 0x54,
-	0x0b, 0x54, 0x86, 0x2c, 0x1f, 0x70, 0x31, 0x64, 0xb0, 0x1c, 0xa2, 0x3d, 0x65, 0x83, 0x29, 0xb6,
-	0xc1, 0x77, 0xa6, 0x36, 0x18, 0xce, 0x52, 0x91, 0x84, 0xcc, 0xc6, 0x94, 0x6d, 0x6e, 0xc0, 0x72,
-	0x63, 0xec, 0x76, 0x2f, 0x84, 0x98, 0xf8, 0x80, 0x9a, 0x6b, 0x8b, 0x38, 0x3d, 0xe2, 0x35, 0xeb,
-	0x4c, 0x54, 0x19, 0x1c, 0x8e, 0x99, 0x5c, 0x89, 0x37, 0x2c, 0x2f, 0x0b, 0xb9, 0x12, 0x6f, 0xb8,
-	0xf9, 0xab, 0x50, 0xd4, 0x16, 0x50, 0x8d, 0x38, 0xc3, 0x8d, 0x78, 0x43, 0x35, 0xe2, 0xbc, 0x62,
-	0xaf, 0xd6, 0x0b, 0x28, 0xe9, 0xb2, 0x44, 0x1f, 0xeb, 0x22, 0x60, 0xd3, 0x14, 0x1e, 0x96, 0x67,
-	0x7d, 0xdc, 0x5e, 0x8e, 0xca, 0xfe, 0xb3, 0x1f, 0xdd, 0x35, 0xb0, 0x2e, 0xba, 0x3b, 0x90, 0x97,
-	0xd3, 0xd6, 0xd9, 0xba, 0x19, 0x1c, 0x01, 0xac, 0xef, 0x19, 0x91, 0x5b, 0x64, 0x0e, 0xea, 0xf8,
-	0xc4, 0x76, 0x03, 0x67, 0x20, 0x76, 0x1d, 0x8e, 0xa9, 0xba, 0xd7, 0x8e, 0x4f, 0xaa, 0x2f, 0x9c,
-	0xfe, 0xc0, 0x39, 0x1b, 0xf0, 0x2f, 0x30, 0xb0, 0x06, 0xa3, 0xfc, 0x87, 0x64, 0xc8, 0xf9, 0xb9,
-	0x28, 0xc3, 0x31, 0xe5, 0x3f, 0x24, 0xc3, 0x88, 0x9f, 0x4b, 0x54, 0x83, 0x59, 0xff, 0x9c, 0x01,
-	0x53, 0xc4, 0x95, 0x7d, 0xe2, 0x78, 0xc1, 0x19, 0x71, 0x82, 0xff, 0x83, 0x81, 0xb7, 0x02, 0xc8,
-	0x76, 0x7c, 0xc9, 0x5b, 0xf3, 0x88, 0x13, 0x90, 0x1e, 0xf3, 0x40, 0x39, 0x9c, 0x80, 0x89, 0x79,
-	0x92, 0x5c, 0x82, 0x27, 0xb9, 0x07, 0xc5, 0xe6, 0xa8, 0x1f, 0x44, 0x01, 0x35, 0xcf, 0x88, 0x74,
-	0x20, 0xa5, 0x7a, 0xea, 0xfa, 0x7e, 0x7f, 0xac, 0x3b, 0x25, 0x1d, 0x48, 0xd7, 0xe3, 0x80, 0x67,
-	0x6e, 0x7f, 0x44, 0x7a, 0xcc, 0x1d, 0xe5, 0xb0, 0x06, 0xfb, 0xca, 0xa3, 0xec, 0x0c, 0x4f, 0x59,
-	0x5a, 0x2c, 0x9a, 0xde, 0xd0, 0xa3, 0xa9, 0x88, 0x7e, 0x1f, 0xc2, 0x6a, 0xad, 0x5d, 0x1d, 0x0c,
-	0xdc, 0xae, 0x13, 0x90, 0x66, 0x3d, 0x21, 0xa8, 0x6e, 0xc0, 0xf2, 0x9e, 0x13, 0x74, 0x2f, 0x84,
-	0x5d, 0xf0, 0x81, 0xf5, 0x8f, 0x29, 0x58, 0x93, 0xde, 0x77, 0xbe, 0x1e, 0x6e, 0x43, 0x01, 0x3b,
-	0xcf, 0x03, 0x5d, 0x09, 0x55, 0x50, 0x82, 0xa6, 0xa6, 0x13, 0x35, 0x35, 0x76, 0x72, 0x99, 0xa4,
-	0x93, 0xfb, 0x62, 0xde, 0x38, 0x59, 0x2f, 0x57, 0x66, 0xea, 0xa5, 0xae, 0x03, 0xd9, 0x85, 0xbd,
-	0x77, 0x03, 0x0a, 0x4a, 0x48, 0x9f, 0xe3, 0xbb, 0xe7, 0xbb, 0xa6, 0xbf, 0x49, 0x83, 0x69, 0xbf,
-	0x49, 0x6f, 0x10, 0x25, 0x21, 0xe9, 0xeb, 0x24, 0x21, 0xc9, 0x62, 0xcb, 0xcc, 0x14, 0xdb, 0xac,
-	0xa4, 0x65, 0xf9, 0xda, 0x49, 0xcb, 0xca, 0x82, 0x49, 0x4b, 0xee, 0xb5, 0x93, 0x96, 0xfc, 0xe2,
-	0x49, 0x0b, 0xcc, 0x4b, 0x5a, 0xb2, 0x66, 0xce, 0xfa, 0x83, 0x14, 0xe4, 0x70, 0xe7, 0x90, 0x7b,
-	0x22, 0x13, 0xd2, 0xb6, 0xef, 0xca, 0xd8, 0x67, 0xfb, 0x2e, 0xb5, 0xb5, 0xe6, 0xa8, 0x47, 0x5e,
-	0x4a, 0x5b, 0x63, 0x03, 0xaa, 0xf7, 0x2d, 0xe2, 0xf8, 0x64, 0xdf, 0x1d, 0xf0, 0x48, 0xcb, 0xe3,
-	0x86, 0x0e, 0xa4, 0xdb, 0xb6, 0xbd, 0xc9, 0x88, 0xda, 0x71, 0xaf, 0xe5, 0x8f, 0x64, 0xf0, 0x50,
-	0x61, 0xe8, 0x19, 0xac, 0x72, 0xa6, 0xbe, 0x1f, 0xb8, 0xde, 0x2b, 0x61, 0x1f, 0x5a, 0x32, 0x20,
-	0x77, 0x57, 0x51, 0x09, 0x79, 0x32, 0xa0, 0xf1, 0x6e, 0x3e, 0x81, 0xb5, 0x18, 0xc9, 0x55, 0xe1,
-	0x3c, 0xa3, 0x86, 0xf3, 0xef, 0x42, 0x9e, 0x19, 0x63, 0xd7, 0xf5, 0x7a, 0x94, 0x91, 0x6e, 0x5a,
-	0x30, 0xd2, 0xbd, 0xee, 0x40, 0xc6, 0x7e, 0x35, 0xe6, 0x7c, 0x25, 0xfd, 0xe0, 0x38, 0x0f, 0xc5,
-	0x62, 0x46, 0x43, 0x35, 0x9e, 0x1d, 0x32, 0x15, 0xcc, 0x2a, 0x66, 0xbf, 0xad, 0xef, 0x1b, 0x00,
-	0x6c, 0xfe, 0xdf, 0x9e, 0x10, 0x9f, 0x19, 0x45, 0xdb, 0x19, 0x12, 0x69, 0x14, 0xf4, 0xb7, 0x6a,
-	0x75, 0x29, 0xdd, 0xea, 0xc4, 0x76, 0xd2, 0xd1, 0x76, 0xca, 0x90, 0x3d, 0x74, 0x5e, 0x76, 0xfa,
-	0xbf, 0x23, 0xc3, 0xb2, 0x1c, 0x52, 0x0b, 0x95, 0x86, 0x51, 0x17, 0xc9, 0x4e, 0x04, 0x60, 0x59,
-	0x50, 0xbb, 0x59, 0x67, 0x7a, 0x4a, 0xb3, 0xa0, 0x76, 0xb3, 0x6e, 0x59, 0x00, 0xb6, 0xef, 0xca,
-	0x9d, 0x6d, 0xc0, 0x72, 0xcd, 0x9d, 0x8c, 0x02, 0xf1, 0xf1, 0x7c, 0x60, 0xfd, 0x87, 0x41, 0x3d,
-	0x33, 0xb3, 0x6c, 0x56, 0xbc, 0x24, 0x5a, 0xf5, 0x23, 0xc8, 0x1f, 0x8d, 0x89, 0xe7, 0x04, 0x7d,
-	0x77, 0x24, 0x04, 0x75, 0x53, 0x2f, 0x40, 0x19, 0xef, 0xd1, 0x18, 0x47, 0x74, 0x68, 0x2f, 0x2c,
-	0x59, 0xb9, 0x89, 0xdf, 0x4b, 0x28, 0x59, 0x19, 0xc1, 0xec, 0xba, 0xf5, 0x4d, 0x97, 0x62, 0x56,
-	0x0b, 0x0a, 0xb5, 0x76, 0x14, 0xa3, 0x93, 0xbe, 0xf5, 0x5d, 0x99, 0x92, 0xa7, 0x66, 0x97, 0xc9,
-	0x9c, 0xc2, 0xfa, 0xb1, 0x90, 0x9d, 0x13, 0xcc, 0x91, 0xdd, 0xe2, 0xf3, 0x5d, 0x2d, 0x31, 0xb9,
-	0xd0, 0x57, 0x28, 0xb1, 0xef, 0xad, 0x40, 0x56, 0x6a, 0x10, 0x8b, 0x11, 0xec, 0x67, 0x18, 0x3f,
-	0x22, 0x00, 0xaa, 0xc0, 0xca, 0x21, 0x09, 0x2e, 0xdc, 0x5e, 0x92, 0x29, 0x71, 0x0c, 0x33, 0x25,
-	0x41, 0x85, 0x76, 0x55, 0xbb, 0x61, 0x26, 0x30, 0xe5, 0x37, 0x23, 0xac, 0xf8, 0x46, 0xd5, 0xce,
-	0xaa, 0x2c, 0x25, 0x0f, 0x83, 0x11, 0x33, 0x96, 0xc2, 0xc3, 0x9f, 0x9b, 0x4e, 0xc9, 0xb5, 0x88,
-	0x85, 0x35, 0x16, 0xf4, 0x98, 0x2a, 0x43, 0x34, 0xc3, 0x32, 0x9b, 0xe1, 0x4e, 0x82, 0x96, 0x46,
-	0x13, 0xa8, 0x0c, 0x94, 0xdf, 0x56, 0xf8, 0x57, 0xe2, 0xfc, 0x76, 0x8c, 0x5f, 0x61, 0xa0, 0x81,
-	0x23, 0x32, 0xcf, 0xa4, 0x98, 0x1e, 0x61, 0xb1, 0x6a, 0xc8, 0xbb, 0x7a, 0x2e, 0x25, 0x42, 0x4e,
-	0x59, 0xdf, 0x78, 0x84, 0xc7, 0x7a, 0xe6, 0xb5, 0xab, 0xdb, 0xbb, 0xa8, 0xb2, 0xcb, 0xb3, 0x8c,
-	0x13, 0xeb, 0xde, 0xe1, 0x9b, 0x9a, 0x01, 0xb1, 0x40, 0x34, 0x15, 0xbc, 0x15, 0x34, 0xd6, 0x8c,
-	0x6d, 0x57, 0x37, 0x16, 0x96, 0xea, 0x26, 0x2c, 0x2c, 0xf1, 0x58, 0x37, 0xad, 0x27, 0x50, 0xac,
-	0x93, 0x01, 0x09, 0x88, 0xd8, 0x8e, 0xc8, 0x83, 0xdf, 0x52, 0xd9, 0x35, 0x02, 0xac, 0xd3, 0xa3,
-	0x3d, 0x28, 0x1d, 0x7b, 0xee, 0xcb, 0x57, 0xd1, 0x81, 0xf1, 0x9c, 0x58, 0xcb, 0xda, 0x74, 0x0a,
-	0x3c, 0xc5, 0x61, 0x75, 0xa0, 0xc0, 0x54, 0xd0, 0x1f, 0xbb, 0x23, 0x9f, 0xcc, 0xc9, 0xa6, 0x84,
-	0x5f, 0x4f, 0x69, 0x7e, 0xbd, 0xe5, 0xf8, 0x41, 0xe4, 0xed, 0xe5, 0xd0, 0xaa, 0x00, 0x52, 0x0e,
-	0x4b, 0x99, 0xfb, 0xe3, 0xbe, 0xa7, 0x58, 0x9a, 0x1c, 0x5a, 0x3f, 0xc9, 0xb0, 0xbc, 0x9e, 0x93,
-	0xbd, 0x59, 0x93, 0xbc, 0x03, 0xf9, 0x86, 0xe7, 0xb9, 0x5e, 0xcd, 0xed, 0x11, 0xb6, 0xcd, 0x22,
-	0x8e, 0x00, 0x34, 0xf2, 0xb3, 0xc1, 0x21, 0xf1, 0x7d, 0xe7, 0x9c, 0x88, 0xb4, 0x58, 0x83, 0xd1,
-	0x2a, 0xaf, 0xe9, 0xef, 0x57, 0x0f, 0x08, 0x19, 0x13, 0x8f, 0x99, 0x54, 0x0e, 0x2b, 0x10, 0xf4,
-	0x44, 0x93, 0xa0, 0xb0, 0x99, 0xdb, 0x31, 0xab, 0xe7, 0x68, 0x61, 0xf6, 0x9a, 0xcc, 0xa9, 0x16,
-	0xb9, 0xc3, 0xa1, 0x33, 0xea, 0xf1, 0x6a, 0x21, 0x9b, 0xa0, 0x45, 0x0a, 0x1e, 0x6b, 0xd4, 0x54,
-	0x7d, 0x99, 0x21, 0x89, 0xe5, 0x73, 0xf1, 0xe5, 0x15, 0x34, 0x56, 0x69, 0xa9, 0xfe, 0xd4, 0x06,
-	0x13, 0x3f, 0x20, 0x5e, 0x9d, 0xd0, 0xa4, 0xd1, 0x17, 0x96, 0xa3, 0xe9, 0x8f, 0x4e, 0x81, 0xa7,
-	0x38, 0xd0, 0x63, 0xc8, 0x47, 0x4d, 0x04, 0x6e, 0x3b, 0xdb, 0x2a, 0x7b, 0x88, 0x64, 0x49, 0x20,
-	0x26, 0xfe, 0x64, 0x10, 0xe0, 0x88, 0x05, 0x3d, 0x06, 0x50, 0xec, 0x9e, 0x1b, 0xd0, 0x96, 0x3a,
-	0x41, 0x5c, 0x91, 0x30, 0x4c, 0xd9, 0xfe, 0x05, 0xe9, 0x5e, 0x12, 0x8f, 0x9b, 0xef, 0x6a, 0x82,
-	0xf0, 0x14, 0x3c, 0xd6, 0xa8, 0xad, 0x67, 0xac, 0x14, 0xe3, 0x49, 0x51, 0x28, 0x96, 0x0f, 0x68,
-	0x78, 0xa0, 0x10, 0xbf, 0x6c, 0xb0, 0xa0, 0x75, 0x33, 0x76, 0x98, 0x14, 0x2b, 0x8e, 0x52, 0xd2,
-	0x5a, 0x5f, 0xd3, 0x0e, 0x82, 0xe6, 0x26, 0xdf, 0x62, 0x41, 0x49, 0xe4, 0x26, 0x6c, 0x60, 0x3d,
-	0x85, 0x22, 0xcd, 0xe9, 0x6d, 0xe7, 0x6c, 0x40, 0x4e, 0x7c, 0xe2, 0xd1, 0x3a, 0x93, 0xfe, 0x1d,
-	0x45, 0x09, 0x56, 0x38, 0xa6, 0xb8, 0x63, 0xc7, 0xf7, 0x3f, 0x75, 0xbd, 0x9e, 0xa8, 0x39, 0xc2,
-	0xb1, 0xf5, 0x87, 0x06, 0xdd, 0x25, 0x2b, 0x66, 0x12, 0x63, 0xf4, 0xec, 0x04, 0x4d, 0x2b, 0x8b,
-	0xd2, 0x53, 0x65, 0x51, 0xd4, 0xac, 0xca, 0xa8, 0xcd, 0xaa, 0x2d, 0x16, 0xd8, 0xf4, 0x4c, 0x4d,
-	0x81, 0x58, 0x7f, 0x92, 0xa2, 0x3a, 0x4c, 0xeb, 0x80, 0xda, 0x85, 0x33, 0x3a, 0x27, 0xe8, 0x51,
-	0xb8, 0x3b, 0xd1, 0x59, 0x5a, 0xd7, 0xb3, 0x50, 0x86, 0x8a, 0x24, 0xc8, 0xbf, 0x63, 0x17, 0x80,
-	0xb3, 0x2b, 0xd9, 0xeb, 0x9d, 0x78, 0xd9, 0x11, 0xd1, 0x60, 0x85, 0x1e, 0xd9, 0x50, 0x6a, 0x8e,
-	0xfa, 0x41, 0xdf, 0x19, 0x1c, 0x92, 0xe1, 0x19, 0xf1, 0x64, 0xca, 0xf1, 0xde, 0xac, 0x19, 0x2a,
-	0x3a, 0x39, 0xcf, 0xd4, 0xa7, 0xe6, 0xd8, 0xac, 0xc2, 0x7a, 0x02, 0xd9, 0xb5, 0x9a, 0x6f, 0xef,
-	0x42, 0xb1, 0x73, 0x31, 0x09, 0x7a, 0xee, 0xa7, 0x23, 0xee, 0xb7, 0xe9, 0xd9, 0xd0, 0x1f, 0xe1,
-	0x91, 0xc9, 0xa1, 0xf5, 0xd7, 0x19, 0xb8, 0xd1, 0xe9, 0x5e, 0x90, 0xde, 0x64, 0x40, 0x84, 0x95,
-	0x27, 0x9e, 0xee, 0x3d, 0x28, 0xee, 0xb9, 0x6e, 0xe0, 0x07, 0x9e, 0x33, 0x1e, 0xf7, 0x47, 0xe7,
-	0x6c, 0xd1, 0x1c, 0xd6, 0x81, 0xd4, 0x35, 0x88, 0x52, 0x8a, 0x09, 0x34, 0xcd, 0x04, 0xaa, 0xb9,
-	0x06, 0x05, 0x8d, 0x55, 0x5a, 0xee, 0x93, 0x22, 0x51, 0x89, 0x5c, 0xa4, 0x3c, 0x4b, 0x94, 0x58,
-	0x3f, 0xfd, 0x27, 0x53, 0x5f, 0x2c, 0x12, 0x91, 0xb7, 0x74, 0xc7, 0xa0, 0x10, 0xe0, 0x29, 0x09,
-	0x1d, 0xc0, 0x1a, 0xaf, 0x77, 0x95, 0x02, 0x58, 0x78, 0x56, 0x2d, 0x1f, 0x8a, 0x11, 0xe1, 0x38,
-	0x5f, 0x3c, 0xce, 0x66, 0xaf, 0x19, 0x67, 0x0f, 0x60, 0xed, 0x99, 0xdb, 0x1f, 0xf1, 0x66, 0x89,
-	0xf0, 0x7f, 0xc2, 0xd1, 0x6a, 0xbb, 0x89, 0x11, 0xe1, 0x38, 0x1f, 0xda, 0x07, 0x93, 0xcf, 0xce,
-	0x02, 0x31, 0xdf, 0x50, 0x3e, 0x9e, 0x67, 0x4d, 0xd3, 0xe0, 0x18, 0x97, 0xf5, 0x20, 0x61, 0x5b,
-	0xd4, 0x67, 0x34, 0x5e, 0xf6, 0xfd, 0x80, 0x2a, 0x05, 0xf5, 0x5e, 0x79, 0x1c, 0x8e, 0xad, 0x41,
-	0x82, 0x54, 0xd1, 0x23, 0xc8, 0x50, 0x87, 0x23, 0xcc, 0x54, 0x13, 0x8a, 0xe6, 0xa9, 0x84, 0xb1,
-	0x32, 0x62, 0x56, 0x31, 0x3b, 0xfe, 0x25, 0xad, 0x16, 0xcf, 0x1c, 0x5f, 0xea, 0xbc, 0x06, 0xa3,
-	0x6a, 0xaf, 0x8b, 0x71, 0xb6, 0xda, 0xbf, 0x17, 0x97, 0xc9, 0x1c, 0x6a, 0x47, 0x8f, 0x97, 0x61,
-	0xb7, 0xdc, 0x88, 0xba, 0xe5, 0xe8, 0x23, 0xde, 0xbe, 0x73, 0x46, 0x3d, 0xd9, 0xb7, 0x7f, 0x5b,
-	0x53, 0x3e, 0xdd, 0xc6, 0x64, 0x2f, 0x4b, 0xb2, 0x58, 0x3f, 0x5d, 0xa6, 0x49, 0x21, 0x5f, 0x90,
-	0x46, 0x29, 0x79, 0xd1, 0x61, 0x28, 0x17, 0x1d, 0xff, 0xbf, 0xba, 0xc4, 0xd5, 0xb0, 0x50, 0xcb,
-	0x31, 0x71, 0x7d, 0x2d, 0x21, 0x7b, 0x66, 0xb7, 0x1c, 0x0b, 0xde, 0xc8, 0xe6, 0x5f, 0xeb, 0x46,
-	0x16, 0x92, 0x7b, 0xd3, 0x7a, 0xef, 0xb2, 0xb0, 0x48, 0xd7, 0x79, 0xf5, 0xca, 0xae, 0x73, 0xf1,
-	0xb5, 0xba, 0xce, 0xa5, 0xd7, 0xba, 0xdb, 0xbd, 0xb1, 0xc8, 0xdd, 0xae, 0xb9, 0x58, 0x37, 0x7a,
-	0xed, 0x2b, 0xb9, 0xdb, 0xfd, 0x53, 0x83, 0x3f, 0x4e, 0x10, 0x37, 0xf5, 0xec, 0xfc, 0x65, 0x3e,
-	0x74, 0x37, 0xa1, 0xc0, 0xa9, 0x70, 0x0a, 0x4d, 0x2f, 0x38, 0x68, 0x13, 0x43, 0x41, 0x41, 0x26,
-	0x6c, 0xf0, 0x7d, 0x7d, 0x83, 0xb7, 0x67, 0xa8, 0x9e, 0x1a, 0x53, 0xff, 0x2a, 0xcd, 0xba, 0xc0,
-	0x6f, 0xc4, 0x40, 0x7f, 0xd6, 0xb8, 0xfd, 0x52, 0x1b, 0xb7, 0x54, 0x93, 0xec, 0x45, 0x34, 0xc9,
-	0xfe, 0x72, 0x35, 0xc9, 0x4e, 0xd6, 0xa4, 0x3f, 0x36, 0x00, 0x94, 0xb0, 0x93, 0x94, 0x6d, 0x49,
-	0xe5, 0x4a, 0x29, 0xca, 0x75, 0x0f, 0x8a, 0xd4, 0x70, 0xc8, 0x48, 0x77, 0xec, 0x3a, 0x70, 0xea,
-	0x3c, 0x32, 0x0b, 0xdf, 0x71, 0xfc, 0x65, 0xb4, 0x29, 0x2a, 0xb6, 0x5f, 0x9b, 0x12, 0x9b, 0x15,
-	0x2b, 0xf0, 0xaf, 0x92, 0xdc, 0x27, 0x57, 0x49, 0xee, 0x3d, 0x5d, 0x72, 0xb7, 0x12, 0x56, 0xa0,
-	0x59, 0x88, 0x22, 0xb8, 0xdf, 0x35, 0xa6, 0xdb, 0x0f, 0xb3, 0x52, 0x55, 0x5d, 0x50, 0xa9, 0xab,
-	0x05, 0x95, 0x5e, 0x58, 0x50, 0x3f, 0x48, 0x4d, 0xd7, 0xb0, 0xe8, 0x03, 0xc8, 0x89, 0xa3, 0x96,
-	0xe2, 0x5a, 0x4f, 0x50, 0x03, 0xe9, 0xac, 0x25, 0x29, 0x65, 0xab, 0x49, 0xb6, 0x54, 0x9c, 0xad,
-	0xa6, 0xb3, 0x49, 0x52, 0xf4, 0x0d, 0xd6, 0x8a, 0x17, 0x7c, 0xdc, 0x7f, 0x6c, 0x24, 0x75, 0xec,
-	0x04, 0x63, 0x44, 0x8c, 0x1e, 0x43, 0x21, 0x12, 0x2c, 0x0d, 0xf8, 0xe9, 0xd9, 0x72, 0x97, 0x6d,
-	0x03, 0x85, 0x01, 0xd5, 0x65, 0x7e, 0xd5, 0x13, 0x33, 0xf0, 0x2b, 0x89, 0x72, 0x3c, 0x8b, 0xec,
-	0xa9, 0x73, 0xe8, 0x4c, 0xd6, 0xef, 0x1b, 0x50, 0x10, 0x02, 0x64, 0x8e, 0xf4, 0x9b, 0x4c, 0x7a,
-	0xdc, 0x1d, 0x1a, 0xc2, 0x1d, 0x86, 0xf1, 0x42, 0x60, 0xb4, 0xfa, 0x37, 0x24, 0x47, 0xbb, 0x5c,
-	0x14, 0x9c, 0x37, 0x25, 0x36, 0x13, 0xc5, 0x1a, 0x81, 0xd2, 0x98, 0x23, 0x06, 0xeb, 0x9f, 0x52,
-	0x70, 0x53, 0x54, 0x5a, 0x32, 0x7b, 0x16, 0xcd, 0xc1, 0x77, 0xa0, 0xd4, 0x9e, 0x0c, 0x8f, 0x9e,
-	0x47, 0x93, 0x73, 0x2f, 0x3f, 0x05, 0xa5, 0x9a, 0xc6, 0x20, 0xe1, 0xfe, 0xb9, 0xbd, 0xea, 0x40,
-	0xb4, 0x03, 0xa6, 0xe4, 0x0b, 0x2f, 0x3b, 0x79, 0x15, 0x1c, 0x83, 0xd3, 0x00, 0xde, 0x26, 0x2f,
-	0x83, 0xf0, 0x85, 0x86, 0x18, 0x21, 0x1b, 0x0a, 0xfc, 0xd7, 0xde, 0xab, 0x03, 0x22, 0xef, 0x82,
-	0x1e, 0xaa, 0x82, 0x4f, 0xfc, 0x92, 0x8a, 0xc2, 0xc4, 0xab, 0x4d, 0x75, 0x9a, 0xcd, 0xc7, 0x60,
-	0x4e, 0x13, 0x24, 0x5f, 0x2a, 0xcf, 0xb8, 0x15, 0xfa, 0x87, 0x14, 0x7f, 0xd3, 0x31, 0x37, 0x28,
-	0xfe, 0xec, 0x4e, 0x39, 0xc9, 0x8d, 0xfc, 0x5d, 0x4a, 0xbc, 0x88, 0xa2, 0xde, 0xf6, 0x71, 0x98,
-	0x0f, 0x70, 0x03, 0xd8, 0x8e, 0xd9, 0x33, 0xf3, 0xb5, 0x8c, 0x44, 0xf7, 0xb5, 0x5c, 0xc3, 0x1e,
-	0x87, 0xde, 0x3a, 0x35, 0x8f, 0x7f, 0xa6, 0xaf, 0xee, 0x40, 0x41, 0x99, 0x3c, 0xa1, 0xd5, 0x50,
-	0xd1, 0x7d, 0xf5, 0xcc, 0x47, 0x3b, 0x8a, 0x72, 0xb0, 0x49, 0xe7, 0x06, 0x80, 0xab, 0x26, 0x4d,
-	0x8a, 0x9d, 0xff, 0xb6, 0xac, 0x77, 0xdf, 0x12, 0x35, 0xee, 0x89, 0xe6, 0x60, 0x12, 0x73, 0xbc,
-	0x08, 0x2d, 0x1d, 0x9d, 0xea, 0x92, 0x1e, 0x85, 0xf9, 0x83, 0x08, 0x0c, 0xeb, 0x09, 0x59, 0x83,
-	0xec, 0x25, 0xc9, 0x4c, 0xe3, 0xc3, 0xe8, 0x40, 0x45, 0xdc, 0xdd, 0x48, 0x3a, 0x06, 0xa9, 0x71,
-	0xe1, 0xe1, 0x3f, 0x0a, 0xd3, 0x5e, 0xd1, 0xb4, 0x58, 0x4f, 0x48, 0x76, 0xe5, 0x62, 0x32, 0x41,
-	0x7e, 0x20, 0x2f, 0xc4, 0x78, 0x4d, 0xa6, 0x15, 0xd1, 0xb2, 0x4f, 0xac, 0x5d, 0x8b, 0xb5, 0x85,
-	0x5e, 0x8b, 0x32, 0x54, 0xf4, 0x2e, 0xb3, 0x8c, 0x7b, 0x6b, 0xba, 0x04, 0xd7, 0xa9, 0x70, 0x02,
-	0x27, 0x6a, 0x4c, 0xb5, 0x15, 0x45, 0xea, 0x77, 0x65, 0x35, 0x3f, 0xd5, 0x8c, 0x94, 0xfe, 0xae,
-	0xc7, 0x12, 0x40, 0xe9, 0xef, 0x7a, 0xe8, 0x40, 0xf7, 0x77, 0xc0, 0xd4, 0xfa, 0xdd, 0x59, 0x3d,
-	0xd6, 0xf9, 0x6e, 0x0e, 0xed, 0xaa, 0xa9, 0x8d, 0xe8, 0xf8, 0xde, 0x4a, 0x4e, 0x68, 0xe4, 0x25,
-	0x99, 0x92, 0x0a, 0xa9, 0xb5, 0xd8, 0xea, 0xe2, 0xb5, 0xd8, 0x17, 0x76, 0xae, 0x7f, 0x64, 0xc0,
-	0xaa, 0x1a, 0x39, 0x13, 0x73, 0x9d, 0x3b, 0x90, 0x67, 0xc8, 0xb0, 0x7f, 0x99, 0xc7, 0x11, 0x00,
-	0x95, 0x21, 0xab, 0x7b, 0x54, 0x39, 0x54, 0x0a, 0xc5, 0x8c, 0x56, 0x28, 0x6e, 0x42, 0xae, 0xee,
-	0x7e, 0x3a, 0x62, 0x98, 0x65, 0x86, 0x09, 0xc7, 0xd6, 0x4f, 0x72, 0x60, 0x4a, 0xdd, 0x0a, 0xdf,
-	0x45, 0x84, 0xaf, 0x20, 0x0c, 0xf5, 0x15, 0x44, 0x52, 0xfe, 0x1a, 0x85, 0xb6, 0xb4, 0x16, 0xda,
-	0x8e, 0xf4, 0xa3, 0xe6, 0x59, 0xc9, 0xfb, 0x49, 0x0a, 0x1d, 0x3e, 0x77, 0x98, 0x7f, 0xdc, 0x09,
-	0x6f, 0x19, 0xff, 0xe7, 0xed, 0xa5, 0x07, 0xe6, 0x54, 0x0b, 0x48, 0xf6, 0x3d, 0x1e, 0xce, 0xfd,
-	0xd4, 0x69, 0x26, 0xd5, 0x7d, 0xc7, 0x66, 0x44, 0x4d, 0x35, 0x21, 0xe2, 0x8f, 0xdc, 0xbf, 0x3e,
-	0x77, 0xfa, 0x90, 0x9a, 0xcb, 0x31, 0xe2, 0x56, 0xdd, 0x12, 0x2c, 0xec, 0x96, 0x14, 0xc7, 0x59,
-	0x78, 0x2d, 0xc7, 0xb9, 0x7a, 0x0d, 0xc7, 0x39, 0xe5, 0xe6, 0x8b, 0xd7, 0x76, 0xf3, 0x31, 0x1f,
-	0x56, 0x7a, 0x2d, 0x1f, 0xa6, 0xbb, 0x97, 0x1b, 0xd7, 0x74, 0x2f, 0xb1, 0xa4, 0xda, 0x7c, 0x8d,
-	0xa4, 0xfa, 0x8b, 0x3a, 0x9b, 0xcd, 0xef, 0xc2, 0xcd, 0x44, 0x4d, 0xbb, 0x66, 0xd8, 0xd6, 0x6e,
-	0x0d, 0x95, 0xe9, 0x77, 0xd9, 0x6b, 0xe0, 0x19, 0x39, 0xc6, 0x95, 0x9e, 0xb0, 0x09, 0x05, 0xf5,
-	0x21, 0xf1, 0x17, 0x78, 0x32, 0x67, 0xfd, 0x59, 0x0a, 0x36, 0x92, 0x2e, 0x08, 0xe7, 0x5c, 0x43,
-	0x1f, 0xc7, 0x1e, 0x64, 0x57, 0xae, 0xba, 0x6e, 0xd4, 0x1f, 0x66, 0xc7, 0x72, 0xcc, 0x37, 0xf3,
-	0x3c, 0xdb, 0xbe, 0xfa, 0x79, 0xf6, 0xbc, 0xe6, 0x84, 0x22, 0x51, 0x55, 0xd6, 0x7f, 0x6b, 0x00,
-	0xec, 0x39, 0xdd, 0xcb, 0xc9, 0x98, 0xb5, 0x69, 0x22, 0xa7, 0x6d, 0x68, 0x4e, 0xbb, 0xa9, 0x3b,
-	0x6d, 0x2e, 0x97, 0x5f, 0x54, 0xe7, 0x8f, 0x26, 0xf9, 0x92, 0x8b, 0x90, 0xdf, 0x33, 0x64, 0x0a,
-	0xde, 0x0c, 0xc8, 0x30, 0xf1, 0xed, 0x98, 0x05, 0xab, 0xb5, 0x89, 0xe7, 0x91, 0x51, 0xf0, 0x2d,
-	0xe5, 0xc2, 0x4c, 0x83, 0x51, 0x9a, 0x3a, 0x79, 0xee, 0x4c, 0x06, 0x82, 0x86, 0x07, 0x4c, 0x0d,
-	0x46, 0x8f, 0xa8, 0x39, 0x0a, 0x88, 0x37, 0x72, 0x06, 0xa2, 0xf6, 0x08, 0xc7, 0xd6, 0x9f, 0x1b,
-	0x6a, 0x25, 0x80, 0x3e, 0x82, 0x6c, 0xcd, 0x1d, 0x05, 0x84, 0x3d, 0x15, 0x8b, 0xb7, 0xb7, 0x43,
-	0xc2, 0x8a, 0xa0, 0xe2, 0x82, 0x91, 0x3c, 0x9b, 0x98, 0xdd, 0x86, 0x85, 0x88, 0x6b, 0xb6, 0x4f,
-	0x22, 0x71, 0x28, 0x82, 0xda, 0xf9, 0x4d, 0x80, 0x93, 0x71, 0xcf, 0x09, 0x78, 0x6e, 0x70, 0x1b,
-	0xd6, 0xb5, 0x37, 0x89, 0x1c, 0x65, 0x2e, 0xa1, 0x9b, 0xb0, 0x26, 0xdf, 0x21, 0xb6, 0x3a, 0x6d,
-	0x01, 0x36, 0xd0, 0x3a, 0xdc, 0xa0, 0xde, 0x8e, 0x6d, 0x47, 0x00, 0x53, 0xa8, 0x08, 0x79, 0xbb,
-	0x73, 0x24, 0x86, 0xe9, 0x9d, 0x0a, 0xe4, 0xc3, 0x7f, 0xb8, 0x40, 0x37, 0xa0, 0xd0, 0x76, 0xbd,
-	0xa1, 0x33, 0x60, 0x43, 0x73, 0x09, 0x99, 0xb0, 0x4a, 0xf3, 0x08, 0x77, 0x12, 0x70, 0x88, 0xb1,
-	0xf3, 0xd3, 0x14, 0x40, 0xf4, 0x82, 0x02, 0x95, 0x00, 0xec, 0xce, 0xd1, 0xe9, 0xc9, 0x71, 0xbd,
-	0x6a, 0x37, 0xcc, 0x25, 0x04, 0xb0, 0x52, 0x3d, 0x3e, 0x6e, 0xb4, 0xeb, 0xa6, 0x81, 0x72, 0x90,
-	0xc1, 0x8d, 0x6a, 0xdd, 0x4c, 0xa1, 0x55, 0xc8, 0xd9, 0xf8, 0xa4, 0x5d, 0xa3, 0x34, 0x69, 0x3a,
-	0xe9, 0xd3, 0x86, 0x7d, 0x1a, 0x42, 0x32, 0xa8, 0x00, 0xd9, 0xda, 0x51, 0xbb, 0xdd, 0xa8, 0xd9,
-	0xe6, 0x32, 0x9d, 0x52, 0x0c, 0x4e, 0xf1, 0x91, 0xb9, 0x82, 0xd6, 0xa0, 0xd8, 0x3a, 0x7a, 0x7a,
-	0xba, 0xdf, 0xa8, 0x62, 0x7b, 0xaf, 0x51, 0xb5, 0xcd, 0x2c, 0x9d, 0xa1, 0xd6, 0x56, 0x20, 0x39,
-	0xb6, 0x51, 0x15, 0x92, 0x47, 0x08, 0x4a, 0xb5, 0xfd, 0x46, 0xed, 0xe0, 0x74, 0xbf, 0x7a, 0xd0,
-	0x68, 0x1c, 0x37, 0xb0, 0x09, 0x54, 0x80, 0x74, 0xe5, 0x5a, 0xeb, 0xa4, 0x63, 0x37, 0xf0, 0x69,
-	0xbd, 0x61, 0x57, 0x9b, 0xad, 0x8e, 0x59, 0xa0, 0xc4, 0x14, 0xd1, 0xd9, 0xaf, 0xe2, 0xfa, 0x69,
-	0xb3, 0xfd, 0xf1, 0x91, 0xb9, 0xca, 0x26, 0x68, 0x9f, 0x56, 0x5b, 0xad, 0x23, 0xba, 0xcb, 0xd3,
-	0x66, 0xdd, 0x2c, 0x52, 0x41, 0xab, 0x13, 0x74, 0x6c, 0xba, 0xff, 0x12, 0x13, 0x34, 0x93, 0xc0,
-	0x69, 0xad, 0x7d, 0xda, 0xaa, 0xee, 0x35, 0x5a, 0xe6, 0x0d, 0x54, 0x86, 0x8d, 0x08, 0xf8, 0xed,
-	0x23, 0x7c, 0x20, 0xc8, 0x4d, 0x3a, 0xf3, 0x71, 0xd5, 0xae, 0xed, 0x53, 0x44, 0xc7, 0x3e, 0xc2,
-	0x0d, 0x73, 0x8d, 0x4e, 0x51, 0x6f, 0xb4, 0x1a, 0x9c, 0x9a, 0x03, 0x11, 0x05, 0x1e, 0xe3, 0xa3,
-	0x5f, 0xff, 0x0d, 0xe5, 0xc3, 0xd6, 0x77, 0xda, 0x00, 0xd1, 0x03, 0x4d, 0x2a, 0x2d, 0x7a, 0xc6,
-	0x1c, 0x62, 0x2e, 0x51, 0x51, 0x4b, 0xfd, 0x36, 0x0d, 0x7a, 0xa0, 0x4c, 0x63, 0xc2, 0xd3, 0x5f,
-	0x13, 0x6f, 0x5d, 0x31, 0xf9, 0x2d, 0xd2, 0x0d, 0x48, 0xcf, 0x4c, 0xef, 0xec, 0x40, 0x3e, 0x7c,
-	0xc7, 0x48, 0xd9, 0x3b, 0x24, 0x60, 0x23, 0x73, 0x89, 0xb2, 0xf3, 0x98, 0xc5, 0x01, 0xc6, 0xce,
-	0xbf, 0xa7, 0x00, 0xc9, 0x84, 0x44, 0x51, 0x4c, 0xaa, 0x05, 0xfd, 0xee, 0xa5, 0xaa, 0x8f, 0xca,
-	0x83, 0xb1, 0x50, 0x1f, 0xa9, 0x9a, 0xc6, 0xc0, 0x29, 0x74, 0x0b, 0x90, 0xfa, 0x3e, 0x4d, 0xaa,
-	0x26, 0x5d, 0xfd, 0x29, 0x09, 0x42, 0x35, 0xcf, 0xa0, 0xb7, 0x62, 0x11, 0x4f, 0xa0, 0x96, 0xa9,
-	0x48, 0x3b, 0x84, 0x2b, 0xa9, 0x80, 0xad, 0xd0, 0x03, 0xd0, 0x3b, 0x2c, 0x02, 0x93, 0x45, 0x77,
-	0xe1, 0xed, 0x0e, 0x09, 0xe2, 0x29, 0x9f, 0x20, 0xc8, 0xa1, 0x4d, 0xb8, 0x25, 0x08, 0xc2, 0x9c,
-	0x41, 0xe0, 0xf2, 0x54, 0x84, 0xfc, 0xb7, 0x90, 0x9a, 0x09, 0xf4, 0xc3, 0x24, 0x28, 0xbc, 0xb6,
-	0x32, 0x0b, 0x54, 0x29, 0x8f, 0x69, 0x58, 0x15, 0x7d, 0x40, 0x73, 0x95, 0xf2, 0x62, 0x32, 0x74,
-	0x5f, 0xc8, 0xeb, 0x4e, 0xb3, 0x48, 0x77, 0xa9, 0x37, 0x48, 0xc5, 0x42, 0xa5, 0x9d, 0xef, 0x1b,
-	0x50, 0xd4, 0x12, 0x5d, 0xaa, 0x0f, 0x12, 0x20, 0x9a, 0x19, 0xe6, 0x12, 0x95, 0x8a, 0x04, 0x6a,
-	0x37, 0xfb, 0xa6, 0x81, 0x7e, 0x01, 0x7e, 0x3e, 0x86, 0x92, 0xb9, 0x02, 0x26, 0x5d, 0xd2, 0x7f,
-	0x41, 0x7a, 0x66, 0x0a, 0xbd, 0x0d, 0xb7, 0x63, 0x64, 0x1f, 0x3b, 0xfd, 0x01, 0x55, 0x0f, 0x75,
-	0x4d, 0x3c, 0x19, 0x8d, 0xe8, 0xc4, 0x99, 0x9d, 0xb3, 0xa4, 0x54, 0x9b, 0x7e, 0x8a, 0x06, 0x8d,
-	0xf6, 0x38, 0x8d, 0x91, 0x33, 0x19, 0x31, 0x4c, 0x27, 0x70, 0xc7, 0x63, 0xba, 0xab, 0x9d, 0x0b,
-	0x30, 0xa7, 0x9f, 0x72, 0x50, 0x45, 0xab, 0xf6, 0x7a, 0x22, 0x0e, 0x9a, 0x4b, 0x91, 0x3c, 0x25,
-	0xc8, 0xa0, 0x42, 0xef, 0x04, 0x8e, 0x17, 0x48, 0x48, 0x8a, 0xea, 0x11, 0x9d, 0x55, 0x02, 0xd2,
-	0x74, 0x96, 0x83, 0xfe, 0x60, 0xf0, 0x1d, 0x77, 0x78, 0xd6, 0x27, 0x66, 0x66, 0xe7, 0x50, 0x7b,
-	0x02, 0x41, 0xd1, 0x34, 0xf3, 0xe1, 0x10, 0x73, 0x89, 0x79, 0xcc, 0xb6, 0x1c, 0x1a, 0x74, 0x58,
-	0x0b, 0x87, 0x29, 0x76, 0xc8, 0x2c, 0x35, 0x14, 0x90, 0xf4, 0x5e, 0xf3, 0xb3, 0x1f, 0x6f, 0x2d,
-	0xfd, 0xf0, 0xf3, 0x2d, 0xe3, 0xb3, 0xcf, 0xb7, 0x8c, 0x7f, 0xf9, 0x7c, 0x6b, 0xe9, 0x2f, 0xfe,
-	0x75, 0xcb, 0xf8, 0xce, 0x23, 0xe5, 0xff, 0x80, 0x87, 0x4e, 0xe0, 0xf5, 0x5f, 0xba, 0x5e, 0xff,
-	0xbc, 0x3f, 0x92, 0x83, 0x11, 0x79, 0x30, 0xbe, 0x3c, 0x7f, 0x30, 0x3e, 0x7b, 0x10, 0x45, 0x85,
-	0xb3, 0x15, 0xf6, 0x4f, 0xc0, 0x8f, 0xfe, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xca, 0x4e, 0x51, 0xf3,
-	0x63, 0x3c, 0x00, 0x00,
+	// 4443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0xcd, 0x6f, 0x1b, 0x49,
+	0x76, 0x57, 0x93, 0x14, 0x45, 0x3e, 0x4a, 0x72, 0xab, 0x24, 0xdb, 0x5c, 0x8d, 0x63, 0x6b, 0x7b,
+	0xbd, 0x13, 0x8f, 0x76, 0x86, 0x4e, 0x6c, 0xcc, 0x64, 0x37, 0xf1, 0xd8, 0xa1, 0x48, 0xda, 0xa6,
+	0x4d, 0x53, 0x9a, 0x22, 0xe5, 0x4d, 0x36, 0x18, 0x28, 0x2d, 0xb2, 0x2c, 0x77, 0x44, 0x76, 0x33,
+	0xdd, 0x4d, 0x8f, 0x95, 0x63, 0xb0, 0x08, 0x90, 0x0d, 0x90, 0x43, 0x90, 0xc3, 0x22, 0x08, 0x90,
+	0xe4, 0x1a, 0x20, 0x08, 0x02, 0xe4, 0x92, 0x53, 0x80, 0xe4, 0xb2, 0xc7, 0xf9, 0x0b, 0x16, 0xd9,
+	0xc9, 0x25, 0xd8, 0x24, 0xa7, 0x00, 0x1b, 0x60, 0x2f, 0x09, 0xea, 0xab, 0xbb, 0xaa, 0xbb, 0x29,
+	0x51, 0xfe, 0xd8, 0xdd, 0x04, 0x7b, 0x52, 0xd7, 0xab, 0xf7, 0xaa, 0xab, 0x5f, 0xbd, 0x8f, 0x5f,
+	0xbd, 0x2a, 0x0a, 0xcc, 0x91, 0x77, 0x14, 0x10, 0xff, 0x85, 0x33, 0x20, 0xb5, 0x89, 0xef, 0x85,
+	0x1e, 0x82, 0x98, 0xb2, 0xf9, 0xc1, 0x91, 0x13, 0x3e, 0x9f, 0x1e, 0xd6, 0x06, 0xde, 0xf8, 0xe6,
+	0x91, 0x77, 0xe4, 0xdd, 0x64, 0x2c, 0x87, 0xd3, 0x67, 0xac, 0xc5, 0x1a, 0xec, 0x89, 0x8b, 0x6e,
+	0xae, 0x8e, 0x49, 0x68, 0x0f, 0xed, 0xd0, 0xe6, 0x6d, 0xeb, 0x3f, 0x16, 0x61, 0xa9, 0xd1, 0xed,
+	0x85, 0x9e, 0x4f, 0x10, 0x82, 0xc2, 0xfe, 0x7e, 0xbb, 0x59, 0x35, 0xb6, 0x8c, 0x1b, 0x65, 0xcc,
+	0x9e, 0xd1, 0xbb, 0xb0, 0xda, 0xe3, 0x6f, 0xaa, 0x0f, 0x87, 0x3e, 0x09, 0x82, 0x6a, 0x8e, 0xf5,
+	0x26, 0xa8, 0xe8, 0x2a, 0x40, 0xef, 0x93, 0x8e, 0xe4, 0xc9, 0x33, 0x1e, 0x85, 0x82, 0x6a, 0x80,
+	0x3a, 0xde, 0xe0, 0x38, 0x31, 0x56, 0x81, 0xf1, 0x65, 0xf4, 0xa0, 0xeb, 0x50, 0xc0, 0xde, 0x88,
+	0x54, 0x8b, 0x5b, 0xc6, 0x8d, 0xd5, 0x5b, 0x66, 0x2d, 0x9a, 0x76, 0xa3, 0x4b, 0xe9, 0x98, 0xf5,
+	0xd2, 0x19, 0xf7, 0x9d, 0xc1, 0x71, 0x75, 0x69, 0xcb, 0xb8, 0x51, 0xc0, 0xec, 0x19, 0x7d, 0x0d,
+	0x16, 0x7b, 0xa1, 0x1d, 0x92, 0x6a, 0x89, 0x89, 0x5e, 0xac, 0x29, 0xea, 0xeb, 0x7a, 0x43, 0xc2,
+	0x3a, 0x31, 0xe7, 0x41, 0x1f, 0x43, 0xb1, 0x63, 0x1f, 0x92, 0x51, 0x50, 0x2d, 0x6f, 0xe5, 0x6f,
+	0x54, 0x6e, 0x5d, 0x53, 0xb9, 0x85, 0x5e, 0x6a, 0x9c, 0xa3, 0xe5, 0x86, 0xfe, 0xc9, 0x4e, 0xe1,
+	0x7b, 0xdf, 0xbf, 0xb6, 0x80, 0x85, 0x10, 0xfa, 0x65, 0x28, 0x7f, 0xd3, 0xf3, 0x8f, 0xf9, 0xfb,
+	0x80, 0xbd, 0x6f, 0x3d, 0x9e, 0x6a, 0xd4, 0x85, 0x63, 0x2e, 0x64, 0xc1, 0xf2, 0x27, 0x53, 0xe2,
+	0x9f, 0x48, 0x15, 0x54, 0x98, 0x0a, 0x34, 0x1a, 0xfa, 0x08, 0xa0, 0xe1, 0xb9, 0xcf, 0x9c, 0xa3,
+	0xa6, 0x1d, 0xda, 0xd5, 0xe5, 0x2d, 0xe3, 0x46, 0xe5, 0xd6, 0x25, 0x6d, 0x66, 0x51, 0x2f, 0x56,
+	0x38, 0xd1, 0x47, 0x50, 0xc2, 0x24, 0xf0, 0xa6, 0xfe, 0x80, 0x54, 0x57, 0x98, 0xd4, 0x86, 0x2a,
+	0x25, 0xfb, 0xc4, 0x47, 0x44, 0xbc, 0xe8, 0x12, 0x14, 0xf7, 0x27, 0x7d, 0x67, 0x4c, 0xaa, 0xab,
+	0x5b, 0xc6, 0x8d, 0x3c, 0x16, 0x2d, 0xf4, 0x4b, 0xb0, 0xde, 0x7b, 0x6e, 0xfb, 0xc3, 0xc4, 0xaa,
+	0x5d, 0x60, 0x53, 0xce, 0xea, 0x42, 0x9b, 0x50, 0x6a, 0x78, 0xe3, 0xb1, 0x13, 0xb6, 0x9b, 0x55,
+	0x93, 0xb1, 0x45, 0x6d, 0xf4, 0x3e, 0x14, 0x3a, 0x9e, 0x3d, 0xac, 0xae, 0xb1, 0x99, 0x21, 0x5d,
+	0xd3, 0xb4, 0x47, 0xcc, 0x8b, 0x71, 0x6d, 0x76, 0xa1, 0xa2, 0xe8, 0x1d, 0x99, 0x90, 0x3f, 0x26,
+	0x27, 0xc2, 0x34, 0xe9, 0x23, 0x7a, 0x0f, 0x16, 0x5f, 0xd8, 0xa3, 0x29, 0x61, 0x06, 0x59, 0x51,
+	0xf5, 0xce, 0xe4, 0x3a, 0x4e, 0x10, 0x62, 0xce, 0xf1, 0xab, 0xb9, 0xaf, 0x1b, 0x8f, 0x0a, 0xa5,
+	0x45, 0xb3, 0x68, 0xfd, 0x5d, 0x01, 0x96, 0xfa, 0x6f, 0xc0, 0xdc, 0xa5, 0xe1, 0xe5, 0xb3, 0x0c,
+	0xaf, 0x30, 0x87, 0xe1, 0x7d, 0x08, 0x45, 0xa6, 0xbf, 0xa0, 0xba, 0xc8, 0x0c, 0xef, 0xb2, 0xca,
+	0xdd, 0xef, 0xb2, 0xbe, 0xb6, 0xfb, 0xcc, 0x93, 0x06, 0xc7, 0x99, 0xd1, 0x2d, 0xd8, 0xe8, 0x78,
+	0x47, 0xa1, 0xed, 0x8c, 0xe8, 0x84, 0x88, 0x2f, 0x67, 0x59, 0x64, 0xb3, 0xcc, 0xec, 0x9b, 0xe1,
+	0x7a, 0x4b, 0x33, 0x5d, 0x4f, 0xb7, 0xbe, 0xf2, 0xdc, 0xd6, 0x97, 0xb4, 0x6c, 0xc8, 0xb0, 0xec,
+	0x19, 0x16, 0x55, 0x99, 0x6d, 0x51, 0x1f, 0x43, 0xa9, 0xe3, 0x0d, 0xec, 0x91, 0x13, 0x9e, 0x54,
+	0x97, 0x99, 0xaa, 0xbe, 0x9c, 0x50, 0x15, 0xf7, 0x51, 0xc1, 0xc3, 0xac, 0x05, 0x47, 0x22, 0x9b,
+	0xbf, 0x06, 0x2b, 0x5a, 0x57, 0x86, 0x21, 0x6d, 0xa8, 0x86, 0x54, 0xd6, 0x6d, 0xa6, 0x64, 0x96,
+	0xad, 0x1f, 0xe7, 0xe8, 0x14, 0x8e, 0x7e, 0x06, 0x8c, 0xe6, 0x0e, 0xf5, 0xef, 0xc9, 0xc8, 0x19,
+	0xd8, 0xd2, 0x6c, 0x36, 0x55, 0xfe, 0x8e, 0x77, 0x24, 0xba, 0x15, 0xcb, 0x89, 0x24, 0x12, 0xeb,
+	0x5a, 0x9c, 0x7b, 0x5d, 0xef, 0x2a, 0x2b, 0xb0, 0xc4, 0xde, 0x6a, 0x25, 0xde, 0xfa, 0xf6, 0x96,
+	0xc0, 0xfa, 0xd3, 0x3c, 0x2c, 0xd3, 0x37, 0x48, 0x7f, 0x40, 0x55, 0x58, 0xe2, 0x0d, 0xbe, 0x06,
+	0x05, 0x2c, 0x9b, 0x68, 0x47, 0xd1, 0x4e, 0x8e, 0xcd, 0xf3, 0xdd, 0xe4, 0x3c, 0xe5, 0x28, 0x35,
+	0xc9, 0x28, 0xe6, 0x1a, 0xe9, 0x68, 0x03, 0x16, 0x5b, 0x13, 0x6f, 0xf0, 0x5c, 0xac, 0x11, 0x6f,
+	0xd0, 0xa8, 0xd6, 0x21, 0xf6, 0x90, 0xf8, 0xed, 0x26, 0x5b, 0xa7, 0x02, 0x8e, 0xda, 0x6c, 0x51,
+	0x89, 0x3f, 0xae, 0x2e, 0x8a, 0x45, 0x25, 0xfe, 0x18, 0x7d, 0x0a, 0x6b, 0x5d, 0xcf, 0x7d, 0xea,
+	0x85, 0x8e, 0x7b, 0x14, 0x4d, 0xa9, 0xc8, 0xa6, 0x74, 0x73, 0xe6, 0x94, 0x52, 0x12, 0x7c, 0x6e,
+	0xe9, 0x91, 0xa8, 0x42, 0x35, 0x1e, 0x55, 0xa1, 0x85, 0x33, 0x14, 0xba, 0xd9, 0x84, 0x4b, 0xd9,
+	0x6f, 0x3a, 0xcf, 0x28, 0xd6, 0x77, 0x0d, 0x58, 0xd5, 0xcd, 0x0d, 0xdd, 0xd7, 0x17, 0x8a, 0x8d,
+	0x53, 0xb9, 0x55, 0x9d, 0xf5, 0xbd, 0x3b, 0x25, 0x6a, 0x9e, 0x9f, 0x7f, 0xff, 0x9a, 0x81, 0xf5,
+	0x05, 0xbe, 0x02, 0x65, 0x39, 0x6c, 0x93, 0xbd, 0xb8, 0x80, 0x63, 0x02, 0xda, 0x82, 0x4a, 0x3b,
+	0x88, 0x3e, 0x80, 0x2d, 0x53, 0x09, 0xab, 0x24, 0xeb, 0x3b, 0x46, 0x9c, 0x05, 0x59, 0x3e, 0xda,
+	0xdb, 0xef, 0x7b, 0xa1, 0x3d, 0x12, 0x1f, 0x16, 0xb5, 0x69, 0xbc, 0x6a, 0xec, 0xed, 0xd7, 0x5f,
+	0xd8, 0xce, 0xc8, 0x3e, 0x1c, 0xf1, 0x8f, 0x34, 0xb0, 0x46, 0xa3, 0xf2, 0x4f, 0xc8, 0x98, 0xcb,
+	0x73, 0x93, 0x88, 0xda, 0x54, 0xfe, 0x09, 0x19, 0xc7, 0xf2, 0xdc, 0x32, 0x34, 0x9a, 0xf5, 0x14,
+	0x8a, 0x3c, 0xb7, 0xd1, 0x20, 0x51, 0x1f, 0x84, 0xce, 0x0b, 0xd2, 0x23, 0x41, 0xe0, 0x78, 0x6e,
+	0x20, 0xe6, 0x93, 0xa0, 0xa2, 0xeb, 0xb0, 0xc2, 0x29, 0x34, 0x6e, 0x3a, 0x24, 0x10, 0x2a, 0xd0,
+	0x89, 0xd6, 0x8f, 0x0b, 0x60, 0x0a, 0x78, 0xf2, 0x90, 0xd8, 0x7e, 0x78, 0x48, 0xec, 0xf0, 0xff,
+	0x20, 0x7e, 0xab, 0x01, 0xea, 0xdb, 0x81, 0x94, 0x6d, 0xf8, 0xc4, 0x0e, 0xc9, 0x90, 0xa5, 0xa6,
+	0x12, 0xce, 0xe8, 0x49, 0xa5, 0x98, 0x52, 0x46, 0x8a, 0xb9, 0x0e, 0x2b, 0x6d, 0xd7, 0x09, 0x63,
+	0x5c, 0x56, 0x66, 0x4c, 0x3a, 0x91, 0x72, 0x3d, 0xf0, 0x82, 0xc0, 0x99, 0xe8, 0xd9, 0x4a, 0x27,
+	0xd2, 0xf7, 0x71, 0xc2, 0x23, 0xcf, 0x71, 0xc9, 0x90, 0xe5, 0xa9, 0x12, 0xd6, 0x68, 0x3f, 0x71,
+	0xb0, 0x36, 0x23, 0x85, 0xae, 0xce, 0x07, 0xca, 0x2e, 0xcc, 0x00, 0x65, 0xe6, 0x3c, 0xa0, 0x4c,
+	0x80, 0xa8, 0x8f, 0x60, 0xb9, 0xd1, 0xad, 0x8f, 0x46, 0xde, 0xc0, 0x0e, 0x49, 0xbb, 0x99, 0x1d,
+	0xcf, 0x77, 0xec, 0x70, 0xf0, 0x5c, 0x18, 0x2f, 0x6f, 0x58, 0xff, 0x9c, 0x87, 0x35, 0x99, 0x2d,
+	0x4e, 0xb7, 0xda, 0x2d, 0xa8, 0x60, 0xfb, 0x59, 0xa8, 0x9b, 0xac, 0x4a, 0xca, 0xb0, 0xeb, 0x7c,
+	0xa6, 0x5d, 0xa7, 0xd6, 0xb9, 0x90, 0xb5, 0xce, 0xaf, 0x97, 0x58, 0xb3, 0xad, 0xb8, 0x38, 0xd3,
+	0x8a, 0x75, 0x8b, 0x59, 0x9a, 0xdb, 0x62, 0x1e, 0x28, 0x89, 0xb8, 0xc4, 0x66, 0xf9, 0xb5, 0xac,
+	0x44, 0x1c, 0xa9, 0xf6, 0xed, 0x64, 0xe4, 0x16, 0x54, 0x14, 0x7c, 0x7a, 0x4a, 0x3e, 0x3e, 0x35,
+	0x90, 0x5b, 0xff, 0x5d, 0x00, 0xb3, 0xff, 0x26, 0x23, 0x58, 0x8c, 0xa8, 0xf3, 0xe7, 0x41, 0xd4,
+	0xd9, 0x8b, 0x57, 0x98, 0xb9, 0x78, 0xb3, 0x10, 0xf8, 0xe2, 0xb9, 0x11, 0x78, 0x71, 0x4e, 0x04,
+	0x5e, 0x7a, 0x65, 0x04, 0x5e, 0x9e, 0x1f, 0x81, 0xc3, 0xec, 0xf0, 0x71, 0x5f, 0x31, 0xbb, 0x0a,
+	0x53, 0xed, 0x76, 0x06, 0x02, 0x3f, 0xd3, 0xea, 0x50, 0x03, 0x56, 0x7a, 0xa1, 0xed, 0x0e, 0x0f,
+	0x4f, 0xc4, 0x3a, 0x2d, 0xcf, 0xb3, 0x4e, 0xba, 0xcc, 0xeb, 0xe2, 0xf9, 0x25, 0xb3, 0x64, 0xfd,
+	0x61, 0x0e, 0x4a, 0xb8, 0xf7, 0x84, 0xe7, 0x01, 0x13, 0xf2, 0xfd, 0xc0, 0x93, 0xa0, 0xa7, 0x1f,
+	0x78, 0x54, 0xbc, 0xed, 0x0e, 0xc9, 0x4b, 0x19, 0xbb, 0x58, 0x83, 0xc6, 0x91, 0x0e, 0xb1, 0x03,
+	0xf2, 0xd0, 0x1b, 0x71, 0x1c, 0xc8, 0xd1, 0x80, 0x4e, 0xa4, 0x0b, 0xd0, 0xf7, 0xa7, 0x2e, 0x8d,
+	0x8b, 0xc3, 0x4e, 0xe0, 0x4a, 0x48, 0xa0, 0xd2, 0xd0, 0x23, 0x58, 0xe6, 0x42, 0x4e, 0x10, 0x7a,
+	0xfe, 0x89, 0x88, 0x37, 0x1a, 0x54, 0x95, 0xb3, 0xab, 0xa9, 0x8c, 0x5c, 0x9d, 0x9a, 0xec, 0xe6,
+	0x3d, 0x58, 0x4b, 0xb1, 0x9c, 0x85, 0xe3, 0x0a, 0xaa, 0x33, 0x7f, 0x0a, 0x65, 0x16, 0xdc, 0x06,
+	0x9e, 0x3f, 0xa4, 0x82, 0x74, 0xd2, 0x42, 0x90, 0xce, 0x75, 0x1b, 0x0a, 0xfd, 0x93, 0x09, 0x97,
+	0x5b, 0xd5, 0x4d, 0x90, 0xcb, 0xd0, 0x5e, 0xcc, 0x78, 0xa8, 0xef, 0x32, 0x73, 0xa5, 0x8a, 0x59,
+	0xc6, 0xec, 0x99, 0xc2, 0x44, 0x60, 0xe3, 0xff, 0xee, 0x94, 0x04, 0xcc, 0xbd, 0xbb, 0xf6, 0x98,
+	0x48, 0xf7, 0xa6, 0xcf, 0x6a, 0xfc, 0xc8, 0xe9, 0xf1, 0x43, 0x4c, 0x27, 0x1f, 0x4f, 0xa7, 0x0a,
+	0x4b, 0x4f, 0xec, 0x97, 0x3d, 0xe7, 0xf7, 0x24, 0xd8, 0x92, 0x4d, 0x1a, 0x6b, 0xa4, 0xe9, 0x34,
+	0x05, 0x14, 0x8f, 0x09, 0x0c, 0xa3, 0x77, 0xdb, 0x4d, 0xe6, 0x71, 0x14, 0xa3, 0x77, 0xdb, 0x4d,
+	0xcb, 0x02, 0xe8, 0x07, 0x9e, 0x9c, 0xd9, 0x06, 0x2c, 0x36, 0xbc, 0xa9, 0x1b, 0x8a, 0x8f, 0xe7,
+	0x0d, 0xeb, 0xdf, 0x0d, 0x9a, 0xe9, 0x98, 0x79, 0xb3, 0x9a, 0x42, 0x66, 0x7c, 0xba, 0x0d, 0xe5,
+	0xdd, 0x09, 0xf1, 0xed, 0xd0, 0xf1, 0x5c, 0xa1, 0xa8, 0x8b, 0x89, 0x34, 0x4a, 0x65, 0x77, 0x27,
+	0x38, 0xe6, 0x43, 0x3b, 0x51, 0xdd, 0x89, 0x07, 0xab, 0xeb, 0x19, 0x75, 0x27, 0xc6, 0x30, 0xbb,
+	0xf8, 0xf4, 0xa6, 0x2b, 0x24, 0x56, 0x07, 0x2a, 0x8d, 0x6e, 0x8c, 0x90, 0xb2, 0xbe, 0xf5, 0x3d,
+	0xb9, 0x5b, 0xcd, 0xcd, 0xae, 0x75, 0x71, 0x0e, 0xeb, 0x07, 0x42, 0x77, 0x76, 0x78, 0x8a, 0xee,
+	0xe6, 0x1f, 0xef, 0x6c, 0x8d, 0xc9, 0x17, 0xfd, 0x04, 0x35, 0xf6, 0x9d, 0x22, 0x2c, 0x49, 0x0b,
+	0x62, 0xd9, 0x8e, 0x3d, 0x46, 0x99, 0x30, 0x26, 0xa0, 0x1a, 0x14, 0x9f, 0x90, 0xf0, 0xb9, 0x37,
+	0xcc, 0x72, 0x25, 0xde, 0xc3, 0x5c, 0x49, 0x70, 0xa1, 0x3b, 0xaa, 0xdf, 0x30, 0x17, 0x48, 0x64,
+	0x80, 0xb8, 0x57, 0x7c, 0xa3, 0xea, 0x67, 0x75, 0xb6, 0x15, 0x8b, 0x22, 0x32, 0x73, 0x96, 0xca,
+	0xad, 0x5f, 0x38, 0x15, 0x2c, 0x60, 0x4d, 0x04, 0xdd, 0xa5, 0xc6, 0x10, 0x8f, 0xb0, 0xc8, 0x46,
+	0xb8, 0x92, 0x61, 0xa5, 0xf1, 0x00, 0xaa, 0x00, 0x95, 0xef, 0x2b, 0xf2, 0xc5, 0xb4, 0x7c, 0x3f,
+	0x25, 0xaf, 0x08, 0xd0, 0x14, 0x18, 0xbb, 0x67, 0x16, 0x46, 0x8a, 0x7b, 0xb1, 0xea, 0xc8, 0x77,
+	0x74, 0x6c, 0x2a, 0x92, 0x67, 0x55, 0x9f, 0x78, 0xdc, 0x8f, 0x75, 0x24, 0x7b, 0x47, 0xf7, 0x77,
+	0x51, 0xfc, 0xaa, 0xce, 0x72, 0x4e, 0xac, 0x47, 0x87, 0x6f, 0x68, 0x0e, 0xc4, 0x52, 0x6a, 0x22,
+	0xbd, 0x29, 0xdd, 0x58, 0x73, 0xb6, 0x3b, 0xba, 0xb3, 0xb0, 0x8d, 0x46, 0xc6, 0x8b, 0x65, 0x3f,
+	0xd6, 0x5d, 0xeb, 0x1e, 0xac, 0x34, 0xc9, 0x88, 0x84, 0x44, 0x4c, 0x47, 0xec, 0x42, 0xbe, 0xa4,
+	0x8a, 0x6b, 0x0c, 0x58, 0xe7, 0x47, 0x3b, 0xb0, 0xba, 0xe7, 0x7b, 0x2f, 0x4f, 0xe2, 0x05, 0xe3,
+	0x3b, 0x12, 0x0d, 0x05, 0xeb, 0x1c, 0x38, 0x21, 0x61, 0xf5, 0xa0, 0xc2, 0x4c, 0x30, 0x98, 0x78,
+	0x6e, 0x40, 0x4e, 0xc1, 0x85, 0x22, 0xae, 0xe7, 0xb4, 0xb8, 0xde, 0xb1, 0x83, 0x30, 0x8e, 0xf6,
+	0xb2, 0x69, 0xd5, 0x00, 0x29, 0x8b, 0xa5, 0x8c, 0x7d, 0xdf, 0xf1, 0x15, 0x4f, 0x93, 0x4d, 0xeb,
+	0x47, 0x05, 0xb6, 0xab, 0xe2, 0x6c, 0x6f, 0xd6, 0x25, 0xaf, 0x40, 0xb9, 0xe5, 0xfb, 0x9e, 0xdf,
+	0xf0, 0x86, 0x84, 0x4d, 0x73, 0x05, 0xc7, 0x04, 0x9a, 0xf9, 0x59, 0xe3, 0x09, 0x09, 0x02, 0xfb,
+	0x88, 0x88, 0x6d, 0x86, 0x46, 0xa3, 0x7b, 0xec, 0x76, 0xf0, 0xb0, 0xfe, 0x98, 0x90, 0x09, 0xf1,
+	0x99, 0x4b, 0x95, 0xb0, 0x42, 0x41, 0xf7, 0x34, 0x0d, 0x0a, 0x9f, 0xb9, 0x9c, 0xf2, 0x7a, 0xde,
+	0x2d, 0xdc, 0x5e, 0xd3, 0x39, 0xb5, 0x22, 0x6f, 0x3c, 0xb6, 0xdd, 0x21, 0xdf, 0x7d, 0x2d, 0x65,
+	0x58, 0x91, 0xd2, 0x8f, 0x35, 0x6e, 0x6a, 0xbe, 0xcc, 0x91, 0xc4, 0xeb, 0x4b, 0xe9, 0xd7, 0x2b,
+	0xdd, 0x58, 0xe5, 0xa5, 0xf6, 0xd3, 0x18, 0x4d, 0x83, 0x90, 0xf8, 0x4d, 0x42, 0xe1, 0x6f, 0x20,
+	0x3c, 0x47, 0xb3, 0x1f, 0x9d, 0x03, 0x27, 0x24, 0xd0, 0x5d, 0x28, 0xc7, 0xc5, 0x23, 0xee, 0x3b,
+	0x5b, 0xaa, 0x78, 0xd4, 0xc9, 0xe0, 0x2c, 0x26, 0xc1, 0x74, 0x14, 0xe2, 0x58, 0x04, 0xdd, 0x05,
+	0x50, 0xfc, 0x9e, 0x3b, 0xd0, 0x55, 0x75, 0x80, 0xb4, 0x21, 0x61, 0x48, 0xf8, 0xfe, 0x73, 0x32,
+	0x38, 0x26, 0x3e, 0x77, 0xdf, 0xe5, 0x0c, 0xe5, 0x29, 0xfd, 0x58, 0xe3, 0xb6, 0x1e, 0xb1, 0xad,
+	0x2d, 0x07, 0x45, 0x91, 0x5a, 0x3e, 0xa4, 0xe9, 0x81, 0x52, 0x82, 0xaa, 0xc1, 0x92, 0xd6, 0xc5,
+	0xd4, 0x62, 0xd2, 0x5e, 0xb1, 0x94, 0x92, 0xd7, 0xfa, 0x8a, 0xb6, 0x10, 0x14, 0x9b, 0x3c, 0x65,
+	0x49, 0x49, 0x60, 0x13, 0xd6, 0xb0, 0x1e, 0xc0, 0x0a, 0xdd, 0x9d, 0xf4, 0xed, 0xc3, 0x11, 0xd9,
+	0x0f, 0x88, 0x4f, 0x77, 0xf9, 0xf4, 0xaf, 0x1b, 0x03, 0xac, 0xa8, 0x4d, 0xfb, 0xf6, 0xec, 0x20,
+	0xf8, 0xcc, 0xf3, 0x87, 0x02, 0x15, 0x47, 0x6d, 0xeb, 0x8f, 0x0c, 0x3a, 0x4b, 0xb6, 0x2d, 0xcb,
+	0xcc, 0xd1, 0xb3, 0x01, 0x9a, 0xb6, 0xc1, 0xcb, 0x27, 0x2b, 0x75, 0x51, 0x29, 0xb5, 0xa0, 0x96,
+	0x52, 0xaf, 0xb2, 0xc4, 0xa6, 0x23, 0x35, 0x85, 0x62, 0xfd, 0x59, 0x8e, 0xda, 0x30, 0xdd, 0xd1,
+	0x34, 0x9e, 0xdb, 0xee, 0x11, 0x41, 0xb7, 0xa3, 0xd9, 0x89, 0x8a, 0xe2, 0xba, 0x8e, 0x42, 0x59,
+	0x57, 0xac, 0x41, 0xfe, 0x1d, 0x77, 0x00, 0xb8, 0xb8, 0x82, 0x5e, 0xaf, 0xa4, 0x37, 0x50, 0x31,
+	0x0f, 0x56, 0xf8, 0x51, 0x1f, 0x56, 0xdb, 0xae, 0x13, 0x3a, 0xf6, 0xe8, 0x09, 0x19, 0x1f, 0x12,
+	0x5f, 0x42, 0x8e, 0xf7, 0x67, 0x8d, 0x50, 0xd3, 0xd9, 0x39, 0x52, 0x4f, 0x8c, 0xb1, 0x59, 0x87,
+	0xf5, 0x0c, 0xb6, 0x73, 0x55, 0x5d, 0xdf, 0x83, 0x95, 0xde, 0xf3, 0x69, 0x38, 0xf4, 0x3e, 0x73,
+	0x79, 0xdc, 0xa6, 0x6b, 0x43, 0x1f, 0xa2, 0x25, 0x93, 0x4d, 0xeb, 0xaf, 0x0b, 0x70, 0xa1, 0x37,
+	0x78, 0x4e, 0x86, 0xd3, 0x11, 0x11, 0x5e, 0x9e, 0xb9, 0xba, 0xd7, 0x61, 0x65, 0xc7, 0xf3, 0xc2,
+	0x20, 0xf4, 0xed, 0xc9, 0xc4, 0x71, 0x8f, 0xd8, 0x4b, 0x4b, 0x58, 0x27, 0xd2, 0xd0, 0x20, 0x36,
+	0x85, 0x4c, 0xa1, 0x79, 0xa6, 0x50, 0x2d, 0x34, 0x28, 0xdd, 0x58, 0xe5, 0xe5, 0x31, 0x29, 0x56,
+	0x95, 0xc0, 0x22, 0xd5, 0x59, 0xaa, 0xc4, 0xfa, 0xea, 0xdf, 0x4b, 0x7c, 0xb1, 0x00, 0x22, 0x5f,
+	0xd2, 0x03, 0x83, 0xc2, 0x80, 0x13, 0x1a, 0x7a, 0x0c, 0x6b, 0x7c, 0xe7, 0xae, 0x6c, 0xe5, 0x45,
+	0x64, 0xd5, 0xf0, 0x50, 0x8a, 0x09, 0xa7, 0xe5, 0xd2, 0x79, 0x76, 0xe9, 0x9c, 0x79, 0xf6, 0x31,
+	0xac, 0x3d, 0xf2, 0x1c, 0x97, 0x17, 0x9f, 0x44, 0xfc, 0x13, 0x81, 0x56, 0x9b, 0x4d, 0x8a, 0x09,
+	0xa7, 0xe5, 0xd0, 0x43, 0x30, 0xf9, 0xe8, 0x2c, 0x11, 0xf3, 0x09, 0x95, 0xd3, 0x38, 0x2b, 0xc9,
+	0x83, 0x53, 0x52, 0xd6, 0xcd, 0x8c, 0x69, 0xd1, 0x98, 0xd1, 0x7a, 0xe9, 0x04, 0xac, 0xcc, 0x4e,
+	0xa3, 0x57, 0x19, 0x47, 0x6d, 0x6b, 0x94, 0xa1, 0x55, 0x74, 0x1b, 0x0a, 0x34, 0xe0, 0x08, 0x37,
+	0xd5, 0x94, 0xa2, 0x45, 0x2a, 0x59, 0x51, 0x64, 0x51, 0x8b, 0xee, 0x98, 0xed, 0xe0, 0x98, 0xee,
+	0x16, 0x0f, 0xed, 0x40, 0xda, 0xbc, 0x46, 0xa3, 0x66, 0xaf, 0xab, 0x71, 0xb6, 0xd9, 0xbf, 0x9f,
+	0xd6, 0xc9, 0x29, 0xdc, 0xb6, 0x9e, 0x2f, 0xa3, 0xb3, 0x1c, 0x43, 0x39, 0xcb, 0xf9, 0x98, 0x17,
+	0x4f, 0x6d, 0x77, 0x28, 0x4f, 0x95, 0xde, 0xd1, 0x8c, 0x4f, 0xf7, 0x31, 0x59, 0x1b, 0x94, 0x22,
+	0xd6, 0xdf, 0x14, 0x29, 0x28, 0xe4, 0x2f, 0xa4, 0x59, 0x4a, 0x9e, 0x01, 0x1a, 0xca, 0x19, 0xe0,
+	0xff, 0xaf, 0x1a, 0x7d, 0x3d, 0xda, 0xa8, 0xf1, 0x1a, 0xe5, 0x57, 0x32, 0xd0, 0x33, 0x3b, 0xf0,
+	0x9a, 0xf3, 0x5a, 0x45, 0xf9, 0x95, 0xae, 0x55, 0x40, 0xf6, 0xc9, 0x80, 0x5e, 0x0b, 0xae, 0xcc,
+	0x53, 0xf3, 0x5f, 0x3e, 0xb3, 0xe6, 0xbf, 0xf2, 0x4a, 0x35, 0xff, 0xd5, 0x57, 0xba, 0xa0, 0x71,
+	0x61, 0x9e, 0x0b, 0x1a, 0xe6, 0x7c, 0x67, 0x01, 0x6b, 0x33, 0xce, 0x02, 0xd0, 0x4f, 0xf1, 0x82,
+	0xc6, 0x9f, 0x1b, 0xfc, 0x3e, 0x92, 0xb8, 0x9c, 0xc3, 0xac, 0x45, 0xa2, 0xa7, 0x6b, 0x19, 0xdb,
+	0xa1, 0x1a, 0xe7, 0xd0, 0xac, 0x88, 0x93, 0x36, 0x31, 0x54, 0x94, 0xce, 0x8c, 0x09, 0x7e, 0xa0,
+	0x4f, 0xf0, 0xf2, 0x0c, 0x43, 0x55, 0x33, 0xf0, 0x7f, 0x16, 0x58, 0xf5, 0xfb, 0x8d, 0xb8, 0xf3,
+	0xcf, 0x0b, 0xd6, 0x6f, 0xa9, 0x60, 0x5d, 0x4f, 0x15, 0xac, 0xbf, 0x9a, 0x51, 0x78, 0xe0, 0x31,
+	0xe8, 0x67, 0xbc, 0x56, 0x4d, 0xdd, 0xa1, 0x3f, 0x8f, 0x3b, 0xf4, 0xdf, 0xae, 0x3b, 0xf4, 0xb3,
+	0xdd, 0xe1, 0x4f, 0x0c, 0x00, 0x25, 0xd3, 0x66, 0x01, 0x4c, 0xe9, 0x21, 0x39, 0xc5, 0x43, 0xae,
+	0xc3, 0x0a, 0xf5, 0x7e, 0xe2, 0xea, 0xb9, 0x4c, 0x27, 0x26, 0x8c, 0xaa, 0x30, 0xaf, 0x51, 0x59,
+	0x7f, 0x15, 0x4f, 0x8a, 0xaa, 0xed, 0xd7, 0x13, 0x6a, 0xb3, 0x52, 0x35, 0x8d, 0xb3, 0x34, 0xf7,
+	0xc9, 0x59, 0x9a, 0x7b, 0x5f, 0xd7, 0xdc, 0xa5, 0x8c, 0x37, 0x50, 0xe0, 0xa5, 0x28, 0xee, 0xf7,
+	0x8d, 0x64, 0xc5, 0x65, 0x16, 0x3a, 0xd7, 0x15, 0x95, 0x3b, 0x5b, 0x51, 0xf9, 0xb9, 0x15, 0xf5,
+	0x5f, 0xf9, 0xe4, 0xb6, 0x1d, 0x7d, 0x08, 0x25, 0xb1, 0xd4, 0x52, 0x5d, 0xeb, 0x19, 0x66, 0x20,
+	0xf3, 0x93, 0x64, 0xa5, 0x62, 0x0d, 0x29, 0x96, 0x4b, 0x8b, 0x35, 0x74, 0x31, 0xc9, 0x8a, 0xbe,
+	0xce, 0x4e, 0x1f, 0x84, 0x1c, 0x0f, 0x82, 0x1b, 0x59, 0x45, 0x4a, 0x21, 0x18, 0x33, 0xa3, 0xbb,
+	0x50, 0x89, 0x15, 0x4b, 0x31, 0x4e, 0x7e, 0xb6, 0xde, 0x65, 0xa5, 0x44, 0x11, 0x40, 0x4d, 0x09,
+	0x29, 0x87, 0x62, 0x04, 0x7e, 0x0a, 0x53, 0x4d, 0x03, 0xe7, 0xa1, 0x3a, 0x86, 0x2e, 0x84, 0x9e,
+	0xc2, 0xfa, 0xde, 0xc8, 0x1e, 0x90, 0x31, 0x71, 0xc3, 0xa7, 0x8e, 0x37, 0x62, 0xb5, 0x7d, 0x79,
+	0xd3, 0x47, 0xab, 0x3d, 0xa4, 0xd9, 0xc4, 0x88, 0x59, 0x03, 0xa0, 0xdf, 0x82, 0x8d, 0xde, 0xc0,
+	0x1e, 0x11, 0x4c, 0x06, 0xde, 0x78, 0x4c, 0xdc, 0xa1, 0x18, 0x78, 0x29, 0x7d, 0xff, 0xad, 0xd1,
+	0xcd, 0xe0, 0x14, 0x63, 0x67, 0x0e, 0x62, 0x85, 0x80, 0xd2, 0xef, 0x3c, 0xa5, 0x5c, 0xb7, 0x01,
+	0x8b, 0xbc, 0x26, 0x29, 0x42, 0x14, 0x2f, 0x39, 0x5e, 0x82, 0x62, 0xd3, 0x1b, 0xdb, 0x8e, 0x2b,
+	0x7c, 0x57, 0xb4, 0x28, 0x5d, 0x59, 0x93, 0xb2, 0xf4, 0x21, 0xeb, 0x9f, 0x72, 0x70, 0x31, 0x73,
+	0xae, 0x54, 0x42, 0xe0, 0x45, 0x6e, 0xf9, 0x12, 0x04, 0x9a, 0x90, 0x6f, 0x74, 0xb9, 0x39, 0x95,
+	0x31, 0x7d, 0x14, 0x97, 0x79, 0xf6, 0x59, 0x7d, 0x2d, 0xcf, 0x2e, 0xeb, 0x44, 0x6d, 0xb4, 0x05,
+	0x95, 0x27, 0x64, 0xec, 0xf9, 0x27, 0xfb, 0x51, 0xf9, 0xcd, 0xc0, 0x2a, 0x29, 0xe3, 0x02, 0xce,
+	0xe2, 0x7c, 0x17, 0x70, 0x8a, 0x19, 0x17, 0x70, 0xd0, 0x4d, 0x28, 0x52, 0x82, 0xe7, 0x32, 0x24,
+	0x9c, 0xdc, 0x0c, 0xd3, 0xcf, 0xe4, 0xdd, 0x58, 0xb0, 0xc5, 0x27, 0x4c, 0x25, 0xe5, 0x84, 0x89,
+	0x7e, 0x3c, 0x26, 0x76, 0xe0, 0xb9, 0x22, 0xf5, 0x89, 0x16, 0xe5, 0x6e, 0xfa, 0x54, 0xbb, 0xc0,
+	0x3e, 0x9f, 0x37, 0xac, 0x3f, 0x30, 0xa0, 0x22, 0x1c, 0x96, 0xa1, 0x8f, 0x6f, 0x30, 0x6f, 0xe5,
+	0x09, 0xca, 0x10, 0x09, 0x2a, 0x02, 0x59, 0xa2, 0x47, 0x2b, 0x31, 0x45, 0xec, 0xe8, 0x0e, 0x77,
+	0x3d, 0x2e, 0x9b, 0x13, 0xc6, 0x1f, 0x03, 0x34, 0xd1, 0xa5, 0x09, 0xc7, 0x02, 0xd6, 0x3f, 0xe6,
+	0xe0, 0xa2, 0x28, 0x66, 0xc8, 0x0d, 0xaa, 0xa8, 0xbf, 0xbf, 0x0b, 0xab, 0xdd, 0xe9, 0x78, 0xf7,
+	0x59, 0x3c, 0xb8, 0xb8, 0xe6, 0xa4, 0x53, 0xa9, 0x96, 0x19, 0x25, 0x9a, 0xbf, 0xb8, 0xe6, 0xa4,
+	0x11, 0xd1, 0x36, 0x98, 0x52, 0x2e, 0xba, 0x9f, 0xc1, 0x0b, 0x4d, 0x29, 0x3a, 0x55, 0x65, 0x97,
+	0xbc, 0x0c, 0xa3, 0x2b, 0x7a, 0xa2, 0x85, 0xfa, 0x50, 0xe1, 0x4f, 0x3b, 0x27, 0x8f, 0x89, 0x3c,
+	0x6e, 0xbd, 0xa5, 0x2e, 0x57, 0xe6, 0x97, 0xd4, 0x14, 0x21, 0x8e, 0x0e, 0xd4, 0x61, 0x36, 0xef,
+	0x82, 0x99, 0x64, 0x38, 0x2b, 0xbd, 0x6b, 0x07, 0xaf, 0xff, 0x20, 0xee, 0x35, 0x9e, 0x8a, 0x24,
+	0x7f, 0x7e, 0x0d, 0x26, 0x0b, 0x34, 0xee, 0xa4, 0xae, 0xc1, 0xbc, 0x9b, 0x95, 0x34, 0x4e, 0xc3,
+	0x77, 0xaf, 0x77, 0x03, 0xe6, 0xef, 0xe5, 0x85, 0x60, 0x0a, 0x2f, 0xee, 0x46, 0x28, 0x9e, 0x7b,
+	0xe0, 0x56, 0x6a, 0x2e, 0x0c, 0x5c, 0x30, 0x16, 0x1d, 0x5c, 0x70, 0x13, 0xbf, 0x1b, 0x05, 0xcc,
+	0xdc, 0x69, 0xf2, 0x33, 0xc1, 0x49, 0x0f, 0x2a, 0xca, 0xe0, 0x19, 0xe5, 0xc4, 0x9a, 0x0e, 0x4e,
+	0x66, 0x5e, 0xc8, 0x54, 0x2f, 0x89, 0xf6, 0xce, 0x42, 0x3c, 0x67, 0x0d, 0x9a, 0x05, 0x16, 0xff,
+	0x6d, 0x51, 0xaf, 0xb0, 0x67, 0x9a, 0xfc, 0x3d, 0x2d, 0xc2, 0x65, 0xee, 0xcc, 0xe2, 0x6e, 0x99,
+	0xd9, 0xd5, 0x98, 0x78, 0x3b, 0x02, 0xcc, 0x02, 0x09, 0xad, 0x67, 0xc0, 0x64, 0x59, 0x2f, 0x96,
+	0xd0, 0xfa, 0xa3, 0x78, 0x41, 0x05, 0xd0, 0xdc, 0xc8, 0x5a, 0x06, 0x69, 0xf2, 0xd1, 0xe2, 0xdf,
+	0x8e, 0x36, 0xab, 0xa2, 0x30, 0xb9, 0x9e, 0xb1, 0x45, 0x95, 0x2f, 0x93, 0xdb, 0xda, 0x9b, 0xf2,
+	0xd0, 0x9b, 0xd7, 0x5d, 0xb4, 0x42, 0x99, 0x3c, 0x0b, 0xd2, 0x8e, 0xbe, 0xbb, 0xc2, 0xb1, 0x44,
+	0xa9, 0x49, 0x9c, 0x4f, 0xf0, 0xbc, 0x73, 0x35, 0x59, 0x66, 0xd3, 0xb9, 0x70, 0x86, 0x24, 0x6a,
+	0x25, 0x8e, 0x0e, 0xc4, 0x86, 0xed, 0xcc, 0x8a, 0x5d, 0xe2, 0xc0, 0x41, 0x06, 0xdc, 0x21, 0xcb,
+	0x5d, 0x32, 0xe0, 0x0e, 0xd1, 0x63, 0x3d, 0xe0, 0x02, 0x33, 0xeb, 0xf7, 0x66, 0x9d, 0xa3, 0x9c,
+	0x1e, 0x67, 0xd1, 0x1d, 0x15, 0xcb, 0x8b, 0x53, 0x9d, 0x4b, 0xd9, 0x08, 0x5e, 0x1e, 0x84, 0x2b,
+	0xd8, 0x5f, 0xad, 0xb7, 0x2c, 0xcf, 0x5f, 0x6f, 0x79, 0xed, 0xe8, 0xfe, 0xc7, 0x06, 0x2c, 0xab,
+	0x50, 0x31, 0x13, 0xdc, 0x5f, 0x81, 0x32, 0xeb, 0x8c, 0xce, 0x28, 0xca, 0x38, 0x26, 0x50, 0x40,
+	0xa6, 0x87, 0x74, 0xd9, 0x54, 0x8a, 0x41, 0x05, 0xad, 0x18, 0xb4, 0x09, 0xa5, 0xa6, 0xf7, 0x99,
+	0xcb, 0x7a, 0x16, 0x59, 0x4f, 0xd4, 0xb6, 0x7e, 0x54, 0x02, 0x53, 0xda, 0x56, 0x74, 0xf7, 0x29,
+	0xba, 0xe9, 0x64, 0xa8, 0x37, 0x9d, 0xb2, 0x36, 0x6c, 0x71, 0x6e, 0xcd, 0x6b, 0xb9, 0x75, 0x57,
+	0x5f, 0x6a, 0x0e, 0xc3, 0x3f, 0xc8, 0x32, 0xe8, 0xe8, 0x4a, 0xd3, 0xe9, 0xcb, 0x9d, 0x75, 0x9b,
+	0xfe, 0xa7, 0xee, 0x2f, 0x43, 0x30, 0x13, 0x65, 0x5e, 0x59, 0xdb, 0xbc, 0x75, 0xea, 0xa7, 0x26,
+	0x85, 0xd4, 0xf0, 0x9d, 0x1a, 0x11, 0xb5, 0x55, 0x44, 0x56, 0x4e, 0x5f, 0xef, 0x4c, 0x0d, 0x1f,
+	0x71, 0x73, 0x3d, 0xc6, 0xd2, 0x6a, 0x58, 0x82, 0xb9, 0xc3, 0x92, 0x12, 0x38, 0x2b, 0xaf, 0x14,
+	0x38, 0x97, 0xcf, 0x11, 0x38, 0x13, 0x61, 0x7e, 0xe5, 0xdc, 0x61, 0x3e, 0x15, 0xc3, 0x56, 0x5f,
+	0x29, 0x86, 0xe9, 0xe1, 0xe5, 0xc2, 0x39, 0xc3, 0x4b, 0x6a, 0x17, 0x69, 0xbe, 0xc2, 0x2e, 0xf2,
+	0x75, 0x83, 0xcd, 0xe6, 0xa7, 0x70, 0x31, 0xd3, 0xd2, 0xce, 0x99, 0xb6, 0xb5, 0x9b, 0x01, 0xca,
+	0xf0, 0x77, 0xd8, 0x2f, 0x3d, 0x66, 0x60, 0x8c, 0x33, 0x23, 0x61, 0x1b, 0x2a, 0xea, 0x8f, 0x44,
+	0x5e, 0xe3, 0x82, 0xaf, 0xf5, 0xc3, 0x3c, 0x6c, 0x64, 0x5d, 0x02, 0x38, 0x65, 0xef, 0xba, 0x97,
+	0xfa, 0x49, 0x50, 0xed, 0xac, 0x2b, 0x05, 0xfa, 0x4f, 0x83, 0x52, 0x20, 0xf7, 0xcd, 0xfc, 0x40,
+	0xe8, 0x78, 0xf6, 0x0f, 0x84, 0x7e, 0xe5, 0xcc, 0x09, 0x66, 0xff, 0x7c, 0x47, 0xcc, 0x34, 0xe3,
+	0xe7, 0x42, 0xfd, 0xb3, 0x7f, 0x2e, 0x74, 0x5a, 0xe9, 0x4f, 0x59, 0x3e, 0xdd, 0xea, 0xe6, 0xff,
+	0x1d, 0xd1, 0xf9, 0x87, 0xb7, 0xfe, 0xd6, 0x00, 0xd8, 0xb1, 0x07, 0xc7, 0xd3, 0x09, 0xc3, 0xfc,
+	0x71, 0x02, 0x32, 0xb4, 0x04, 0xd4, 0xd6, 0x13, 0x10, 0x5f, 0xe3, 0x5f, 0x54, 0xc7, 0x8f, 0x07,
+	0x79, 0xcb, 0x3b, 0xba, 0x6f, 0x1b, 0x72, 0x3f, 0xd3, 0x0e, 0xc9, 0x38, 0xf3, 0xae, 0xab, 0x05,
+	0xcb, 0x8d, 0xa9, 0xef, 0x13, 0x37, 0x7c, 0xaa, 0xec, 0x2c, 0x34, 0x1a, 0xe5, 0x69, 0x92, 0x67,
+	0xf6, 0x74, 0x24, 0x78, 0x78, 0xf2, 0xd7, 0x68, 0xd4, 0xdc, 0xda, 0x6e, 0x48, 0x7c, 0xd7, 0x1e,
+	0x89, 0x8d, 0x5c, 0xd4, 0xb6, 0xfe, 0xc2, 0x50, 0xb7, 0x55, 0xe8, 0x63, 0x58, 0x6a, 0x78, 0x6e,
+	0x48, 0xd8, 0xd5, 0xd6, 0xf4, 0x71, 0x5c, 0xc4, 0x58, 0x13, 0x5c, 0x5c, 0x31, 0x52, 0x66, 0x13,
+	0xb3, 0xd3, 0xfb, 0xa8, 0xe3, 0x9c, 0xb5, 0xcf, 0x58, 0x1d, 0x8a, 0xa2, 0xb6, 0x7f, 0x1b, 0x60,
+	0x7f, 0x32, 0xb4, 0x43, 0x8e, 0x73, 0x2e, 0xc3, 0xba, 0x76, 0x87, 0x9a, 0x77, 0x99, 0x0b, 0xe8,
+	0x22, 0xac, 0xc9, 0x7b, 0xd3, 0x9d, 0x5e, 0x57, 0x90, 0x0d, 0xb4, 0x0e, 0x17, 0x68, 0xe4, 0x66,
+	0xd3, 0x11, 0xc4, 0x1c, 0x5a, 0x81, 0x72, 0xbf, 0xb7, 0x2b, 0x9a, 0xf9, 0xed, 0x1a, 0x94, 0xa3,
+	0xdf, 0x4e, 0xa2, 0x0b, 0x50, 0xe9, 0x7a, 0xfe, 0xd8, 0x1e, 0xb1, 0xa6, 0xb9, 0x80, 0x4c, 0x58,
+	0xa6, 0x98, 0xc8, 0x9b, 0x86, 0x9c, 0x62, 0x6c, 0xff, 0x4f, 0x0e, 0x20, 0xbe, 0xf1, 0x85, 0x56,
+	0x01, 0xfa, 0xbd, 0xdd, 0x83, 0xfd, 0xbd, 0x66, 0xbd, 0xdf, 0x32, 0x17, 0x10, 0x40, 0xb1, 0xbe,
+	0xb7, 0xd7, 0xea, 0x36, 0x4d, 0x03, 0x95, 0xa0, 0x80, 0x5b, 0xf5, 0xa6, 0x99, 0x43, 0xcb, 0x50,
+	0xea, 0xe3, 0xfd, 0x6e, 0x83, 0xf2, 0xe4, 0xe9, 0xa0, 0x0f, 0x5a, 0xfd, 0x83, 0x88, 0x52, 0x40,
+	0x15, 0x58, 0x6a, 0xec, 0x76, 0xbb, 0xad, 0x46, 0xdf, 0x5c, 0xa4, 0x43, 0x8a, 0xc6, 0x01, 0xde,
+	0x35, 0x8b, 0x68, 0x0d, 0x56, 0x3a, 0xbb, 0x0f, 0x0e, 0x1e, 0xb6, 0xea, 0xb8, 0xbf, 0xd3, 0xaa,
+	0xf7, 0xcd, 0x25, 0x3a, 0x42, 0xa3, 0xab, 0x50, 0x4a, 0x6c, 0xa2, 0x2a, 0xa5, 0x8c, 0x10, 0xac,
+	0x36, 0x1e, 0xb6, 0x1a, 0x8f, 0x0f, 0x1e, 0xd6, 0x1f, 0xb7, 0x5a, 0x7b, 0x2d, 0x6c, 0x02, 0x55,
+	0x20, 0x7d, 0x73, 0xa3, 0xb3, 0xdf, 0xeb, 0xb7, 0xf0, 0x41, 0xb3, 0xd5, 0xaf, 0xb7, 0x3b, 0x3d,
+	0xb3, 0x42, 0x99, 0x69, 0x47, 0xef, 0x61, 0x1d, 0x37, 0x0f, 0xda, 0xdd, 0xfb, 0xbb, 0xe6, 0x32,
+	0x1b, 0xa0, 0x7b, 0x50, 0xef, 0x74, 0x76, 0xe9, 0x2c, 0x0f, 0xda, 0x4d, 0x73, 0x85, 0x2a, 0x5a,
+	0x1d, 0xa0, 0xd7, 0xa7, 0xf3, 0x5f, 0x65, 0x8a, 0x66, 0x1a, 0x38, 0x68, 0x74, 0x0f, 0x3a, 0xf5,
+	0x9d, 0x56, 0xc7, 0xbc, 0x80, 0xaa, 0xb0, 0x11, 0x13, 0xbf, 0xb9, 0x8b, 0x1f, 0x0b, 0x76, 0x93,
+	0x8e, 0xbc, 0x57, 0xef, 0x37, 0x1e, 0xd2, 0x8e, 0x5e, 0x7f, 0x17, 0xb7, 0xcc, 0x35, 0x3a, 0x44,
+	0xb3, 0xd5, 0x69, 0x71, 0x6e, 0x4e, 0x44, 0x94, 0xb8, 0x87, 0x77, 0x7f, 0xe3, 0x37, 0x95, 0x0f,
+	0x5b, 0xdf, 0xee, 0x02, 0xc4, 0x17, 0xca, 0xa9, 0xb6, 0xe8, 0x1a, 0x73, 0x8a, 0xb9, 0x40, 0x55,
+	0x2d, 0xed, 0xdb, 0x34, 0xe8, 0x82, 0x32, 0x8b, 0x89, 0x56, 0x7f, 0x4d, 0xdc, 0xcd, 0xc7, 0xe4,
+	0x77, 0xc8, 0x20, 0x24, 0x43, 0x33, 0xbf, 0xbd, 0x0d, 0xe5, 0xe8, 0xde, 0x35, 0x15, 0xef, 0x91,
+	0x90, 0xb5, 0xcc, 0x05, 0x2a, 0xce, 0xf3, 0x2f, 0x27, 0x18, 0xdb, 0x3f, 0xcc, 0x01, 0x92, 0xe0,
+	0x4a, 0x31, 0x4c, 0x6a, 0x05, 0xce, 0xe0, 0x58, 0xb5, 0x47, 0xe5, 0x82, 0x6b, 0x64, 0x8f, 0xd4,
+	0x4c, 0x53, 0xe4, 0x1c, 0xba, 0x04, 0x48, 0xbd, 0x4f, 0x2b, 0x4d, 0x93, 0xbe, 0xfd, 0x01, 0x09,
+	0x23, 0x33, 0x2f, 0xa0, 0x2f, 0xa5, 0xb2, 0xb7, 0xe8, 0x5a, 0xa4, 0x2a, 0xed, 0x11, 0x6e, 0xa4,
+	0x82, 0x56, 0xa4, 0x0b, 0xa0, 0x97, 0xab, 0x44, 0xcf, 0x12, 0xba, 0x06, 0xef, 0xf4, 0x48, 0x98,
+	0x86, 0xaf, 0x82, 0xa1, 0x84, 0x36, 0xe1, 0x92, 0x60, 0x88, 0xf0, 0x8f, 0xe8, 0x2b, 0x53, 0x15,
+	0xf2, 0x67, 0xa1, 0x35, 0x13, 0xe8, 0x87, 0x49, 0x52, 0x74, 0xcc, 0x6e, 0x56, 0xa8, 0x51, 0xee,
+	0x51, 0x88, 0x20, 0x8a, 0xf8, 0xe6, 0x32, 0x95, 0xc5, 0x64, 0xec, 0xbd, 0x90, 0xd7, 0x33, 0xcc,
+	0x15, 0x3a, 0x4b, 0xfd, 0x74, 0x43, 0xbc, 0x68, 0x75, 0xfb, 0xbb, 0x06, 0xac, 0x68, 0xa0, 0x9d,
+	0xda, 0x83, 0x24, 0x88, 0xca, 0x90, 0xb9, 0x40, 0xb5, 0x22, 0x89, 0xda, 0x4d, 0x24, 0xd3, 0x40,
+	0x5f, 0x85, 0x2f, 0xa7, 0xba, 0x24, 0xee, 0xc1, 0x64, 0x40, 0x9c, 0x17, 0x64, 0x68, 0xe6, 0xd0,
+	0x3b, 0x70, 0x39, 0xc5, 0x76, 0xdf, 0x76, 0x46, 0xd4, 0x3c, 0xd4, 0x77, 0xe2, 0xa9, 0xeb, 0xd2,
+	0x81, 0x0b, 0xdb, 0x87, 0x59, 0xdb, 0x06, 0xfa, 0x29, 0x1a, 0x35, 0x9e, 0x63, 0xb2, 0x47, 0x8e,
+	0x64, 0xa4, 0x7a, 0x7a, 0xa1, 0x37, 0x99, 0xd0, 0x59, 0x6d, 0x7f, 0x3b, 0x07, 0x66, 0xf2, 0xee,
+	0x19, 0xb5, 0xb4, 0xfa, 0x70, 0x28, 0x12, 0xa1, 0xb9, 0x10, 0x2b, 0x54, 0x92, 0x0c, 0xaa, 0xf5,
+	0x5e, 0x68, 0xfb, 0xa1, 0xa4, 0xe4, 0xa8, 0x21, 0xd1, 0x61, 0x25, 0x21, 0x4f, 0x47, 0x79, 0xec,
+	0x8c, 0x46, 0xdf, 0xf2, 0xc6, 0x87, 0x0e, 0x35, 0xac, 0xcb, 0xb0, 0x5e, 0x1f, 0x0e, 0x93, 0x39,
+	0xda, 0x5c, 0xa4, 0x76, 0xc0, 0x87, 0x4f, 0xf5, 0x15, 0x99, 0x35, 0xd2, 0xf7, 0xa4, 0xba, 0x96,
+	0xe8, 0x78, 0xac, 0x4b, 0x9c, 0x71, 0xca, 0x8e, 0x12, 0x95, 0xd9, 0xf3, 0xbd, 0xb1, 0x17, 0x92,
+	0x44, 0x57, 0x99, 0x7a, 0x01, 0x9d, 0x64, 0x82, 0x0e, 0xdb, 0x4f, 0xb4, 0xfb, 0x64, 0x74, 0xea,
+	0x14, 0x62, 0x72, 0x8a, 0xb9, 0xc0, 0xc2, 0x79, 0x57, 0x36, 0x0d, 0xda, 0x6c, 0x44, 0xcd, 0x1c,
+	0xb3, 0x40, 0x86, 0xc1, 0x05, 0x25, 0xbf, 0xfd, 0x21, 0x54, 0x94, 0x92, 0x3b, 0x8d, 0xbc, 0x5d,
+	0x8f, 0x11, 0x78, 0xec, 0x60, 0x8f, 0xbb, 0xd3, 0xd0, 0x34, 0x68, 0x17, 0x6b, 0xb5, 0x5d, 0x33,
+	0xb7, 0xd3, 0xfe, 0xfc, 0x07, 0x57, 0x17, 0xbe, 0xf7, 0xc5, 0x55, 0xe3, 0xf3, 0x2f, 0xae, 0x1a,
+	0xff, 0xf2, 0xc5, 0xd5, 0x85, 0xbf, 0xfc, 0xd7, 0xab, 0xc6, 0xb7, 0x6e, 0x2b, 0xff, 0x50, 0x65,
+	0x6c, 0x87, 0xbe, 0xf3, 0xd2, 0xf3, 0x9d, 0x23, 0xc7, 0x95, 0x0d, 0x97, 0xdc, 0x9c, 0x1c, 0x1f,
+	0xdd, 0x9c, 0x1c, 0xde, 0x8c, 0x33, 0xdd, 0x61, 0x91, 0xfd, 0x37, 0x95, 0xdb, 0xff, 0x1b, 0x00,
+	0x00, 0xff, 0xff, 0x05, 0x2c, 0xb9, 0x1e, 0xac, 0x45, 0x00, 0x00,
 }
 
 func (m *CNStore) Marshal() (dAtA []byte, err error) {
@@ -5079,6 +5583,18 @@ func (m *CNStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	{
+		size, err := m.Load.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLogservice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
 	if len(m.CommitID) > 0 {
 		i -= len(m.CommitID)
 		copy(dAtA[i:], m.CommitID)
@@ -5228,6 +5744,25 @@ func (m *TNStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Locality) > 0 {
+		for k := range m.Locality {
+			v := m.Locality[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintLogservice(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLogservice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLogservice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.ShardServiceAddress) > 0 {
 		i -= len(m.ShardServiceAddress)
 		copy(dAtA[i:], m.ShardServiceAddress)
@@ -5333,6 +5868,25 @@ func (m *LogStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Locality) > 0 {
+		for k := range m.Locality {
+			v := m.Locality[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintLogservice(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLogservice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLogservice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.ConfigData != nil {
 		{
 			size, err := m.ConfigData.MarshalToSizedBuffer(dAtA[:i])
@@ -5410,6 +5964,23 @@ func (m *LogShardInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.NonVotingReplicas) > 0 {
+		for k := range m.NonVotingReplicas {
+			v := m.NonVotingReplicas[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintLogservice(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintLogservice(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintLogservice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.Term != 0 {
 		i = encodeVarintLogservice(dAtA, i, uint64(m.Term))
 		i--
@@ -5474,6 +6045,16 @@ func (m *LogReplicaInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.IsNonVoting {
+		i--
+		if m.IsNonVoting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.ReplicaID != 0 {
 		i = encodeVarintLogservice(dAtA, i, uint64(m.ReplicaID))
 		i--
@@ -5540,7 +6121,7 @@ func (m *Resource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CNStoreHeartbeat) Marshal() (dAtA []byte, err error) {
+func (m *CNLoad) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5550,12 +6131,12 @@ func (m *CNStoreHeartbeat) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CNStoreHeartbeat) MarshalTo(dAtA []byte) (int, error) {
+func (m *CNLoad) MarshalTo(dAtA []byte) (int, error) {
 	size := m.ProtoSize()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CNStoreHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CNLoad) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5564,24 +6145,73 @@ func (m *CNStoreHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.CommitID) > 0 {
-		i -= len(m.CommitID)
-		copy(dAtA[i:], m.CommitID)
-		i = encodeVarintLogservice(dAtA, i, uint64(len(m.CommitID)))
+	if m.ActiveQueries != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.ActiveQueries))
 		i--
-		dAtA[i] = 0x7a
+		dAtA[i] = 0x10
 	}
-	if len(m.ShardServiceAddress) > 0 {
-		i -= len(m.ShardServiceAddress)
-		copy(dAtA[i:], m.ShardServiceAddress)
-		i = encodeVarintLogservice(dAtA, i, uint64(len(m.ShardServiceAddress)))
+	if m.ActiveSessions != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.ActiveSessions))
 		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0x8
 	}
-	{
-		size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	return len(dAtA) - i, nil
+}
+
+func (m *CNStoreHeartbeat) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CNStoreHeartbeat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CNStoreHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	{
+		size, err := m.Load.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLogservice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.CommitID) > 0 {
+		i -= len(m.CommitID)
+		copy(dAtA[i:], m.CommitID)
+		i = encodeVarintLogservice(dAtA, i, uint64(len(m.CommitID)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.ShardServiceAddress) > 0 {
+		i -= len(m.ShardServiceAddress)
+		copy(dAtA[i:], m.ShardServiceAddress)
+		i = encodeVarintLogservice(dAtA, i, uint64(len(m.ShardServiceAddress)))
+		i--
+		dAtA[i] = 0x72
+	}
+	{
+		size, err := m.Resource.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
 		i -= size
 		i = encodeVarintLogservice(dAtA, i, uint64(size))
@@ -5740,6 +6370,25 @@ func (m *LogStoreHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Locality) > 0 {
+		for k := range m.Locality {
+			v := m.Locality[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintLogservice(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLogservice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLogservice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.ConfigData != nil {
 		{
 			size, err := m.ConfigData.MarshalToSizedBuffer(dAtA[:i])
@@ -5868,6 +6517,39 @@ func (m *TNStoreHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.StandbyShards) > 0 {
+		for iNdEx := len(m.StandbyShards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StandbyShards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLogservice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Locality) > 0 {
+		for k := range m.Locality {
+			v := m.Locality[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintLogservice(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLogservice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLogservice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ShardServiceAddress) > 0 {
 		i -= len(m.ShardServiceAddress)
 		copy(dAtA[i:], m.ShardServiceAddress)
@@ -7293,6 +7975,18 @@ func (m *CNStoreInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	{
+		size, err := m.Load.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLogservice(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
 	if len(m.CommitID) > 0 {
 		i -= len(m.CommitID)
 		copy(dAtA[i:], m.CommitID)
@@ -7510,6 +8204,39 @@ func (m *TNStoreInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.StandbyShards) > 0 {
+		for iNdEx := len(m.StandbyShards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StandbyShards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLogservice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.Locality) > 0 {
+		for k := range m.Locality {
+			v := m.Locality[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintLogservice(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLogservice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLogservice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ShardServiceAddress) > 0 {
 		i -= len(m.ShardServiceAddress)
 		copy(dAtA[i:], m.ShardServiceAddress)
@@ -7826,6 +8553,34 @@ func (m *ClusterDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ScaleRecommendations) > 0 {
+		for iNdEx := len(m.ScaleRecommendations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScaleRecommendations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLogservice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PlacementViolations) > 0 {
+		for iNdEx := len(m.PlacementViolations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlacementViolations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLogservice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.DeletedStores) > 0 {
 		for iNdEx := len(m.DeletedStores) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7899,6 +8654,152 @@ func (m *ClusterDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PlacementViolation) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlacementViolation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlacementViolation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Stores) > 0 {
+		for iNdEx := len(m.Stores) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Stores[iNdEx])
+			copy(dAtA[i:], m.Stores[iNdEx])
+			i = encodeVarintLogservice(dAtA, i, uint64(len(m.Stores[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintLogservice(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintLogservice(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CNScaleRecommendation) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CNScaleRecommendation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CNScaleRecommendation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Drain) > 0 {
+		for iNdEx := len(m.Drain) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Drain[iNdEx])
+			copy(dAtA[i:], m.Drain[iNdEx])
+			i = encodeVarintLogservice(dAtA, i, uint64(len(m.Drain[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintLogservice(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Count != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Action != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ActiveQueries != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.ActiveQueries))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ActiveSessions != 0 {
+		i = encodeVarintLogservice(dAtA, i, uint64(m.ActiveSessions))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MemoryUsage != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MemoryUsage))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.CPUUsage != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CPUUsage))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if len(m.CNs) > 0 {
+		for iNdEx := len(m.CNs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CNs[iNdEx])
+			copy(dAtA[i:], m.CNs[iNdEx])
+			i = encodeVarintLogservice(dAtA, i, uint64(len(m.CNs[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Labels) > 0 {
+		i -= len(m.Labels)
+		copy(dAtA[i:], m.Labels)
+		i = encodeVarintLogservice(dAtA, i, uint64(len(m.Labels)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ClusterInfo) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -8042,14 +8943,33 @@ func (m *LogStoreInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ConfigData != nil {
-		{
-			size, err := m.ConfigData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintLogservice(dAtA, i, uint64(size))
+	if len(m.Locality) > 0 {
+		for k := range m.Locality {
+			v := m.Locality[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintLogservice(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintLogservice(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintLogservice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ConfigData != nil {
+		{
+			size, err := m.ConfigData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLogservice(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0x3a
@@ -8628,6 +9548,28 @@ func (m *ShardInfoQueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.NonVotingReplicas) > 0 {
+		for k := range m.NonVotingReplicas {
+			v := m.NonVotingReplicas[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLogservice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintLogservice(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintLogservice(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.Term != 0 {
 		i = encodeVarintLogservice(dAtA, i, uint64(m.Term))
 		i--
@@ -8905,6 +9847,8 @@ func (m *CNStore) ProtoSize() (n int) {
 	if l > 0 {
 		n += 2 + l + sovLogservice(uint64(l))
 	}
+	l = m.Load.ProtoSize()
+	n += 2 + l + sovLogservice(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8957,6 +9901,14 @@ func (m *TNStore) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLogservice(uint64(l))
 	}
+	if len(m.Locality) > 0 {
+		for k, v := range m.Locality {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovLogservice(uint64(len(k))) + 1 + len(v) + sovLogservice(uint64(len(v)))
+			n += mapEntrySize + 1 + sovLogservice(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -8993,6 +9945,14 @@ func (m *LogStore) ProtoSize() (n int) {
 		l = m.ConfigData.ProtoSize()
 		n += 1 + l + sovLogservice(uint64(l))
 	}
+	if len(m.Locality) > 0 {
+		for k, v := range m.Locality {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovLogservice(uint64(len(k))) + 1 + len(v) + sovLogservice(uint64(len(v)))
+			n += mapEntrySize + 1 + sovLogservice(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9025,6 +9985,14 @@ func (m *LogShardInfo) ProtoSize() (n int) {
 	if m.Term != 0 {
 		n += 1 + sovLogservice(uint64(m.Term))
 	}
+	if len(m.NonVotingReplicas) > 0 {
+		for k, v := range m.NonVotingReplicas {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovLogservice(uint64(k)) + 1 + len(v) + sovLogservice(uint64(len(v)))
+			n += mapEntrySize + 1 + sovLogservice(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9042,6 +10010,9 @@ func (m *LogReplicaInfo) ProtoSize() (n int) {
 	if m.ReplicaID != 0 {
 		n += 1 + sovLogservice(uint64(m.ReplicaID))
 	}
+	if m.IsNonVoting {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9072,6 +10043,24 @@ func (m *Resource) ProtoSize() (n int) {
 	return n
 }
 
+func (m *CNLoad) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActiveSessions != 0 {
+		n += 1 + sovLogservice(uint64(m.ActiveSessions))
+	}
+	if m.ActiveQueries != 0 {
+		n += 1 + sovLogservice(uint64(m.ActiveQueries))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *CNStoreHeartbeat) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -9129,6 +10118,8 @@ func (m *CNStoreHeartbeat) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLogservice(uint64(l))
 	}
+	l = m.Load.ProtoSize()
+	n += 2 + l + sovLogservice(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9189,6 +10180,14 @@ func (m *LogStoreHeartbeat) ProtoSize() (n int) {
 		l = m.ConfigData.ProtoSize()
 		n += 1 + l + sovLogservice(uint64(l))
 	}
+	if len(m.Locality) > 0 {
+		for k, v := range m.Locality {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovLogservice(uint64(len(k))) + 1 + len(v) + sovLogservice(uint64(len(v)))
+			n += mapEntrySize + 1 + sovLogservice(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9256,6 +10255,20 @@ func (m *TNStoreHeartbeat) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLogservice(uint64(l))
 	}
+	if len(m.Locality) > 0 {
+		for k, v := range m.Locality {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovLogservice(uint64(len(k))) + 1 + len(v) + sovLogservice(uint64(len(v)))
+			n += mapEntrySize + 1 + sovLogservice(uint64(mapEntrySize))
+		}
+	}
+	if len(m.StandbyShards) > 0 {
+		for _, e := range m.StandbyShards {
+			l = e.ProtoSize()
+			n += 1 + l + sovLogservice(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9911,6 +10924,8 @@ func (m *CNStoreInfo) ProtoSize() (n int) {
 	if l > 0 {
 		n += 2 + l + sovLogservice(uint64(l))
 	}
+	l = m.Load.ProtoSize()
+	n += 2 + l + sovLogservice(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -9980,6 +10995,20 @@ func (m *TNStoreInfo) ProtoSize() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLogservice(uint64(l))
 	}
+	if len(m.Locality) > 0 {
+		for k, v := range m.Locality {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovLogservice(uint64(len(k))) + 1 + len(v) + sovLogservice(uint64(len(v)))
+			n += mapEntrySize + 1 + sovLogservice(uint64(mapEntrySize))
+		}
+	}
+	if len(m.StandbyShards) > 0 {
+		for _, e := range m.StandbyShards {
+			l = e.ProtoSize()
+			n += 1 + l + sovLogservice(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10115,6 +11144,97 @@ func (m *ClusterDetails) ProtoSize() (n int) {
 			n += 1 + l + sovLogservice(uint64(l))
 		}
 	}
+	if len(m.PlacementViolations) > 0 {
+		for _, e := range m.PlacementViolations {
+			l = e.ProtoSize()
+			n += 1 + l + sovLogservice(uint64(l))
+		}
+	}
+	if len(m.ScaleRecommendations) > 0 {
+		for _, e := range m.ScaleRecommendations {
+			l = e.ProtoSize()
+			n += 1 + l + sovLogservice(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PlacementViolation) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardID != 0 {
+		n += 1 + sovLogservice(uint64(m.ShardID))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovLogservice(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovLogservice(uint64(l))
+	}
+	if len(m.Stores) > 0 {
+		for _, s := range m.Stores {
+			l = len(s)
+			n += 1 + l + sovLogservice(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CNScaleRecommendation) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Labels)
+	if l > 0 {
+		n += 1 + l + sovLogservice(uint64(l))
+	}
+	if len(m.CNs) > 0 {
+		for _, s := range m.CNs {
+			l = len(s)
+			n += 1 + l + sovLogservice(uint64(l))
+		}
+	}
+	if m.CPUUsage != 0 {
+		n += 9
+	}
+	if m.MemoryUsage != 0 {
+		n += 9
+	}
+	if m.ActiveSessions != 0 {
+		n += 1 + sovLogservice(uint64(m.ActiveSessions))
+	}
+	if m.ActiveQueries != 0 {
+		n += 1 + sovLogservice(uint64(m.ActiveQueries))
+	}
+	if m.Action != 0 {
+		n += 1 + sovLogservice(uint64(m.Action))
+	}
+	if m.Count != 0 {
+		n += 1 + sovLogservice(uint64(m.Count))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovLogservice(uint64(l))
+	}
+	if len(m.Drain) > 0 {
+		for _, s := range m.Drain {
+			l = len(s)
+			n += 1 + l + sovLogservice(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10211,6 +11331,14 @@ func (m *LogStoreInfo) ProtoSize() (n int) {
 		l = m.ConfigData.ProtoSize()
 		n += 1 + l + sovLogservice(uint64(l))
 	}
+	if len(m.Locality) > 0 {
+		for k, v := range m.Locality {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovLogservice(uint64(len(k))) + 1 + len(v) + sovLogservice(uint64(len(v)))
+			n += mapEntrySize + 1 + sovLogservice(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10443,6 +11571,15 @@ func (m *ShardInfoQueryResult) ProtoSize() (n int) {
 	if m.Term != 0 {
 		n += 1 + sovLogservice(uint64(m.Term))
 	}
+	if len(m.NonVotingReplicas) > 0 {
+		for k, v := range m.NonVotingReplicas {
+			_ = k
+			_ = v
+			l = v.ProtoSize()
+			mapEntrySize := 1 + sovLogservice(uint64(k)) + 1 + l + sovLogservice(uint64(l))
+			n += mapEntrySize + 1 + sovLogservice(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11077,6 +12214,39 @@ func (m *CNStore) Unmarshal(dAtA []byte) error {
 			}
 			m.CommitID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Load.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
@@ -11428,37 +12598,164 @@ func (m *TNStore) Unmarshal(dAtA []byte) error {
 			}
 			m.ShardServiceAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLogservice(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locality", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthLogservice
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LogStore) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLogservice
+			if m.Locality == nil {
+				m.Locality = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogservice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLogservice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Locality[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogStore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogservice
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -11651,6 +12948,133 @@ func (m *LogStore) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locality", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Locality == nil {
+				m.Locality = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogservice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLogservice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Locality[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
@@ -11891,11 +13315,124 @@ func (m *LogShardInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLogservice(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonVotingReplicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NonVotingReplicas == nil {
+				m.NonVotingReplicas = make(map[uint64]string)
+			}
+			var mapkey uint64
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogservice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLogservice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NonVotingReplicas[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthLogservice
@@ -11994,6 +13531,26 @@ func (m *LogReplicaInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsNonVoting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsNonVoting = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
@@ -12135,6 +13692,95 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CNLoad) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CNLoad: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CNLoad: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSessions", wireType)
+			}
+			m.ActiveSessions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveSessions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveQueries", wireType)
+			}
+			m.ActiveQueries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveQueries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CNStoreHeartbeat) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -12580,6 +14226,39 @@ func (m *CNStoreHeartbeat) Unmarshal(dAtA []byte) error {
 			}
 			m.CommitID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Load.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
@@ -12951,20 +14630,147 @@ func (m *LogStoreHeartbeat) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLogservice(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLogservice
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locality", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Locality == nil {
+				m.Locality = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogservice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLogservice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Locality[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
 
@@ -13373,81 +15179,11 @@ func (m *TNStoreHeartbeat) Unmarshal(dAtA []byte) error {
 			}
 			m.ShardServiceAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipLogservice(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthLogservice
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RSMState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowLogservice
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RSMState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RSMState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tso", wireType)
-			}
-			m.Tso = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLogservice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Tso |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locality", wireType)
 			}
-			m.Index = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogservice
@@ -13457,51 +15193,282 @@ func (m *RSMState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseHolderID", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
 			}
-			m.LeaseHolderID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLogservice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LeaseHolderID |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TruncatedLsn", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TruncatedLsn = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLogservice
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TruncatedLsn |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Locality == nil {
+				m.Locality = make(map[string]string)
 			}
-		case 5:
-			if wireType != 2 {
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogservice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLogservice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Locality[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandbyShards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StandbyShards = append(m.StandbyShards, TNShardInfo{})
+			if err := m.StandbyShards[len(m.StandbyShards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RSMState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RSMState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RSMState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tso", wireType)
+			}
+			m.Tso = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tso |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseHolderID", wireType)
+			}
+			m.LeaseHolderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaseHolderID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TruncatedLsn", wireType)
+			}
+			m.TruncatedLsn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TruncatedLsn |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LeaseHistory", wireType)
 			}
 			var msglen int
@@ -17727,8 +19694,41 @@ func (m *CNStoreInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.CommitID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Load", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Load.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -18227,6 +20227,167 @@ func (m *TNStoreInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.ShardServiceAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locality", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Locality == nil {
+				m.Locality = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogservice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLogservice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Locality[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandbyShards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StandbyShards = append(m.StandbyShards, TNShardInfo{})
+			if err := m.StandbyShards[len(m.StandbyShards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
@@ -19083,23 +21244,536 @@ func (m *ClusterDetails) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthLogservice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyStores = append(m.ProxyStores, ProxyStore{})
+			if err := m.ProxyStores[len(m.ProxyStores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedStores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedStores = append(m.DeletedStores, DeletedStore{})
+			if err := m.DeletedStores[len(m.DeletedStores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacementViolations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlacementViolations = append(m.PlacementViolations, PlacementViolation{})
+			if err := m.PlacementViolations[len(m.PlacementViolations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleRecommendations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScaleRecommendations = append(m.ScaleRecommendations, CNScaleRecommendation{})
+			if err := m.ScaleRecommendations[len(m.ScaleRecommendations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlacementViolation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlacementViolation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlacementViolation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stores", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stores = append(m.Stores, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CNScaleRecommendation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CNScaleRecommendation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CNScaleRecommendation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CNs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CNs = append(m.CNs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUUsage", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CPUUsage = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryUsage", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MemoryUsage = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSessions", wireType)
+			}
+			m.ActiveSessions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveSessions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveQueries", wireType)
+			}
+			m.ActiveQueries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveQueries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= ScaleAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLogservice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProxyStores = append(m.ProxyStores, ProxyStore{})
-			if err := m.ProxyStores[len(m.ProxyStores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedStores", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Drain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLogservice
@@ -19109,25 +21783,23 @@ func (m *ClusterDetails) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLogservice
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLogservice
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletedStores = append(m.DeletedStores, DeletedStore{})
-			if err := m.DeletedStores[len(m.DeletedStores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Drain = append(m.Drain, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19744,6 +22416,133 @@ func (m *LogStoreInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locality", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Locality == nil {
+				m.Locality = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogservice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLogservice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Locality[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
@@ -21804,6 +24603,121 @@ func (m *ShardInfoQueryResult) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonVotingReplicas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NonVotingReplicas == nil {
+				m.NonVotingReplicas = make(map[uint64]ReplicaInfo)
+			}
+			var mapkey uint64
+			mapvalue := &ReplicaInfo{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLogservice
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLogservice
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ReplicaInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipLogservice(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthLogservice
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NonVotingReplicas[mapkey] = *mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLogservice(dAtA[iNdEx:])
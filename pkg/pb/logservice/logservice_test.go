@@ -623,3 +623,47 @@ func TestProxyStateUpdate(t *testing.T) {
 		ListenAddress: hb1.ListenAddress,
 	})
 }
+
+func TestLogStateUpdateNonVotingReplicas(t *testing.T) {
+	state := LogState{
+		Shards: map[uint64]LogShardInfo{},
+		Stores: map[string]LogStoreInfo{},
+	}
+	shard := LogShardInfo{
+		ShardID:  1,
+		Replicas: map[uint64]string{1: "log-a", 2: "log-b"},
+		Epoch:    3,
+		LeaderID: 1,
+		Term:     1,
+	}
+
+	// the follower doesn't report non-voting replicas
+	state.Update(LogStoreHeartbeat{
+		UUID:     "log-b",
+		Replicas: []LogReplicaInfo{{LogShardInfo: shard, ReplicaID: 2}},
+	}, 100)
+	assert.Empty(t, state.Shards[1].NonVotingReplicas)
+
+	leader := shard
+	leader.NonVotingReplicas = map[uint64]string{3: "log-c"}
+	state.Update(LogStoreHeartbeat{
+		UUID:     "log-a",
+		Replicas: []LogReplicaInfo{{LogShardInfo: leader, ReplicaID: 1}},
+	}, 100)
+	assert.Equal(t, map[uint64]string{3: "log-c"}, state.Shards[1].NonVotingReplicas)
+
+	// reported by the follower again, non-voting replicas are kept
+	state.Update(LogStoreHeartbeat{
+		UUID:     "log-b",
+		Replicas: []LogReplicaInfo{{LogShardInfo: shard, ReplicaID: 2}},
+	}, 200)
+	assert.Equal(t, map[uint64]string{3: "log-c"}, state.Shards[1].NonVotingReplicas)
+
+	// the non-voting replica itself
+	state.Update(LogStoreHeartbeat{
+		UUID:     "log-c",
+		Replicas: []LogReplicaInfo{{LogShardInfo: shard, ReplicaID: 3, IsNonVoting: true}},
+	}, 200)
+	assert.Equal(t, map[uint64]string{3: "log-c"}, state.Shards[1].NonVotingReplicas)
+	assert.True(t, state.Stores["log-c"].Replicas[0].IsNonVoting)
+}
@@ -311,7 +311,9 @@ type LogShard struct {
 	// LogShard extends LogShardRecord
 	LogShardRecord `protobuf:"bytes,1,opt,name=LogShardRecord,proto3,embedded=LogShardRecord" json:"LogShardRecord"`
 	// ReplicaID is the replica ID of the replica running on the LogStore.
-	ReplicaID            uint64   `protobuf:"varint,2,opt,name=ReplicaID,proto3" json:"ReplicaID,omitempty"`
+	ReplicaID uint64 `protobuf:"varint,2,opt,name=ReplicaID,proto3" json:"ReplicaID,omitempty"`
+	// NonVoting indicates whether the replica is a non-voting replica.
+	NonVoting            bool     `protobuf:"varint,3,opt,name=NonVoting,proto3" json:"NonVoting,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -357,6 +359,13 @@ func (m *LogShard) GetReplicaID() uint64 {
 	return 0
 }
 
+func (m *LogShard) GetNonVoting() bool {
+	if m != nil {
+		return m.NonVoting
+	}
+	return false
+}
+
 // TNStore TN store metadata
 type TNStore struct {
 	// UUID TNStore uuid id
@@ -831,55 +840,56 @@ func init() {
 func init() { proto.RegisterFile("metadata.proto", fileDescriptor_56d9f74966f40d04) }
 
 var fileDescriptor_56d9f74966f40d04 = []byte{
-	// 763 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xc1, 0x6e, 0xda, 0x4c,
-	0x10, 0x66, 0xb1, 0x63, 0xf0, 0x90, 0x1f, 0x39, 0x1b, 0xfd, 0xf9, 0xad, 0xe8, 0x17, 0x89, 0xdc,
-	0x1e, 0x28, 0x6a, 0x21, 0x49, 0xab, 0xaa, 0xaa, 0x54, 0xa9, 0x09, 0xb4, 0x69, 0x22, 0x64, 0x88,
-	0x31, 0x6d, 0xd3, 0x4b, 0x65, 0x60, 0xe3, 0x58, 0x80, 0x17, 0x19, 0x93, 0x86, 0x57, 0xe8, 0x53,
-	0xf4, 0x71, 0x72, 0xcc, 0xb5, 0x97, 0xa8, 0x4d, 0x8f, 0x7d, 0x89, 0xca, 0x6b, 0x1b, 0x1c, 0x03,
-	0x69, 0x2e, 0x39, 0xb1, 0x33, 0xdf, 0xcc, 0xb7, 0xf3, 0xcd, 0xcc, 0x62, 0xc8, 0xf6, 0x89, 0x6b,
-	0x74, 0x0c, 0xd7, 0x28, 0x0e, 0x1c, 0xea, 0x52, 0x9c, 0x0e, 0xed, 0xf5, 0x27, 0xa6, 0xe5, 0x9e,
-	0x8e, 0x5a, 0xc5, 0x36, 0xed, 0x97, 0x4c, 0x6a, 0xd2, 0x12, 0x0b, 0x68, 0x8d, 0x4e, 0x98, 0xc5,
-	0x0c, 0x76, 0xf2, 0x13, 0x95, 0x03, 0xf8, 0x47, 0x57, 0x1b, 0xa7, 0x86, 0xd3, 0xd1, 0x48, 0x9b,
-	0x3a, 0x1d, 0x2c, 0x43, 0x8a, 0x99, 0x07, 0x15, 0x19, 0x6d, 0xa2, 0x3c, 0xaf, 0x85, 0x26, 0xce,
-	0x01, 0x54, 0xa9, 0x19, 0x82, 0x49, 0x06, 0x46, 0x3c, 0xca, 0x57, 0x04, 0xa9, 0x80, 0x0b, 0xef,
-	0xc7, 0x68, 0x19, 0x57, 0x66, 0xe7, 0xbf, 0xe2, 0xa4, 0xee, 0x1b, 0xf0, 0x5e, 0xfa, 0xe2, 0x6a,
-	0x23, 0x71, 0x79, 0xb5, 0x81, 0xb4, 0x58, 0x39, 0xff, 0x83, 0xa8, 0x91, 0x41, 0xcf, 0x6a, 0x1b,
-	0x93, 0x3b, 0xa7, 0x0e, 0xaf, 0xd8, 0xdd, 0x4e, 0xc7, 0x21, 0xc3, 0xa1, 0xcc, 0x6d, 0xa2, 0xbc,
-	0xa8, 0x85, 0xa6, 0xf2, 0x1e, 0xb2, 0x61, 0x69, 0x7f, 0x15, 0x56, 0x00, 0x49, 0x1d, 0xf5, 0x5b,
-	0xc4, 0xa9, 0x9d, 0x04, 0xd4, 0xc3, 0xe0, 0xaa, 0x19, 0xbf, 0xe2, 0x42, 0x3a, 0xe4, 0xc5, 0x87,
-	0xf1, 0x3b, 0x02, 0x95, 0xf2, 0x54, 0xe5, 0x4d, 0x3c, 0x22, 0x33, 0x5e, 0xdd, 0xad, 0x3a, 0x15,
-	0x95, 0x75, 0xd6, 0xa5, 0x0e, 0xc1, 0x18, 0xf8, 0x66, 0x33, 0xd0, 0x20, 0x6a, 0xec, 0x8c, 0x4b,
-	0x20, 0x30, 0x2e, 0xaf, 0x6c, 0x2e, 0x9f, 0xd9, 0x59, 0x99, 0x69, 0xf3, 0x1e, 0xef, 0xdd, 0xac,
-	0x05, 0x61, 0x4a, 0xdd, 0x57, 0xb1, 0x90, 0x70, 0x2b, 0x46, 0x88, 0x67, 0x15, 0xc5, 0x18, 0xcb,
-	0x90, 0x2a, 0xdf, 0x52, 0xe1, 0x43, 0xe0, 0x35, 0xda, 0x23, 0x4c, 0x59, 0x76, 0x47, 0x9a, 0xd2,
-	0x95, 0x55, 0xcf, 0xaf, 0x31, 0x54, 0xf9, 0xcd, 0x81, 0x58, 0x56, 0x1b, 0xc4, 0x39, 0xb3, 0xda,
-	0xc4, 0x6b, 0x49, 0x70, 0x9c, 0x90, 0x4d, 0x1d, 0xb8, 0x08, 0xb8, 0x4a, 0xdb, 0xdd, 0xc0, 0x11,
-	0x6e, 0x41, 0x92, 0x85, 0xcd, 0x41, 0xf0, 0x73, 0x58, 0xab, 0x5b, 0x03, 0xd2, 0xb3, 0x6c, 0x12,
-	0xcb, 0xf1, 0x37, 0x67, 0x01, 0xea, 0x6d, 0x7d, 0xe3, 0xa8, 0x1a, 0xc6, 0xf2, 0x2c, 0x36, 0xe2,
-	0xc1, 0xaf, 0x40, 0xa8, 0x1a, 0x2d, 0xd2, 0x1b, 0xca, 0x02, 0x6b, 0xd5, 0x46, 0x54, 0x5b, 0xc0,
-	0x55, 0xf4, 0x23, 0xde, 0xd8, 0xae, 0x33, 0x0e, 0xfb, 0xe6, 0xbb, 0xf0, 0x36, 0x88, 0x1f, 0xa8,
-	0xd3, 0x6d, 0xb8, 0x86, 0x4b, 0xe4, 0x14, 0xeb, 0xce, 0xea, 0x94, 0x61, 0x02, 0x69, 0xd3, 0x28,
-	0xac, 0xc0, 0xf2, 0xd1, 0x88, 0x38, 0xe3, 0xb0, 0xa6, 0x34, 0xab, 0xe9, 0x86, 0x0f, 0x6f, 0xc1,
-	0x2a, 0x1b, 0x4c, 0x4c, 0xaa, 0xc8, 0x42, 0xe7, 0x41, 0x78, 0x1d, 0xd2, 0x65, 0xda, 0xef, 0x5b,
-	0xee, 0x41, 0x45, 0x06, 0x16, 0x36, 0xb1, 0xd7, 0x55, 0xc8, 0x44, 0x14, 0x60, 0x09, 0xb8, 0x2e,
-	0x19, 0x07, 0x23, 0xf1, 0x8e, 0xf8, 0x11, 0x2c, 0x9d, 0x19, 0xbd, 0x91, 0x3f, 0xdf, 0x4c, 0x54,
-	0x01, 0xcb, 0xab, 0x5a, 0x43, 0x57, 0xf3, 0x23, 0x5e, 0x26, 0x5f, 0xa0, 0x43, 0x3e, 0xbd, 0x24,
-	0x09, 0xca, 0x77, 0x0e, 0x44, 0xfd, 0x8e, 0xd3, 0x7e, 0x0c, 0x2b, 0xfa, 0xb9, 0x3d, 0x77, 0xd8,
-	0xb3, 0x00, 0x7e, 0x06, 0xff, 0x56, 0xa9, 0xa9, 0x1b, 0x56, 0x6f, 0xee, 0xa8, 0xe7, 0x83, 0x0b,
-	0x36, 0x8a, 0x5f, 0xb8, 0x51, 0xd3, 0x57, 0x27, 0xdc, 0xe9, 0xd5, 0x45, 0x56, 0x25, 0x15, 0x5f,
-	0x15, 0xfd, 0x0e, 0xab, 0x72, 0x2f, 0x73, 0xbf, 0xa7, 0xd9, 0x3e, 0x00, 0x71, 0x82, 0xe2, 0xb5,
-	0x89, 0x6e, 0xb4, 0xc9, 0xe5, 0xc5, 0x50, 0x50, 0x61, 0x17, 0x32, 0x41, 0x31, 0xfa, 0x78, 0x40,
-	0xb0, 0x00, 0xc9, 0xb2, 0x2a, 0x25, 0xbc, 0x5f, 0x5d, 0x95, 0x10, 0x4e, 0x01, 0x57, 0xad, 0xed,
-	0x4b, 0x49, 0x2c, 0xc2, 0x52, 0x5d, 0xab, 0x7d, 0x3c, 0x96, 0x38, 0x9c, 0x05, 0xa8, 0x1f, 0xeb,
-	0xef, 0x6a, 0xea, 0xe7, 0x66, 0xe5, 0xad, 0xc4, 0x17, 0x64, 0x10, 0xfc, 0x7f, 0x10, 0x96, 0x55,
-	0xf7, 0xb3, 0x77, 0xeb, 0x12, 0x2a, 0xbc, 0x8e, 0x3c, 0x2c, 0x9c, 0x81, 0x54, 0xd3, 0xee, 0xda,
-	0xf4, 0x8b, 0x2d, 0x25, 0x3c, 0xc3, 0x43, 0x2c, 0xdb, 0x94, 0x10, 0x5e, 0x86, 0x74, 0xc5, 0x31,
-	0x2c, 0xdb, 0xb3, 0x92, 0x1e, 0xc4, 0x2c, 0xd2, 0x91, 0xb8, 0xbd, 0xfd, 0xcb, 0x9f, 0x39, 0x74,
-	0x71, 0x9d, 0x43, 0x97, 0xd7, 0x39, 0xf4, 0xe3, 0x3a, 0x97, 0xf8, 0xf6, 0x2b, 0x87, 0x3e, 0x6d,
-	0x47, 0xbe, 0xaf, 0x7d, 0xc3, 0x75, 0xac, 0x73, 0xea, 0x58, 0xa6, 0x65, 0x87, 0x86, 0x4d, 0x4a,
-	0x83, 0xae, 0x59, 0x1a, 0xb4, 0x4a, 0x61, 0x9f, 0x5a, 0x02, 0xfb, 0xd4, 0x3e, 0xfd, 0x13, 0x00,
-	0x00, 0xff, 0xff, 0x16, 0xd1, 0x7e, 0x57, 0xb5, 0x07, 0x00, 0x00,
+	// 782 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x41, 0x6f, 0xda, 0x48,
+	0x14, 0xc6, 0xd8, 0x31, 0xf6, 0x23, 0x8b, 0x9c, 0x89, 0x36, 0x6b, 0x45, 0x11, 0x89, 0xbc, 0x7b,
+	0x60, 0xd1, 0x2e, 0x24, 0xd9, 0x55, 0x55, 0x55, 0xaa, 0xd4, 0x04, 0xda, 0x34, 0x11, 0x32, 0xc4,
+	0x98, 0xb4, 0xe9, 0xa5, 0x32, 0x30, 0x71, 0x2c, 0xc0, 0x83, 0x8c, 0x49, 0xc3, 0x5f, 0xe8, 0xb1,
+	0xbf, 0xa0, 0x3f, 0x27, 0xc7, 0x5c, 0x7b, 0x89, 0xda, 0xf4, 0xd8, 0x3f, 0x51, 0x79, 0x6c, 0x63,
+	0xc7, 0x40, 0x9a, 0x4b, 0x4e, 0xcc, 0xf7, 0xbe, 0x37, 0xdf, 0xbc, 0x6f, 0xde, 0x1b, 0x0c, 0xb9,
+	0x01, 0x76, 0x8d, 0xae, 0xe1, 0x1a, 0xa5, 0xa1, 0x43, 0x5c, 0x82, 0x84, 0x10, 0xaf, 0xff, 0x6b,
+	0x5a, 0xee, 0xf9, 0xb8, 0x5d, 0xea, 0x90, 0x41, 0xd9, 0x24, 0x26, 0x29, 0xd3, 0x84, 0xf6, 0xf8,
+	0x8c, 0x22, 0x0a, 0xe8, 0xca, 0xdf, 0xa8, 0x1c, 0xc2, 0x6f, 0xba, 0xda, 0x3c, 0x37, 0x9c, 0xae,
+	0x86, 0x3b, 0xc4, 0xe9, 0x22, 0x19, 0x32, 0x14, 0x1e, 0x56, 0x65, 0x66, 0x8b, 0x29, 0x70, 0x5a,
+	0x08, 0x51, 0x1e, 0xa0, 0x46, 0xcc, 0x90, 0x4c, 0x53, 0x32, 0x16, 0x51, 0x3e, 0x32, 0x90, 0x09,
+	0xb4, 0xd0, 0x41, 0x42, 0x96, 0x6a, 0x65, 0x77, 0xff, 0x28, 0x4d, 0xeb, 0xbe, 0x43, 0xef, 0x0b,
+	0x57, 0x37, 0x9b, 0xa9, 0xeb, 0x9b, 0x4d, 0x46, 0x4b, 0x94, 0xb3, 0x01, 0xa2, 0x86, 0x87, 0x7d,
+	0xab, 0x63, 0x4c, 0xcf, 0x8c, 0x02, 0x5e, 0xb1, 0x7b, 0xdd, 0xae, 0x83, 0x47, 0x23, 0x99, 0xdd,
+	0x62, 0x0a, 0xa2, 0x16, 0x42, 0xe5, 0x04, 0x72, 0x61, 0x69, 0xbf, 0x34, 0x56, 0x04, 0x49, 0x1d,
+	0x0f, 0xda, 0xd8, 0xa9, 0x9f, 0x05, 0xd2, 0xa3, 0xe0, 0xa8, 0x99, 0xb8, 0xf2, 0x89, 0x01, 0x21,
+	0x14, 0x46, 0x47, 0xc9, 0x43, 0x02, 0x9b, 0x72, 0x64, 0xf3, 0x2e, 0x1f, 0xf3, 0x99, 0x2c, 0xef,
+	0x7e, 0xa3, 0x1b, 0x20, 0xaa, 0xc4, 0x3e, 0x21, 0xae, 0x65, 0x9b, 0xd4, 0xaa, 0xa0, 0x45, 0x01,
+	0x45, 0xa5, 0x17, 0xef, 0x12, 0x07, 0x23, 0x04, 0x5c, 0xab, 0x15, 0x58, 0x14, 0x35, 0xba, 0x46,
+	0x65, 0xe0, 0xe9, 0x49, 0x9e, 0x2b, 0xb6, 0x90, 0xdd, 0x5d, 0x99, 0xe9, 0xc2, 0x3e, 0xe7, 0xd5,
+	0xa5, 0x05, 0x69, 0x4a, 0xc3, 0xf7, 0xb8, 0x50, 0x70, 0x3b, 0x21, 0x88, 0x66, 0xfd, 0x26, 0x14,
+	0x2b, 0x90, 0xa9, 0xdc, 0x53, 0xe1, 0x5f, 0xc0, 0x69, 0xa4, 0x8f, 0xa9, 0xef, 0xdc, 0xae, 0x14,
+	0xc9, 0x55, 0x54, 0x2f, 0xae, 0x51, 0x56, 0xf9, 0xc1, 0x82, 0x58, 0x51, 0x9b, 0xd8, 0xb9, 0xb0,
+	0x3a, 0xd8, 0xbb, 0x92, 0x60, 0x39, 0x15, 0x8b, 0x02, 0xa8, 0x04, 0xa8, 0x46, 0x3a, 0xbd, 0x20,
+	0x10, 0x0e, 0x49, 0x9a, 0xa6, 0xcd, 0x61, 0xd0, 0x13, 0x58, 0x6b, 0x58, 0x43, 0xdc, 0xb7, 0x6c,
+	0x9c, 0xd8, 0xe3, 0x0f, 0xd6, 0x02, 0xd6, 0x7b, 0x14, 0xcd, 0xe3, 0x5a, 0x98, 0xcb, 0xd1, 0xdc,
+	0x58, 0x04, 0x3d, 0x07, 0xbe, 0x66, 0xb4, 0x71, 0x7f, 0x24, 0xf3, 0xf4, 0xaa, 0x36, 0xe3, 0xde,
+	0x02, 0xad, 0x92, 0x9f, 0xf1, 0xd2, 0x76, 0x9d, 0x49, 0x78, 0x6f, 0x7e, 0x08, 0xed, 0x80, 0xf8,
+	0x86, 0x38, 0xbd, 0xa6, 0x6b, 0xb8, 0x58, 0xce, 0xd0, 0xdb, 0x59, 0x8d, 0x14, 0xa6, 0x94, 0x16,
+	0x65, 0x21, 0x05, 0x96, 0x8f, 0xc7, 0xd8, 0x99, 0x84, 0x35, 0x09, 0xb4, 0xa6, 0x3b, 0x31, 0xb4,
+	0x0d, 0xab, 0xb4, 0x31, 0x09, 0xab, 0x22, 0x4d, 0x9d, 0x47, 0xa1, 0x75, 0x10, 0x2a, 0x64, 0x30,
+	0xb0, 0xdc, 0xc3, 0xaa, 0x0c, 0x34, 0x6d, 0x8a, 0xd7, 0x55, 0xc8, 0xc6, 0x1c, 0x20, 0x09, 0xd8,
+	0x1e, 0x9e, 0x04, 0x2d, 0xf1, 0x96, 0xe8, 0x6f, 0x58, 0xba, 0x30, 0xfa, 0x63, 0xbf, 0xbf, 0xd9,
+	0xb8, 0x03, 0xba, 0xaf, 0x66, 0x8d, 0x5c, 0xcd, 0xcf, 0x78, 0x96, 0x7e, 0xca, 0x1c, 0x71, 0xc2,
+	0x92, 0xc4, 0x2b, 0x5f, 0x58, 0x10, 0xf5, 0x07, 0x76, 0xfb, 0x1f, 0x58, 0xd1, 0x2f, 0xed, 0xb9,
+	0xcd, 0x9e, 0x25, 0xd0, 0xff, 0xf0, 0x7b, 0x8d, 0x98, 0xba, 0x61, 0xf5, 0xe7, 0xb6, 0x7a, 0x3e,
+	0xb9, 0x60, 0xa2, 0xb8, 0x85, 0x13, 0x15, 0xbd, 0x3a, 0xfe, 0x41, 0xaf, 0x2e, 0x36, 0x2a, 0x99,
+	0xe4, 0xa8, 0xe8, 0x0f, 0x18, 0x95, 0x47, 0xe9, 0xfb, 0x23, 0xf5, 0xf6, 0x4f, 0x10, 0xa7, 0x2c,
+	0x5a, 0x9b, 0xfa, 0x66, 0xb6, 0xd8, 0x82, 0x18, 0x1a, 0x2a, 0xee, 0x41, 0x36, 0x28, 0x46, 0x9f,
+	0x0c, 0x31, 0xe2, 0x21, 0x5d, 0x51, 0xa5, 0x94, 0xf7, 0xab, 0xab, 0x12, 0x83, 0x32, 0xc0, 0xd6,
+	0xea, 0x07, 0x52, 0x1a, 0x89, 0xb0, 0xd4, 0xd0, 0xea, 0x6f, 0x4f, 0x25, 0x16, 0xe5, 0x00, 0x1a,
+	0xa7, 0xfa, 0xeb, 0xba, 0xfa, 0xbe, 0x55, 0x7d, 0x25, 0x71, 0x45, 0x19, 0x78, 0xff, 0x1f, 0x84,
+	0xee, 0x6a, 0xf8, 0xbb, 0xf7, 0x1a, 0x12, 0x53, 0x7c, 0x11, 0x7b, 0x58, 0x28, 0x0b, 0x99, 0x96,
+	0xdd, 0xb3, 0xc9, 0x07, 0x5b, 0x4a, 0x79, 0xc0, 0x63, 0x2c, 0xdb, 0x94, 0x18, 0xb4, 0x0c, 0x42,
+	0xd5, 0x31, 0x2c, 0xdb, 0x43, 0x69, 0x8f, 0xa2, 0x08, 0x77, 0x25, 0x76, 0xff, 0xe0, 0xfa, 0x5b,
+	0x9e, 0xb9, 0xba, 0xcd, 0x33, 0xd7, 0xb7, 0x79, 0xe6, 0xeb, 0x6d, 0x3e, 0xf5, 0xf9, 0x7b, 0x9e,
+	0x79, 0xb7, 0x13, 0xfb, 0xfc, 0x0e, 0x0c, 0xd7, 0xb1, 0x2e, 0x89, 0x63, 0x99, 0x96, 0x1d, 0x02,
+	0x1b, 0x97, 0x87, 0x3d, 0xb3, 0x3c, 0x6c, 0x97, 0xc3, 0x7b, 0x6a, 0xf3, 0xf4, 0x4b, 0xfc, 0xdf,
+	0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x47, 0x4b, 0x7b, 0x3e, 0xd4, 0x07, 0x00, 0x00,
 }
 
 func (m *TNShardRecord) Marshal() (dAtA []byte, err error) {
@@ -1029,6 +1039,16 @@ func (m *LogShard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.NonVoting {
+		i--
+		if m.NonVoting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.ReplicaID != 0 {
 		i = encodeVarintMetadata(dAtA, i, uint64(m.ReplicaID))
 		i--
@@ -1509,6 +1529,9 @@ func (m *LogShard) ProtoSize() (n int) {
 	if m.ReplicaID != 0 {
 		n += 1 + sovMetadata(uint64(m.ReplicaID))
 	}
+	if m.NonVoting {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2099,6 +2122,26 @@ func (m *LogShard) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonVoting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonVoting = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
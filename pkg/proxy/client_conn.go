@@ -208,7 +208,11 @@ func newClientConn(
 	}
 	c.log = logger.With(zap.Uint32("ConnID", c.connID))
 	fp := config.FrontendParameters{
-		EnableTls: cfg.TLSEnabled,
+		EnableTls:            cfg.TLSEnabled,
+		EnableCompression:    cfg.CompressionEnabled,
+		ZlibCompressionLevel: cfg.ZlibCompressionLevel,
+
+		DefaultAuthenticationPlugin: cfg.DefaultAuthenticationPlugin,
 	}
 	fp.SetDefaultValues()
 	pu := config.NewParameterUnit(&fp, nil, nil, nil)
@@ -297,6 +301,17 @@ func (c *clientConn) BuildConnWithServer(prevAddr string) (ServerConn, error) {
 		c.log.Error("failed to connect to backend", zap.Error(err))
 		return nil, err
 	}
+	if prevAddr == "" {
+		// Step 4, the packets after the OK packet are compressed if the
+		// client asks for it.
+		if err := c.upgradeToCompression(); err != nil {
+			c.log.Error("failed to upgrade to compression", zap.Error(err))
+			if closeErr := conn.Close(); closeErr != nil {
+				c.log.Error("failed to close server connection", zap.Error(closeErr))
+			}
+			return nil, err
+		}
+	}
 	return conn, nil
 }
 
@@ -307,6 +322,10 @@ func (c *clientConn) HandleEvent(ctx context.Context, e IEvent, resp chan<- []by
 		return c.handleKillQuery(ev, resp)
 	case *setVarEvent:
 		return c.handleSetVar(ev)
+	case *resetConnectionEvent:
+		return c.handleResetConnection(ev)
+	case *changeUserEvent:
+		return c.handleChangeUser(ev)
 	default:
 	}
 	return nil
@@ -390,6 +409,41 @@ func (c *clientConn) handleSetVar(e *setVarEvent) error {
 	return nil
 }
 
+// handleResetConnection handles the reset connection event. The session
+// variables are reset on the server, so they should not be set again when
+// the connection is transferred.
+func (c *clientConn) handleResetConnection(_ *resetConnectionEvent) error {
+	c.migration.setVarStmts = nil
+	return nil
+}
+
+// handleChangeUser handles the change user event. The session is reset and
+// the new user logs in with the handshake packet when the connection is
+// transferred. The server closes the connection if the user fails to log
+// in, so there is no need to wait for the response.
+func (c *clientConn) handleChangeUser(e *changeUserEvent) error {
+	pack, username, err := changeUserHandshakePack(c.handshakePack, e.data)
+	if err != nil {
+		return err
+	}
+	ci := clientInfo{
+		originIP:   c.clientInfo.originIP,
+		originPort: c.clientInfo.originPort,
+	}
+	if err := ci.parse(username); err != nil {
+		return err
+	}
+	ci.labelInfo = newLabelInfo(ci.Tenant, ci.Labels)
+	c.log.Info("client changes user",
+		zap.String("tenant", string(ci.Tenant)),
+		zap.String("username", ci.username))
+	c.handshakePack = pack
+	c.clientInfo = ci
+	c.mysqlProto.SetUserName(username)
+	c.migration.setVarStmts = nil
+	return nil
+}
+
 // Close implements the ClientConn interface.
 func (c *clientConn) Close() error {
 	return c.queryClient.Close()
@@ -467,6 +521,18 @@ func (c *clientConn) connectToBackend(prevAdd string) (ServerConn, error) {
 		}
 
 		if prevAdd == "" {
+			// The CN server asks for more authentication data, which is
+			// relayed between the client and the CN server.
+			if isAuthMoreDataPacket(r) {
+				if r, err = c.relayAuth(sc, r); err != nil {
+					c.log.Error("failed to relay authentication data", zap.Error(err))
+					v2.ProxyConnectCommonFailCounter.Inc()
+					if closeErr := sc.Close(); closeErr != nil {
+						c.log.Error("failed to close server connection", zap.Error(closeErr))
+					}
+					return nil, err
+				}
+			}
 			// r is the packet received from CN server, send r to client.
 			if err := c.mysqlProto.WritePacket(r[4:]); err != nil {
 				c.log.Error("failed to write packet to client", zap.Error(err))
@@ -478,6 +544,16 @@ func (c *clientConn) connectToBackend(prevAdd string) (ServerConn, error) {
 				return nil, err
 			}
 		} else {
+			// The full authentication of caching_sha2_password needs the password
+			// from the client, which is not available when the connection is
+			// transferred, so the user must have been cached in the CN server.
+			if isAuthMoreDataPacket(r) {
+				c.log.Error("CN server asks for full authentication when transferring connection",
+					zap.Uint32("conn ID", c.connID),
+					zap.String("current uuid", cn.uuid),
+				)
+				break
+			}
 			// The connection has been transferred to a new server, but migration fails,
 			// but we don't return error, which will cause unknown issue.
 			if err := c.migrateConn(prevAdd, sc); err != nil {
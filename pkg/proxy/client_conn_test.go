@@ -15,6 +15,7 @@
 package proxy
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"net"
@@ -526,3 +527,94 @@ func TestClientConn_SendErrToClient(t *testing.T) {
 	cc.SendErrToClient(moerr.NewInternalErrorNoCtx("msg1"))
 	wg.Wait()
 }
+
+func TestBackendHandshakePack(t *testing.T) {
+	pack := &frontend.Packet{Payload: makeClientHandshakeResp()[4:]}
+	require.Equal(t, pack, backendHandshakePack(pack))
+
+	capabilities := binary.LittleEndian.Uint32(pack.Payload)
+	capabilities |= frontend.CLIENT_COMPRESS | frontend.CLIENT_ZSTD_COMPRESSION_ALGORITHM
+	payload := append([]byte(nil), pack.Payload...)
+	binary.LittleEndian.PutUint32(payload, capabilities)
+	// the zstd compression level
+	payload = append(payload, 3)
+
+	p := backendHandshakePack(&frontend.Packet{Payload: payload})
+	require.Equal(t, pack.Payload, p.Payload)
+	require.Equal(t, int32(len(pack.Payload)), p.Length)
+}
+
+func makeChangeUserData(username, db string) []byte {
+	data := append([]byte(username), 0)
+	data = append(data, 20)
+	data = append(data, make([]byte, 20)...)
+	data = append(data, db...)
+	data = append(data, 0)
+	data = append(data, 45, 0)
+	data = append(data, "mysql_native_password"...)
+	return append(data, 0)
+}
+
+func TestChangeUserHandshakePack(t *testing.T) {
+	pack := &frontend.Packet{Payload: makeClientHandshakeResp()[4:]}
+
+	// the same user makes the same packet.
+	p, username, err := changeUserHandshakePack(pack, makeChangeUserData("tenant1:user1", "db1"))
+	require.NoError(t, err)
+	require.Equal(t, "tenant1:user1", username)
+	require.Equal(t, pack.Payload, p.Payload)
+	require.Equal(t, int32(len(pack.Payload)), p.Length)
+
+	p, username, err = changeUserHandshakePack(pack, makeChangeUserData("tenant2:user2", ""))
+	require.NoError(t, err)
+	require.Equal(t, "tenant2:user2", username)
+	require.True(t, bytes.Contains(p.Payload, []byte("tenant2:user2\x00")))
+	require.False(t, bytes.Contains(p.Payload, []byte("db1")))
+
+	_, _, err = changeUserHandshakePack(pack, []byte("user"))
+	require.Error(t, err)
+	_, _, err = changeUserHandshakePack(pack, []byte{'u', 0, 20, 0})
+	require.Error(t, err)
+}
+
+func TestSwitchAuthHandshakePack(t *testing.T) {
+	pack := &frontend.Packet{Payload: makeClientHandshakeResp()[4:]}
+
+	// the authentication method is not switched.
+	p, err := switchAuthHandshakePack(pack, frontend.AuthNativePassword, make([]byte, 20))
+	require.NoError(t, err)
+	require.Equal(t, pack, p)
+
+	auth := bytes.Repeat([]byte{1}, 32)
+	p, err = switchAuthHandshakePack(pack, frontend.AuthCachingSha2Password, auth)
+	require.NoError(t, err)
+	require.Equal(t, int32(len(p.Payload)), p.Length)
+	require.True(t, bytes.Contains(p.Payload, append([]byte("tenant1:user1\x00\x20"), auth...)))
+	require.True(t, bytes.HasSuffix(p.Payload, []byte("db1\x00caching_sha2_password\x00")))
+
+	_, err = switchAuthHandshakePack(&frontend.Packet{Payload: pack.Payload[:40]}, frontend.AuthCachingSha2Password, auth)
+	require.Error(t, err)
+}
+
+func TestClientConn_HandleChangeUser(t *testing.T) {
+	cc, cleanup := createNewClientConn(t)
+	defer cleanup()
+	c, ok := cc.(*clientConn)
+	require.True(t, ok)
+	c.handshakePack = &frontend.Packet{Payload: makeClientHandshakeResp()[4:]}
+	c.clientInfo.Tenant = "tenant1"
+	c.migration.setVarStmts = []string{"set @a=1"}
+
+	require.NoError(t, c.HandleEvent(context.Background(), makeResetConnectionEvent(), nil))
+	require.Empty(t, c.migration.setVarStmts)
+
+	c.migration.setVarStmts = []string{"set @a=1"}
+	e := makeChangeUserEvent(makeChangeUserData("tenant2:user2?k1=v1", "db2"))
+	require.NoError(t, c.HandleEvent(context.Background(), e, nil))
+	require.Empty(t, c.migration.setVarStmts)
+	require.Equal(t, Tenant("tenant2"), c.GetTenant())
+	require.Equal(t, "user2", c.clientInfo.username)
+	require.Equal(t, map[string]string{"k1": "v1"}, c.clientInfo.Labels)
+	require.Equal(t, "tenant2:user2?k1=v1", c.mysqlProto.GetUserName())
+	require.True(t, bytes.Contains(c.GetHandshakePack().Payload, []byte("db2\x00")))
+}
@@ -102,6 +102,17 @@ type Config struct {
 	// TLSKeyFile is the file path of file that contains X509 key in PEM
 	// format for client.
 	TLSKeyFile string `toml:"tls-key-file" user_setting:"advanced"`
+	// Default is false. With true, proxy will support the compressed
+	// protocol with clients. The packets between proxy and CN servers are
+	// not compressed.
+	CompressionEnabled bool `toml:"compression-enabled" user_setting:"advanced"`
+	// ZlibCompressionLevel is the level of zlib compression, default is 6.
+	// The level of zstd compression is sent by the client.
+	ZlibCompressionLevel int `toml:"zlib-compression-level" user_setting:"advanced"`
+	// DefaultAuthenticationPlugin is the authentication method advertised
+	// to clients, mysql_native_password or caching_sha2_password. Default
+	// is mysql_native_password.
+	DefaultAuthenticationPlugin string `toml:"default-authentication-plugin" user_setting:"advanced"`
 	// InternalCIDRs is the config which indicates that the CIDR list of
 	// internal network. The addresses outside the range are external
 	// addresses.
@@ -32,6 +32,10 @@ func (t eventType) String() string {
 		return "KillQuery"
 	case TypeSetVar:
 		return "SetVar"
+	case TypeResetConnection:
+		return "ResetConnection"
+	case TypeChangeUser:
+		return "ChangeUser"
 	}
 	return "Unknown"
 }
@@ -43,6 +47,10 @@ const (
 	TypeKillQuery eventType = 1
 	// TypeSetVar indicates the set variable statement.
 	TypeSetVar eventType = 2
+	// TypeResetConnection indicates the COM_RESET_CONNECTION command.
+	TypeResetConnection eventType = 3
+	// TypeChangeUser indicates the COM_CHANGE_USER command.
+	TypeChangeUser eventType = 4
 )
 
 // IEvent is the event interface.
@@ -99,6 +107,13 @@ func makeEvent(msg []byte, b *msgBuf) (IEvent, bool) {
 			return nil, false
 		}
 	}
+	// The commands below should be sent to dst, so return false.
+	if isCmdResetConnection(msg) {
+		return makeResetConnectionEvent(), false
+	}
+	if isCmdChangeUser(msg) {
+		return makeChangeUserEvent(msg[preRecvLen:]), false
+	}
 	return nil, false
 }
 
@@ -150,3 +165,46 @@ func makeSetVarEvent(stmt string) IEvent {
 func (e *setVarEvent) eventType() eventType {
 	return TypeSetVar
 }
+
+// resetConnectionEvent is the event that COM_RESET_CONNECTION is captured.
+// The session is reset on the server, so the variables kept in clientConn
+// are not needed anymore.
+type resetConnectionEvent struct {
+	baseEvent
+}
+
+// makeResetConnectionEvent creates an event with TypeResetConnection type.
+func makeResetConnectionEvent() IEvent {
+	e := &resetConnectionEvent{}
+	e.typ = TypeResetConnection
+	return e
+}
+
+// eventType implements the IEvent interface.
+func (e *resetConnectionEvent) eventType() eventType {
+	return TypeResetConnection
+}
+
+// changeUserEvent is the event that COM_CHANGE_USER is captured. The session
+// is reset on the server and the connection belongs to the new user, so the
+// login information kept in clientConn needs to be updated.
+type changeUserEvent struct {
+	baseEvent
+	// data is the payload of the command without the command byte.
+	data []byte
+}
+
+// makeChangeUserEvent creates an event with TypeChangeUser type.
+func makeChangeUserEvent(data []byte) IEvent {
+	e := &changeUserEvent{
+		// The message buffer is reused, so copy the data.
+		data: append([]byte(nil), data...),
+	}
+	e.typ = TypeChangeUser
+	return e
+}
+
+// eventType implements the IEvent interface.
+func (e *changeUserEvent) eventType() eventType {
+	return TypeChangeUser
+}
@@ -102,6 +102,26 @@ func TestMakeEvent(t *testing.T) {
 			require.False(t, r)
 		}
 	})
+
+	t.Run("reset connection", func(t *testing.T) {
+		e, r = makeEvent([]byte{1, 0, 0, 0, byte(cmdResetConnection)}, nil)
+		require.NotNil(t, e)
+		require.Equal(t, TypeResetConnection, e.eventType())
+		require.False(t, r)
+	})
+
+	t.Run("change user", func(t *testing.T) {
+		msg := []byte{6, 0, 0, 0, byte(cmdChangeUser), 'u', 's', 'e', 'r', 0}
+		e, r = makeEvent(msg, nil)
+		require.NotNil(t, e)
+		require.False(t, r)
+		ev, ok := e.(*changeUserEvent)
+		require.True(t, ok)
+		require.Equal(t, []byte("user\x00"), ev.data)
+		// the data is not changed with the message buffer.
+		msg[5] = 'U'
+		require.Equal(t, []byte("user\x00"), ev.data)
+	})
 }
 
 func TestKillQueryEvent(t *testing.T) {
@@ -431,4 +451,10 @@ func TestEventType_String(t *testing.T) {
 
 	e3 := setVarEvent{}
 	require.Equal(t, "SetVar", e3.eventType().String())
+
+	e4 := resetConnectionEvent{}
+	require.Equal(t, "ResetConnection", e4.eventType().String())
+
+	e5 := changeUserEvent{}
+	require.Equal(t, "ChangeUser", e5.eventType().String())
 }
@@ -55,6 +55,13 @@ func (c *clientConn) handleHandshakeResp() error {
 		}
 		return c.handleHandshakeResp()
 	}
+	c.handshakePack = backendHandshakePack(pack)
+	// The client may have switched the authentication method.
+	c.handshakePack, err = switchAuthHandshakePack(c.handshakePack,
+		c.mysqlProto.GetAuthPlugin(), c.mysqlProto.GetAuthResponse())
+	if err != nil {
+		return err
+	}
 
 	// parse tenant information from client login request.
 	if err := c.clientInfo.parse(c.mysqlProto.GetUserName()); err != nil {
@@ -81,6 +88,301 @@ func (c *clientConn) upgradeToTLS() error {
 	}
 	c.conn.UseConn(tlsConn)
 	c.mysqlProto.UseConn(tlsConn)
+	c.mysqlProto.SetTlsEstablished()
+	return nil
+}
+
+// relayAuth relays the authentication data between the client and the CN
+// server until the CN server sends the result, r is the AuthMoreData packet
+// from the CN server. It is needed by the full authentication of
+// caching_sha2_password.
+func (c *clientConn) relayAuth(sc ServerConn, r []byte) ([]byte, error) {
+	for isAuthMoreDataPacket(r) {
+		if err := c.mysqlProto.WritePacket(r[4:]); err != nil {
+			return nil, err
+		}
+		pack, err := c.readPacket()
+		if err != nil {
+			return nil, err
+		}
+		c.mysqlProto.SetSequenceID(uint8(pack.SequenceID + 1))
+		data := pack.Payload
+		// The client sends the password in clear text on the TLS connection,
+		// but the connection between proxy and CN server is not secure, so
+		// the password is encrypted with the public key of the CN server.
+		if c.mysqlProto.IsTlsEstablished() {
+			if data, err = c.encryptPassword(sc, data); err != nil {
+				return nil, err
+			}
+		}
+		resp, err := sc.WriteAuthData(data)
+		if err != nil {
+			return nil, err
+		}
+		r = packetToBytes(resp)
+	}
+	return r, nil
+}
+
+// encryptPassword encrypts the password in clear text with the public key
+// of the CN server.
+func (c *clientConn) encryptPassword(sc ServerConn, password []byte) ([]byte, error) {
+	resp, err := sc.WriteAuthData([]byte{frontend.CachingSha2RequestPublicKey})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Payload) < 1 || resp.Payload[0] != frontend.AuthMoreDataHeader {
+		return nil, moerr.NewInternalErrorNoCtx("failed to get public key from CN server")
+	}
+	return frontend.EncryptCachingSha2Password(
+		bytes.TrimSuffix(password, []byte{0}), c.mysqlProto.GetSalt(), resp.Payload[1:])
+}
+
+// backendHandshakePack returns the login packet sent to CN servers. The proxy
+// speaks the compressed protocol with the client itself, and the packets are
+// tunneled to CN servers without compression, so the compression flags are
+// cleared from the packet.
+func backendHandshakePack(pack *frontend.Packet) *frontend.Packet {
+	if len(pack.Payload) < 4 {
+		return pack
+	}
+	payload := append([]byte(nil), pack.Payload...)
+	capabilities := binary.LittleEndian.Uint32(payload)
+	protocol41 := capabilities&frontend.CLIENT_PROTOCOL_41 != 0
+	if !protocol41 {
+		// only 2 bytes of capabilities in protocol 320.
+		capabilities &= 0xFFFF
+	}
+	flags := frontend.CLIENT_COMPRESS | frontend.CLIENT_ZSTD_COMPRESSION_ALGORITHM
+	if capabilities&flags == 0 {
+		return pack
+	}
+	// the zstd compression level is the last field of the packet.
+	if capabilities&frontend.CLIENT_ZSTD_COMPRESSION_ALGORITHM != 0 {
+		payload = payload[:len(payload)-1]
+	}
+	capabilities &^= flags
+	if protocol41 {
+		binary.LittleEndian.PutUint32(payload, capabilities)
+	} else {
+		binary.LittleEndian.PutUint16(payload, uint16(capabilities))
+	}
+	return &frontend.Packet{
+		Length:     int32(len(payload)),
+		SequenceID: pack.SequenceID,
+		Payload:    payload,
+	}
+}
+
+// changeUserHandshakePack returns the login packet sent to CN servers after
+// the client changes the user with COM_CHANGE_USER, whose payload is data.
+// The client uses the same salt to make the auth response, so the packet
+// can be used to log in the user again when the connection is transferred.
+// It also returns the new username.
+func changeUserHandshakePack(pack *frontend.Packet, data []byte) (*frontend.Packet, string, error) {
+	// capabilities, max-packet size, character set and reserved bytes.
+	const headerLen = 32
+	if len(pack.Payload) < headerLen {
+		return nil, "", moerr.NewInternalErrorNoCtx("protocol error: handshake packet is too short")
+	}
+	capabilities := binary.LittleEndian.Uint32(pack.Payload)
+	if capabilities&frontend.CLIENT_PROTOCOL_41 == 0 {
+		return nil, "", moerr.NewInternalErrorNoCtx("protocol error: capabilities does not have protocol 41")
+	}
+	readStringNUL := func(pos int) (string, int, error) {
+		end := bytes.IndexByte(data[pos:], 0)
+		if end == -1 {
+			return "", 0, moerr.NewInternalErrorNoCtx("protocol error: cannot get null string")
+		}
+		return string(data[pos : pos+end]), pos + end + 1, nil
+	}
+
+	// Parse the payload of COM_CHANGE_USER.
+	username, pos, err := readStringNUL(0)
+	if err != nil {
+		return nil, "", err
+	}
+	var auth []byte
+	if capabilities&frontend.CLIENT_SECURE_CONNECTION != 0 {
+		if pos >= len(data) || pos+1+int(data[pos]) > len(data) {
+			return nil, "", moerr.NewInternalErrorNoCtx("protocol error: cannot get auth response")
+		}
+		auth = data[pos+1 : pos+1+int(data[pos])]
+		pos += 1 + int(data[pos])
+	} else {
+		var s string
+		if s, pos, err = readStringNUL(pos); err != nil {
+			return nil, "", err
+		}
+		auth = []byte(s)
+	}
+	db, pos, err := readStringNUL(pos)
+	if err != nil {
+		return nil, "", err
+	}
+	collationID := pack.Payload[8]
+	plugin := frontend.AuthNativePassword
+	var attrs []byte
+	if pos+2 <= len(data) {
+		collationID = data[pos]
+		pos += 2
+		if capabilities&frontend.CLIENT_PLUGIN_AUTH != 0 && pos < len(data) {
+			if plugin, pos, err = readStringNUL(pos); err != nil {
+				return nil, "", err
+			}
+		}
+		attrs = data[pos:]
+	}
+
+	// Build the handshake response with the new user.
+	if db != "" {
+		capabilities |= frontend.CLIENT_CONNECT_WITH_DB
+	}
+	payload := make([]byte, headerLen, len(pack.Payload)+len(data))
+	copy(payload, pack.Payload[:headerLen])
+	binary.LittleEndian.PutUint32(payload, capabilities)
+	payload[8] = collationID
+	payload = append(payload, username...)
+	payload = append(payload, 0)
+	if capabilities&frontend.CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA != 0 {
+		if len(auth) >= 251 {
+			payload = append(payload, 0xfc, byte(len(auth)), byte(len(auth)>>8))
+		} else {
+			payload = append(payload, byte(len(auth)))
+		}
+		payload = append(payload, auth...)
+	} else if capabilities&frontend.CLIENT_SECURE_CONNECTION != 0 {
+		payload = append(payload, byte(len(auth)))
+		payload = append(payload, auth...)
+	} else {
+		payload = append(payload, auth...)
+		payload = append(payload, 0)
+	}
+	if capabilities&frontend.CLIENT_CONNECT_WITH_DB != 0 {
+		payload = append(payload, db...)
+		payload = append(payload, 0)
+	}
+	if capabilities&frontend.CLIENT_PLUGIN_AUTH != 0 {
+		payload = append(payload, plugin...)
+		payload = append(payload, 0)
+	}
+	if capabilities&frontend.CLIENT_CONNECT_ATTRS != 0 {
+		if len(attrs) == 0 {
+			attrs = []byte{0}
+		}
+		payload = append(payload, attrs...)
+	}
+	return &frontend.Packet{
+		Length:     int32(len(payload)),
+		SequenceID: pack.SequenceID,
+		Payload:    payload,
+	}, username, nil
+}
+
+// switchAuthHandshakePack returns the login packet with the auth response
+// of the authentication method plugin if the client has switched to it after
+// the AuthSwitchRequest from proxy. Otherwise, the CN server would ask to
+// switch the method again.
+func switchAuthHandshakePack(pack *frontend.Packet, plugin string, auth []byte) (*frontend.Packet, error) {
+	// capabilities, max-packet size, character set and reserved bytes.
+	const headerLen = 32
+	if len(pack.Payload) < headerLen {
+		return pack, nil
+	}
+	payload := pack.Payload
+	capabilities := binary.LittleEndian.Uint32(payload)
+	if capabilities&frontend.CLIENT_PROTOCOL_41 == 0 || capabilities&frontend.CLIENT_PLUGIN_AUTH == 0 {
+		return pack, nil
+	}
+	skipStringNUL := func(pos int) (int, error) {
+		end := bytes.IndexByte(payload[pos:], 0)
+		if end == -1 {
+			return 0, moerr.NewInternalErrorNoCtx("protocol error: cannot get null string")
+		}
+		return pos + end + 1, nil
+	}
+
+	// Find the auth response and the plugin name.
+	authStart, err := skipStringNUL(headerLen)
+	if err != nil {
+		return nil, err
+	}
+	authEnd := authStart
+	if capabilities&frontend.CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA != 0 {
+		if authStart >= len(payload) {
+			return nil, moerr.NewInternalErrorNoCtx("protocol error: cannot get auth response")
+		}
+		switch payload[authStart] {
+		case 0xfc:
+			if authStart+3 > len(payload) {
+				return nil, moerr.NewInternalErrorNoCtx("protocol error: cannot get auth response")
+			}
+			authEnd = authStart + 3 + int(binary.LittleEndian.Uint16(payload[authStart+1:]))
+		default:
+			authEnd = authStart + 1 + int(payload[authStart])
+		}
+	} else if capabilities&frontend.CLIENT_SECURE_CONNECTION != 0 {
+		if authStart >= len(payload) {
+			return nil, moerr.NewInternalErrorNoCtx("protocol error: cannot get auth response")
+		}
+		authEnd = authStart + 1 + int(payload[authStart])
+	} else if authEnd, err = skipStringNUL(authStart); err != nil {
+		return nil, err
+	}
+	if authEnd > len(payload) {
+		return nil, moerr.NewInternalErrorNoCtx("protocol error: cannot get auth response")
+	}
+	pluginStart := authEnd
+	if capabilities&frontend.CLIENT_CONNECT_WITH_DB != 0 {
+		if pluginStart, err = skipStringNUL(authEnd); err != nil {
+			return nil, err
+		}
+	}
+	pluginEnd, err := skipStringNUL(pluginStart)
+	if err != nil {
+		return nil, err
+	}
+	if string(payload[pluginStart:pluginEnd-1]) == plugin {
+		return pack, nil
+	}
+
+	// Build the handshake response with the switched auth response.
+	res := make([]byte, authStart, len(payload)+len(auth)+len(plugin))
+	copy(res, payload[:authStart])
+	if capabilities&frontend.CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA != 0 && len(auth) >= 251 {
+		res = append(res, 0xfc, byte(len(auth)), byte(len(auth)>>8))
+		res = append(res, auth...)
+	} else if capabilities&(frontend.CLIENT_PLUGIN_AUTH_LENENC_CLIENT_DATA|frontend.CLIENT_SECURE_CONNECTION) != 0 {
+		res = append(res, byte(len(auth)))
+		res = append(res, auth...)
+	} else {
+		res = append(res, auth...)
+		res = append(res, 0)
+	}
+	res = append(res, payload[authEnd:pluginStart]...)
+	res = append(res, plugin...)
+	res = append(res, 0)
+	res = append(res, payload[pluginEnd:]...)
+	return &frontend.Packet{
+		Length:     int32(len(res)),
+		SequenceID: pack.SequenceID,
+		Payload:    res,
+	}, nil
+}
+
+// upgradeToCompression makes the connection speak the compressed protocol
+// if the client asks for it in the login packet. It must be done after the
+// OK packet is sent to the client.
+func (c *clientConn) upgradeToCompression() error {
+	conn, err := c.mysqlProto.CompressConn(c.conn.RawConn())
+	if err != nil {
+		return err
+	}
+	if conn == c.conn.RawConn() {
+		return nil
+	}
+	c.conn.UseConn(conn)
+	c.mysqlProto.UseConn(conn)
 	return nil
 }
 
@@ -125,9 +427,30 @@ func (s *serverConn) writeHandshakeResp(handshakeResp *frontend.Packet) (*fronte
 	}
 	// The CN server send a response back to indicate if the auth packet
 	// is OK to login.
-	data, err := s.readPacket()
-	if err != nil {
+	return s.readAuthResult()
+}
+
+// WriteAuthData implements the ServerConn interface.
+func (s *serverConn) WriteAuthData(data []byte) (*frontend.Packet, error) {
+	if err := s.mysqlProto.WritePacket(data); err != nil {
 		return nil, err
 	}
-	return data, nil
+	return s.readAuthResult()
+}
+
+// readAuthResult reads the response of the authentication from CN server.
+// The fast authentication success of caching_sha2_password is followed by
+// the OK packet, so it is skipped.
+func (s *serverConn) readAuthResult() (*frontend.Packet, error) {
+	fastAuthSuccess := []byte{frontend.AuthMoreDataHeader, frontend.CachingSha2FastAuthSuccess}
+	for {
+		data, err := s.readPacket()
+		if err != nil {
+			return nil, err
+		}
+		s.mysqlProto.SetSequenceID(uint8(data.SequenceID + 1))
+		if !bytes.Equal(data.Payload, fastAuthSuccess) {
+			return data, nil
+		}
+	}
 }
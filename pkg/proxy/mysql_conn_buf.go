@@ -49,6 +49,9 @@ const (
 	// For stmt prepare and execute cmd from JDBC.
 	cmdStmtPrepare MySQLCmd = 0x16
 	cmdStmtClose   MySQLCmd = 0x19
+	// For the session reset cmds from connection pools.
+	cmdChangeUser      MySQLCmd = 0x11
+	cmdResetConnection MySQLCmd = 0x1f
 )
 
 // MySQLConn contains a buffer to save data which may be only part
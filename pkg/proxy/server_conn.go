@@ -41,6 +41,9 @@ type ServerConn interface {
 	// HandleHandshake handles the handshake communication with CN server.
 	// handshakeResp is a auth packet received from client.
 	HandleHandshake(handshakeResp *frontend.Packet, timeout time.Duration) (*frontend.Packet, error)
+	// WriteAuthData writes the authentication data received from client
+	// to CN server and returns the response of CN server.
+	WriteAuthData(data []byte) (*frontend.Packet, error)
 	// ExecStmt executes a simple statement, it sends a query to backend server.
 	// After it finished, server connection should be closed immediately because
 	// it is a temp connection.
@@ -78,6 +78,9 @@ func (s *mockServerConn) RawConn() net.Conn { return s.conn }
 func (s *mockServerConn) HandleHandshake(_ *frontend.Packet, _ time.Duration) (*frontend.Packet, error) {
 	return nil, nil
 }
+func (s *mockServerConn) WriteAuthData(_ []byte) (*frontend.Packet, error) {
+	return nil, nil
+}
 func (s *mockServerConn) ExecStmt(stmt internalStmt, resp chan<- []byte) (bool, error) {
 	sendResp(makeOKPacket(8), resp)
 	return true, nil
@@ -633,3 +636,44 @@ func TestServerConnParseConnID(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestServerConn_WriteAuthData(t *testing.T) {
+	local, remote := net.Pipe()
+	defer func() {
+		_ = local.Close()
+		_ = remote.Close()
+	}()
+	fp := config.FrontendParameters{}
+	fp.SetDefaultValues()
+	ios, err := frontend.NewIOSession(local, config.NewParameterUnit(&fp, nil, nil, nil))
+	require.NoError(t, err)
+	sc := &serverConn{
+		conn: goetty.NewIOSession(goetty.WithSessionConn(1, local),
+			goetty.WithSessionCodec(frontend.NewSqlCodec())),
+		mysqlProto: frontend.NewMysqlClientProtocol("", 1, ios, 0, &fp),
+	}
+
+	reply := func(resp ...[]byte) {
+		header := make([]byte, 4)
+		_, err := remote.Read(header)
+		require.NoError(t, err)
+		payload := make([]byte, int(header[0]))
+		_, err = remote.Read(payload)
+		require.NoError(t, err)
+		for i, r := range resp {
+			_, err = remote.Write(append([]byte{byte(len(r)), 0, 0, header[3] + byte(i) + 1}, r...))
+			require.NoError(t, err)
+		}
+	}
+
+	// the fast authentication success is skipped.
+	go reply([]byte{frontend.AuthMoreDataHeader, frontend.CachingSha2FastAuthSuccess}, []byte{0, 0, 0, 2, 0, 0, 0})
+	p, err := sc.WriteAuthData([]byte{1, 2, 3})
+	require.NoError(t, err)
+	require.True(t, isOKPacket(packetToBytes(p)))
+
+	go reply([]byte{frontend.AuthMoreDataHeader, frontend.CachingSha2PerformFullAuth})
+	p, err = sc.WriteAuthData([]byte{1, 2, 3})
+	require.NoError(t, err)
+	require.True(t, isAuthMoreDataPacket(packetToBytes(p)))
+}
@@ -74,6 +74,20 @@ func isCmdStmtClose(p []byte) bool {
 	return false
 }
 
+func isCmdChangeUser(p []byte) bool {
+	if len(p) > 4 && p[4] == byte(cmdChangeUser) {
+		return true
+	}
+	return false
+}
+
+func isCmdResetConnection(p []byte) bool {
+	if len(p) > 4 && p[4] == byte(cmdResetConnection) {
+		return true
+	}
+	return false
+}
+
 // isOKPacket returns true if []byte is a MySQL OK packet.
 func isOKPacket(p []byte) bool {
 	if len(p) > 4 && p[4] == 0 {
@@ -90,6 +104,14 @@ func isEOFPacket(p []byte) bool {
 	return false
 }
 
+// isAuthMoreDataPacket returns true if []byte is a MySQL AuthMoreData packet.
+func isAuthMoreDataPacket(p []byte) bool {
+	if len(p) > 4 && p[4] == frontend.AuthMoreDataHeader {
+		return true
+	}
+	return false
+}
+
 // isErrPacket returns true if []byte is a MySQL Err packet.
 func isErrPacket(p []byte) bool {
 	if len(p) > 4 && p[4] == 0xFF {
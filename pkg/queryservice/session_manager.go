@@ -29,6 +29,8 @@ type Session interface {
 	StatusSession() *status.Session
 	// SetSessionRoutineStatus set the session Status
 	SetSessionRoutineStatus(status string) error
+	// GetQueryInProgress returns true if the session is running a query.
+	GetQueryInProgress() bool
 }
 
 // SessionManager manages all sessions locally.
@@ -89,6 +91,21 @@ func (sm *SessionManager) GetAllSessions() []Session {
 	return sessions
 }
 
+// GetLoad returns the number of the sessions and the sessions running a query.
+func (sm *SessionManager) GetLoad() (sessions, queries int) {
+	if sm == nil {
+		return 0, 0
+	}
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+	for _, session := range sm.mu.sessionsByID {
+		if session.GetQueryInProgress() {
+			queries++
+		}
+	}
+	return len(sm.mu.sessionsByID), queries
+}
+
 // GetAllStatusSessions returns all status sessions in the manager.
 func (sm *SessionManager) GetAllStatusSessions() []*status.Session {
 	if sm == nil {
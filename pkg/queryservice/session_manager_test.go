@@ -25,8 +25,9 @@ import (
 )
 
 type mockSession struct {
-	id     string
-	tenant string
+	id      string
+	tenant  string
+	running bool
 }
 
 func (s *mockSession) GetUUIDString() string {
@@ -48,6 +49,10 @@ func (s *mockSession) SetSessionRoutineStatus(status string) error {
 	return nil
 }
 
+func (s *mockSession) GetQueryInProgress() bool {
+	return s.running
+}
+
 func TestNewSessionManager(t *testing.T) {
 	sm := NewSessionManager()
 	assert.NotNil(t, sm)
@@ -55,6 +60,21 @@ func TestNewSessionManager(t *testing.T) {
 	assert.NotNil(t, sm.mu.sessionsByTenant)
 }
 
+func TestSessionManagerLoad(t *testing.T) {
+	var nilManager *SessionManager
+	sessions, queries := nilManager.GetLoad()
+	assert.Equal(t, 0, sessions)
+	assert.Equal(t, 0, queries)
+
+	sm := NewSessionManager()
+	sm.AddSession(&mockSession{id: uuid.NewString(), tenant: "t1", running: true})
+	sm.AddSession(&mockSession{id: uuid.NewString(), tenant: "t1"})
+	sm.AddSession(&mockSession{id: uuid.NewString(), tenant: "t2"})
+	sessions, queries = sm.GetLoad()
+	assert.Equal(t, 3, sessions)
+	assert.Equal(t, 1, queries)
+}
+
 func TestSessionManagerMain(t *testing.T) {
 	sm := NewSessionManager()
 	assert.NotNil(t, sm)
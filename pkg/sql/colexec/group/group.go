@@ -175,6 +175,10 @@ func (ctr *container) processGroupByAndAgg(ap *Group, proc *process.Process, ana
 					// no group-by clause.
 					err = ctr.processH0()
 
+				} else if dict := ctr.getDictGroups(ap, bat); dict != nil {
+					// group by a column read from dictionary encoded blocks
+					err = ctr.processDict(dict, proc)
+
 				} else {
 					// with group-by clause
 					switch ctr.typ {
@@ -318,7 +322,112 @@ func (ctr *container) processHStr(bat *batch.Batch, proc *process.Process) error
 	return nil
 }
 
+// getDictGroups returns the dictionary groups of the only group by column
+// if it is a column of the batch that has them.
+func (ctr *container) getDictGroups(ap *Group, bat *batch.Batch) *batch.DictGroups {
+	if len(ap.Exprs) != 1 || !bat.HasDictGroups() {
+		return nil
+	}
+	col := ap.Exprs[0].GetCol()
+	if col == nil {
+		return nil
+	}
+	dict := bat.GetDictGroups(int(col.ColPos))
+	if dict == nil || bat.Vecs[col.ColPos] != ctr.groupVecs.Vec[0] {
+		return nil
+	}
+	return dict
+}
+
+// processDict does the group by on a column whose rows are already grouped
+// by their dictionary codes. Only the first row of every dictionary group
+// is inserted into the hash map, the other rows take the hash group of the
+// row picked for their dictionary group.
+func (ctr *container) processDict(dict *batch.DictGroups, proc *process.Process) error {
+	picked := make([]int32, dict.Count)
+	for i := range picked {
+		picked[i] = -1
+	}
+	sels := ctr.dictSels[:0]
+	for row, g := range dict.Groups {
+		if picked[g] < 0 {
+			picked[g] = int32(len(sels))
+			sels = append(sels, int64(row))
+		}
+	}
+	ctr.dictSels = sels
+
+	if ctr.dictKeys == nil {
+		ctr.dictKeys = []*vector.Vector{proc.GetVector(*ctr.groupVecs.Vec[0].GetType())}
+	} else {
+		ctr.dictKeys[0].CleanOnlyData()
+	}
+	if err := ctr.dictKeys[0].Union(ctr.groupVecs.Vec[0], sels, proc.Mp()); err != nil {
+		return err
+	}
+
+	var itr hashmap.Iterator
+	var groupCount func() uint64
+	if ctr.typ == H8 {
+		itr, groupCount = ctr.intHashMap.NewIterator(), ctr.intHashMap.GroupCount
+	} else {
+		itr, groupCount = ctr.strHashMap.NewIterator(), ctr.strHashMap.GroupCount
+	}
+	groups := make([]uint64, len(sels))
+	for i := 0; i < len(sels); i += hashmap.UnitLimit {
+		n := len(sels) - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		rows := groupCount()
+		vals, _, err := itr.Insert(i, n, ctr.dictKeys)
+		if err != nil {
+			return err
+		}
+		copy(groups[i:i+n], vals[:n])
+		if _, err = ctr.growGroups(ctr.dictKeys, i, n, vals, rows, proc); err != nil {
+			return err
+		}
+	}
+
+	if ctr.dictVals == nil {
+		ctr.dictVals = make([]uint64, hashmap.UnitLimit)
+	}
+	count := len(dict.Groups)
+	for i := 0; i < count; i += hashmap.UnitLimit {
+		n := count - i
+		if n > hashmap.UnitLimit {
+			n = hashmap.UnitLimit
+		}
+		for k, g := range dict.Groups[i : i+n] {
+			ctr.dictVals[k] = groups[picked[g]]
+		}
+		for j, ag := range ctr.bat.Aggs {
+			if err := ag.BatchFill(i, ctr.dictVals[:n], ctr.aggVecs[j].Vec); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (ctr *container) batchFill(i int, n int, vals []uint64, hashRows uint64, proc *process.Process) error {
+	valCnt, err := ctr.growGroups(ctr.groupVecs.Vec, i, n, vals, hashRows, proc)
+	if err != nil || valCnt == 0 {
+		return err
+	}
+	for j, ag := range ctr.bat.Aggs {
+		err := ag.BatchFill(i, vals[:n], ctr.aggVecs[j].Vec)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// growGroups adds the groups newly inserted by the rows [i, i+n) of keys
+// to the result, it returns the number of rows that belong to a group.
+func (ctr *container) growGroups(keys []*vector.Vector, i int, n int, vals []uint64, hashRows uint64, proc *process.Process) (int, error) {
 	cnt := 0
 	valCnt := 0
 	copy(ctr.inserted[:n], ctr.zInserted[:n])
@@ -337,26 +446,17 @@ func (ctr *container) batchFill(i int, n int, vals []uint64, hashRows uint64, pr
 
 	if cnt > 0 {
 		for j, vec := range ctr.bat.Vecs {
-			if err := vec.UnionBatch(ctr.groupVecs.Vec[j], int64(i), cnt, ctr.inserted[:n], proc.Mp()); err != nil {
-				return err
+			if err := vec.UnionBatch(keys[j], int64(i), cnt, ctr.inserted[:n], proc.Mp()); err != nil {
+				return 0, err
 			}
 		}
 		for _, ag := range ctr.bat.Aggs {
 			if err := ag.GroupGrow(cnt); err != nil {
-				return err
+				return 0, err
 			}
 		}
 	}
-	if valCnt == 0 {
-		return nil
-	}
-	for j, ag := range ctr.bat.Aggs {
-		err := ag.BatchFill(i, vals[:n], ctr.aggVecs[j].Vec)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+	return valCnt, nil
 }
 
 func (ctr *container) evaluateAggAndGroupBy(
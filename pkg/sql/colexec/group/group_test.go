@@ -28,6 +28,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
@@ -248,3 +249,50 @@ func resetChildren(arg *Group, bats []*batch.Batch) {
 	}
 	arg.ctr.state = vm.Build
 }
+
+func TestGroupDict(t *testing.T) {
+	ts := []types.Type{types.T_varchar.ToType(), types.T_int64.ToType()}
+	tc := newTestCase([]bool{false}, ts, []int{0}, 1)
+	tc.arg.NeedEval = true
+	mp := tc.proc.Mp()
+
+	newDictBatch := func(keys []string, vals []int64, groups []uint32, count int) *batch.Batch {
+		bat := batch.NewWithSize(2)
+		bat.Vecs[0] = vector.NewVec(ts[0])
+		bat.Vecs[1] = vector.NewVec(ts[1])
+		for i, key := range keys {
+			require.NoError(t, vector.AppendBytes(bat.Vecs[0], []byte(key), key == "", mp))
+			require.NoError(t, vector.AppendFixed(bat.Vecs[1], vals[i], false, mp))
+		}
+		bat.SetRowCount(len(keys))
+		bat.SetDictGroups(0, groups, count)
+		return bat
+	}
+	require.NoError(t, tc.arg.Prepare(tc.proc))
+	resetChildren(tc.arg, []*batch.Batch{
+		// dictionary a, b, c and the null group 3
+		newDictBatch([]string{"b", "a", "", "b", "c", "a"}, []int64{1, 2, 3, 4, 5, 6}, []uint32{1, 0, 3, 1, 2, 0}, 4),
+		// dictionary a, c
+		newDictBatch([]string{"c", "a"}, []int64{10, 20}, []uint32{1, 0}, 3),
+		nil,
+	})
+	result, err := tc.arg.Call(tc.proc)
+	require.NoError(t, err)
+	bat := result.Batch
+	require.Equal(t, 4, bat.RowCount())
+	sums := make(map[string]int64)
+	for i := 0; i < bat.RowCount(); i++ {
+		key := "null"
+		if !bat.Vecs[0].IsNull(uint64(i)) {
+			key = bat.Vecs[0].GetStringAt(i)
+		}
+		sums[key] = vector.GetFixedAt[int64](bat.Vecs[1], i)
+	}
+	require.Equal(t, map[string]int64{"a": 28, "b": 5, "c": 15, "null": 3}, sums)
+	bat.Clean(mp)
+
+	tc.arg.Free(tc.proc, false, nil)
+	tc.arg.GetChildren(0).Free(tc.proc, false, nil)
+	tc.proc.FreeVectors()
+	require.Equal(t, int64(0), mp.CurrNB())
+}
@@ -86,6 +86,12 @@ type container struct {
 	keyWidth          int
 	groupVecsNullable bool
 
+	// dictKeys and dictSels hold one row of every dictionary group of the
+	// batch, see processDict.
+	dictKeys []*vector.Vector
+	dictSels []int64
+	dictVals []uint64
+
 	bat *batch.Batch
 }
 
@@ -169,6 +175,7 @@ func (group *Group) Free(proc *process.Process, pipelineFailed bool, err error)
 		ctr.cleanHashMap()
 		ctr.cleanAggVectors()
 		ctr.cleanGroupVectors()
+		ctr.cleanDictKeys(mp)
 		group.ctr = nil
 	}
 }
@@ -191,6 +198,13 @@ func (ctr *container) cleanGroupVectors() {
 	ctr.groupVecs.Free()
 }
 
+func (ctr *container) cleanDictKeys(mp *mpool.MPool) {
+	for _, vec := range ctr.dictKeys {
+		vec.Free(mp)
+	}
+	ctr.dictKeys = nil
+}
+
 func (ctr *container) cleanHashMap() {
 	if ctr.intHashMap != nil {
 		ctr.intHashMap.Free()
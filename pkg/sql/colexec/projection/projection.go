@@ -102,12 +102,34 @@ func (projection *Projection) Call(proc *process.Process) (vm.CallResult, error)
 		projection.ctr.buf.Vecs[i] = vec
 	}
 
+	// the columns passed through keep their dictionary groups
+	var dicts []*batch.DictGroups
+	var passed []*vector.Vector
+	if bat.HasDictGroups() {
+		dicts = make([]*batch.DictGroups, len(projection.ctr.buf.Vecs))
+		passed = make([]*vector.Vector, len(projection.ctr.buf.Vecs))
+		copy(passed, projection.ctr.buf.Vecs)
+		for i, vec := range passed {
+			for k, oldV := range bat.Vecs {
+				if vec == oldV {
+					dicts[i] = bat.GetDictGroups(k)
+					break
+				}
+			}
+		}
+	}
+
 	newAlloc, err := colexec.FixProjectionResult(proc, projection.ctr.projExecutors, projection.ctr.uafs, projection.ctr.buf, bat)
 	if err != nil {
 		return result, err
 	}
 	projection.maxAllocSize = max(projection.maxAllocSize, newAlloc)
 	projection.ctr.buf.SetRowCount(bat.RowCount())
+	for i, dict := range dicts {
+		if dict != nil && projection.ctr.buf.Vecs[i] == passed[i] {
+			projection.ctr.buf.SetDictGroups(i, dict.Groups, dict.Count)
+		}
+	}
 
 	anal.Output(projection.ctr.buf, projection.GetIsLast())
 	result.Batch = projection.ctr.buf
@@ -51,6 +51,9 @@ type S3Writer struct {
 	seqnums       []uint16
 	tablename     string
 	attrs         []string
+	compressAlg   uint8
+	bloomCols     []uint16
+	ngramCols     []uint16
 
 	writer  *blockio.BlockWriter
 	lengths []uint64
@@ -131,10 +134,12 @@ func AllocS3Writer(proc *process.Process, tableDef *plan.TableDef) (*S3Writer, e
 		tablename:      tableDef.GetName(),
 		seqnums:        make([]uint16, 0, len(tableDef.Cols)),
 		schemaVersion:  tableDef.Version,
+		compressAlg:    catalog.GetCompressAlgFromTableDef(tableDef),
 		sortIndex:      -1,
 		pk:             -1,
 		partitionIndex: 0,
 	}
+	writer.bloomCols, writer.ngramCols = catalog.GetFilterColumnsFromTableDef(tableDef)
 
 	writer.ResetBlockInfoBat(proc)
 	for i, colDef := range tableDef.Cols {
@@ -183,10 +188,12 @@ func AllocPartitionS3Writer(proc *process.Process, tableDef *plan.TableDef) ([]*
 			tablename:      tableDef.GetName(),
 			seqnums:        make([]uint16, 0, len(tableDef.Cols)),
 			schemaVersion:  tableDef.Version,
+			compressAlg:    catalog.GetCompressAlgFromTableDef(tableDef),
 			sortIndex:      -1,
 			pk:             -1,
 			partitionIndex: int16(i), // This value is aligned with the partition number
 		}
+		writers[i].bloomCols, writers[i].ngramCols = catalog.GetFilterColumnsFromTableDef(tableDef)
 
 		writers[i].ResetBlockInfoBat(proc)
 		for j, colDef := range tableDef.Cols {
@@ -569,6 +576,8 @@ func (w *S3Writer) generateWriter(proc *process.Process) (objectio.ObjectName, e
 	if err != nil {
 		return nil, err
 	}
+	w.writer.SetCompressAlg(w.compressAlg)
+	w.writer.SetColumnFilters(w.bloomCols, w.ngramCols)
 	w.lengths = w.lengths[:0]
 	return obj, err
 }
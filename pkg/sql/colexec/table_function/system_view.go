@@ -773,3 +773,94 @@ var listTnService = func(
 var requestMultipleCn = func(ctx context.Context, nodes []string, qc qclient.QueryClient, genRequest func() *query.Request, handleValidResponse func(string, *query.Response), handleInvalidResponse func(string)) error {
 	return queryservice.RequestMultipleCn(ctx, nodes, qc, genRequest, handleValidResponse, handleInvalidResponse)
 }
+
+func moCNScalingPrepare(proc *process.Process, tableFunction *TableFunction) error {
+	tableFunction.ctr.state = dataProducing
+	if len(tableFunction.Args) > 0 {
+		return moerr.NewInvalidInput(proc.Ctx, "moCNScaling: no argument is required")
+	}
+	for i := range tableFunction.Attrs {
+		tableFunction.Attrs[i] = strings.ToUpper(tableFunction.Attrs[i])
+	}
+	return nil
+}
+
+func moCNScalingCall(_ int, proc *process.Process, tableFunction *TableFunction, result *vm.CallResult) (bool, error) {
+	switch tableFunction.ctr.state {
+	case dataProducing:
+
+		if proc.Base.Hakeeper == nil {
+			return false, moerr.NewInternalError(proc.Ctx, "hakeeper is nil")
+		}
+
+		//get cluster details
+		details, err := proc.Base.Hakeeper.GetClusterDetails(proc.Ctx)
+		if err != nil {
+			return false, err
+		}
+
+		//alloc batch
+		bat := batch.NewWithSize(len(tableFunction.Attrs))
+		for i, col := range tableFunction.Attrs {
+			col = strings.ToLower(col)
+			idx, ok := plan2.MoCNScalingColName2Index[col]
+			if !ok {
+				return false, moerr.NewInternalError(proc.Ctx, "bad input select columns name %v", col)
+			}
+
+			tp := plan2.MoCNScalingColTypes[idx]
+			bat.Vecs[i] = proc.GetVector(tp)
+		}
+		bat.Attrs = tableFunction.Attrs
+
+		for _, r := range details.GetScaleRecommendations() {
+			if err = fillCNScalingRecord(proc, tableFunction.Attrs, bat, r); err != nil {
+				return false, err
+			}
+		}
+
+		bat.SetRowCount(bat.Vecs[0].Length())
+		result.Batch = bat
+		tableFunction.ctr.state = dataFinished
+		return false, nil
+
+	case dataFinished:
+		result.Batch = nil
+		return true, nil
+	default:
+		return false, moerr.NewInternalError(proc.Ctx, "unknown state %v", tableFunction.ctr.state)
+	}
+}
+
+func fillCNScalingRecord(proc *process.Process, attrs []string, bat *batch.Batch, r logservicepb.CNScaleRecommendation) error {
+	var err error
+	mp := proc.GetMPool()
+	for colIdx, attr := range attrs {
+		switch plan2.MoCNScalingColType(plan2.MoCNScalingColName2Index[strings.ToLower(attr)]) {
+		case plan2.MoCNScalingColTypeLabels:
+			err = vector.AppendBytes(bat.Vecs[colIdx], []byte(r.Labels), false, mp)
+		case plan2.MoCNScalingColTypeCNs:
+			err = vector.AppendBytes(bat.Vecs[colIdx], []byte(strings.Join(r.CNs, ",")), false, mp)
+		case plan2.MoCNScalingColTypeCPUUsage:
+			err = vector.AppendFixed(bat.Vecs[colIdx], r.CPUUsage, false, mp)
+		case plan2.MoCNScalingColTypeMemoryUsage:
+			err = vector.AppendFixed(bat.Vecs[colIdx], r.MemoryUsage, false, mp)
+		case plan2.MoCNScalingColTypeActiveSessions:
+			err = vector.AppendFixed(bat.Vecs[colIdx], r.ActiveSessions, false, mp)
+		case plan2.MoCNScalingColTypeActiveQueries:
+			err = vector.AppendFixed(bat.Vecs[colIdx], r.ActiveQueries, false, mp)
+		case plan2.MoCNScalingColTypeAction:
+			err = vector.AppendBytes(bat.Vecs[colIdx], []byte(r.Action.String()), false, mp)
+		case plan2.MoCNScalingColTypeCount:
+			err = vector.AppendFixed(bat.Vecs[colIdx], r.Count, false, mp)
+		case plan2.MoCNScalingColTypeReason:
+			err = vector.AppendBytes(bat.Vecs[colIdx], []byte(r.Reason), false, mp)
+		case plan2.MoCNScalingColTypeDrain:
+			err = vector.AppendBytes(bat.Vecs[colIdx], []byte(strings.Join(r.Drain, ",")), false, mp)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
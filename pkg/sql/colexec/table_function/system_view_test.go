@@ -30,6 +30,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/lock"
 	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/query"
 	"github.com/matrixorigin/matrixone/pkg/queryservice"
 	qclient "github.com/matrixorigin/matrixone/pkg/queryservice/client"
@@ -492,6 +493,17 @@ func (m *mockHKClient) AllocateIDByKeyWithBatch(ctx context.Context, key string,
 
 func (m *mockHKClient) GetClusterDetails(ctx context.Context) (pb.ClusterDetails, error) {
 	cd := pb.ClusterDetails{
+		ScaleRecommendations: []pb.CNScaleRecommendation{
+			{
+				Labels:         "role=tp",
+				CNs:            []string{"cn1", "cn2", "cn3"},
+				CPUUsage:       0.1,
+				ActiveSessions: 6,
+				Action:         pb.ScaleIn,
+				Count:          2,
+				Drain:          []string{"cn2", "cn3"},
+			},
+		},
 		CNStores: []pb.CNStore{
 			{
 				ConfigData: &pb.ConfigData{
@@ -629,3 +641,38 @@ func Test_moConfigurationsCall(t *testing.T) {
 		})
 	}
 }
+
+func Test_moCNScalingCall(t *testing.T) {
+	mp, err := mpool.NewMPool("ut_pool", 0, mpool.NoFixed)
+	if err != nil {
+		assert.NoError(t, err)
+	}
+	defer mpool.DeleteMPool(mp)
+	testProc := process.New(context.Background(), mp, nil, nil, nil, nil, &mockQueryService{}, &mockHKClient{}, nil, nil)
+
+	arg := &TableFunction{
+		ctr:   &container{},
+		Attrs: []string{"labels", "action", "count", "cpu_usage", "drain"},
+	}
+	assert.NoError(t, moCNScalingPrepare(testProc, arg))
+	assert.Error(t, moCNScalingPrepare(testProc, &TableFunction{
+		ctr:  &container{},
+		Args: []*plan.Expr{{}},
+	}))
+
+	result := vm.NewCallResult()
+	end, err := moCNScalingCall(0, testProc, arg, &result)
+	assert.NoError(t, err)
+	assert.False(t, end)
+	bat := result.Batch
+	assert.Equal(t, 1, bat.RowCount())
+	assert.Equal(t, "role=tp", bat.GetVector(0).GetStringAt(0))
+	assert.Equal(t, pb.ScaleIn.String(), bat.GetVector(1).GetStringAt(0))
+	assert.Equal(t, uint64(2), vector.GetFixedAt[uint64](bat.GetVector(2), 0))
+	assert.Equal(t, 0.1, vector.GetFixedAt[float64](bat.GetVector(3), 0))
+	assert.Equal(t, "cn2,cn3", bat.GetVector(4).GetStringAt(0))
+
+	end, err = moCNScalingCall(0, testProc, arg, &result)
+	assert.NoError(t, err)
+	assert.True(t, end)
+}
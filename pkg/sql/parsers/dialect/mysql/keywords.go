@@ -467,6 +467,7 @@ func init() {
 		"triggers":                   TRIGGERS,
 		"true":                       TRUE,
 		"truncate":                   TRUNCATE,
+		"ttl":                        TTL,
 		"uncommitted":                UNCOMMITTED,
 		"undo":                       UNUSED,
 		"unknown":                    UNKNOWN,
@@ -633,5 +634,6 @@ func init() {
 		"restore":                    RESTORE,
 		"pitr":                       PITR,
 		"cdc":                        CDC,
+		"statistics":                 STATISTICS,
 	}
 }
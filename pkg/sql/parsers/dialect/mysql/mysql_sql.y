@@ -335,7 +335,7 @@ import (
 %token <str> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
 %token <str> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
 %token <str> STATUS VARIABLES ROLE PROXY AVG_ROW_LENGTH STORAGE DISK MEMORY
-%token <str> CHECKSUM COMPRESSION DATA DIRECTORY DELAY_KEY_WRITE ENCRYPTION ENGINE
+%token <str> CHECKSUM COMPRESSION TTL DATA DIRECTORY DELAY_KEY_WRITE ENCRYPTION ENGINE
 %token <str> MAX_ROWS MIN_ROWS PACK_KEYS ROW_FORMAT STATS_AUTO_RECALC STATS_PERSISTENT STATS_SAMPLE_PAGES
 %token <str> DYNAMIC COMPRESSED REDUNDANT COMPACT FIXED COLUMN_FORMAT AUTO_RANDOM ENGINE_ATTRIBUTE SECONDARY_ENGINE_ATTRIBUTE INSERT_METHOD
 %token <str> RESTRICT CASCADE ACTION PARTIAL SIMPLE CHECK ENFORCED
@@ -482,6 +482,9 @@ import (
 // CDC
 %token <str> CDC
 
+// Extended statistics
+%token <str> STATISTICS
+
 %type <statement> stmt block_stmt block_type_stmt normal_stmt
 %type <statements> stmt_list stmt_list_return
 %type <statement> create_stmt insert_stmt delete_stmt drop_stmt alter_stmt truncate_table_stmt alter_sequence_stmt upgrade_stmt
@@ -540,6 +543,7 @@ import (
 %type <statement> create_stage_stmt drop_stage_stmt alter_stage_stmt
 %type <statement> create_snapshot_stmt drop_snapshot_stmt
 %type <statement> create_pitr_stmt drop_pitr_stmt show_pitr_stmt alter_pitr_stmt restore_pitr_stmt
+%type <statement> create_statistics_stmt drop_statistics_stmt
 %type <str> urlparams
 %type <str> comment_opt view_list_opt view_opt security_opt view_tail check_type
 %type <subscriptionOption> subscription_opt
@@ -560,6 +564,7 @@ import (
 %type <limit> limit_opt limit_clause
 %type <str> insert_column
 %type <identifierList> column_list column_list_opt partition_clause_opt partition_id_list insert_column_list accounts_list
+%type <identifierList> statistics_kind_list_opt
 %type <joinCond> join_condition join_condition_opt on_expression_opt
 %type <selectLockInfo> select_lock_opt
 %type <upgrade_target> target
@@ -627,7 +632,7 @@ import (
 %type <unresolvedName> column_name column_name_unresolved normal_ident
 %type <strs> enum_values force_quote_opt force_quote_list infile_or_s3_param infile_or_s3_params credentialsparams credentialsparam create_cdc_opt create_cdc_opts
 %type <str> charset_keyword db_name db_name_opt
-%type <str> backup_type_opt backup_timestamp_opt
+%type <str> backup_type_opt backup_timestamp_opt backup_parent_opt backup_encryption_opt
 %type <str> not_keyword func_not_keyword
 %type <str> non_reserved_keyword
 %type <str> equal_opt column_keyword_opt
@@ -932,7 +937,7 @@ normal_stmt:
 
 
 backup_stmt:
-    BACKUP STRING FILESYSTEM STRING PARALLELISM STRING backup_type_opt backup_timestamp_opt
+    BACKUP STRING FILESYSTEM STRING PARALLELISM STRING backup_type_opt backup_timestamp_opt backup_parent_opt backup_encryption_opt
 	{
         var timestamp = $2
         var isS3 = false
@@ -941,9 +946,11 @@ backup_stmt:
         var option []string
         var backuptype = $7
         var backupts = $8
-        $$ = tree.NewBackupStart(timestamp, isS3, dir, parallelism, option, backuptype, backupts)
+        var parent = $9
+        var encryption = $10
+        $$ = tree.NewBackupStart(timestamp, isS3, dir, parallelism, option, backuptype, backupts, parent, encryption)
 	}
-    | BACKUP STRING S3OPTION '{' infile_or_s3_params '}' backup_type_opt backup_timestamp_opt
+    | BACKUP STRING S3OPTION '{' infile_or_s3_params '}' backup_type_opt backup_timestamp_opt backup_parent_opt backup_encryption_opt
     {
         var timestamp = $2
         var isS3 = true
@@ -952,7 +959,9 @@ backup_stmt:
         var option = $5
         var backuptype = $7
         var backupts = $8
-        $$ = tree.NewBackupStart(timestamp, isS3, dir, parallelism, option, backuptype, backupts)
+        var parent = $9
+        var encryption = $10
+        $$ = tree.NewBackupStart(timestamp, isS3, dir, parallelism, option, backuptype, backupts, parent, encryption)
     }
 
 backup_type_opt:
@@ -973,6 +982,24 @@ backup_timestamp_opt:
         $$ = $2
     }
 
+backup_parent_opt:
+    {
+        $$ = ""
+    }
+|   FROM STRING
+    {
+        $$ = $2
+    }
+
+backup_encryption_opt:
+    {
+        $$ = ""
+    }
+|   ENCRYPTION STRING
+    {
+        $$ = $2
+    }
+
 create_cdc_stmt:
     CREATE CDC not_exists_opt STRING STRING STRING STRING STRING '{' create_cdc_opts '}'
     {
@@ -3728,7 +3755,7 @@ alter_account_auth_option:
 }
 
 alter_user_stmt:
-    ALTER USER exists_opt user_spec_list_of_create_user default_role_opt pwd_or_lck_opt user_comment_or_attribute_opt
+    ALTER USER exists_opt user_spec_list default_role_opt pwd_or_lck_opt user_comment_or_attribute_opt
     {
         // Create temporary variables with meaningful names
         ifExists := $3
@@ -4412,6 +4439,7 @@ drop_ddl_stmt:
 |   drop_snapshot_stmt
 |   drop_pitr_stmt
 |   drop_cdc_stmt
+|   drop_statistics_stmt
 
 drop_sequence_stmt:
     DROP SEQUENCE exists_opt table_name_list
@@ -6038,6 +6066,7 @@ create_ddl_stmt:
 |   create_function_stmt
 |   create_extension_stmt
 |   create_sequence_stmt
+|   create_statistics_stmt
 |   create_procedure_stmt
 |   create_source_stmt
 |   create_connector_stmt
@@ -6746,6 +6775,14 @@ drop_pitr_stmt:
        $$ = tree.NewDropPitr(ifExists, name)
    }
 
+drop_statistics_stmt:
+    DROP STATISTICS exists_opt ident ON table_name
+    {
+        var ifExists = $3
+        var name = tree.Identifier($4.Compare())
+        $$ = tree.NewDropStatistics(ifExists, name, $6)
+    }
+
 account_role_name:
     ident
     {
@@ -7626,6 +7663,23 @@ load_charset:
     	$$ = $2
     }
 
+create_statistics_stmt:
+    CREATE STATISTICS not_exists_opt ident statistics_kind_list_opt ON column_list FROM table_name
+    {
+        var ifNotExists = $3
+        var name = tree.Identifier($4.Compare())
+        $$ = tree.NewCreateStatistics(ifNotExists, name, $5, $7, $9)
+    }
+
+statistics_kind_list_opt:
+    {
+        $$ = nil
+    }
+|   '(' column_list ')'
+    {
+        $$ = $2
+    }
+
 create_sequence_stmt:
     CREATE SEQUENCE not_exists_opt table_name as_datatype_opt increment_by_opt min_value_opt max_value_opt start_with_opt cycle_opt
     {
@@ -8235,6 +8289,15 @@ table_option:
     {
         $$ = tree.NewTableOptionCompression($3)
     }
+|   TTL equal_opt ident '+' INTERVAL INTEGRAL time_stamp_unit
+    {
+        v, errStr := util.GetInt64($6)
+        if errStr != "" {
+           yylex.Error(errStr)
+           goto ret1
+        }
+        $$ = tree.NewTableOptionTTL($3.Compare(), v, $7)
+    }
 |   CONNECTION equal_opt STRING
     {
         $$ = tree.NewTableOptionConnection($3)
@@ -12122,6 +12185,7 @@ non_reserved_keyword:
 |   SPATIAL
 |   START
 |   STATUS
+|   STATISTICS
 |   STORAGE
 |   STATS_AUTO_RECALC
 |   STATS_PERSISTENT
@@ -12139,6 +12203,7 @@ non_reserved_keyword:
 |   TINYTEXT
 |   TRANSACTION
 |   TRIGGER
+|   TTL
 |   UNCOMMITTED
 |   UNSIGNED
 |   UNUSED
@@ -1043,6 +1043,12 @@ var (
 		}, {
 			input:  "create table table10 (a int primary key, b varchar(10)) checksum=0 COMMENT=\"asdf\"",
 			output: "create table table10 (a int primary key, b varchar(10)) checksum = 0 comment = 'asdf'",
+		}, {
+			input:  "create table t1 (a int primary key, created_at datetime) TTL = created_at + INTERVAL 30 DAY",
+			output: "create table t1 (a int primary key, created_at datetime) ttl = created_at + interval 30 day",
+		}, {
+			input:  "create table t1 (a int, ttl timestamp) ttl ttl + interval 12 hour",
+			output: "create table t1 (a int, ttl timestamp) ttl = ttl + interval 12 hour",
 		}, {
 			input:  "create temporary table table05 ( a int, b char(10));",
 			output: "create temporary table table05 (a int, b char(10))",
@@ -1800,6 +1806,12 @@ var (
 		}, {
 			input:  "alter user if exists abc1 identified by '123'",
 			output: "alter user if exists abc1 identified by '******'",
+		}, {
+			input:  "alter user abc1 password expire interval 90 day",
+			output: "alter user abc1 password expire interval 90 day",
+		}, {
+			input:  "alter user abc1 failed_login_attempts 3",
+			output: "alter user abc1 failed_login_attempts 3",
 		}, {
 			input:  "alter user if exists abc1 identified by '123'",
 			output: "alter user if exists abc1 identified by '******'",
@@ -2805,6 +2817,12 @@ var (
 		}, {
 			input:  "backup '125' filesystem '/tmp/backup' parallelism '1';",
 			output: "backup 125 filesystem /tmp/backup parallelism 1",
+		}, {
+			input:  "backup '126' filesystem '/tmp/backup2' parallelism '1' type 'incremental' from '/tmp/backup'",
+			output: "backup 126 filesystem /tmp/backup2 parallelism 1 backuptype incremental from /tmp/backup",
+		}, {
+			input:  "backup '126' filesystem '/tmp/backup' parallelism '1' encryption 'file:/tmp/backup.key'",
+			output: "backup 126 filesystem /tmp/backup parallelism 1 encryption file:/tmp/backup.key",
 		}, {
 			input:  "backup '123' s3option {\"bucket\"='dan-test1', \"filepath\"='ex_table_dan_gzip.gz',\"role_arn\"='arn:aws:iam::468413122987:role/dev-cross-s3', \"external_id\"='5404f91c_4e59_4898_85b3', \"compression\"='auto'}",
 			output: "backup 123 s3option {'bucket'='dan-test1', 'filepath'='ex_table_dan_gzip.gz', 'role_arn'='arn:aws:iam::468413122987:role/dev-cross-s3', 'external_id'='5404f91c_4e59_4898_85b3', 'compression'='auto'}",
@@ -2977,6 +2995,26 @@ var (
 			input:  "restore cluster from pitr pitr01 '2021-01-01 00:00:00'",
 			output: "restore cluster from pitr pitr01 timestamp = 2021-01-01 00:00:00",
 		},
+		{
+			input:  "create statistics s1 on a, b from t1",
+			output: "create statistics s1 on a, b from t1",
+		},
+		{
+			input:  "create statistics if not exists s1 (ndistinct, dependencies) on country, city from db1.t1",
+			output: "create statistics if not exists s1 (ndistinct, dependencies) on country, city from db1.t1",
+		},
+		{
+			input:  "drop statistics s1 on t1",
+			output: "drop statistics s1 on t1",
+		},
+		{
+			input:  "drop statistics if exists s1 on db1.t1",
+			output: "drop statistics if exists s1 on db1.t1",
+		},
+		{
+			input:  "select statistics from t1",
+			output: "select statistics from t1",
+		},
 	}
 )
 
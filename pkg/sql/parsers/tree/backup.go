@@ -36,6 +36,12 @@ type BackupStart struct {
 	// incremental backup
 	BackupType string
 	BackupTs   string
+	// Parent is the dir or s3 filepath of the parent backup, the
+	// incremental backup copies the files created since the parent
+	Parent string
+	// Encryption is the key of the encrypted backup, a key file as
+	// file:<path> or a kms key as kms:<key id>
+	Encryption string
 }
 
 func (node *BackupStart) Format(ctx *FmtCtx) {
@@ -61,9 +67,19 @@ func (node *BackupStart) Format(ctx *FmtCtx) {
 		ctx.WriteString(" backupts ")
 		ctx.WriteString(node.BackupTs)
 	}
+
+	if node.Parent != "" {
+		ctx.WriteString(" from ")
+		ctx.WriteString(node.Parent)
+	}
+
+	if node.Encryption != "" {
+		ctx.WriteString(" encryption ")
+		ctx.WriteString(node.Encryption)
+	}
 }
 
-func NewBackupStart(timestamp string, isS3 bool, dir string, parallelism string, option []string, backupType, backupTs string) *BackupStart {
+func NewBackupStart(timestamp string, isS3 bool, dir string, parallelism string, option []string, backupType, backupTs, parent, encryption string) *BackupStart {
 	backup := reuse.Alloc[BackupStart](nil)
 	backup.Timestamp = timestamp
 	backup.IsS3 = isS3
@@ -72,6 +88,8 @@ func NewBackupStart(timestamp string, isS3 bool, dir string, parallelism string,
 	backup.Option = option
 	backup.BackupType = backupType
 	backup.BackupTs = backupTs
+	backup.Parent = parent
+	backup.Encryption = encryption
 	return backup
 }
 
@@ -299,6 +299,12 @@ func init() {
 		reuse.DefaultOptions[TableOptionCompression](), //.
 	) //WithEnableChecker()
 
+	reuse.CreatePool[TableOptionTTL](
+		func() *TableOptionTTL { return &TableOptionTTL{} },
+		func(t *TableOptionTTL) { t.reset() },
+		reuse.DefaultOptions[TableOptionTTL](), //.
+	) //WithEnableChecker()
+
 	reuse.CreatePool[TableOptionConnection](
 		func() *TableOptionConnection { return &TableOptionConnection{} },
 		func(t *TableOptionConnection) { t.reset() },
@@ -1152,6 +1158,8 @@ func (node *CreateTable) reset() {
 				opt.Free()
 			case *TableOptionCompression:
 				opt.Free()
+			case *TableOptionTTL:
+				opt.Free()
 			case *TableOptionConnection:
 				opt.Free()
 			case *TableOptionPassword:
@@ -1241,6 +1249,8 @@ func (node *CreateTable) reset() {
 				opt.Free()
 			case *TableOptionCompression:
 				opt.Free()
+			case *TableOptionTTL:
+				opt.Free()
 			case *TableOptionConnection:
 				opt.Free()
 			case *TableOptionPassword:
@@ -2867,6 +2877,42 @@ func NewTableOptionCompression(c string) *TableOptionCompression {
 	return t
 }
 
+// TableOptionTTL is the row expiry of a table, a row is expired once
+// Column + INTERVAL Interval Unit is not after the current time.
+type TableOptionTTL struct {
+	tableOptionImpl
+	Column   string
+	Interval int64
+	Unit     string
+}
+
+func (node *TableOptionTTL) Format(ctx *FmtCtx) {
+	ctx.WriteString("ttl = ")
+	ctx.WriteString(node.Column)
+	ctx.WriteString(" + interval ")
+	ctx.WriteString(strconv.FormatInt(node.Interval, 10))
+	ctx.WriteByte(' ')
+	ctx.WriteString(strings.ToLower(node.Unit))
+}
+
+func (node TableOptionTTL) TypeName() string { return "tree.TableOptionTTL" }
+
+func (node *TableOptionTTL) reset() {
+	*node = TableOptionTTL{}
+}
+
+func (node *TableOptionTTL) Free() {
+	reuse.Free[TableOptionTTL](node, nil)
+}
+
+func NewTableOptionTTL(column string, interval int64, unit string) *TableOptionTTL {
+	t := reuse.Alloc[TableOptionTTL](nil)
+	t.Column = column
+	t.Interval = interval
+	t.Unit = unit
+	return t
+}
+
 type TableOptionConnection struct {
 	tableOptionImpl
 	Connection string
@@ -3769,6 +3815,8 @@ func (node *Partition) reset() {
 				opt.Free()
 			case *TableOptionCompression:
 				opt.Free()
+			case *TableOptionTTL:
+				opt.Free()
 			case *TableOptionConnection:
 				opt.Free()
 			case *TableOptionPassword:
@@ -3902,6 +3950,8 @@ func (node *SubPartition) reset() {
 				opt.Free()
 			case *TableOptionCompression:
 				opt.Free()
+			case *TableOptionTTL:
+				opt.Free()
 			case *TableOptionConnection:
 				opt.Free()
 			case *TableOptionPassword:
@@ -0,0 +1,118 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tree
+
+import "github.com/matrixorigin/matrixone/pkg/common/reuse"
+
+func init() {
+	reuse.CreatePool[CreateStatistics](
+		func() *CreateStatistics { return &CreateStatistics{} },
+		func(c *CreateStatistics) { c.reset() },
+		reuse.DefaultOptions[CreateStatistics](), //.
+	) //WithEnableChecker()
+
+	reuse.CreatePool[DropStatistics](
+		func() *DropStatistics { return &DropStatistics{} },
+		func(d *DropStatistics) { d.reset() },
+		reuse.DefaultOptions[DropStatistics](), //.
+	) //WithEnableChecker()
+}
+
+// CreateStatistics is the statement
+// CREATE STATISTICS [IF NOT EXISTS] name [(kind, ...)] ON col, col, ... FROM table
+type CreateStatistics struct {
+	statementImpl
+
+	IfNotExists bool
+	Name        Identifier
+	// Kinds of the statistics, ndistinct and dependencies.
+	// all kinds are built if it is empty.
+	Kinds IdentifierList
+	Cols  IdentifierList
+	Table *TableName
+}
+
+func (node *CreateStatistics) Format(ctx *FmtCtx) {
+	ctx.WriteString("create statistics ")
+	if node.IfNotExists {
+		ctx.WriteString("if not exists ")
+	}
+	node.Name.Format(ctx)
+	if len(node.Kinds) > 0 {
+		ctx.WriteString(" (")
+		node.Kinds.Format(ctx)
+		ctx.WriteString(")")
+	}
+	ctx.WriteString(" on ")
+	node.Cols.Format(ctx)
+	ctx.WriteString(" from ")
+	node.Table.Format(ctx)
+}
+
+func (node *CreateStatistics) GetStatementType() string { return "Create Statistics" }
+func (node *CreateStatistics) GetQueryType() string     { return QueryTypeDDL }
+
+func (node *CreateStatistics) Free() { reuse.Free[CreateStatistics](node, nil) }
+
+func (node *CreateStatistics) reset() { *node = CreateStatistics{} }
+
+func (node CreateStatistics) TypeName() string { return "tree.CreateStatistics" }
+
+func NewCreateStatistics(ifNotExists bool, name Identifier, kinds, cols IdentifierList, table *TableName) *CreateStatistics {
+	create := reuse.Alloc[CreateStatistics](nil)
+	create.IfNotExists = ifNotExists
+	create.Name = name
+	create.Kinds = kinds
+	create.Cols = cols
+	create.Table = table
+	return create
+}
+
+// DropStatistics is the statement
+// DROP STATISTICS [IF EXISTS] name ON table
+type DropStatistics struct {
+	statementImpl
+
+	IfExists bool
+	Name     Identifier
+	Table    *TableName
+}
+
+func (node *DropStatistics) Format(ctx *FmtCtx) {
+	ctx.WriteString("drop statistics ")
+	if node.IfExists {
+		ctx.WriteString("if exists ")
+	}
+	node.Name.Format(ctx)
+	ctx.WriteString(" on ")
+	node.Table.Format(ctx)
+}
+
+func (node *DropStatistics) GetStatementType() string { return "Drop Statistics" }
+func (node *DropStatistics) GetQueryType() string     { return QueryTypeDDL }
+
+func (node *DropStatistics) Free() { reuse.Free[DropStatistics](node, nil) }
+
+func (node *DropStatistics) reset() { *node = DropStatistics{} }
+
+func (node DropStatistics) TypeName() string { return "tree.DropStatistics" }
+
+func NewDropStatistics(ifExists bool, name Identifier, table *TableName) *DropStatistics {
+	drop := reuse.Alloc[DropStatistics](nil)
+	drop.IfExists = ifExists
+	drop.Name = name
+	drop.Table = table
+	return drop
+}
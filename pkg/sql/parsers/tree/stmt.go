@@ -491,6 +491,14 @@ func (node *AnalyzeStmt) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
 
+func (node *CreateStatistics) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
+func (node *DropStatistics) StmtKind() StmtKind {
+	return frontendStatusTyp
+}
+
 func (node *SetRole) StmtKind() StmtKind {
 	return frontendStatusTyp
 }
@@ -52,6 +52,8 @@ func TestQueryType(t *testing.T) {
 					&DropTable{}:           QueryTypeDDL,
 					&DropView{}:            QueryTypeDDL,
 					&DropIndex{}:           QueryTypeDDL,
+					&CreateStatistics{}:    QueryTypeDDL,
+					&DropStatistics{}:      QueryTypeDDL,
 					&DropFunction{}:        QueryTypeDDL,
 					&TruncateTable{}:       QueryTypeDDL,
 					// DML
@@ -24,6 +24,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/defines"
 	"github.com/matrixorigin/matrixone/pkg/pb/plan"
@@ -690,6 +691,31 @@ func buildCreateSequence(stmt *tree.CreateSequence, ctx CompilerContext) (*Plan,
 	}, nil
 }
 
+// checkFilterColumns checks that the columns listed by the table properties
+// bloom_filter_columns and ngram_filter_columns exist, and that the ngram
+// filter columns are strings
+func checkFilterColumns(ctx CompilerContext, tableDef *TableDef, key, value string) error {
+	if key != catalog.PropBloomFilterColumns && key != catalog.PropNgramFilterColumns {
+		return nil
+	}
+	for _, name := range catalog.SplitFilterColumns(value) {
+		var col *ColDef
+		for _, c := range tableDef.Cols {
+			if strings.EqualFold(c.Name, name) {
+				col = c
+				break
+			}
+		}
+		if col == nil {
+			return moerr.NewBadFieldError(ctx.GetContext(), name, tableDef.Name)
+		}
+		if key == catalog.PropNgramFilterColumns && !types.T(col.Typ.Id).ToType().IsVarlen() {
+			return moerr.NewNotSupported(ctx.GetContext(), "ngram filter on column '%s' of type %s", name, types.T(col.Typ.Id).String())
+		}
+	}
+	return nil
+}
+
 func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error) {
 	if stmt.IsAsLike {
 		var err error
@@ -795,6 +821,9 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 		case *tree.TableOptionProperties:
 			properties := make([]*plan.Property, len(opt.Preperties))
 			for idx, property := range opt.Preperties {
+				if err = checkFilterColumns(ctx, createTable.TableDef, property.Key, property.Value); err != nil {
+					return nil, err
+				}
 				properties[idx] = &plan.Property{
 					Key:   property.Key,
 					Value: property.Value,
@@ -830,6 +859,57 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 			if opt.Value != 0 {
 				createTable.TableDef.AutoIncrOffset = opt.Value - 1
 			}
+		case *tree.TableOptionCompression:
+			alg := strings.ToLower(opt.Compression)
+			if _, ok := compress.Algorithms[alg]; !ok {
+				return nil, moerr.NewNotSupported(ctx.GetContext(), "compression algorithm '%s'", opt.Compression)
+			}
+			createTable.TableDef.Defs = append(createTable.TableDef.Defs, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: []*plan.Property{
+							{
+								Key:   catalog.PropCompression,
+								Value: alg,
+							},
+						},
+					},
+				},
+			})
+		case *tree.TableOptionTTL:
+			var col *ColDef
+			for _, c := range createTable.TableDef.Cols {
+				if strings.EqualFold(c.Name, opt.Column) {
+					col = c
+					break
+				}
+			}
+			if col == nil {
+				return nil, moerr.NewBadFieldError(ctx.GetContext(), opt.Column, createTable.TableDef.Name)
+			}
+			if !catalog.IsTTLColumnType(types.T(col.Typ.Id)) {
+				return nil, moerr.NewNotSupported(ctx.GetContext(), "ttl on column '%s' of type %s", opt.Column, types.T(col.Typ.Id).String())
+			}
+			interval, err := catalog.NormalizeTTLInterval(opt.Interval, opt.Unit)
+			if err != nil {
+				return nil, err
+			}
+			createTable.TableDef.Defs = append(createTable.TableDef.Defs, &plan.TableDef_DefType{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: []*plan.Property{
+							{
+								Key:   catalog.PropTTLColumn,
+								Value: col.Name,
+							},
+							{
+								Key:   catalog.PropTTLInterval,
+								Value: interval,
+							},
+						},
+					},
+				},
+			})
 
 		// these table options is not support in plan
 		// case *tree.TableOptionEngine, *tree.TableOptionSecondaryEngine, *tree.TableOptionCharset,
@@ -843,7 +923,7 @@ func buildCreateTable(stmt *tree.CreateTable, ctx CompilerContext) (*Plan, error
 		// 	*tree.TableOptionUnion, *tree.TableOptionEncryption:
 		// 	return nil, moerr.NewNotSupported("statement: '%v'", tree.String(stmt, dialect.MYSQL))
 		case *tree.TableOptionAUTOEXTEND_SIZE, *tree.TableOptionAvgRowLength,
-			*tree.TableOptionCharset, *tree.TableOptionChecksum, *tree.TableOptionCollate, *tree.TableOptionCompression,
+			*tree.TableOptionCharset, *tree.TableOptionChecksum, *tree.TableOptionCollate,
 			*tree.TableOptionConnection, *tree.TableOptionDataDirectory, *tree.TableOptionIndexDirectory,
 			*tree.TableOptionDelayKeyWrite, *tree.TableOptionEncryption, *tree.TableOptionEngine, *tree.TableOptionEngineAttr,
 			*tree.TableOptionKeyBlockSize, *tree.TableOptionMaxRows, *tree.TableOptionMinRows, *tree.TableOptionPackKeys,
@@ -263,12 +263,23 @@ func ConstructCreateTableSQL(ctx CompilerContext, tableDef *plan.TableDef, snaps
 
 	var comment string
 	var partition string
+	var compression string
+	var ttlColumn, ttlInterval string
 	for _, def := range tableDef.Defs {
 		if proDef, ok := def.Def.(*plan.TableDef_DefType_Properties); ok {
 			for _, kv := range proDef.Properties.Properties {
 				if kv.Key == catalog.SystemRelAttr_Comment {
 					comment = " COMMENT='" + kv.Value + "'"
 				}
+				if kv.Key == catalog.PropCompression {
+					compression = " COMPRESSION='" + kv.Value + "'"
+				}
+				if kv.Key == catalog.PropTTLColumn {
+					ttlColumn = kv.Value
+				}
+				if kv.Key == catalog.PropTTLInterval {
+					ttlInterval = strings.ToUpper(kv.Value)
+				}
 			}
 		}
 	}
@@ -277,6 +288,10 @@ func ConstructCreateTableSQL(ctx CompilerContext, tableDef *plan.TableDef, snaps
 		partition = ` ` + tableDef.Partition.PartitionMsg
 	}
 
+	createStr += compression
+	if ttlColumn != "" && ttlInterval != "" {
+		createStr += fmt.Sprintf(" TTL = `%s` + INTERVAL %s", formatStr(ttlColumn), ttlInterval)
+	}
 	createStr += comment
 	createStr += partition
 
@@ -111,6 +111,14 @@ func Test_buildTestShowCreateTable(t *testing.T) {
 				)`,
 			want: "CREATE TABLE `t` (\n  `a` TIMESTAMP NOT NULL DEFAULT current_timestamp(),\n  `b` TIMESTAMP(3) DEFAULT current_timestamp(3),\n  `c` DATETIME DEFAULT current_timestamp(),\n  `d` DATETIME(4) DEFAULT current_timestamp(4),\n  `e` VARCHAR(20) DEFAULT 'cUrrent_tImestamp',\n  `f` DATETIME(2) DEFAULT current_timestamp(2) ON UPDATE current_timestamp(2),\n  `g` TIMESTAMP(2) DEFAULT current_timestamp(2) ON UPDATE current_timestamp(2),\n  `h` DATE DEFAULT '2024-01-01'\n)",
 		},
+		{
+			name: "test7",
+			sql: `create table events (
+				id bigint primary key,
+				created_at datetime not null
+				) ttl = created_at + interval 30 day`,
+			want: "CREATE TABLE `events` (\n  `id` BIGINT NOT NULL,\n  `created_at` DATETIME NOT NULL,\n  PRIMARY KEY (`id`)\n) TTL = `created_at` + INTERVAL 30 DAY",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
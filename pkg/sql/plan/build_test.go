@@ -811,6 +811,11 @@ func TestDdl(t *testing.T) {
 		"create view v_nation as select n_nationkey,n_name,n_regionkey,n_comment from nation",
 		"CREATE TABLE t1(id INT PRIMARY KEY,name VARCHAR(25),deptId INT,CONSTRAINT fk_t1 FOREIGN KEY(deptId) REFERENCES nation(n_nationkey)) COMMENT='xxxxx'",
 		"create table t2(empno int unsigned,ename varchar(15),job varchar(10)) cluster by(empno,ename)",
+		"create table t3(a int primary key, b varchar(20)) compression = 'zstd'",
+		"create table t4(a int primary key, b varchar(20)) compression 'NONE'",
+		"create table t5(a int primary key, b int, c varchar(20)) properties('bloom_filter_columns' = 'b,c', 'ngram_filter_columns' = 'c')",
+		"create table t6(a int primary key, b datetime) ttl = b + interval 30 day",
+		"create table t7(a int primary key, b timestamp) ttl = B + interval 12 hour",
 		"lock tables nation read",
 		"lock tables nation write, supplier read",
 		"unlock tables",
@@ -828,6 +833,12 @@ func TestDdl(t *testing.T) {
 		"drop table tbl_name",           //table not exists in tpch
 		"drop table tpch.tbl_not_exist", //database not exists
 		"drop table db_not_exist.tbl",   //table not exists
+		"create table t3(a int primary key, b varchar(20)) compression = 'zlib'",             //compression algorithm not supported
+		"create table t5(a int primary key, b int) properties('bloom_filter_columns' = 'c')", //filter column not exists
+		"create table t5(a int primary key, b int) properties('ngram_filter_columns' = 'b')", //ngram filter on non string column
+		"create table t6(a int primary key, b datetime) ttl = c + interval 30 day",           //ttl column not exists
+		"create table t6(a int primary key, b int) ttl = b + interval 30 day",                //ttl on non time column
+		"create table t6(a int primary key, b datetime) ttl = b + interval 1 month",          //ttl interval unit not supported
 		"create table t6(empno int unsigned,ename varchar(15) auto_increment) cluster by(empno,ename)",
 		"lock tables t3 read",
 		"lock tables t1 read, t1 write",
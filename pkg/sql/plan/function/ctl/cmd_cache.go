@@ -0,0 +1,124 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
+	"github.com/matrixorigin/matrixone/pkg/vm/process"
+)
+
+type cacheWarmResult struct {
+	Objects int `json:"objects"`
+}
+
+// handleCache handles the cache operations of the current CN.
+//
+// "warm:dbName.tableName" loads the objects of the table into the disk cache
+func handleCache(
+	proc *process.Process,
+	service serviceType,
+	parameter string,
+	_ requestSender,
+) (Result, error) {
+	if service != cn {
+		return Result{}, moerr.NewWrongServiceNoCtx("CN", string(service))
+	}
+
+	op, db, tbl, err := parseCacheParameter(parameter)
+	if err != nil {
+		return Result{}, err
+	}
+
+	switch op {
+	case "warm":
+		n, err := warmTableCache(proc, db, tbl)
+		if err != nil {
+			return Result{}, err
+		}
+		return Result{
+			Method: CacheMethod,
+			Data:   cacheWarmResult{Objects: n},
+		}, nil
+	}
+
+	return Result{}, moerr.NewInvalidArgNoCtx("cache", parameter)
+}
+
+func parseCacheParameter(parameter string) (op string, db string, tbl string, err error) {
+	op, arg, ok := strings.Cut(parameter, ":")
+	op = strings.ToLower(strings.TrimSpace(op))
+	if !ok || op != "warm" {
+		return "", "", "", moerr.NewInvalidArgNoCtx("cache", parameter)
+	}
+	db, tbl, ok = strings.Cut(strings.TrimSpace(arg), ".")
+	if !ok || db == "" || tbl == "" {
+		return "", "", "", moerr.NewInvalidArgNoCtx("dbName.tableName", arg)
+	}
+	return op, db, tbl, nil
+}
+
+// warmTableCache prefetches the objects of the table visible to the txn,
+// returns the number of objects.
+func warmTableCache(proc *process.Process, db string, tbl string) (int, error) {
+	txnOp := proc.GetTxnOperator()
+	if txnOp == nil {
+		return 0, moerr.NewInternalError(proc.Ctx, "handleCache: txn operator is nil")
+	}
+
+	database, err := proc.GetSessionInfo().StorageEngine.Database(proc.Ctx, db, txnOp)
+	if err != nil {
+		return 0, err
+	}
+	rel, err := database.Relation(proc.Ctx, tbl, nil)
+	if err != nil {
+		return 0, err
+	}
+	ranges, err := rel.Ranges(proc.Ctx, nil, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	fs, err := fileservice.Get[fileservice.FileService](
+		proc.GetFileService(),
+		defines.SharedFileServiceName,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	objects := make(map[string]struct{})
+	for i := 0; i < ranges.Len(); i++ {
+		if engine.IsMemtable(ranges.GetBytes(i)) {
+			continue
+		}
+		blk := objectio.DecodeBlockInfo(ranges.GetBytes(i))
+		name := blk.MetaLocation().Name().String()
+		if _, ok := objects[name]; ok {
+			continue
+		}
+		objects[name] = struct{}{}
+		if err := fs.PrefetchFile(proc.Ctx, name); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(objects), nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCacheParameter(t *testing.T) {
+	op, db, tbl, err := parseCacheParameter("warm:db1.t1")
+	require.NoError(t, err)
+	require.Equal(t, "warm", op)
+	require.Equal(t, "db1", db)
+	require.Equal(t, "t1", tbl)
+
+	op, _, _, err = parseCacheParameter("WARM: db1.t1")
+	require.NoError(t, err)
+	require.Equal(t, "warm", op)
+
+	for _, c := range []string{
+		"",
+		"warm",
+		"warm:",
+		"warm:db1",
+		"warm:.t1",
+		"warm:db1.",
+		"cool:db1.t1",
+	} {
+		_, _, _, err = parseCacheParameter(c)
+		require.Error(t, err, c)
+	}
+}
+
+func TestHandleCacheWrongService(t *testing.T) {
+	_, err := handleCache(nil, tn, "warm:db1.t1", nil)
+	require.Error(t, err)
+}
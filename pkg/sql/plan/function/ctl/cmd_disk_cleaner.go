@@ -15,6 +15,8 @@
 package ctl
 
 import (
+	"strings"
+
 	"github.com/fagongzi/util/protoc"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
@@ -22,11 +24,18 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	gc "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/gc/v1"
 	"github.com/matrixorigin/matrixone/pkg/vm/process"
-	"strings"
 )
 
 func IsValidArg(parameter string, proc *process.Process) (*db.DiskCleaner, error) {
 	parameters := strings.Split(parameter, ".")
+	if parameters[0] == gc.DryRun {
+		if len(parameters) != 1 {
+			return nil, moerr.NewInternalError(proc.Ctx, "handleDiskCleaner: invalid argument!")
+		}
+		return &db.DiskCleaner{
+			Op: gc.DryRun,
+		}, nil
+	}
 	if len(parameters) > 3 || len(parameters) < 2 {
 		return nil, moerr.NewInternalError(proc.Ctx, "handleDiskCleaner: invalid argument!")
 	}
@@ -0,0 +1,37 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ctl
+
+import (
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	gc "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/gc/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiskCleanerDryRunArg(t *testing.T) {
+	proc := testutil.NewProcess()
+	arg, err := IsValidArg(gc.DryRun, proc)
+	require.NoError(t, err)
+	require.Equal(t, gc.DryRun, arg.Op)
+
+	_, err = IsValidArg(gc.DryRun+".ttl", proc)
+	require.Error(t, err)
+
+	arg, err = IsValidArg("add_checker.ttl.2h", proc)
+	require.NoError(t, err)
+	require.Equal(t, "2h", arg.Value)
+}
@@ -47,6 +47,7 @@ var (
 	InterceptCommitMethod  = "INTERCEPTCOMMIT"
 	MergeObjectsMethod     = "MERGEOBJECTS"
 	DiskCleanerMethod      = "DISKCLEANER"
+	CacheMethod            = "CACHE"
 
 	GetProtocolVersionMethod = "GETPROTOCOLVERSION"
 	SetProtocolVersionMethod = "SETPROTOCOLVERSION"
@@ -89,6 +90,7 @@ var (
 		InterceptCommitMethod:  handleInterceptCommit(),
 		MergeObjectsMethod:     handleCNMerge,
 		DiskCleanerMethod:      handleDiskCleaner(),
+		CacheMethod:            handleCache,
 
 		GetProtocolVersionMethod: handleGetProtocolVersion,
 		SetProtocolVersionMethod: handleSetProtocolVersion,
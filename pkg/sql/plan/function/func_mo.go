@@ -577,6 +577,7 @@ var (
 		"mo_stages":                   0,
 		"mo_snapshots":                0,
 		"mo_pitr":                     0,
+		catalog.MO_STATISTICS_EXT:     0,
 	}
 )
 
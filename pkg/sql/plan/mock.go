@@ -364,6 +364,16 @@ func NewMockCompilerContext(isDml bool) *MockCompilerContext {
 			{"creator", types.T_int32, false, 50, 0},
 			{"owner", types.T_int32, false, 50, 0},
 			{"default_role", types.T_int32, false, 50, 0},
+			{"password_last_changed", types.T_timestamp, true, 0, 0},
+			{"password_expired", types.T_bool, true, 0, 0},
+			{"password_lifetime", types.T_int32, true, 50, 0},
+			{"password_reuse_history", types.T_int32, true, 50, 0},
+			{"password_reuse_time", types.T_int32, true, 50, 0},
+			{"password_history", types.T_text, true, 0, 0},
+			{"failed_login_attempts", types.T_int32, true, 50, 0},
+			{"password_lock_time", types.T_int32, true, 50, 0},
+			{"failed_login_count", types.T_int32, true, 50, 0},
+			{"locked_time", types.T_timestamp, true, 0, 0},
 			{catalog.Row_ID, types.T_Rowid, false, 16, 0},
 		},
 		pks: []int{0},
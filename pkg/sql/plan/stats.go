@@ -60,11 +60,14 @@ const (
 
 type StatsCache struct {
 	cache map[uint64]*pb.StatsInfo
+	// extStats is the extended statistics of the tables, see ExtendedStats
+	extStats map[uint64][]*ExtendedStats
 }
 
 func NewStatsCache() *StatsCache {
 	return &StatsCache{
-		cache: make(map[uint64]*pb.StatsInfo, statsCacheInitSize),
+		cache:    make(map[uint64]*pb.StatsInfo, statsCacheInitSize),
+		extStats: make(map[uint64][]*ExtendedStats),
 	}
 }
 
@@ -79,6 +82,7 @@ func (sc *StatsCache) GetStatsInfo(tableID uint64, create bool) *pb.StatsInfo {
 	if create {
 		if len(sc.cache) > statsCacheMaxSize {
 			sc.cache = make(map[uint64]*pb.StatsInfo, statsCacheInitSize)
+			sc.extStats = make(map[uint64][]*ExtendedStats)
 			logutil.Infof("statscache entries more than %v in long session, release memory and create new cachepool", statsCacheMaxSize)
 		}
 		s := NewStatsInfo()
@@ -235,10 +239,7 @@ func isHighNdvCols(cols []int32, tableDef *TableDef, builder *QueryBuilder) bool
 	if s == nil {
 		return false
 	}
-	var totalNDV float64 = 1
-	for i := range cols {
-		totalNDV *= s.NdvMap[tableDef.Cols[cols[i]].Name]
-	}
+	totalNDV := builder.getColsNDV(cols, tableDef, s.NdvMap)
 	return totalNDV > s.TableCnt*highNDVcolumnThreshHold
 }
 
@@ -255,10 +256,7 @@ func (builder *QueryBuilder) getColNDVRatio(cols []int32, tableDef *TableDef) fl
 	if s == nil {
 		return 0
 	}
-	var totalNDV float64 = 1
-	for i := range cols {
-		totalNDV *= s.NdvMap[tableDef.Cols[cols[i]].Name]
-	}
+	totalNDV := builder.getColsNDV(cols, tableDef, s.NdvMap)
 	result := totalNDV / s.TableCnt
 	if result > 1 {
 		result = 1
@@ -513,6 +511,10 @@ func estimateExprSelectivity(expr *plan.Expr, builder *QueryBuilder) float64 {
 		case ">", "<", ">=", "<=", "between":
 			ret = estimateNonEqualitySelectivity(expr, funcName, builder)
 		case "and":
+			if sel, ok := estimateAndSelectivityByExtStats(expr, builder); ok {
+				ret = sel
+				break
+			}
 			ret = estimateExprSelectivity(exprImpl.F.Args[0], builder)
 			if len(exprImpl.F.Args) == 2 {
 				sel2 := estimateExprSelectivity(exprImpl.F.Args[1], builder)
@@ -769,6 +771,7 @@ func ReCalcNodeStats(nodeID int32, builder *QueryBuilder, recursive bool, leafNo
 					outcnt *= ndv
 				}
 			}
+			outcnt = estimateGroupByNDVByExtStats(node.GroupBy, outcnt, builder)
 			if outcnt > incnt {
 				outcnt = math.Min(incnt, outcnt*math.Pow(childStats.Selectivity, 0.8))
 			}
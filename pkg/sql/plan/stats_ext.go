@@ -0,0 +1,334 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"sort"
+
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	"github.com/matrixorigin/matrixone/pkg/sql/colexec"
+)
+
+const (
+	ExtStatsKindNDistinct    = "ndistinct"
+	ExtStatsKindDependencies = "dependencies"
+
+	// ExtStatsMaxColumns is the max number of columns an extended statistics object can cover.
+	ExtStatsMaxColumns = 8
+)
+
+// ExtendedStats is a multi-column statistics object built by CREATE STATISTICS.
+// single column statistics assume the columns are independent, which badly
+// underestimates the cardinality of filters and group by on correlated columns,
+// like (country, city).
+type ExtendedStats struct {
+	Name    string
+	Columns []string
+	// NDistinct is the number of distinct groups of all the Columns,
+	// 0 if ndistinct is not built.
+	NDistinct float64
+	// Dependencies are the functional dependencies between pairs of Columns.
+	Dependencies []*FuncDependency
+}
+
+// FuncDependency is the functional dependency From => To.
+// Degree is the fraction of rows in which the value of From determines
+// the value of To, 1 means To is fully determined by From.
+type FuncDependency struct {
+	From   string  `json:"from"`
+	To     string  `json:"to"`
+	Degree float64 `json:"degree"`
+}
+
+func (s *ExtendedStats) coveredBy(cols map[string]float64) bool {
+	if len(s.Columns) == 0 {
+		return false
+	}
+	for _, col := range s.Columns {
+		if _, ok := cols[col]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// GetExtendedStats returns the extended statistics of the table.
+func (sc *StatsCache) GetExtendedStats(tableID uint64) []*ExtendedStats {
+	if sc == nil {
+		return nil
+	}
+	return sc.extStats[tableID]
+}
+
+// ExtendedStatsLoaded reports whether the extended statistics of the table,
+// none included, have been loaded into the cache.
+func (sc *StatsCache) ExtendedStatsLoaded(tableID uint64) bool {
+	if sc == nil {
+		return false
+	}
+	_, ok := sc.extStats[tableID]
+	return ok
+}
+
+// SetExtendedStats updates the extended statistics of the table in the cache.
+// A table without extended statistics is kept too, so that it is not loaded
+// again.
+func (sc *StatsCache) SetExtendedStats(tableID uint64, stats []*ExtendedStats) {
+	if sc == nil {
+		return
+	}
+	sc.extStats[tableID] = stats
+}
+
+func (builder *QueryBuilder) getExtendedStatsByTableID(tableID uint64) []*ExtendedStats {
+	if builder == nil {
+		return nil
+	}
+	return builder.compCtx.GetStatsCache().GetExtendedStats(tableID)
+}
+
+// getGroupNDVByExtStats returns the number of distinct groups of cols,
+// whose single column ndv is in ndvs. It starts from the product of the
+// single column ndv, and replaces the product of the columns covered by an
+// extended statistics object with its group ndv.
+func getGroupNDVByExtStats(extStats []*ExtendedStats, ndvs map[string]float64) float64 {
+	total := 1.0
+	for _, ndv := range ndvs {
+		if ndv > 1 {
+			total *= ndv
+		}
+	}
+	if len(extStats) == 0 || len(ndvs) < 2 {
+		return total
+	}
+
+	// use the statistics covering more columns first,
+	// and do not let one column be corrected twice.
+	candidates := make([]*ExtendedStats, 0, len(extStats))
+	for _, s := range extStats {
+		if s.NDistinct > 0 && s.coveredBy(ndvs) {
+			candidates = append(candidates, s)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return len(candidates[i].Columns) > len(candidates[j].Columns)
+	})
+	used := make(map[string]bool, len(ndvs))
+	for _, s := range candidates {
+		product := 1.0
+		overlapped := false
+		for _, col := range s.Columns {
+			if used[col] {
+				overlapped = true
+				break
+			}
+			if ndvs[col] > 1 {
+				product *= ndvs[col]
+			}
+		}
+		if overlapped || s.NDistinct >= product {
+			continue
+		}
+		for _, col := range s.Columns {
+			used[col] = true
+		}
+		total = total / product * s.NDistinct
+	}
+	return total
+}
+
+// getColsNDV returns the ndv of the group of cols of the table,
+// corrected by the extended statistics if there are any.
+func (builder *QueryBuilder) getColsNDV(cols []int32, tableDef *TableDef, ndvMap map[string]float64) float64 {
+	totalNDV := 1.0
+	ndvs := make(map[string]float64, len(cols))
+	for i := range cols {
+		name := tableDef.Cols[cols[i]].Name
+		totalNDV *= ndvMap[name]
+		ndvs[name] = ndvMap[name]
+	}
+	// keep the product for duplicate columns or columns without stats
+	if len(ndvs) < len(cols) || totalNDV < 1 {
+		return totalNDV
+	}
+	extStats := builder.getExtendedStatsByTableID(tableDef.TblId)
+	if len(extStats) == 0 {
+		return totalNDV
+	}
+	return getGroupNDVByExtStats(extStats, ndvs)
+}
+
+// estimateGroupByNDVByExtStats corrects outcnt, the product of the ndv of the
+// group by expressions, for the group by columns of the same table covered by
+// extended statistics.
+func estimateGroupByNDVByExtStats(groupBy []*plan.Expr, outcnt float64, builder *QueryBuilder) float64 {
+	if builder == nil {
+		return outcnt
+	}
+	tables := make(map[int32]map[string]float64)
+	for _, expr := range groupBy {
+		col, ok := expr.Expr.(*plan.Expr_Col)
+		if !ok || expr.Ndv <= 1 {
+			continue
+		}
+		tableDef, ok := builder.tag2Table[col.Col.RelPos]
+		if !ok {
+			continue
+		}
+		if tables[col.Col.RelPos] == nil {
+			tables[col.Col.RelPos] = make(map[string]float64)
+		}
+		tables[col.Col.RelPos][tableDef.Cols[col.Col.ColPos].Name] = expr.Ndv
+	}
+	for relPos, ndvs := range tables {
+		if len(ndvs) < 2 {
+			continue
+		}
+		extStats := builder.getExtendedStatsByTableID(builder.tag2Table[relPos].TblId)
+		if len(extStats) == 0 {
+			continue
+		}
+		product := 1.0
+		for _, ndv := range ndvs {
+			product *= ndv
+		}
+		outcnt = outcnt / product * getGroupNDVByExtStats(extStats, ndvs)
+	}
+	return outcnt
+}
+
+// getEqualityColRef returns the column of the filter col = const.
+func getEqualityColRef(expr *plan.Expr) *ColRef {
+	f, ok := expr.Expr.(*plan.Expr_F)
+	if !ok || f.F.Func.ObjName != "=" || len(f.F.Args) != 2 {
+		return nil
+	}
+	col, ok := f.F.Args[0].Expr.(*plan.Expr_Col)
+	if !ok {
+		return nil
+	}
+	if extractColRefInFilter(expr) == nil {
+		return nil
+	}
+	return col.Col
+}
+
+// applyFuncDependencies returns the selectivity of the conjunction of equality
+// filters, whose single column selectivities are in sels. For the strongest
+// dependency a => b with degree d, it estimates
+//
+//	P(a = x and b = y) = P(a = x) * (d + (1 - d) * P(b = y))
+//
+// the columns on the right side of a dependency can not be used to imply others.
+// ok is false if no dependency can be applied.
+func applyFuncDependencies(extStats []*ExtendedStats, sels map[string]float64) (float64, bool) {
+	var deps []*FuncDependency
+	for _, s := range extStats {
+		for _, dep := range s.Dependencies {
+			if dep.Degree <= 0 || dep.From == dep.To {
+				continue
+			}
+			if _, ok := sels[dep.From]; !ok {
+				continue
+			}
+			if _, ok := sels[dep.To]; !ok {
+				continue
+			}
+			deps = append(deps, dep)
+		}
+	}
+	if len(deps) == 0 {
+		return 1, false
+	}
+	sort.SliceStable(deps, func(i, j int) bool {
+		return deps[i].Degree > deps[j].Degree
+	})
+
+	implied := make(map[string]float64, len(sels))
+	determinant := make(map[string]bool, len(sels))
+	for _, dep := range deps {
+		if _, ok := implied[dep.To]; ok {
+			continue
+		}
+		if _, ok := implied[dep.From]; ok {
+			continue
+		}
+		if determinant[dep.To] {
+			continue
+		}
+		implied[dep.To] = dep.Degree
+		determinant[dep.From] = true
+	}
+
+	ret := 1.0
+	for col, sel := range sels {
+		if degree, ok := implied[col]; ok {
+			ret *= degree + (1-degree)*sel
+		} else {
+			ret *= sel
+		}
+	}
+	return ret, true
+}
+
+// estimateAndSelectivityByExtStats estimates the selectivity of the conjunctive
+// filter expr with the functional dependencies of the extended statistics.
+// ok is false if there are no extended statistics for the filter.
+func estimateAndSelectivityByExtStats(expr *plan.Expr, builder *QueryBuilder) (float64, bool) {
+	if builder == nil {
+		return 1, false
+	}
+	conjuncts := colexec.SplitAndExprs([]*plan.Expr{expr})
+	if len(conjuncts) < 2 {
+		return 1, false
+	}
+
+	var tableDef *TableDef
+	var sels map[string]float64
+	var others []*plan.Expr
+	for _, conjunct := range conjuncts {
+		col := getEqualityColRef(conjunct)
+		if col == nil {
+			others = append(others, conjunct)
+			continue
+		}
+		def, ok := builder.tag2Table[col.RelPos]
+		if !ok || (tableDef != nil && def != tableDef) {
+			others = append(others, conjunct)
+			continue
+		}
+		if tableDef == nil {
+			tableDef = def
+			sels = make(map[string]float64)
+		}
+		name := def.Cols[col.ColPos].Name
+		if _, ok = sels[name]; ok {
+			others = append(others, conjunct)
+			continue
+		}
+		sels[name] = estimateExprSelectivity(conjunct, builder)
+	}
+	if len(sels) < 2 {
+		return 1, false
+	}
+	ret, ok := applyFuncDependencies(builder.getExtendedStatsByTableID(tableDef.TblId), sels)
+	if !ok {
+		return 1, false
+	}
+	for _, other := range others {
+		ret = andSelectivity(ret, estimateExprSelectivity(other, builder))
+	}
+	return ret, true
+}
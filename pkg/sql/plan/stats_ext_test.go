@@ -0,0 +1,96 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatsCacheExtendedStats(t *testing.T) {
+	sc := NewStatsCache()
+	require.Nil(t, sc.GetExtendedStats(1))
+	require.False(t, sc.ExtendedStatsLoaded(1))
+
+	stats := []*ExtendedStats{{Name: "s1", Columns: []string{"a", "b"}, NDistinct: 10}}
+	sc.SetExtendedStats(1, stats)
+	require.Equal(t, stats, sc.GetExtendedStats(1))
+
+	sc.SetExtendedStats(1, nil)
+	require.Nil(t, sc.GetExtendedStats(1))
+	require.True(t, sc.ExtendedStatsLoaded(1))
+
+	var nilCache *StatsCache
+	nilCache.SetExtendedStats(1, stats)
+	require.Nil(t, nilCache.GetExtendedStats(1))
+	require.False(t, nilCache.ExtendedStatsLoaded(1))
+}
+
+func TestGetGroupNDVByExtStats(t *testing.T) {
+	ndvs := map[string]float64{"country": 200, "city": 10000, "age": 100}
+
+	// no extended statistics, the columns are assumed to be independent
+	require.Equal(t, float64(200*10000*100), getGroupNDVByExtStats(nil, ndvs))
+
+	extStats := []*ExtendedStats{
+		{Name: "s1", Columns: []string{"country", "city"}, NDistinct: 12000},
+	}
+	require.Equal(t, float64(12000*100), getGroupNDVByExtStats(extStats, ndvs))
+
+	// the statistics is not used if it does not cover the columns
+	require.Equal(t, float64(200*100), getGroupNDVByExtStats(extStats, map[string]float64{"country": 200, "age": 100}))
+
+	// the statistics covering more columns is preferred
+	extStats = append(extStats, &ExtendedStats{Name: "s2", Columns: []string{"country", "city", "age"}, NDistinct: 50000})
+	require.Equal(t, float64(50000), getGroupNDVByExtStats(extStats, ndvs))
+
+	// a group ndv larger than the product is useless
+	extStats = []*ExtendedStats{
+		{Name: "s1", Columns: []string{"country", "city"}, NDistinct: 1e10},
+	}
+	require.Equal(t, float64(200*10000*100), getGroupNDVByExtStats(extStats, ndvs))
+}
+
+func TestApplyFuncDependencies(t *testing.T) {
+	sels := map[string]float64{"country": 0.01, "city": 0.001}
+
+	_, ok := applyFuncDependencies(nil, sels)
+	require.False(t, ok)
+
+	extStats := []*ExtendedStats{
+		{
+			Name:    "s1",
+			Columns: []string{"country", "city"},
+			Dependencies: []*FuncDependency{
+				{From: "city", To: "country", Degree: 1},
+				{From: "country", To: "city", Degree: 0.1},
+			},
+		},
+	}
+	// city => country fully, so the filter on country is redundant
+	sel, ok := applyFuncDependencies(extStats, sels)
+	require.True(t, ok)
+	require.InDelta(t, 0.001, sel, 1e-9)
+
+	extStats[0].Dependencies[0].Degree = 0.5
+	sel, ok = applyFuncDependencies(extStats, sels)
+	require.True(t, ok)
+	require.InDelta(t, 0.001*(0.5+0.5*0.01), sel, 1e-9)
+
+	// dependencies on columns without filters are ignored
+	_, ok = applyFuncDependencies(extStats, map[string]float64{"country": 0.01, "age": 0.1})
+	require.False(t, ok)
+}
@@ -345,3 +345,89 @@ func (builder *QueryBuilder) buildMoCache(tbl *tree.TableFunction, ctx *BindCont
 	}
 	return builder.appendNode(node, ctx), err
 }
+
+var MoCNScalingColNames = []string{
+	"labels",
+	"cns",
+	"cpu_usage",
+	"memory_usage",
+	"active_sessions",
+	"active_queries",
+	"action",
+	"count",
+	"reason",
+	"drain",
+}
+
+var MoCNScalingColTypes = []types.Type{
+	types.New(types.T_varchar, types.MaxVarcharLen, 0),
+	types.New(types.T_varchar, types.MaxVarcharLen, 0),
+	types.New(types.T_float64, 0, 0),
+	types.New(types.T_float64, 0, 0),
+	types.New(types.T_uint64, 0, 0),
+	types.New(types.T_uint64, 0, 0),
+	types.New(types.T_varchar, types.MaxVarcharLen, 0),
+	types.New(types.T_uint64, 0, 0),
+	types.New(types.T_varchar, types.MaxVarcharLen, 0),
+	types.New(types.T_varchar, types.MaxVarcharLen, 0),
+}
+
+var MoCNScalingColName2Index = map[string]int32{
+	"labels":          0,
+	"cns":             1,
+	"cpu_usage":       2,
+	"memory_usage":    3,
+	"active_sessions": 4,
+	"active_queries":  5,
+	"action":          6,
+	"count":           7,
+	"reason":          8,
+	"drain":           9,
+}
+
+type MoCNScalingColType int32
+
+const (
+	MoCNScalingColTypeLabels = iota
+	MoCNScalingColTypeCNs
+	MoCNScalingColTypeCPUUsage
+	MoCNScalingColTypeMemoryUsage
+	MoCNScalingColTypeActiveSessions
+	MoCNScalingColTypeActiveQueries
+	MoCNScalingColTypeAction
+	MoCNScalingColTypeCount
+	MoCNScalingColTypeReason
+	MoCNScalingColTypeDrain
+)
+
+func (builder *QueryBuilder) buildMoCNScaling(tbl *tree.TableFunction, ctx *BindContext, exprs []*plan.Expr, childId int32) (int32, error) {
+	var err error
+
+	colDefs := make([]*plan.ColDef, 0, len(MoCNScalingColNames))
+
+	for i, name := range MoCNScalingColNames {
+		colDefs = append(colDefs, &plan.ColDef{
+			Name: name,
+			Typ: plan.Type{
+				Id:    int32(MoCNScalingColTypes[i].Oid),
+				Width: MoCNScalingColTypes[i].Width,
+			},
+		})
+	}
+
+	node := &plan.Node{
+		NodeType: plan.Node_FUNCTION_SCAN,
+		Stats:    &plan.Stats{},
+		TableDef: &plan.TableDef{
+			TableType: "func_table",
+			TblFunc: &plan.TableFunction{
+				Name: "mo_cn_scaling",
+			},
+			Cols: colDefs,
+		},
+		BindingTags:     []int32{builder.genNewTag()},
+		Children:        []int32{childId},
+		TblFuncExprList: exprs,
+	}
+	return builder.appendNode(node, ctx), err
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/lockservice"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
@@ -91,6 +92,9 @@ type Config struct {
 	// ServiceHost is the host name/IP for the service address of RPC request. There is
 	// no port value in it.
 	ServiceHost string `toml:"service-host" user_setting:"basic"`
+	// Locality is the failure domain labels of the tn store, such as
+	// zone = "az-1" and rack = "r1".
+	Locality map[string]string `toml:"locality"`
 
 	// HAKeeper configuration
 	HAKeeper struct {
@@ -129,6 +133,7 @@ type Config struct {
 		ScanGCInterval toml.Duration `toml:"scan-gc-interval"`
 		DisableGC      bool          `toml:"disable-gc"`
 		CheckGC        bool          `toml:"check-gc"`
+		ScrubInterval  toml.Duration `toml:"scrub-interval"`
 	}
 
 	Merge struct {
@@ -138,6 +143,23 @@ type Config struct {
 		CNMergeMemHint   toml.ByteSize `toml:"offload-mem-hint"`
 	}
 
+	// Wal configuration of the WAL appended into logservice
+	Wal struct {
+		// Compression is the compression algorithm of the WAL records. [none|lz4|zstd],
+		// default none.
+		Compression string `toml:"compression"`
+		// CompressionMinSize is the min size of the WAL records to compress.
+		CompressionMinSize toml.ByteSize `toml:"compression-min-size"`
+		// GroupCommitLatency is the max duration a commit waits to be appended with the
+		// concurrent ones, group commit is disabled if it's 0.
+		GroupCommitLatency toml.Duration `toml:"group-commit-latency"`
+		// GroupCommitSize stops waiting once the waiting commits are this large.
+		GroupCommitSize toml.ByteSize `toml:"group-commit-size"`
+		// TailInterval is the interval a hot-standby replica reads the WAL appended by
+		// the working replica.
+		TailInterval toml.Duration `toml:"tail-interval"`
+	}
+
 	LogtailServer struct {
 		ListenAddress              string        `toml:"listen-address"`
 		ServiceAddress             string        `toml:"service-address"`
@@ -258,6 +280,11 @@ func (c *Config) Validate() error {
 	if c.Ckp.ReservedWALEntryCount == 0 {
 		c.Ckp.ReservedWALEntryCount = defaultReservedWALEntryCount
 	}
+	if c.Wal.Compression != "" {
+		if _, ok := compress.Algorithms[c.Wal.Compression]; !ok {
+			return moerr.NewInternalError(context.Background(), "invalid wal compression %s", c.Wal.Compression)
+		}
+	}
 
 	if c.LogtailServer.ListenAddress == "" {
 		c.LogtailServer.ListenAddress = defaultLogtailListenAddress
@@ -72,7 +72,7 @@ func (s *store) createTxnStorage(ctx context.Context, shard metadata.TNShard) (s
 		return s.newMemKVStorage(shard, logClient)
 
 	case StorageTAE:
-		ts, err := s.newTAEStorage(ctx, shard, factory)
+		ts, err := s.newTAEStorage(ctx, shard, factory, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -86,7 +86,7 @@ func (s *store) createLogServiceClient(shard metadata.TNShard) (logservice.Clien
 	if s.options.logServiceClientFactory != nil {
 		return s.options.logServiceClientFactory(shard)
 	}
-	return s.newLogServiceClient(shard)
+	return s.newLogServiceClient(shard, false)
 }
 
 func (s *store) createLogServiceClientFactroy(shard metadata.TNShard) logservice.ClientFactory {
@@ -95,11 +95,26 @@ func (s *store) createLogServiceClientFactroy(shard metadata.TNShard) logservice
 	}
 }
 
-func (s *store) newLogServiceClient(shard metadata.TNShard) (logservice.Client, error) {
+// createStandbyTxnStorage creates the storage of a hot-standby replica, it
+// tails the WAL with read-only log clients until it's promoted.
+func (s *store) createStandbyTxnStorage(ctx context.Context, shard metadata.TNShard) (storage.TxnStorage, error) {
+	if s.cfg.Txn.Storage.Backend != StorageTAE {
+		return nil, moerr.NewNotSupported(ctx, "standby replica of %s", s.cfg.Txn.Storage.Backend)
+	}
+	readOnlyFactory := func() (logservice.Client, error) {
+		if s.options.logServiceClientFactory != nil {
+			return s.options.logServiceClientFactory(shard)
+		}
+		return s.newLogServiceClient(shard, true)
+	}
+	return s.newTAEStorage(ctx, shard, s.createLogServiceClientFactroy(shard), readOnlyFactory)
+}
+
+func (s *store) newLogServiceClient(shard metadata.TNShard, readOnly bool) (logservice.Client, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.LogService.ConnectTimeout.Duration)
 	defer cancel()
 	return logservice.NewClient(ctx, s.cfg.UUID, logservice.ClientConfig{
-		ReadOnly:         false,
+		ReadOnly:         readOnly,
 		LogShardID:       shard.LogShardID,
 		TNReplicaID:      shard.ReplicaID,
 		ServiceAddresses: s.cfg.HAKeeper.ClientConfig.ServiceAddresses,
@@ -129,7 +144,12 @@ func (s *store) newMemKVStorage(shard metadata.TNShard, logClient logservice.Cli
 	return mem.NewKVTxnStorage(0, logClient, s.rt.Clock()), nil
 }
 
-func (s *store) newTAEStorage(ctx context.Context, shard metadata.TNShard, factory logservice.ClientFactory) (storage.TxnStorage, error) {
+func (s *store) newTAEStorage(
+	ctx context.Context,
+	shard metadata.TNShard,
+	factory logservice.ClientFactory,
+	standbyFactory logservice.ClientFactory,
+) (storage.TxnStorage, error) {
 	// use s3 as main fs
 	fs, err := fileservice.Get[fileservice.FileService](s.fileService, defines.SharedFileServiceName)
 	if err != nil {
@@ -158,6 +178,7 @@ func (s *store) newTAEStorage(ctx context.Context, shard metadata.TNShard, facto
 		ScanGCInterval: s.cfg.GCCfg.ScanGCInterval.Duration,
 		DisableGC:      s.cfg.GCCfg.DisableGC,
 		CheckGC:        s.cfg.GCCfg.CheckGC,
+		ScrubInterval:  s.cfg.GCCfg.ScrubInterval.Duration,
 	}
 
 	mergeCfg := &options.MergeConfig{
@@ -167,6 +188,14 @@ func (s *store) newTAEStorage(ctx context.Context, shard metadata.TNShard, facto
 		CNStandaloneTake:      s.cfg.Merge.CNStandaloneTake,
 	}
 
+	walCfg := &options.WalCfg{
+		Compression:        s.cfg.Wal.Compression,
+		CompressionMinSize: int(s.cfg.Wal.CompressionMinSize),
+		GroupCommitLatency: s.cfg.Wal.GroupCommitLatency.Duration,
+		GroupCommitSize:    int(s.cfg.Wal.GroupCommitSize),
+		TailInterval:       s.cfg.Wal.TailInterval.Duration,
+	}
+
 	logtailServerAddr := s.logtailServiceListenAddr()
 	logtailServerCfg := &options.LogtailServerCfg{
 		RpcMaxMessageSize:      int64(s.cfg.LogtailServer.RpcMaxMessageSize),
@@ -191,6 +220,7 @@ func (s *store) newTAEStorage(ctx context.Context, shard metadata.TNShard, facto
 		CheckpointCfg:     ckpcfg,
 		GCCfg:             gcCfg,
 		MergeCfg:          mergeCfg,
+		WalCfg:            walCfg,
 		LogStoreT:         options.LogstoreLogservice,
 		IncrementalDedup:  s.cfg.Txn.IncrementalDedup == "true",
 		IsStandalone:      s.cfg.InStandalone,
@@ -199,6 +229,9 @@ func (s *store) newTAEStorage(ctx context.Context, shard metadata.TNShard, facto
 		TaskServiceGetter: s.GetTaskService,
 		SID:               s.cfg.UUID,
 	}
+	if standbyFactory != nil {
+		opt.StandbyLc = logservicedriver.LogServiceClientFactory(standbyFactory)
+	}
 
 	return taestorage.NewTAEStorage(
 		ctx,
@@ -0,0 +1,212 @@
+// Copyright 2021 - 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tnservice
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/log"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	logservicepb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/pb/metadata"
+	"github.com/matrixorigin/matrixone/pkg/txn/service"
+	"github.com/matrixorigin/matrixone/pkg/txn/storage"
+	"github.com/matrixorigin/matrixone/pkg/txn/util"
+	"go.uber.org/zap"
+)
+
+// standbyStorage is a TxnStorage which tails the WAL of the working replica
+// until it's promoted.
+type standbyStorage interface {
+	storage.TxnStorage
+	Promote() error
+}
+
+// standbyReplica is a hot-standby replica of a tn shard. It doesn't serve
+// any txn request until promoted by HAKeeper.
+type standbyReplica struct {
+	logger *log.MOLogger
+	shard  metadata.TNShard
+
+	mu struct {
+		sync.Mutex
+		storage   standbyStorage
+		closed    bool
+		promoting bool
+	}
+}
+
+func newStandbyReplica(shard metadata.TNShard, logger *log.MOLogger) *standbyReplica {
+	return &standbyReplica{
+		shard:  shard,
+		logger: logger.With(util.TxnTNShardField(shard)),
+	}
+}
+
+// setStorage returns false if the replica is closed before the storage is
+// created, the caller should close the storage.
+func (r *standbyReplica) setStorage(s standbyStorage) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.mu.closed {
+		return false
+	}
+	r.mu.storage = s
+	return true
+}
+
+// startPromote returns the storage to promote, it's nil if the storage is
+// not created yet.
+func (r *standbyReplica) startPromote() standbyStorage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.mu.closed || r.mu.promoting || r.mu.storage == nil {
+		return nil
+	}
+	r.mu.promoting = true
+	return r.mu.storage
+}
+
+func (r *standbyReplica) close(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.mu.closed || r.mu.promoting {
+		return nil
+	}
+	r.mu.closed = true
+	if r.mu.storage == nil {
+		return nil
+	}
+	return r.mu.storage.Close(ctx)
+}
+
+func (s *store) createStandbyReplica(shard metadata.TNShard) error {
+	if s.getReplica(shard.ShardID) != nil {
+		return moerr.NewInvalidStateNoCtx("tn shard %d is working on the store", shard.ShardID)
+	}
+	r := newStandbyReplica(shard, s.rt.Logger())
+	if _, ok := s.standbys.LoadOrStore(shard.ShardID, r); ok {
+		return nil
+	}
+
+	return s.stopper.RunTask(func(ctx context.Context) {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+				ts, err := s.createStandbyTxnStorage(ctx, shard)
+				if err != nil {
+					r.logger.Error("start standby DNShard failed",
+						zap.Error(err))
+					time.Sleep(retryCreateStorageInterval)
+					continue
+				}
+				if !r.setStorage(ts.(standbyStorage)) {
+					if err := ts.Close(ctx); err != nil {
+						r.logger.Error("close standby DNShard failed",
+							zap.Error(err))
+					}
+					return
+				}
+				r.logger.Info("standby DNShard started")
+				return
+			}
+		}
+	})
+}
+
+func (s *store) removeStandbyReplica(tnShardID uint64) error {
+	if r := s.getStandbyReplica(tnShardID); r != nil {
+		err := r.close(context.Background())
+		s.standbys.Delete(tnShardID)
+		return err
+	}
+	return nil
+}
+
+// promoteStandbyReplica replays the rest of the WAL and starts the txn
+// service of the standby replica. It's done in background since replaying
+// takes a while, HAKeeper sees the replica working once it's reported in
+// the heartbeat.
+func (s *store) promoteStandbyReplica(tnShardID uint64) error {
+	r := s.getStandbyReplica(tnShardID)
+	if r == nil {
+		return moerr.NewInvalidStateNoCtx("no standby of tn shard %d", tnShardID)
+	}
+	ts := r.startPromote()
+	if ts == nil {
+		return moerr.NewInvalidStateNoCtx("standby of tn shard %d is not ready", tnShardID)
+	}
+
+	return s.stopper.RunTask(func(ctx context.Context) {
+		start := time.Now()
+		if err := ts.Promote(); err != nil {
+			r.logger.Error("promote standby DNShard failed",
+				zap.Error(err))
+			s.standbys.Delete(tnShardID)
+			if err := ts.Close(ctx); err != nil {
+				r.logger.Error("close standby DNShard failed",
+					zap.Error(err))
+			}
+			return
+		}
+
+		replica := newReplica(r.shard, s.rt)
+		s.replicas.Store(tnShardID, replica)
+		s.standbys.Delete(tnShardID)
+		s.mu.Lock()
+		s.addTNShardLocked(r.shard)
+		s.mu.Unlock()
+		if err := replica.start(
+			service.NewTxnService(
+				s.cfg.UUID,
+				r.shard,
+				ts,
+				s.sender,
+				s.cfg.Txn.ZombieTimeout.Duration,
+				s.lockTableAllocator,
+			),
+		); err != nil {
+			r.logger.Fatal("start DNShard failed",
+				zap.Error(err))
+		}
+		r.logger.Info("standby DNShard promoted",
+			zap.Duration("cost", time.Since(start)))
+	})
+}
+
+func (s *store) getStandbyReplica(id uint64) *standbyReplica {
+	v, ok := s.standbys.Load(id)
+	if !ok {
+		return nil
+	}
+	return v.(*standbyReplica)
+}
+
+func (s *store) getTNStandbyShardInfo() []logservicepb.TNShardInfo {
+	var shards []logservicepb.TNShardInfo
+	s.standbys.Range(func(_, value any) bool {
+		r := value.(*standbyReplica)
+		shards = append(shards, logservicepb.TNShardInfo{
+			ShardID:   r.shard.ShardID,
+			ReplicaID: r.shard.ReplicaID,
+		})
+		return true
+	})
+	return shards
+}
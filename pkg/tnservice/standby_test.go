@@ -0,0 +1,121 @@
+// Copyright 2021 - 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tnservice
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	logservicepb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/txn/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testStandbyStorage struct {
+	storage.TxnStorage
+	promoted atomic.Bool
+}
+
+func (s *testStandbyStorage) Promote() error {
+	s.promoted.Store(true)
+	return nil
+}
+
+func TestStandbyStorageNotSupported(t *testing.T) {
+	runTNStoreTest(t, func(s *store) {
+		_, err := s.createStandbyTxnStorage(context.Background(), newTestTNShard(1, 2, 3))
+		assert.True(t, moerr.IsMoErrCode(err, moerr.ErrNotSupported))
+	})
+}
+
+func TestPromoteStandbyReplica(t *testing.T) {
+	runTNStoreTest(t, func(s *store) {
+		shard := newTestTNShard(1, 2, 3)
+		ts, err := s.createTxnStorage(context.Background(), shard)
+		require.NoError(t, err)
+		standby := &testStandbyStorage{TxnStorage: ts}
+
+		// not ready before the storage is created
+		r := newStandbyReplica(shard, s.rt.Logger())
+		s.standbys.Store(shard.ShardID, r)
+		assert.Error(t, s.promoteStandbyReplica(shard.ShardID))
+		assert.True(t, r.setStorage(standby))
+		assert.Equal(t,
+			[]logservicepb.TNShardInfo{{ShardID: 1, ReplicaID: 2}},
+			s.getTNStandbyShardInfo())
+		assert.Empty(t, s.getTNShardInfo())
+
+		s.handleCommands([]logservicepb.ScheduleCommand{
+			{
+				ServiceType: logservicepb.TNService,
+				ConfigChange: &logservicepb.ConfigChange{
+					ChangeType: logservicepb.PromoteStandbyReplica,
+					Replica: logservicepb.Replica{
+						LogShardID: 3,
+						ReplicaID:  2,
+						ShardID:    1,
+					},
+				},
+			},
+		})
+
+		for {
+			if rep := s.getReplica(1); rep != nil {
+				rep.waitStarted()
+				break
+			}
+			time.Sleep(time.Millisecond * 10)
+		}
+		assert.True(t, standby.promoted.Load())
+		assert.Nil(t, s.getStandbyReplica(1))
+		assert.Empty(t, s.getTNStandbyShardInfo())
+		assert.Equal(t,
+			[]logservicepb.TNShardInfo{{ShardID: 1, ReplicaID: 2}},
+			s.getTNShardInfo())
+	})
+}
+
+func TestStopStandbyReplica(t *testing.T) {
+	runTNStoreTest(t, func(s *store) {
+		shard := newTestTNShard(1, 2, 3)
+		ts, err := s.createTxnStorage(context.Background(), shard)
+		require.NoError(t, err)
+
+		r := newStandbyReplica(shard, s.rt.Logger())
+		s.standbys.Store(shard.ShardID, r)
+		assert.True(t, r.setStorage(&testStandbyStorage{TxnStorage: ts}))
+
+		s.handleCommands([]logservicepb.ScheduleCommand{
+			{
+				ServiceType: logservicepb.TNService,
+				ConfigChange: &logservicepb.ConfigChange{
+					ChangeType: logservicepb.StopStandbyReplica,
+					Replica: logservicepb.Replica{
+						LogShardID: 3,
+						ReplicaID:  2,
+						ShardID:    1,
+					},
+				},
+			},
+		})
+		assert.Nil(t, s.getStandbyReplica(1))
+		// the storage created after closed is dropped
+		assert.False(t, r.setStorage(&testStandbyStorage{TxnStorage: ts}))
+	})
+}
@@ -109,6 +109,7 @@ type store struct {
 	shardServer         shardservice.ShardServer
 	moCluster           clusterservice.MOCluster
 	replicas            *sync.Map
+	standbys            *sync.Map
 	stopper             *stopper.Stopper
 	shutdownC           chan struct{}
 
@@ -177,6 +178,7 @@ func NewService(
 	}
 	s.registerServices()
 	s.replicas = &sync.Map{}
+	s.standbys = &sync.Map{}
 	s.stopper = stopper.NewStopper("dn-store",
 		stopper.WithLogger(s.rt.Logger().RawLogger()))
 	s.mu.metadata = metadata.TNStore{UUID: cfg.UUID}
@@ -252,6 +254,13 @@ func (s *store) Close() error {
 		}
 		return true
 	})
+	s.standbys.Range(func(_, value any) bool {
+		r := value.(*standbyReplica)
+		if e := r.close(context.Background()); e != nil {
+			err = errors.Join(e, err)
+		}
+		return true
+	})
 	s.task.RLock()
 	ts := s.task.serviceHolder
 	s.task.RUnlock()
@@ -63,11 +63,13 @@ func (s *store) heartbeat(ctx context.Context) {
 		UUID:                 s.cfg.UUID,
 		ServiceAddress:       s.txnServiceServiceAddr(),
 		Shards:               s.getTNShardInfo(),
+		StandbyShards:        s.getTNStandbyShardInfo(),
 		TaskServiceCreated:   s.taskServiceCreated(),
 		LogtailServerAddress: s.logtailServiceServiceAddr(),
 		LockServiceAddress:   s.lockServiceServiceAddr(),
 		ShardServiceAddress:  s.shardServiceServiceAddr(),
 		ConfigData:           s.config.GetData(),
+		Locality:             s.cfg.Locality,
 	}
 
 	if s.queryService != nil {
@@ -97,6 +99,12 @@ func (s *store) handleCommands(cmds []logservicepb.ScheduleCommand) {
 				s.handleAddReplica(cmd)
 			case logservicepb.RemoveReplica, logservicepb.StopReplica:
 				s.handleRemoveReplica(cmd)
+			case logservicepb.StartStandbyReplica:
+				s.handleStartStandbyReplica(cmd)
+			case logservicepb.PromoteStandbyReplica:
+				s.handlePromoteStandbyReplica(cmd)
+			case logservicepb.StopStandbyReplica:
+				s.handleStopStandbyReplica(cmd)
 			}
 		} else if cmd.GetShutdownStore() != nil {
 			s.handleShutdownStore(cmd)
@@ -131,6 +139,31 @@ func (s *store) handleRemoveReplica(cmd logservicepb.ScheduleCommand) {
 	}
 }
 
+func (s *store) handleStartStandbyReplica(cmd logservicepb.ScheduleCommand) {
+	if err := s.createStandbyReplica(metadata.TNShard{
+		TNShardRecord: metadata.TNShardRecord{
+			ShardID:    cmd.ConfigChange.Replica.ShardID,
+			LogShardID: cmd.ConfigChange.Replica.LogShardID,
+		},
+		ReplicaID: cmd.ConfigChange.Replica.ReplicaID,
+		Address:   s.cfg.ServiceAddress,
+	}); err != nil {
+		s.rt.Logger().Error("failed to start standby replica", zap.Error(err))
+	}
+}
+
+func (s *store) handlePromoteStandbyReplica(cmd logservicepb.ScheduleCommand) {
+	if err := s.promoteStandbyReplica(cmd.ConfigChange.Replica.ShardID); err != nil {
+		s.rt.Logger().Error("failed to promote standby replica", zap.Error(err))
+	}
+}
+
+func (s *store) handleStopStandbyReplica(cmd logservicepb.ScheduleCommand) {
+	if err := s.removeStandbyReplica(cmd.ConfigChange.Replica.ShardID); err != nil {
+		s.rt.Logger().Error("failed to stop standby replica", zap.Error(err))
+	}
+}
+
 func (s *store) handleShutdownStore(_ logservicepb.ScheduleCommand) {
 	// notify main routine that have received shutdown cmd
 	select {
@@ -25,6 +25,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/pb/txn"
 	"github.com/matrixorigin/matrixone/pkg/txn/storage"
 	"github.com/matrixorigin/matrixone/pkg/util/status"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/rpchandle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail/service"
@@ -34,6 +35,7 @@ import (
 
 type taeStorage struct {
 	shard         metadata.TNShard
+	tae           *db.DB
 	taeHandler    rpchandle.Handler
 	logtailServer *service.LogtailServer
 }
@@ -67,6 +69,7 @@ func NewTAEStorage(
 
 	return &taeStorage{
 		shard:         shard,
+		tae:           tae,
 		taeHandler:    taeHandler,
 		logtailServer: server,
 	}, nil
@@ -77,6 +80,12 @@ func (s *taeStorage) Start() error {
 	return s.logtailServer.Start()
 }
 
+// Promote makes a hot-standby storage the working one, it must be called
+// before Start.
+func (s *taeStorage) Promote() error {
+	return s.tae.Promote()
+}
+
 // Close implements storage.TxnTAEStorage
 func (s *taeStorage) Close(ctx context.Context) error {
 	return errors.Join(s.logtailServer.Close(), s.taeHandler.HandleClose(ctx))
@@ -119,15 +119,28 @@ func (s *taeStorage) Debug(ctx context.Context,
 		}
 		return resp.Read()
 	case uint32(api.OpCode_OpDiskDiskCleaner):
-		_, err := handleRead(ctx, txnMeta, data, s.taeHandler.HandleDiskCleaner)
+		ret, err := handleRead(ctx, txnMeta, data, s.taeHandler.HandleDiskCleaner)
 		if err != nil {
 			resp := protoc.MustMarshal(&api.TNStringResponse{
 				ReturnStr: "Failed!" + err.Error(),
 			})
 			return resp, err
 		}
+		str := "OK"
+		payload, err := ret.Read()
+		if err != nil {
+			return nil, err
+		}
+		var result db.DiskCleanerResp
+		if err = result.UnmarshalBinary(payload); err != nil {
+			return nil, err
+		}
+		if result.Summary != "" {
+			// the summary of dry run
+			str = result.Summary
+		}
 		resp := protoc.MustMarshal(&api.TNStringResponse{
-			ReturnStr: "OK",
+			ReturnStr: str,
 		})
 		return resp, nil
 	default:
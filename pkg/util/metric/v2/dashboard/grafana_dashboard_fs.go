@@ -31,6 +31,7 @@ func (c *DashboardCreator) initFileServiceDashboard() error {
 		"FileService Metrics",
 		c.withRowOptions(
 			c.initFSOverviewRow(),
+			c.initFSReadAheadRow(),
 			c.initFSObjectStorageRow(),
 			c.initFSIOMergerDurationRow(),
 			c.initFSReadWriteDurationRow(),
@@ -69,6 +70,38 @@ func (c *DashboardCreator) initFSOverviewRow() dashboard.Option {
 	)
 }
 
+func (c *DashboardCreator) initFSReadAheadRow() dashboard.Option {
+	return dashboard.Row(
+		"FileService read ahead",
+		c.withMultiGraph(
+			"Read ahead requests",
+			6,
+			[]string{
+				`sum(rate(` + c.getMetricWithFilter("mo_fs_read_ahead_total", `type="issue"`) + `[$interval]))`,
+				`sum(rate(` + c.getMetricWithFilter("mo_fs_read_ahead_total", `type="skip"`) + `[$interval]))`,
+				`sum(rate(` + c.getMetricWithFilter("mo_fs_read_ahead_total", `type="hit"`) + `[$interval]))`,
+			},
+			[]string{
+				"issue",
+				"skip",
+				"hit",
+			}),
+		c.withMultiGraph(
+			"Read ahead bytes",
+			6,
+			[]string{
+				`sum(rate(` + c.getMetricWithFilter("mo_fs_read_ahead_bytes_total", `type="issue"`) + `[$interval]))`,
+				`sum(rate(` + c.getMetricWithFilter("mo_fs_read_ahead_bytes_total", `type="hit"`) + `[$interval]))`,
+				`sum(rate(` + c.getMetricWithFilter("mo_fs_read_ahead_bytes_total", `type="waste"`) + `[$interval]))`,
+			},
+			[]string{
+				"issue",
+				"hit",
+				"waste",
+			}),
+	)
+}
+
 func (c *DashboardCreator) initFSReadWriteBytesRow() dashboard.Option {
 	return dashboard.Row(
 		"FileService read write bytes",
@@ -33,6 +33,7 @@ func (c *DashboardCreator) initLogTailDashboard() error {
 			c.initLogtailOverviewRow(),
 			c.initLogtailQueueRow(),
 			c.initLogtailBytesRow(),
+			c.initLogtailWALAppendRow(),
 			c.initLogtailLoadCheckpointRow(),
 			c.initLogtailCollectRow(),
 			c.initLogtailTransmitRow(),
@@ -144,6 +145,39 @@ func (c *DashboardCreator) initLogtailBytesRow() dashboard.Option {
 	)
 }
 
+func (c *DashboardCreator) initLogtailWALAppendRow() dashboard.Option {
+	return dashboard.Row(
+		"WAL append",
+		c.getHistogram(
+			"WAL record raw size",
+			c.getMetricWithFilter(`mo_logtail_append_bytes_bucket`, `type="raw"`),
+			[]float64{0.50, 0.8, 0.90, 0.99},
+			3,
+			axis.Unit("bytes"),
+			axis.Min(0)),
+		c.getHistogram(
+			"WAL record compressed size",
+			c.getMetricWithFilter(`mo_logtail_append_bytes_bucket`, `type="compressed"`),
+			[]float64{0.50, 0.8, 0.90, 0.99},
+			3,
+			axis.Unit("bytes"),
+			axis.Min(0)),
+		c.getHistogram(
+			"WAL entries per append",
+			c.getMetricWithFilter(`mo_logtail_append_entries_bucket`, ``),
+			[]float64{0.50, 0.8, 0.90, 0.99},
+			3,
+			axis.Min(0)),
+		c.getHistogram(
+			"Group commit wait duration",
+			c.getMetricWithFilter(`mo_logtail_group_commit_wait_duration_seconds_bucket`, ``),
+			[]float64{0.50, 0.8, 0.90, 0.99},
+			3,
+			axis.Unit("s"),
+			axis.Min(0)),
+	)
+}
+
 func (c *DashboardCreator) initLogtailOverviewRow() dashboard.Option {
 	return dashboard.Row(
 		"Logtail overview",
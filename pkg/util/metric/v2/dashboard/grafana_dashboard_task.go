@@ -213,6 +213,21 @@ func (c *DashboardCreator) initTaskMergeRow() dashboard.Option {
 			[]string{"Size"},
 			timeseries.Axis(tsaxis.Unit("decbytes")),
 		),
+		c.getTimeSeries(
+			"Merge Policy",
+			[]string{
+				fmt.Sprintf(
+					"sum by (policy) (increase(%s[$interval]))",
+					c.getMetricWithFilter(`mo_task_merge_policy_total`, `type="scheduled"`)),
+				fmt.Sprintf(
+					"sum by (policy) (increase(%s[$interval]))",
+					c.getMetricWithFilter(`mo_task_merge_policy_total`, `type="objects"`)),
+			},
+			[]string{
+				"{{ policy }}: scheduled",
+				"{{ policy }}: objects",
+			},
+		),
 	)
 }
 
@@ -49,6 +49,30 @@ var (
 	FSReadHitRemoteCounter = fsReadCounter.WithLabelValues("hit-remote")
 )
 
+var (
+	fsReadAheadCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mo",
+			Subsystem: "fs",
+			Name:      "read_ahead_total",
+			Help:      "Total number of read ahead operations.",
+		}, []string{"type"})
+	FSReadAheadIssueCounter = fsReadAheadCounter.WithLabelValues("issue")
+	FSReadAheadSkipCounter  = fsReadAheadCounter.WithLabelValues("skip")
+	FSReadAheadHitCounter   = fsReadAheadCounter.WithLabelValues("hit")
+
+	fsReadAheadBytesCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mo",
+			Subsystem: "fs",
+			Name:      "read_ahead_bytes_total",
+			Help:      "Total bytes of read ahead.",
+		}, []string{"type"})
+	FSReadAheadIssueBytesCounter = fsReadAheadBytesCounter.WithLabelValues("issue")
+	FSReadAheadHitBytesCounter   = fsReadAheadBytesCounter.WithLabelValues("hit")
+	FSReadAheadWasteBytesCounter = fsReadAheadBytesCounter.WithLabelValues("waste")
+)
+
 var (
 	s3IOBytesHistogram = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
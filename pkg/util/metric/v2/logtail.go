@@ -64,6 +64,35 @@ var (
 			Buckets:   prometheus.ExponentialBuckets(1, 2.0, 10),
 		})
 
+	logTailAppendBytesHistogram = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "mo",
+			Subsystem: "logtail",
+			Name:      "append_bytes",
+			Help:      "Bucketed histogram of the size of WAL records appended into logservice.",
+			Buckets:   prometheus.ExponentialBuckets(256, 2.0, 18),
+		}, []string{"type"})
+	LogTailAppendRawBytesHistogram        = logTailAppendBytesHistogram.WithLabelValues("raw")
+	LogTailAppendCompressedBytesHistogram = logTailAppendBytesHistogram.WithLabelValues("compressed")
+
+	LogTailAppendEntriesHistogram = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "mo",
+			Subsystem: "logtail",
+			Name:      "append_entries",
+			Help:      "Bucketed histogram of the number of WAL entries in a logservice append.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2.0, 12),
+		})
+
+	LogTailGroupCommitWaitDurationHistogram = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "mo",
+			Subsystem: "logtail",
+			Name:      "group_commit_wait_duration_seconds",
+			Help:      "Bucketed histogram of the duration WAL entries wait for group commit.",
+			Buckets:   getDurationBuckets(),
+		})
+
 	logTailApplyDurationHistogram = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: "mo",
@@ -74,6 +74,7 @@ func initTaskMetrics() {
 
 	registry.MustRegister(taskScheduledByCounter)
 	registry.MustRegister(taskGeneratedStuffCounter)
+	registry.MustRegister(taskMergePolicyCounter)
 	registry.MustRegister(taskSelectivityCounter)
 
 	registry.MustRegister(transferPageHitHistogram)
@@ -85,6 +86,8 @@ func initTaskMetrics() {
 
 func initFileServiceMetrics() {
 	registry.MustRegister(fsReadCounter)
+	registry.MustRegister(fsReadAheadCounter)
+	registry.MustRegister(fsReadAheadBytesCounter)
 	registry.MustRegister(S3ConnectCounter)
 	registry.MustRegister(S3DNSResolveCounter)
 
@@ -105,6 +108,9 @@ func initLogtailMetrics() {
 	registry.MustRegister(logTailQueueSizeGauge)
 
 	registry.MustRegister(LogTailBytesHistogram)
+	registry.MustRegister(logTailAppendBytesHistogram)
+	registry.MustRegister(LogTailAppendEntriesHistogram)
+	registry.MustRegister(LogTailGroupCommitWaitDurationHistogram)
 	registry.MustRegister(logTailApplyDurationHistogram)
 	registry.MustRegister(logtailUpdatePartitionDurationHistogram)
 	registry.MustRegister(LogTailAppendDurationHistogram)
@@ -105,6 +105,14 @@ var (
 	TaskDNMergedSizeCounter = taskGeneratedStuffCounter.WithLabelValues("merged_size", "dn")
 	TaskCNMergedSizeCounter = taskGeneratedStuffCounter.WithLabelValues("merged_size", "cn")
 
+	taskMergePolicyCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mo",
+			Subsystem: "task",
+			Name:      "merge_policy_total",
+			Help:      "Total number of merges and merged objects picked by each merge policy.",
+		}, []string{"policy", "type"})
+
 	taskSelectivityCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: "mo",
@@ -160,3 +168,11 @@ var (
 		Help:      "The total number of transfer row.",
 	})
 )
+
+func NewTaskMergePolicyScheduledCounterByName(policy string) prometheus.Counter {
+	return taskMergePolicyCounter.WithLabelValues(policy, "scheduled")
+}
+
+func NewTaskMergePolicyObjectCounterByName(policy string) prometheus.Counter {
+	return taskMergePolicyCounter.WithLabelValues(policy, "objects")
+}
@@ -31,16 +31,21 @@ type NodeStatus struct {
 	Labels    map[string]metadata.LabelList `json:"Labels"`
 	WorkState metadata.WorkState            `json:"WorkState"`
 	Resource  pb.Resource                   `json:"Resource"`
+	Load      pb.CNLoad                     `json:"load"`
 	UpTime    time.Time                     `json:"up_time"`
 	DownTime  time.Time                     `json:"down_time"`
 }
 
 // HAKeeperStatus contains the status of HAKeeper. Currently, we
-// focus on the uptime/downtime of nodes in HAKeeper.
+// focus on the uptime/downtime of nodes in HAKeeper, the Log shards
+// whose replicas are not spread across failure domains, and the
+// scale recommendations of the CN groups.
 type HAKeeperStatus struct {
-	Nodes        []NodeStatus `json:"nodes"`
-	DeletedNodes []NodeStatus `json:"deleted_nodes"`
-	ErrMsg       string       `json:"err_msg"`
+	Nodes                []NodeStatus               `json:"nodes"`
+	DeletedNodes         []NodeStatus               `json:"deleted_nodes"`
+	PlacementViolations  []pb.PlacementViolation    `json:"placement_violations"`
+	ScaleRecommendations []pb.CNScaleRecommendation `json:"scale_recommendations"`
+	ErrMsg               string                     `json:"err_msg"`
 }
 
 func (s *HAKeeperStatus) fill(client logservice.ClusterHAKeeperClient) {
@@ -67,6 +72,7 @@ func (s *HAKeeperStatus) fill(client logservice.ClusterHAKeeperClient) {
 			Labels:    cn.Labels,
 			WorkState: cn.WorkState,
 			Resource:  cn.Resource,
+			Load:      cn.Load,
 			UpTime:    time.Unix(cn.UpTime/1e9, cn.UpTime%1e9),
 		})
 	}
@@ -79,4 +85,6 @@ func (s *HAKeeperStatus) fill(client logservice.ClusterHAKeeperClient) {
 			DownTime: time.Unix(deleted.DownTime/1e9, deleted.DownTime%1e9),
 		})
 	}
+	s.PlacementViolations = details.PlacementViolations
+	s.ScaleRecommendations = details.ScaleRecommendations
 }
@@ -71,3 +71,34 @@ func TestFillHAKeeper(t *testing.T) {
 	assert.Equal(t, 10, len(status.HAKeeperStatus.Nodes))
 	assert.Equal(t, 10, len(status.HAKeeperStatus.DeletedNodes))
 }
+
+func TestFillHAKeeperPlacementViolations(t *testing.T) {
+	var status Status
+	var client mockHAKeeperClient
+	client.details.PlacementViolations = []pb.PlacementViolation{{
+		ShardID: 1,
+		Label:   "zone",
+		Domain:  "z1",
+		Stores:  []string{"a", "b"},
+	}}
+	status.HAKeeperStatus.fill(&client)
+	assert.Equal(t, client.details.PlacementViolations, status.HAKeeperStatus.PlacementViolations)
+}
+
+func TestFillHAKeeperScaleRecommendations(t *testing.T) {
+	var status Status
+	var client mockHAKeeperClient
+	client.details.CNStores = []pb.CNStore{{
+		UUID: "cn1",
+		Load: pb.CNLoad{ActiveSessions: 10, ActiveQueries: 2},
+	}}
+	client.details.ScaleRecommendations = []pb.CNScaleRecommendation{{
+		Labels: "role=tp",
+		CNs:    []string{"cn1"},
+		Action: pb.ScaleOut,
+		Count:  1,
+	}}
+	status.HAKeeperStatus.fill(&client)
+	assert.Equal(t, client.details.ScaleRecommendations, status.HAKeeperStatus.ScaleRecommendations)
+	assert.Equal(t, uint64(10), status.HAKeeperStatus.Nodes[0].Load.ActiveSessions)
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package motrace
+
+import (
+	"context"
+	"time"
+	"unsafe"
+
+	"github.com/matrixorigin/matrixone/pkg/util/export/table"
+)
+
+// MOReportHolder is a row of a report view over the rawlog table, such as
+// system.scrub_report and system.gc_report. Name, Message and Detail are
+// saved in logger_name, message and error, which the view renames.
+type MOReportHolder struct {
+	View      *table.View `json:"-"`
+	Level     string      `json:"level"`
+	Name      string      `json:"name"`
+	Message   string      `json:"message"`
+	Detail    string      `json:"detail"`
+	Extra     string      `json:"extra"`
+	Timestamp time.Time   `json:"timestamp"`
+}
+
+func (h *MOReportHolder) GetName() string {
+	return h.View.OriginTable.GetName()
+}
+
+func (h *MOReportHolder) Size() int64 {
+	return int64(unsafe.Sizeof(*h)) + int64(len(h.Level)+len(h.Name)+len(h.Message)+len(h.Detail)+len(h.Extra))
+}
+
+func (h *MOReportHolder) Free() {}
+
+func (h *MOReportHolder) GetTable() *table.Table { return h.View.OriginTable }
+
+func (h *MOReportHolder) FillRow(ctx context.Context, row *table.Row) {
+	row.Reset()
+	row.SetColumnVal(rawItemCol, table.StringField(h.View.Table))
+	row.SetColumnVal(timestampCol, table.TimeField(h.Timestamp))
+	row.SetColumnVal(nodeUUIDCol, table.StringField(GetNodeResource().NodeUuid))
+	row.SetColumnVal(nodeTypeCol, table.StringField(GetNodeResource().NodeType))
+	row.SetColumnVal(levelCol, table.StringField(h.Level))
+	row.SetColumnVal(loggerNameCol, table.StringField(h.Name))
+	row.SetColumnVal(messageCol, table.StringField(h.Message))
+	row.SetColumnVal(errorCol, table.StringField(h.Detail))
+	if h.Extra != "" {
+		row.SetColumnVal(extraCol, table.JsonField(h.Extra))
+	}
+}
+
+// ReportView sends the row of the report view to BatchProcessor
+func ReportView(ctx context.Context, h *MOReportHolder) {
+	if !GetTracerProvider().IsEnable() {
+		return
+	}
+	if ctx == nil {
+		ctx = DefaultContext()
+	}
+	if h.Level == "" {
+		h.Level = "info"
+	}
+	if h.Timestamp.IsZero() {
+		h.Timestamp = time.Now()
+	}
+	GetGlobalBatchProcessor().Collect(ctx, h)
+}
@@ -44,6 +44,11 @@ const (
 	errorInfoTbl = "error_info"
 
 	SqlStatementHotspotTbl = "sql_statement_hotspot"
+
+	// ScrubReportTbl is a view of the issues found by the object scrubber
+	ScrubReportTbl = "scrub_report"
+	// GCReportTbl is a view of the decisions made by the dry run of disk GC
+	GCReportTbl = "gc_report"
 )
 
 var (
@@ -258,6 +263,19 @@ var (
 		Condition: &table.ViewSingleCondition{Column: rawItemCol, Table: spanInfoTbl},
 	}
 
+	ScrubReportView = newReportView(ScrubReportTbl,
+		levelCol,
+		viewColumn(loggerNameCol, "kind"),
+		viewColumn(messageCol, "object"),
+		viewColumn(errorCol, "detail"),
+	)
+
+	GCReportView = newReportView(GCReportTbl,
+		viewColumn(loggerNameCol, "decision"),
+		viewColumn(messageCol, "object"),
+		viewColumn(errorCol, "reason"),
+	)
+
 	SqlStatementHotspotView = &table.View{
 		Database:    StatsDatabase,
 		Table:       SqlStatementHotspotTbl,
@@ -293,7 +311,28 @@ const (
 )
 
 var tables = []*table.Table{SingleStatementTable, SingleRowLogTable}
-var views = []*table.View{logView, errorView, spanView, SqlStatementHotspotView}
+var views = []*table.View{logView, errorView, spanView, SqlStatementHotspotView, ScrubReportView, GCReportView}
+
+// viewColumn renames the column of the origin table in the view.
+func viewColumn(col table.Column, alias string) table.Column {
+	col.Alias = alias
+	return col
+}
+
+// newReportView returns the view of the report saved by MOReportHolder in the
+// rawlog table, the cols are the ones between node_type and extra.
+func newReportView(tbl string, cols ...table.Column) *table.View {
+	columns := []table.Column{timestampCol, nodeUUIDCol, nodeTypeCol}
+	columns = append(columns, cols...)
+	columns = append(columns, extraCol)
+	return &table.View{
+		Database:    StatsDatabase,
+		Table:       tbl,
+		OriginTable: SingleRowLogTable,
+		Columns:     columns,
+		Condition:   &table.ViewSingleCondition{Column: rawItemCol, Table: tbl},
+	}
+}
 
 // InitSchemaByInnerExecutor init schema, which can access db by io.InternalExecutor on any Node.
 func InitSchemaByInnerExecutor(ctx context.Context, ieFactory func() ie.InternalExecutor) error {
@@ -149,3 +149,19 @@ func TestGetSchemaForAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestScrubReportView(t *testing.T) {
+	sql := ScrubReportView.ToCreateSql(context.Background(), true)
+	require.Equal(t, "CREATE VIEW IF NOT EXISTS `system`.`scrub_report` as "+
+		"select `timestamp`, `node_uuid`, `node_type`, `level`, `logger_name` as `kind`, "+
+		"`message` as `object`, `error` as `detail`, `extra` "+
+		"from `system`.`rawlog` where `raw_item` = \"scrub_report\"", sql)
+}
+
+func TestGCReportView(t *testing.T) {
+	sql := GCReportView.ToCreateSql(context.Background(), true)
+	require.Equal(t, "CREATE VIEW IF NOT EXISTS `system`.`gc_report` as "+
+		"select `timestamp`, `node_uuid`, `node_type`, `logger_name` as `decision`, "+
+		"`message` as `object`, `error` as `reason`, `extra` "+
+		"from `system`.`rawlog` where `raw_item` = \"gc_report\"", sql)
+}
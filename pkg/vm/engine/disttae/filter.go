@@ -99,6 +99,106 @@ func init() {
 	}
 }
 
+// columnFilterLoader loads the block level filters built on a non sort key
+// column along with the object meta. ExecuteBlockFilter checks the blocks
+// of an object right after loading it, so only the filters of the last
+// loaded object are kept.
+type columnFilterLoader struct {
+	seqnum  uint16
+	typ     uint8
+	filters objectio.ColumnFilters
+}
+
+// newColumnFilterLoader returns nil if the column has no filter of the
+// given types. The bloom filter is preferred if both are built.
+func newColumnFilterLoader(tableDef *plan.TableDef, colDef *plan.ColDef, bloom, ngram bool) *columnFilterLoader {
+	bloomCols, ngramCols := catalog.GetFilterColumnsFromTableDef(tableDef)
+	hasFilter := func(positions []uint16) bool {
+		for _, pos := range positions {
+			if tableDef.Cols[pos].Seqnum == colDef.Seqnum {
+				return true
+			}
+		}
+		return false
+	}
+	if bloom && hasFilter(bloomCols) {
+		return &columnFilterLoader{seqnum: uint16(colDef.Seqnum), typ: index.BF}
+	}
+	if ngram && hasFilter(ngramCols) {
+		return &columnFilterLoader{seqnum: uint16(colDef.Seqnum), typ: index.NGF}
+	}
+	return nil
+}
+
+func (l *columnFilterLoader) wrap(fs fileservice.FileService, inner LoadOp) LoadOp {
+	return func(
+		ctx context.Context,
+		obj objectio.ObjectStats,
+		inMeta objectio.ObjectMeta,
+		inBF objectio.BloomFilter,
+	) (outMeta objectio.ObjectMeta, outBF objectio.BloomFilter, err error) {
+		if outMeta, outBF, err = inner(ctx, obj, inMeta, inBF); err != nil {
+			return
+		}
+		location := obj.ObjectLocation()
+		if l.filters, err = objectio.LoadColumnFiltersWithMeta(
+			ctx, outMeta.MustDataMeta(), location, fs,
+		); err != nil {
+			return nil, nil, err
+		}
+		return
+	}
+}
+
+// mayContain returns false only if the filter of the blkIdx-th block of the
+// last loaded object rules it out
+func (l *columnFilterLoader) mayContain(
+	blkIdx int,
+	check func(index.StaticFilter) (bool, error),
+) (bool, error) {
+	if len(l.filters) == 0 {
+		return true, nil
+	}
+	buf, ok := l.filters.GetFilter(uint32(blkIdx), l.seqnum, l.typ)
+	if !ok {
+		return true, nil
+	}
+	filter := index.NewEmptyBloomFilterWithType(l.typ)
+	if err := index.DecodeBloomFilter(filter, buf); err != nil {
+		return false, err
+	}
+	return check(filter)
+}
+
+// likeLiterals returns the literal parts of a LIKE pattern, split by the
+// wildcards
+func likeLiterals(pattern []byte) [][]byte {
+	var (
+		ret [][]byte
+		cur []byte
+	)
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '%', '_':
+			if len(cur) > 0 {
+				ret = append(ret, cur)
+				cur = nil
+			}
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+			}
+			cur = append(cur, pattern[i])
+		default:
+			cur = append(cur, c)
+		}
+	}
+	if len(cur) > 0 {
+		ret = append(ret, cur)
+	}
+	return ret
+}
+
 func isSortedKey(colDef *plan.ColDef) (isPK, isSorted bool) {
 	if colDef.Name == catalog.FakePrimaryKeyColName {
 		return false, false
@@ -257,9 +357,6 @@ func CompileFilterExprs(
 	) (meta objectio.ObjectMeta, bf objectio.BloomFilter, err error) {
 		_, _ = inMeta, inBF
 		for _, op := range ops2 {
-			if meta != nil && bf != nil {
-				continue
-			}
 			if meta, bf, err = op(ctx, obj, meta, bf); err != nil {
 				return
 			}
@@ -858,10 +955,14 @@ func CompileFilterExpr(
 					return obj.SortKeyZoneMap().AnyIn(vec), nil
 				}
 			}
+			var cf *columnFilterLoader
 			if isPK {
 				loadOp = loadMetadataAndBFOpFactory(fs)
 			} else {
 				loadOp = loadMetadataOnlyOpFactory(fs)
+				if cf = newColumnFilterLoader(tableDef, colDef, true, true); cf != nil {
+					loadOp = cf.wrap(fs, loadOp)
+				}
 			}
 
 			highSelectivityHint = isPK && vec.Length() <= 10
@@ -892,6 +993,14 @@ func CompileFilterExpr(
 					if exist := blkBfIdx.MayContainsAny(vec, lowerBound, upperBound); !exist {
 						return false, false, nil
 					}
+				} else if cf != nil {
+					exist, err := cf.mayContain(blkIdx, func(f index.StaticFilter) (bool, error) {
+						lowerBound, upperBound := zm.SubVecIn(vec)
+						return f.MayContainsAny(vec, lowerBound, upperBound), nil
+					})
+					if err != nil || !exist {
+						return false, false, err
+					}
 				}
 				return false, true, nil
 			}
@@ -912,10 +1021,14 @@ func CompileFilterExpr(
 					return obj.SortKeyZoneMap().ContainsKey(vals[0]), nil
 				}
 			}
+			var cf *columnFilterLoader
 			if isPK {
 				loadOp = loadMetadataAndBFOpFactory(fs)
 			} else {
 				loadOp = loadMetadataOnlyOpFactory(fs)
+				if cf = newColumnFilterLoader(tableDef, colDef, true, true); cf != nil {
+					loadOp = cf.wrap(fs, loadOp)
+				}
 			}
 
 			highSelectivityHint = isPK
@@ -959,6 +1072,13 @@ func CompileFilterExpr(
 					if err != nil || !exist {
 						return false, false, err
 					}
+				} else if cf != nil {
+					exist, err := cf.mayContain(blkIdx, func(f index.StaticFilter) (bool, error) {
+						return f.MayContainsKey(vals[0])
+					})
+					if err != nil || !exist {
+						return false, false, err
+					}
 				}
 				return false, true, nil
 			}
@@ -971,6 +1091,42 @@ func CompileFilterExpr(
 					return blkIdx
 				}
 			}
+		case "like":
+			// only the columns with an ngram filter can be pruned
+			if len(exprImpl.F.Args) != 2 {
+				canCompile = false
+				return
+			}
+			if _, ok := exprImpl.F.Args[0].Expr.(*plan.Expr_Col); !ok {
+				canCompile = false
+				return
+			}
+			colExpr, vals, ok := mustColConstValueFromBinaryFuncExpr(exprImpl, tableDef, proc)
+			if !ok {
+				canCompile = false
+				return
+			}
+			colDef := getColDefByName(colExpr.Col.Name, tableDef)
+			cf := newColumnFilterLoader(tableDef, colDef, false, true)
+			if cf == nil {
+				canCompile = false
+				return
+			}
+			literals := likeLiterals(vals[0])
+			loadOp = cf.wrap(fs, loadMetadataOnlyOpFactory(fs))
+			blockFilterOp = func(
+				blkIdx int, _ objectio.BlockObject, _ objectio.BloomFilter,
+			) (bool, bool, error) {
+				exist, err := cf.mayContain(blkIdx, func(f index.StaticFilter) (bool, error) {
+					for _, literal := range literals {
+						if ok, err := f.MayContainsKey(literal); err != nil || !ok {
+							return false, err
+						}
+					}
+					return true, nil
+				})
+				return false, exist, err
+			}
 		default:
 			canCompile = false
 		}
@@ -0,0 +1,147 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disttae
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
+	plan2 "github.com/matrixorigin/matrixone/pkg/sql/plan"
+	"github.com/matrixorigin/matrixone/pkg/testutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLikeLiterals(t *testing.T) {
+	require.Equal(t, [][]byte{[]byte("abc")}, likeLiterals([]byte("%abc%")))
+	require.Equal(t, [][]byte{[]byte("ab"), []byte("cd"), []byte("e%f")}, likeLiterals([]byte("ab_cd%e\\%f")))
+	require.Nil(t, likeLiterals([]byte("%%")))
+}
+
+func TestColumnFilterPruning(t *testing.T) {
+	ctx := context.Background()
+	mp := mpool.MustNewZero()
+	proc := testutil.NewProcessWithMPool("", mp)
+	fs, err := fileservice.NewFileService(ctx, fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: t.TempDir(),
+		Cache:   fileservice.DisabledCacheConfig,
+	}, nil)
+	require.NoError(t, err)
+	defer fs.Close()
+
+	// two blocks whose zonemaps overlap on b and c
+	name := objectio.BuildObjectNameWithObjectID(objectio.NewObjectid())
+	writer, err := blockio.NewBlockWriterNew(fs, name, 0, []uint16{0, 1, 2})
+	require.NoError(t, err)
+	writer.SetPrimaryKey(0)
+	// c has both a bloom filter and an ngram filter
+	writer.SetColumnFilters([]uint16{1, 2}, []uint16{2})
+	for blk := 0; blk < 2; blk++ {
+		bat := batch.NewWithSize(3)
+		bat.Vecs[0] = vector.NewVec(types.T_int64.ToType())
+		bat.Vecs[1] = vector.NewVec(types.T_int64.ToType())
+		bat.Vecs[2] = vector.NewVec(types.T_varchar.ToType())
+		words := []string{"apple", "banana"}
+		for i := 0; i < 1000; i++ {
+			require.NoError(t, vector.AppendFixed(bat.Vecs[0], int64(blk*1000+i), false, mp))
+			require.NoError(t, vector.AppendFixed(bat.Vecs[1], int64(i*2+blk), false, mp))
+			v := fmt.Sprintf("a-%s-%d", words[blk], i)
+			require.NoError(t, vector.AppendBytes(bat.Vecs[2], []byte(v), false, mp))
+		}
+		bat.SetRowCount(1000)
+		_, err = writer.WriteBatch(bat)
+		require.NoError(t, err)
+		bat.Clean(mp)
+	}
+	_, _, err = writer.Sync(ctx)
+	require.NoError(t, err)
+	stats := writer.GetObjectStats()[objectio.SchemaData]
+
+	tableDef := &plan.TableDef{
+		Name: "t",
+		Cols: []*plan.ColDef{
+			{Name: "a", Seqnum: 0, Primary: true, Typ: plan.Type{Id: int32(types.T_int64)}},
+			{Name: "b", Seqnum: 1, Typ: plan.Type{Id: int32(types.T_int64)}},
+			{Name: "c", Seqnum: 2, Typ: plan.Type{Id: int32(types.T_varchar), Width: 100}},
+		},
+		Name2ColIndex: map[string]int32{"a": 0, "b": 1, "c": 2},
+		Pkey:          &plan.PrimaryKeyDef{PkeyColName: "a"},
+		Defs: []*plan.TableDef_DefType{
+			{
+				Def: &plan.TableDef_DefType_Properties{
+					Properties: &plan.PropertiesDef{
+						Properties: []*plan.Property{
+							{Key: catalog.PropBloomFilterColumns, Value: "b,c"},
+							{Key: catalog.PropNgramFilterColumns, Value: "c"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	selected := func(expr *plan.Expr) []bool {
+		_, loadOp, _, blockFilterOp, _, can, _ := CompileFilterExpr(expr, proc, tableDef, fs)
+		require.True(t, can)
+		meta, bf, err := loadOp(ctx, stats, nil, nil)
+		require.NoError(t, err)
+		dataMeta := meta.MustDataMeta()
+		var ret []bool
+		for i := 0; i < int(dataMeta.BlockCount()); i++ {
+			_, ok, err := blockFilterOp(i, dataMeta.GetBlockMeta(uint32(i)), bf)
+			require.NoError(t, err)
+			ret = append(ret, ok)
+		}
+		return ret
+	}
+
+	colB := makeColExprForTest(1, types.T_int64)
+	colC := makeColExprForTest(2, types.T_varchar)
+	require.Equal(t, []bool{true, false}, selected(makeFunctionExprForTest("=", []*plan.Expr{
+		colB, plan2.MakePlan2Int64ConstExprWithType(10),
+	})))
+	require.Equal(t, []bool{false, true}, selected(makeFunctionExprForTest("=", []*plan.Expr{
+		colB, plan2.MakePlan2Int64ConstExprWithType(11),
+	})))
+	require.Equal(t, []bool{true, true}, selected(makeInExprForTest[int64](colB, []int64{10, 11}, types.T_int64, mp)))
+	require.Equal(t, []bool{false, true}, selected(makeFunctionExprForTest("like", []*plan.Expr{
+		colC, plan2.MakePlan2StringConstExprWithType("%nana-1%"),
+	})))
+	require.Equal(t, []bool{true, false}, selected(makeFunctionExprForTest("=", []*plan.Expr{
+		colC, plan2.MakePlan2StringConstExprWithType("a-apple-7"),
+	})))
+
+	// columns without filters are only pruned by zonemaps
+	tableDef.Defs = nil
+	require.Equal(t, []bool{true, true}, selected(makeFunctionExprForTest("=", []*plan.Expr{
+		colB, plan2.MakePlan2Int64ConstExprWithType(10),
+	})))
+	_, _, _, _, _, can, _ := CompileFilterExpr(makeFunctionExprForTest("like", []*plan.Expr{
+		colC, plan2.MakePlan2StringConstExprWithType("%nana%"),
+	}), proc, tableDef, fs)
+	require.False(t, can)
+}
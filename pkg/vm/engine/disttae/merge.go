@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"strings"
 	"sync/atomic"
+	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
@@ -71,6 +72,14 @@ type cnMergeTask struct {
 	blkIters []*StatsBlkIter
 
 	targetObjSize uint32
+	compressAlg   uint8
+	bloomCols     []uint16
+	ngramCols     []uint16
+
+	// rows expired at the snapshot are dropped
+	ttl    catalog.TableTTL
+	ttlPos int
+	hasTTL bool
 }
 
 func newCNMergeTask(
@@ -104,6 +113,12 @@ func newCNMergeTask(
 		blkIters[i] = NewStatsBlkIter(&objInfo.ObjectStats, meta.MustDataMeta())
 	}
 
+	bloomCols, ngramCols := catalog.GetFilterColumnsFromTableDef(tbl.tableDef)
+	ttl, hasTTL := catalog.GetTTLFromTableDef(tbl.tableDef)
+	ttlPos := -1
+	if hasTTL {
+		ttlPos = int(tbl.tableDef.Name2ColIndex[strings.ToLower(ttl.Column)])
+	}
 	return &cnMergeTask{
 		taskId:      gTaskID.Add(1),
 		host:        tbl,
@@ -122,6 +137,12 @@ func newCNMergeTask(
 		blkIters:    blkIters,
 
 		targetObjSize: targetObjSize,
+		compressAlg:   catalog.GetCompressAlgFromTableDef(tbl.tableDef),
+		bloomCols:     bloomCols,
+		ngramCols:     ngramCols,
+		ttl:           ttl,
+		ttlPos:        ttlPos,
+		hasTTL:        hasTTL,
 		doTransfer:    !strings.Contains(tbl.comment, catalog.MO_COMMENT_NO_DEL_HINT),
 	}, nil
 }
@@ -252,7 +273,9 @@ func (t *cnMergeTask) prepareCommitEntry() *api.MergeCommitEntry {
 }
 
 func (t *cnMergeTask) PrepareNewWriter() *blockio.BlockWriter {
-	return mergesort.GetNewWriter(t.fs, t.version, t.colseqnums, t.sortkeyPos, t.sortkeyIsPK)
+	writer := mergesort.GetNewWriter(t.fs, t.version, t.colseqnums, t.sortkeyPos, t.sortkeyIsPK, t.compressAlg)
+	writer.SetColumnFilters(t.bloomCols, t.ngramCols)
+	return writer
 }
 
 // readblock reads block data. there is no rowid column, no ablk
@@ -293,6 +316,19 @@ func (t *cnMergeTask) readblock(ctx context.Context, info *objectio.BlockInfo) (
 	if dels.Count() > 0 {
 		logutil.Infof("mergeblocks read block %v, %d deleted(%d from disk)", info.BlockID.ShortStringEx(), dels.Count(), deltalocDel)
 	}
+	if t.hasTTL {
+		expired := t.ttl.ExpiredRows(bat.Vecs[t.ttlPos], time.Unix(0, t.snapshot.Physical()), nil)
+		if expired != nil {
+			if t.doTransfer {
+				blkID := info.BlockID
+				if err = mergesort.AddExpiredRows(t.GetCommitEntry(), &blkID, expired); err != nil {
+					release()
+					return nil, nil, nil, err
+				}
+			}
+			dels.Or(expired)
+		}
+	}
 
 	bat.SetAttributes(t.colattrs)
 	return
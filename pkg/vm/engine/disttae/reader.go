@@ -16,6 +16,7 @@ package disttae
 
 import (
 	"context"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -611,6 +612,50 @@ func (r *blockMergeReader) Read(
 	return r.blockReader.Read(ctx, cols, expr, mp, vp)
 }
 
+// -----------------------------------------------------------------
+// ------------------------ ttlReader ------------------------------
+// -----------------------------------------------------------------
+
+func newTTLReader(rd engine.Reader, ttl ttlColumn, now time.Time) *ttlReader {
+	return &ttlReader{
+		Reader: rd,
+		ttl:    ttl,
+		now:    now,
+	}
+}
+
+func (r *ttlReader) Read(
+	ctx context.Context,
+	cols []string,
+	expr *plan.Expr,
+	mp *mpool.MPool,
+	vp engine.VectorPool,
+) (*batch.Batch, error) {
+	// read the ttl column as an extra column if it is not projected
+	pos := slices.IndexFunc(cols, func(col string) bool {
+		return strings.EqualFold(col, r.ttl.attr)
+	})
+	readCols := cols
+	if pos == -1 {
+		pos = len(cols)
+		readCols = append(cols[:len(cols):len(cols)], r.ttl.attr)
+	}
+
+	bat, err := r.Reader.Read(ctx, readCols, expr, mp, vp)
+	if err != nil || bat == nil {
+		return bat, err
+	}
+	if dels := r.ttl.ExpiredRows(bat.Vecs[pos], r.now, nil); dels != nil {
+		bat.Shrink(dels.ToI64Arrary(), true)
+	}
+	if pos == len(cols) {
+		bat.Vecs[pos].Free(mp)
+		bat.Vecs = bat.Vecs[:pos]
+		bat.SetAttributes(cols)
+	}
+	return bat, nil
+}
+
 // -----------------------------------------------------------------
 // ------------------------ mergeReader ----------------------------
 // -----------------------------------------------------------------
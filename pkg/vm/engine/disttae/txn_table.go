@@ -1636,6 +1636,36 @@ func (tbl *txnTable) NewReader(
 	ranges []byte,
 	orderedScan bool,
 	txnOffset int,
+) ([]engine.Reader, error) {
+	rds, err := tbl.buildReaders(ctx, num, expr, ranges, orderedScan, txnOffset)
+	if err != nil {
+		return nil, err
+	}
+	// rows expired at the snapshot are hidden until merge drops them
+	if ttl, ok := catalog.GetTTLFromTableDef(tbl.tableDef); ok {
+		colIdx, ok := tbl.tableDef.Name2ColIndex[strings.ToLower(ttl.Column)]
+		if !ok {
+			return rds, nil
+		}
+		col := ttlColumn{
+			TableTTL: ttl,
+			attr:     tbl.tableDef.Cols[colIdx].GetOriginCaseName(),
+		}
+		now := catalog.TTLNow(tbl.db.op.SnapshotTS())
+		for i := range rds {
+			rds[i] = newTTLReader(rds[i], col, now)
+		}
+	}
+	return rds, nil
+}
+
+func (tbl *txnTable) buildReaders(
+	ctx context.Context,
+	num int,
+	expr *plan.Expr,
+	ranges []byte,
+	orderedScan bool,
+	txnOffset int,
 ) ([]engine.Reader, error) {
 	if plan2.IsFalseExpr(expr) {
 		return []engine.Reader{new(emptyReader)}, nil
@@ -875,3 +875,17 @@ type mergeReader struct {
 
 type emptyReader struct {
 }
+
+// ttlReader hides the rows of a TTL table expired at the snapshot of the
+// txn, which are not dropped by merge yet.
+type ttlReader struct {
+	engine.Reader
+	ttl ttlColumn
+	now time.Time
+}
+
+type ttlColumn struct {
+	catalog.TableTTL
+	// attr is the name of the ttl column passed to the inner reader
+	attr string
+}
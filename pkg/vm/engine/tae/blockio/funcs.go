@@ -37,12 +37,30 @@ func LoadColumnsData(
 	m *mpool.MPool,
 	policy fileservice.Policy,
 ) (bat *batch.Batch, release func(), err error) {
-	name := location.Name()
 	var meta objectio.ObjectMeta
-	var ioVectors *fileservice.IOVector
 	if meta, err = objectio.FastLoadObjectMeta(ctx, &location, false, fs); err != nil {
 		return
 	}
+	return loadColumnsData(ctx, meta, metaType, cols, typs, fs, location, m, policy, false)
+}
+
+// loadColumnsData loads the columns of the block with the object meta that
+// is already loaded. If withDictGroups is true, the dictionary groups of
+// the dictionary encoded data columns are recorded in the batch.
+func loadColumnsData(
+	ctx context.Context,
+	meta objectio.ObjectMeta,
+	metaType objectio.DataMetaType,
+	cols []uint16,
+	typs []types.Type,
+	fs fileservice.FileService,
+	location objectio.Location,
+	m *mpool.MPool,
+	policy fileservice.Policy,
+	withDictGroups bool,
+) (bat *batch.Batch, release func(), err error) {
+	name := location.Name()
+	var ioVectors *fileservice.IOVector
 	dataMeta := meta.MustGetMeta(metaType)
 	if ioVectors, err = objectio.ReadOneBlock(ctx, &dataMeta, name.String(), location.ID(), cols, typs, m, fs, policy); err != nil {
 		return
@@ -60,11 +78,56 @@ func LoadColumnsData(
 		}
 		bat.Vecs[i] = obj.(*vector.Vector)
 		bat.SetRowCount(bat.Vecs[i].Length())
+		if withDictGroups && metaType == objectio.SchemaData &&
+			meta.ColumnEncoding(uint32(location.ID()), cols[i]) == objectio.EncodingDict {
+			var dict *objectio.DictColumn
+			if dict, err = objectio.DecodeDictColumn(ioVectors.Entries[i].CachedData.Bytes()); err != nil {
+				return
+			}
+			if dict != nil {
+				groups, _ := dict.Groups()
+				bat.SetDictGroups(i, groups, dict.Dict.Length()+1)
+			}
+		}
 	}
 	//TODO call CachedData.Release
 	return
 }
 
+// LoadDictColumn loads a dictionary encoded column of the block without
+// decoding it. It returns a nil column without reading anything if the
+// object meta does not record the column as dictionary encoded.
+func LoadDictColumn(
+	ctx context.Context,
+	meta objectio.ObjectMeta,
+	seqnum uint16,
+	typ types.Type,
+	fs fileservice.FileService,
+	location objectio.Location,
+	m *mpool.MPool,
+	policy fileservice.Policy,
+) (col *objectio.DictColumn, release func(), err error) {
+	if meta.ColumnEncoding(uint32(location.ID()), seqnum) != objectio.EncodingDict {
+		return
+	}
+	dataMeta := meta.MustDataMeta()
+	var ioVectors *fileservice.IOVector
+	if ioVectors, err = objectio.ReadOneBlock(
+		ctx, &dataMeta, location.Name().String(), location.ID(),
+		[]uint16{seqnum}, []types.Type{typ}, m, fs, policy,
+	); err != nil {
+		return
+	}
+	if col, err = objectio.DecodeDictColumn(ioVectors.Entries[0].CachedData.Bytes()); err != nil || col == nil {
+		objectio.ReleaseIOVector(ioVectors)
+		return
+	}
+	release = func() {
+		objectio.ReleaseIOVector(ioVectors)
+	}
+	return
+}
+
 func LoadColumnsData2(
 	ctx context.Context,
 	metaType objectio.DataMetaType,
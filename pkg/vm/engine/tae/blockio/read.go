@@ -54,11 +54,35 @@ func ReadByFilter(
 	fs fileservice.FileService,
 	mp *mpool.MPool,
 ) (sels []int64, err error) {
-	bat, release, err := LoadColumns(ctx, columns, colTypes, fs, info.MetaLocation(), mp, fileservice.Policy(0))
-	if err != nil {
+	location := info.MetaLocation()
+	var meta objectio.ObjectMeta
+	if meta, err = objectio.FastLoadObjectMeta(ctx, &location, false, fs); err != nil {
 		return
 	}
-	defer release()
+	// a filter on a single dictionary encoded column is evaluated on the
+	// dictionary, the column is not decoded
+	var dict *objectio.DictColumn
+	if len(columns) == 1 {
+		var release func()
+		if dict, release, err = LoadDictColumn(
+			ctx, meta, columns[0], colTypes[0], fs, location, mp, fileservice.Policy(0),
+		); err != nil {
+			return
+		}
+		if dict != nil {
+			defer release()
+		}
+	}
+	var bat *batch.Batch
+	if dict == nil {
+		var release func()
+		if bat, release, err = loadColumnsData(
+			ctx, meta, objectio.SchemaData, columns, colTypes, fs, location, mp, fileservice.Policy(0), false,
+		); err != nil {
+			return
+		}
+		defer release()
+	}
 	var deleteMask *nulls.Nulls
 
 	// merge persisted deletes
@@ -98,7 +122,13 @@ func ReadByFilter(
 		deleteMask.Add(uint64(row))
 	}
 
-	sels = searchFunc(bat.Vecs)
+	if dict != nil {
+		sels = dict.Filter(func(vec *vector.Vector) []int64 {
+			return searchFunc([]*vector.Vector{vec})
+		})
+	} else {
+		sels = searchFunc(bat.Vecs)
+	}
 
 	// deslect deleted rows from sels
 	if !deleteMask.IsEmpty() {
@@ -295,6 +325,13 @@ func BlockReadInner(
 			if err = result.Vecs[i].Union(col, selectRows, mp); err != nil {
 				break
 			}
+			if dict := loaded.GetDictGroups(i); dict != nil {
+				groups := make([]uint32, len(selectRows))
+				for j, row := range selectRows {
+					groups[j] = dict.Groups[row]
+				}
+				result.SetDictGroups(i, groups, dict.Count)
+			}
 		}
 		if err != nil {
 			for _, col := range result.Vecs {
@@ -395,6 +432,9 @@ func BlockReadInner(
 		if len(deletedRows) > 0 {
 			result.Vecs[i].Shrink(deletedRows, true)
 		}
+		if dict := loaded.GetDictGroups(i); dict != nil {
+			result.SetDictGroups(i, shrinkDictGroups(dict.Groups, deletedRows), dict.Count)
+		}
 	}
 
 	// if any error happens, free the result batch allocated
@@ -408,6 +448,23 @@ func BlockReadInner(
 	return
 }
 
+// shrinkDictGroups returns the groups of the rows that are not deleted,
+// deletedRows is in ascending order
+func shrinkDictGroups(groups []uint32, deletedRows []int64) []uint32 {
+	if len(deletedRows) == 0 {
+		return groups
+	}
+	ret := make([]uint32, 0, len(groups)-len(deletedRows))
+	for i, g := range groups {
+		if len(deletedRows) > 0 && deletedRows[0] == int64(i) {
+			deletedRows = deletedRows[1:]
+			continue
+		}
+		ret = append(ret, g)
+	}
+	return ret
+}
+
 func getRowsIdIndex(colIndexes []uint16, colTypes []types.Type) (int, []uint16, []types.Type) {
 	idx := -1
 	for i, typ := range colTypes {
@@ -484,7 +541,14 @@ func readBlockData(
 			return
 		}
 
-		if loaded, release, err = LoadColumns(ctx, cols, typs, fs, info.MetaLocation(), m, policy); err != nil {
+		location := info.MetaLocation()
+		var meta objectio.ObjectMeta
+		if meta, err = objectio.FastLoadObjectMeta(ctx, &location, false, fs); err != nil {
+			return
+		}
+		if loaded, release, err = loadColumnsData(
+			ctx, meta, objectio.SchemaData, cols, typs, fs, location, m, policy, true,
+		); err != nil {
 			return
 		}
 
@@ -493,6 +557,9 @@ func readBlockData(
 		for i, typ := range colTypes {
 			if typ.Oid != types.T_Rowid {
 				result.Vecs[i] = loaded.Vecs[colPos]
+				if dict := loaded.GetDictGroups(colPos); dict != nil {
+					result.SetDictGroups(i, dict.Groups, dict.Count)
+				}
 				colPos++
 			}
 		}
@@ -0,0 +1,138 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package blockio
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/defines"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadByFilterOnDict(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	ctx := context.Background()
+
+	dir := testutils.InitTestEnv(ModuleName, t)
+	dir = path.Join(dir, "/local")
+	c := fileservice.Config{
+		Name:    defines.LocalFileServiceName,
+		Backend: "DISK",
+		DataDir: dir,
+	}
+	service, err := fileservice.NewFileService(ctx, c, nil)
+	require.NoError(t, err)
+
+	mp := mpool.MustNewZero()
+	bat := batch.NewWithSize(2)
+	bat.Vecs[0] = vector.NewVec(types.T_int32.ToType())
+	bat.Vecs[1] = vector.NewVec(types.T_varchar.ToType())
+	defer bat.Clean(mp)
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, vector.AppendFixed(bat.Vecs[0], int32(i), false, mp))
+		require.NoError(t, vector.AppendBytes(bat.Vecs[1], []byte(fmt.Sprintf("k%d", i%5)), i%100 == 0, mp))
+	}
+	bat.SetRowCount(1000)
+
+	name := objectio.BuildObjectName(objectio.NewSegmentid(), 0)
+	writer, err := NewBlockWriterNew(service, name, 0, nil)
+	require.NoError(t, err)
+	_, err = writer.WriteBatch(bat)
+	require.NoError(t, err)
+	blocks, _, err := writer.Sync(ctx)
+	require.NoError(t, err)
+
+	var info objectio.BlockInfo
+	info.SetMetaLocation(EncodeLocation(writer.GetName(), blocks[0].GetExtent(), 1000, blocks[0].GetID()))
+	location := info.MetaLocation()
+	meta, err := objectio.FastLoadObjectMeta(ctx, &location, false, service)
+	require.NoError(t, err)
+	require.Equal(t, objectio.EncodingDict, meta.ColumnEncoding(uint32(location.ID()), 1))
+
+	// only a dictionary encoded column is loaded as a dictionary
+	dict, release, err := LoadDictColumn(
+		ctx, meta, 0, types.T_int32.ToType(), service, location, mp, fileservice.Policy(0))
+	require.NoError(t, err)
+	require.Nil(t, dict)
+	require.Nil(t, release)
+
+	// the search function only sees the dictionary
+	var seen int
+	search := func(vecs []*vector.Vector) []int64 {
+		seen = vecs[0].Length()
+		return vector.VarlenBinarySearchOffsetByValFactory([][]byte{[]byte("k1"), []byte("k3")})(vecs[0])
+	}
+	sels, err := ReadByFilter(
+		ctx, "", &info, []int64{1, 3}, []uint16{1}, []types.Type{types.T_varchar.ToType()},
+		types.TS{}, search, service, mp,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 5, seen)
+	var expected []int64
+	for i := 0; i < 1000; i++ {
+		if (i%5 == 1 || i%5 == 3) && i%100 != 0 && i != 1 && i != 3 {
+			expected = append(expected, int64(i))
+		}
+	}
+	require.Equal(t, expected, sels)
+
+	// not dictionary encoded
+	sels, err = ReadByFilter(
+		ctx, "", &info, nil, []uint16{0}, []types.Type{types.T_int32.ToType()},
+		types.TS{}, func(vecs []*vector.Vector) []int64 {
+			seen = vecs[0].Length()
+			return []int64{7}
+		}, service, mp,
+	)
+	require.NoError(t, err)
+	require.Equal(t, 1000, seen)
+	require.Equal(t, []int64{7}, sels)
+
+	// the rows read from the block keep the groups of their codes
+	read, err := BlockRead(
+		ctx, "", &info, []int64{0, 2}, []uint16{0, 1},
+		[]types.Type{types.T_int32.ToType(), types.T_varchar.ToType()},
+		types.TS{}.ToTimestamp(), nil, nil, BlockReadFilter{}, service, mp, nil, fileservice.Policy(0),
+	)
+	require.NoError(t, err)
+	defer read.Clean(mp)
+	require.Nil(t, read.GetDictGroups(0))
+	groups := read.GetDictGroups(1)
+	require.NotNil(t, groups)
+	require.Equal(t, 6, groups.Count)
+	require.Equal(t, 998, len(groups.Groups))
+	keys := make(map[uint32]string)
+	for i, g := range groups.Groups {
+		key := "null"
+		if !read.Vecs[1].IsNull(uint64(i)) {
+			key = read.Vecs[1].GetStringAt(i)
+		}
+		if prev, ok := keys[g]; ok {
+			require.Equal(t, prev, key)
+		}
+		keys[g] = key
+	}
+	require.Equal(t, 6, len(keys))
+}
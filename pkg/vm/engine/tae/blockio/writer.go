@@ -40,6 +40,8 @@ type BlockWriter struct {
 	name           objectio.ObjectName
 	objectStats    []objectio.ObjectStats
 	prefix         []index.PrefixFn
+	bloomCols      []uint16
+	ngramCols      []uint16
 }
 
 func NewBlockWriter(fs fileservice.FileService, name string) (*BlockWriter, error) {
@@ -89,6 +91,19 @@ func (w *BlockWriter) SetSortKey(idx uint16) {
 	w.sortKeyIdx = idx
 }
 
+// SetCompressAlg sets the compress algorithm of the column data
+func (w *BlockWriter) SetCompressAlg(alg uint8) {
+	w.writer.SetCompressAlg(alg)
+}
+
+// SetColumnFilters makes the writer build a bloom filter on the columns at
+// bloom and an ngram filter on the varlen columns at ngram for every
+// block. The positions are the indexes of the columns in the written batch.
+func (w *BlockWriter) SetColumnFilters(bloom, ngram []uint16) {
+	w.bloomCols = bloom
+	w.ngramCols = ngram
+}
+
 func (w *BlockWriter) SetAppendable() {
 	w.writer.SetAppendable()
 }
@@ -169,9 +184,49 @@ func (w *BlockWriter) WriteBatch(batch *batch.Batch) (objectio.BlockObject, erro
 			return nil, err
 		}
 	}
+	if err = w.writeColumnFilters(int(block.GetID()), batch, seqnums); err != nil {
+		return nil, err
+	}
 	return block, nil
 }
 
+func (w *BlockWriter) writeColumnFilters(blkIdx int, batch *batch.Batch, seqnums []uint16) error {
+	build := func(positions []uint16, typ uint8) error {
+		for _, pos := range positions {
+			if int(pos) >= len(batch.Vecs) || (w.isSetPK && w.pk == pos) {
+				continue
+			}
+			vec := batch.Vecs[pos]
+			columnData := containers.ToTNVector(vec, common.DefaultAllocator)
+			var (
+				filter index.StaticFilter
+				err    error
+			)
+			if typ == index.NGF {
+				if !vec.GetType().IsVarlen() {
+					continue
+				}
+				filter, err = index.NewNgramFilter(columnData)
+			} else {
+				filter, err = index.NewBloomFilter(columnData)
+			}
+			if err != nil {
+				return err
+			}
+			buf, err := filter.Marshal()
+			if err != nil {
+				return err
+			}
+			w.writer.WriteColumnFilter(blkIdx, seqnums[pos], typ, buf)
+		}
+		return nil
+	}
+	if err := build(w.bloomCols, index.BF); err != nil {
+		return err
+	}
+	return build(w.ngramCols, index.NGF)
+}
+
 func (w *BlockWriter) WriteTombstoneBatch(batch *batch.Batch) (objectio.BlockObject, error) {
 	block, err := w.writer.WriteTombstone(batch)
 	if err != nil {
@@ -139,6 +139,9 @@ type Schema struct {
 	PhyAddrKey *ColDef
 
 	isSecondaryIndexTable bool
+	compressAlg           uint8
+	ttl                   pkgcatalog.TableTTL
+	hasTTL                bool
 }
 
 func NewEmptySchema(name string) *Schema {
@@ -182,8 +185,14 @@ func (s *Schema) ApplyAlterTable(req *apipb.AlterTableReq) error {
 		s.Extra.MaxObjOnerun = p.GetMaxObjOnerun()
 		s.Extra.MinCnMergeSize = p.GetMinCnMergeSize()
 		s.Extra.Hints = p.GetHints()
+		s.Extra.MergePolicy = p.GetPolicy()
+		s.Extra.SizeRatio = p.GetSizeRatio()
+		s.Extra.TimeColumn = p.GetTimeColumn()
+		s.Extra.TimeWindow = p.GetTimeWindow()
 	case apipb.AlterKind_UpdateConstraint:
 		s.Constraint = req.GetUpdateCstr().GetConstraints()
+		s.compressAlg = pkgcatalog.GetCompressAlgFromConstraint(s.Constraint)
+		s.ttl, s.hasTTL = pkgcatalog.GetTTLFromConstraint(s.Constraint)
 	case apipb.AlterKind_UpdateComment:
 		s.Comment = req.GetUpdateComment().GetComment()
 	case apipb.AlterKind_RenameColumn:
@@ -333,6 +342,46 @@ func (s *Schema) GetPrimaryKey() *ColDef {
 	return s.getFakePrimaryKey()
 }
 
+// GetCompressAlg returns the compress algorithm of the column data written by flush and merge
+func (s *Schema) GetCompressAlg() uint8 {
+	return s.compressAlg
+}
+
+// GetColumnFilters returns the positions of the columns that get a bloom
+// filter or an ngram filter at flush and merge time
+func (s *Schema) GetColumnFilters() (bloom, ngram []uint16) {
+	bloomNames, ngramNames := pkgcatalog.GetFilterColumnsFromConstraint(s.Constraint)
+	positions := func(names []string) []uint16 {
+		var ret []uint16
+		for _, name := range names {
+			for _, def := range s.ColDefs {
+				if !def.IsPhyAddr() && strings.EqualFold(def.Name, name) {
+					ret = append(ret, uint16(def.Idx))
+					break
+				}
+			}
+		}
+		return ret
+	}
+	return positions(bloomNames), positions(ngramNames)
+}
+
+// GetTTL returns the row expiry of the table and the position of its ttl
+// column. Expired rows are hidden from reads and the primary key dedup, and
+// dropped by merge.
+func (s *Schema) GetTTL() (ttl pkgcatalog.TableTTL, colIdx int, ok bool) {
+	if !s.hasTTL {
+		return
+	}
+	ttl = s.ttl
+	for _, def := range s.ColDefs {
+		if !def.IsPhyAddr() && strings.EqualFold(def.Name, ttl.Column) {
+			return ttl, def.Idx, true
+		}
+	}
+	return pkgcatalog.TableTTL{}, -1, false
+}
+
 func (s *Schema) HasPKOrFakePK() bool {
 	if s.HasPK() {
 		return true
@@ -941,6 +990,8 @@ func (s *Schema) Finalize(withoutPhyAddr bool) (err error) {
 		panic("schema: multiple sort keys")
 	}
 	s.isSecondaryIndexTable = strings.Contains(s.Name, "__mo_index_secondary_")
+	s.compressAlg = pkgcatalog.GetCompressAlgFromConstraint(s.Constraint)
+	s.ttl, s.hasTTL = pkgcatalog.GetTTLFromConstraint(s.Constraint)
 	return
 }
 
@@ -1017,6 +1068,32 @@ func MockSnapShotSchema() *Schema {
 	return schema
 }
 
+func MockPITRSchema() *Schema {
+	schema := NewEmptySchema("mo_pitr")
+
+	constraintDef := &engine.ConstraintDef{
+		Cts: make([]engine.Constraint, 0),
+	}
+
+	schema.AppendCol("pitr_id", types.T_uuid.ToType())
+	schema.AppendCol("pitr_name", types.T_varchar.ToType())
+	schema.AppendCol("create_account", types.T_uint64.ToType())
+	schema.AppendCol("create_time", types.T_timestamp.ToType())
+	schema.AppendCol("modified_time", types.T_timestamp.ToType())
+	schema.AppendCol("level", types.T_varchar.ToType())
+	schema.AppendCol("account_id", types.T_uint64.ToType())
+	schema.AppendCol("account_name", types.T_varchar.ToType())
+	schema.AppendCol("database_name", types.T_varchar.ToType())
+	schema.AppendCol("table_name", types.T_varchar.ToType())
+	schema.AppendCol("obj_id", types.T_uint64.ToType())
+	schema.AppendCol("pitr_length", types.T_uint8.ToType())
+	schema.AppendCol("pitr_unit", types.T_varchar.ToType())
+	schema.Constraint, _ = constraintDef.MarshalBinary()
+
+	_ = schema.Finalize(false)
+	return schema
+}
+
 // MockSchemaAll if char/varchar is needed, colCnt = 14, otherwise colCnt = 12
 // pkIdx == -1 means no pk defined
 func MockSchemaAll(colCnt int, pkIdx int, from ...int) *Schema {
@@ -639,6 +639,10 @@ func (entry *TableEntry) AlterTable(ctx context.Context, txn txnif.TxnReader, re
 			MaxOsizeMergedObj: newSchema.Extra.MaxOsizeMergedObj,
 			MinCnMergeSize:    newSchema.Extra.MinCnMergeSize,
 			Hints:             hints,
+			MergePolicy:       newSchema.Extra.MergePolicy,
+			SizeRatio:         newSchema.Extra.SizeRatio,
+			TimeColumn:        newSchema.Extra.TimeColumn,
+			TimeWindow:        newSchema.Extra.TimeWindow,
 		}
 
 	}
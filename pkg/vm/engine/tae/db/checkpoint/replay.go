@@ -292,6 +292,99 @@ func (r *runner) Replay(dataFactory catalog.DataFactory) (
 	return
 }
 
+// ReplayMetadata reloads the checkpoint entries from the latest metadata
+// file without applying their data. A hot-standby TN tails the WAL instead
+// of replaying checkpoints, so it calls this on promotion to pick up the
+// checkpoints made by the previous TN after the standby was opened.
+func (r *runner) ReplayMetadata() (err error) {
+	ctx := r.ctx
+	dirs, err := r.rt.Fs.ListDir(CheckpointDir)
+	if err != nil {
+		return
+	}
+	if len(dirs) == 0 {
+		return
+	}
+	metaFiles := make([]*MetaFile, 0)
+	r.checkpointMetaFiles.Lock()
+	for i, dir := range dirs {
+		r.checkpointMetaFiles.files[dir.Name] = struct{}{}
+		start, end := blockio.DecodeCheckpointMetadataFileName(dir.Name)
+		metaFiles = append(metaFiles, &MetaFile{
+			start: start,
+			end:   end,
+			index: i,
+		})
+	}
+	r.checkpointMetaFiles.Unlock()
+	sort.Slice(metaFiles, func(i, j int) bool {
+		return metaFiles[i].end.Less(&metaFiles[j].end)
+	})
+	dir := dirs[metaFiles[len(metaFiles)-1].index]
+	reader, err := blockio.NewFileReader(r.rt.SID(), r.rt.Fs.Service, CheckpointDir+dir.Name)
+	if err != nil {
+		return
+	}
+	bats, closeCB, err := reader.LoadAllColumns(ctx, nil, common.CheckpointAllocator)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if closeCB != nil {
+			closeCB()
+		}
+	}()
+	bat := containers.NewBatch()
+	defer bat.Close()
+	colNames := CheckpointSchema.Attrs()
+	colTypes := CheckpointSchema.Types()
+	var checkpointVersion int
+	vecLen := len(bats[0].Vecs)
+	if vecLen < CheckpointSchemaColumnCountV1 {
+		checkpointVersion = 1
+	} else if vecLen < CheckpointSchemaColumnCountV2 {
+		checkpointVersion = 2
+	} else {
+		checkpointVersion = 3
+	}
+	for i := range bats[0].Vecs {
+		var vec containers.Vector
+		if bats[0].Vecs[i].Length() == 0 {
+			vec = containers.MakeVector(colTypes[i], common.CheckpointAllocator)
+		} else {
+			vec = containers.ToTNVector(bats[0].Vecs[i], common.CheckpointAllocator)
+		}
+		bat.AddVector(colNames[i], vec)
+	}
+
+	// entries already known by the runner are skipped by tryAdd
+	var maxTs types.TS
+	entries, _ := replayCheckpointEntries(bat, checkpointVersion)
+	for _, entry := range entries {
+		entry.sid = r.rt.SID()
+		switch entry.GetType() {
+		case ET_Global:
+			r.tryAddNewGlobalCheckpointEntry(entry)
+		case ET_Incremental:
+			r.tryAddNewIncrementalCheckpointEntry(entry)
+		case ET_Backup:
+			r.tryAddNewBackupCheckpointEntry(entry)
+		}
+		if maxTs.Less(&entry.end) {
+			maxTs = entry.end
+		}
+	}
+	logutil.Info("promote-tae", common.OperationField("replay"),
+		common.OperandField("checkpoint-metadata"),
+		common.AnyField("file", dir.Name),
+		common.AnyField("count", len(entries)),
+		common.AnyField("max-ts", maxTs.ToString()))
+	if !maxTs.IsEmpty() {
+		r.source.Init(maxTs)
+	}
+	return
+}
+
 func MergeCkpMeta(
 	ctx context.Context,
 	sid string,
@@ -48,6 +48,7 @@ type Runner interface {
 	String() string
 	EnqueueWait(any) error
 	Replay(catalog.DataFactory) (types.TS, uint64, bool, error)
+	ReplayMetadata() error
 
 	FlushTable(ctx context.Context, dbID, tableID uint64, ts types.TS) error
 	GCByTS(ctx context.Context, ts types.TS) error
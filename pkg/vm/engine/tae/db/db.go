@@ -66,6 +66,7 @@ type DB struct {
 	MergeHandle        *MergeTaskBuilder
 
 	DiskCleaner *gc2.DiskCleaner
+	Scrubber    *gc2.Scrubber
 
 	Runtime *dbutils.Runtime
 
@@ -73,6 +74,9 @@ type DB struct {
 
 	CNMergeSched merge.CNMergeScheduler
 
+	// standby is not nil if the db is a hot-standby not promoted yet.
+	standby atomic.Pointer[standby]
+
 	Closed *atomic.Value
 }
 
@@ -213,6 +217,10 @@ func (db *DB) Replay(dataFactory *tables.DataFactory, maxTs types.TS, lsn uint64
 	}
 	replayer := newReplayer(dataFactory, db, maxTs, lsn, valid)
 	replayer.OnTimeStamp(maxTs)
+	db.replayWal(replayer)
+}
+
+func (db *DB) replayWal(replayer *Replayer) {
 	replayer.Replay()
 
 	err := db.TxnMgr.Init(replayer.GetMaxTS())
@@ -232,15 +240,22 @@ func (db *DB) Close() error {
 		panic(err)
 	}
 	db.Closed.Store(ErrClosed)
-	db.GCManager.Stop()
-	db.BGScanner.Stop()
-	db.BGCheckpointRunner.Stop()
+	standby := db.standby.Load()
+	if standby != nil {
+		standby.close()
+	} else {
+		db.GCManager.Stop()
+		db.BGScanner.Stop()
+		db.BGCheckpointRunner.Stop()
+	}
 	db.Runtime.Scheduler.Stop()
 	db.TxnMgr.Stop()
 	db.LogtailMgr.Stop()
 	db.Wal.Close()
 	db.Catalog.Close()
-	db.DiskCleaner.Stop()
+	if standby == nil {
+		db.DiskCleaner.Stop()
+	}
 	db.Runtime.TransferTable.Close()
 	db.usageMemo.Clear()
 	return db.DBLocker.Close()
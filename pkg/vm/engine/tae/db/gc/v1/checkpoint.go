@@ -689,6 +689,7 @@ func (c *checkpointCleaner) tryGC(data *logtail.CheckpointData, gckp *checkpoint
 	}
 	var err error
 	var snapshots map[uint32]containers.Vector
+	var pitrs []logtail.PITRRange
 	defer func() {
 		if err != nil {
 			logutil.Errorf("[DiskCleaner] tryGC failed: %v", err.Error())
@@ -703,7 +704,12 @@ func (c *checkpointCleaner) tryGC(data *logtail.CheckpointData, gckp *checkpoint
 		logutil.Errorf("[DiskCleaner] GetSnapshots failed: %v", err.Error())
 		return nil
 	}
-	gc, snapshotList := c.softGC(gcTable, gckp, snapshots)
+	pitrs, err = c.GetPITRs()
+	if err != nil {
+		logutil.Errorf("[DiskCleaner] GetPITRs failed: %v", err.Error())
+		return nil
+	}
+	gc, snapshotList := c.softGC(gcTable, gckp, snapshots, pitrs)
 	// Delete files after softGC
 	// TODO:Requires Physical Removal Policy
 	err = c.delWorker.ExecDelete(c.ctx, gc, c.disableGC)
@@ -724,6 +730,7 @@ func (c *checkpointCleaner) softGC(
 	t *GCTable,
 	gckp *checkpoint.CheckpointEntry,
 	snapshots map[uint32]containers.Vector,
+	pitrs []logtail.PITRRange,
 ) ([]string, map[uint32][]types.TS) {
 	c.inputs.Lock()
 	defer c.inputs.Unlock()
@@ -741,13 +748,13 @@ func (c *checkpointCleaner) softGC(
 	for _, table := range c.inputs.tables {
 		mergeTable.Merge(table)
 	}
-	gc, snapList := mergeTable.SoftGC(t, gckp.GetEnd(), snapshots, c.snapshotMeta)
+	gc, snapList := mergeTable.SoftGC(t, gckp.GetEnd(), snapshots, pitrs, c.snapshotMeta)
 	softCost = time.Since(now)
 	now = time.Now()
 	c.inputs.tables = make([]*GCTable, 0)
 	c.inputs.tables = append(c.inputs.tables, mergeTable)
 	c.updateMaxCompared(gckp)
-	c.snapshotMeta.MergeTableInfo(snapList)
+	c.snapshotMeta.MergeTableInfo(snapList, pitrs)
 	mergeCost = time.Since(now)
 	//logutil.Infof("SoftGC is %v, merge table: %v", gc, mergeTable.String())
 	return gc, snapList
@@ -821,7 +828,12 @@ func (c *checkpointCleaner) CheckGC() error {
 		return moerr.NewInternalErrorNoCtx("processing clean GetSnapshots %s: %v", debugCandidates[0].String(), err)
 	}
 	defer logtail.CloseSnapshotList(snapshots)
-	debugTable.SoftGC(gcTable, gCkp.GetEnd(), snapshots, c.snapshotMeta)
+	pitrs, err := c.GetPITRs()
+	if err != nil {
+		logutil.Errorf("processing clean %s: %v", debugCandidates[0].String(), err)
+		return moerr.NewInternalErrorNoCtx("processing clean GetPITRs %s: %v", debugCandidates[0].String(), err)
+	}
+	debugTable.SoftGC(gcTable, gCkp.GetEnd(), snapshots, pitrs, c.snapshotMeta)
 	var mergeTable *GCTable
 	if len(c.inputs.tables) > 1 {
 		mergeTable = NewGCTable()
@@ -831,7 +843,7 @@ func (c *checkpointCleaner) CheckGC() error {
 	} else {
 		mergeTable = c.inputs.tables[0]
 	}
-	mergeTable.SoftGC(gcTable, gCkp.GetEnd(), snapshots, c.snapshotMeta)
+	mergeTable.SoftGC(gcTable, gCkp.GetEnd(), snapshots, pitrs, c.snapshotMeta)
 	if !mergeTable.Compare(debugTable) {
 		logutil.Errorf("inputs :%v", c.inputs.tables[0].String())
 		logutil.Errorf("debugTable :%v", debugTable.String())
@@ -1025,6 +1037,11 @@ func (c *checkpointCleaner) GetSnapshots() (map[uint32]containers.Vector, error)
 	return c.snapshotMeta.GetSnapshot(c.ctx, c.sid, c.fs.Service, c.mPool)
 }
 
+// GetPITRs returns the PITRs with their ranges ending now
+func (c *checkpointCleaner) GetPITRs() ([]logtail.PITRRange, error) {
+	return c.snapshotMeta.GetPITR(c.ctx, c.sid, time.Now(), c.fs.Service, c.mPool)
+}
+
 func isSnapshotCKPRefers(start, end types.TS, snapVec []types.TS) bool {
 	if len(snapVec) == 0 {
 		return false
@@ -0,0 +1,263 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/util/trace/impl/motrace"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
+)
+
+const (
+	GCDecisionDelete = "delete"
+	GCDecisionRetain = "retain"
+)
+
+// the reasons why an object is retained by GC
+const (
+	// RetainCheckpoint is an object not covered by the max global
+	// checkpoint yet
+	RetainCheckpoint = "active checkpoint"
+	// RetainSnapshot is an object visible to a snapshot
+	RetainSnapshot = "snapshot"
+	// RetainAlive is an object alive in the max global checkpoint
+	RetainAlive = "alive"
+	// RetainReferenced is a tombstone of an object retained
+	RetainReferenced = "referenced"
+	// RetainPITR is an object dropped in the range of a PITR
+	RetainPITR = "PITR"
+)
+
+// GCDecision is the decision of GC on an object or a tombstone
+type GCDecision struct {
+	Object    string `json:"object"`
+	Tombstone bool   `json:"tombstone,omitempty"`
+	Table     uint64 `json:"table,omitempty"`
+	Decision  string `json:"decision"`
+	Reason    string `json:"reason,omitempty"`
+	Detail    string `json:"detail,omitempty"`
+}
+
+func (d *GCDecision) retain(reason, detail string) {
+	d.Decision = GCDecisionRetain
+	d.Reason = reason
+	d.Detail = detail
+}
+
+// DryRunReport is the result of a dry run of GC. The objects alive in the
+// max global checkpoint are only counted, the others are listed with the
+// decisions.
+type DryRunReport struct {
+	Start      time.Time     `json:"start"`
+	Duration   time.Duration `json:"duration"`
+	Checkpoint string        `json:"checkpoint"`
+	Objects    int           `json:"objects"`
+	Alive      int           `json:"alive"`
+	Deletes    int           `json:"deletes"`
+	Retains    int           `json:"retains"`
+	Decisions  []GCDecision  `json:"decisions,omitempty"`
+}
+
+func (r *DryRunReport) add(d GCDecision) {
+	if d.Decision == GCDecisionDelete {
+		r.Deletes++
+	} else {
+		r.Retains++
+	}
+	r.Decisions = append(r.Decisions, d)
+}
+
+// Summary returns the report in json without the decisions, which are
+// reported to system.gc_report
+func (r *DryRunReport) Summary() string {
+	summary := *r
+	summary.Decisions = nil
+	data, _ := json.Marshal(&summary)
+	return string(data)
+}
+
+func (r *DryRunReport) String() string {
+	var w strings.Builder
+	w.WriteString(fmt.Sprintf("gc dry run started at %s, cost %s\n", r.Start.Format(time.RFC3339), r.Duration))
+	if r.Checkpoint == "" {
+		w.WriteString("no global checkpoint, nothing to gc\n")
+		return w.String()
+	}
+	w.WriteString(fmt.Sprintf("global checkpoint: %s\n", r.Checkpoint))
+	w.WriteString(fmt.Sprintf("objects: %d, alive: %d, delete: %d, retain: %d\n",
+		r.Objects, r.Alive, r.Deletes, r.Retains))
+	for _, d := range r.Decisions {
+		kind := "object"
+		if d.Tombstone {
+			kind = "tombstone"
+		}
+		w.WriteString(fmt.Sprintf("  %s %s %s", d.Decision, kind, d.Object))
+		if d.Reason != "" {
+			w.WriteString(fmt.Sprintf(": %s, %s", d.Reason, d.Detail))
+		}
+		w.WriteString("\n")
+	}
+	return w.String()
+}
+
+// DryRunGC computes the files the next round of GC deletes against the max
+// global checkpoint, the snapshots and the PITRs, and explains why the others
+// are retained. Nothing is deleted. The decisions are reported to
+// system.gc_report.
+func (c *checkpointCleaner) DryRunGC(ctx context.Context) (*DryRunReport, error) {
+	report := &DryRunReport{Start: time.Now()}
+	gckp := c.ckpClient.MaxGlobalCheckpoint()
+	if gckp == nil {
+		return report, nil
+	}
+	data, err := c.collectGlobalCkpData(gckp)
+	if err != nil {
+		return nil, err
+	}
+	defer data.Close()
+	gcTable := NewGCTable()
+	gcTable.UpdateTable(data)
+	snapshots, err := c.GetSnapshots()
+	if err != nil {
+		return nil, err
+	}
+	defer logtail.CloseSnapshotList(snapshots)
+	pitrs, err := c.GetPITRs()
+	if err != nil {
+		return nil, err
+	}
+
+	// SoftGC changes the tables, so the dry run works on a merged copy
+	mergeTable := NewGCTable()
+	c.inputs.RLock()
+	for _, table := range c.inputs.tables {
+		mergeTable.Merge(table)
+	}
+	c.inputs.RUnlock()
+
+	report.Checkpoint = gckp.String()
+	mergeTable.explain(gcTable, gckp.GetEnd(), snapshots, pitrs, c.snapshotMeta, report)
+	report.Duration = time.Since(report.Start)
+	c.reportDryRun(ctx, report)
+	return report, nil
+}
+
+// explain makes the same decisions as SoftGC without changing the table
+func (t *GCTable) explain(
+	table *GCTable,
+	ts types.TS,
+	snapShotList map[uint32]containers.Vector,
+	pitrs []logtail.PITRRange,
+	meta *logtail.SnapshotMeta,
+	report *DryRunReport,
+) {
+	snapList := make(map[uint32][]types.TS)
+	for acct, snap := range snapShotList {
+		snapList[acct] = vector.MustFixedCol[types.TS](snap.GetDownstreamVector())
+	}
+
+	objects := t.getObjects()
+	names := make([]string, 0, len(objects))
+	for name := range objects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	retained := make(map[string]struct{}, len(objects))
+	for _, name := range names {
+		entry := objects[name]
+		report.Objects++
+		tsList := meta.GetSnapshotList(snapList, entry.table)
+		reason, detail, retain := retainObject(name, entry, table.objects[name] != nil, ts, tsList, pitrs, meta)
+		if retain {
+			retained[name] = struct{}{}
+		}
+		if reason == RetainAlive {
+			report.Alive++
+			continue
+		}
+		decision := GCDecision{
+			Object:   name,
+			Table:    entry.table,
+			Decision: GCDecisionDelete,
+		}
+		if retain {
+			decision.retain(reason, detail)
+		}
+		report.add(decision)
+	}
+
+	tombstones := t.getTombstones()
+	names = names[:0]
+	for name := range tombstones {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		tombstone := tombstones[name]
+		if _, ok := tombstone.objects[name]; ok {
+			// tombstone for aObject is same as aObject, decided with it
+			continue
+		}
+		decision := GCDecision{
+			Object:    name,
+			Tombstone: true,
+			Decision:  GCDecisionDelete,
+		}
+		reason, detail, retain := retainTombstone(tombstone, ts, func(obj string) bool {
+			_, ok := retained[obj]
+			return ok
+		})
+		if retain {
+			if reason == RetainReferenced {
+				detail = fmt.Sprintf("object %s", detail)
+			}
+			decision.retain(reason, detail)
+		}
+		report.add(decision)
+	}
+}
+
+func (c *checkpointCleaner) reportDryRun(ctx context.Context, report *DryRunReport) {
+	logutil.Info("[DiskCleaner]", common.OperationField("dry-run"),
+		common.AnyField("checkpoint", report.Checkpoint),
+		common.AnyField("objects", report.Objects),
+		common.AnyField("alive", report.Alive),
+		common.AnyField("deletes", report.Deletes),
+		common.AnyField("retains", report.Retains),
+		common.AnyField("cost", report.Duration))
+	for i := range report.Decisions {
+		d := &report.Decisions[i]
+		extra, _ := json.Marshal(d)
+		motrace.ReportView(ctx, &motrace.MOReportHolder{
+			View:      motrace.GCReportView,
+			Name:      d.Decision,
+			Message:   d.Object,
+			Detail:    d.Reason,
+			Extra:     string(extra),
+			Timestamp: report.Start,
+		})
+	}
+}
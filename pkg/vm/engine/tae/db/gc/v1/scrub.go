@@ -0,0 +1,468 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	catalog2 "github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/util/trace/impl/motrace"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
+)
+
+// the kinds of the issues found by the scrubber
+const (
+	// ScrubCorrupted is an object which can not be read or decoded, or
+	// whose data mismatches the checksum
+	ScrubCorrupted = "corrupted"
+	// ScrubDangling is an object referenced by the catalog or the
+	// checkpoints but not found in the object store
+	ScrubDangling = "dangling"
+	// ScrubOrphan is an object in the object store referenced by nothing
+	// and older than the GC window
+	ScrubOrphan = "orphan"
+)
+
+// ScrubIssue is an object found broken or leaked by the scrubber
+type ScrubIssue struct {
+	Kind   string `json:"kind"`
+	Object string `json:"object"`
+	Detail string `json:"detail,omitempty"`
+	Size   int64  `json:"size,omitempty"`
+	Age    string `json:"age,omitempty"`
+}
+
+func (issue *ScrubIssue) level() string {
+	if issue.Kind == ScrubOrphan {
+		return "warn"
+	}
+	return "error"
+}
+
+// ScrubResult is the result of a round of scrub
+type ScrubResult struct {
+	Start       time.Time     `json:"start"`
+	Duration    time.Duration `json:"duration"`
+	Checkpoints int           `json:"checkpoints"`
+	Objects     int           `json:"objects"`
+	Verified    int           `json:"verified"`
+	Extents     int           `json:"extents"`
+	Issues      []ScrubIssue  `json:"issues"`
+}
+
+func (r *ScrubResult) String() string {
+	var w strings.Builder
+	w.WriteString(fmt.Sprintf("scrub started at %s, cost %s\n", r.Start.Format(time.RFC3339), r.Duration))
+	w.WriteString(fmt.Sprintf("checkpoints: %d, objects: %d, verified objects: %d, verified extents: %d\n",
+		r.Checkpoints, r.Objects, r.Verified, r.Extents))
+	w.WriteString(fmt.Sprintf("issues: %d\n", len(r.Issues)))
+	for _, issue := range r.Issues {
+		w.WriteString(fmt.Sprintf("  %s %s", issue.Kind, issue.Object))
+		if issue.Age != "" {
+			w.WriteString(fmt.Sprintf(", age %s", issue.Age))
+		}
+		if issue.Detail != "" {
+			w.WriteString(fmt.Sprintf(": %s", issue.Detail))
+		}
+		w.WriteString("\n")
+	}
+	return w.String()
+}
+
+// Scrubber walks the checkpoints, the catalog and the object store to find
+// the corrupted objects, the objects referenced but lost and the objects
+// leaked. Every referenced object is read bypassing the caches, so the
+// checksums of the file service and of the column data are verified and
+// every extent is decoded. The issues are reported to system.scrub_report.
+type Scrubber struct {
+	sid       string
+	fs        *objectio.ObjectFS
+	ckpClient checkpoint.RunnerReader
+	cleaner   Cleaner
+
+	// gcWindow is the TTL of GC. The objects not referenced by anything are
+	// not reported until they are older than it, as they may be written by
+	// the transactions not committed yet.
+	gcWindow time.Duration
+
+	// only one round runs at the same time
+	running sync.Mutex
+	last    struct {
+		sync.RWMutex
+		result *ScrubResult
+	}
+
+	now func() time.Time
+}
+
+func NewScrubber(
+	sid string,
+	fs *objectio.ObjectFS,
+	ckpClient checkpoint.RunnerReader,
+	cleaner Cleaner,
+	gcWindow time.Duration,
+) *Scrubber {
+	return &Scrubber{
+		sid:       sid,
+		fs:        fs,
+		ckpClient: ckpClient,
+		cleaner:   cleaner,
+		gcWindow:  gcWindow,
+		now:       time.Now,
+	}
+}
+
+// NewOfflineScrubber creates a scrubber of the storage not served by any TN,
+// the checkpoints are read from the checkpoint meta files.
+func NewOfflineScrubber(
+	sid string,
+	fs *objectio.ObjectFS,
+	gcWindow time.Duration,
+) *Scrubber {
+	return NewScrubber(sid, fs, nil, nil, gcWindow)
+}
+
+// LastResult returns the result of the last round of scrub
+func (s *Scrubber) LastResult() *ScrubResult {
+	s.last.RLock()
+	defer s.last.RUnlock()
+	return s.last.result
+}
+
+// scrubRefs collects the objects referenced by the checkpoints and the
+// catalog. An object in required must exist in the object store, and an
+// object in referenced is still in use and must not be reported as leaked.
+type scrubRefs struct {
+	required   map[string]struct{}
+	referenced map[string]struct{}
+}
+
+func (r *scrubRefs) refer(name string, required bool) {
+	r.referenced[name] = struct{}{}
+	if required {
+		r.required[name] = struct{}{}
+	}
+}
+
+// Scrub runs a round of scrub
+func (s *Scrubber) Scrub(ctx context.Context) (*ScrubResult, error) {
+	if !s.running.TryLock() {
+		return nil, moerr.NewInternalError(ctx, "scrub is running")
+	}
+	defer s.running.Unlock()
+
+	result := &ScrubResult{Start: s.now()}
+	refs := &scrubRefs{
+		required:   make(map[string]struct{}),
+		referenced: make(map[string]struct{}),
+	}
+	var err error
+	if result.Checkpoints, err = s.collectCheckpoints(ctx, refs); err != nil {
+		return nil, err
+	}
+	s.collectCatalog(ctx, refs)
+	if s.cleaner != nil {
+		// the objects consumed by GC but not deleted yet
+		for _, table := range s.cleaner.GetGCTables() {
+			for name := range table.getObjects() {
+				refs.refer(name, false)
+			}
+			for name := range table.getTombstones() {
+				refs.refer(name, false)
+			}
+		}
+	}
+
+	entries, err := s.fs.ListDir("")
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[string]int64, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir {
+			continue
+		}
+		stored[entry.Name] = entry.Size
+	}
+	result.Objects = len(stored)
+
+	names := make([]string, 0, len(stored))
+	for name := range stored {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, ok := refs.referenced[name]; !ok {
+			if issue := s.checkOrphan(name, stored[name]); issue != nil {
+				result.Issues = append(result.Issues, *issue)
+			}
+			continue
+		}
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+		extents, err := objectio.VerifyObject(ctx, name, s.fs.Service)
+		if err != nil {
+			if moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+				// deleted by GC after listed
+				continue
+			}
+			result.Issues = append(result.Issues, ScrubIssue{
+				Kind:   ScrubCorrupted,
+				Object: name,
+				Detail: err.Error(),
+				Size:   stored[name],
+			})
+			continue
+		}
+		result.Verified++
+		result.Extents += extents
+	}
+
+	dangling := make([]string, 0)
+	for name := range refs.required {
+		if _, ok := stored[name]; !ok {
+			dangling = append(dangling, name)
+		}
+	}
+	sort.Strings(dangling)
+	for _, name := range dangling {
+		// confirm it as it may be written after listed
+		if _, err = s.fs.Service.StatFile(ctx, name); err == nil {
+			continue
+		} else if !moerr.IsMoErrCode(err, moerr.ErrFileNotFound) {
+			return nil, err
+		}
+		result.Issues = append(result.Issues, ScrubIssue{
+			Kind:   ScrubDangling,
+			Object: name,
+			Detail: "referenced but not found in the object store",
+		})
+	}
+
+	result.Duration = time.Since(result.Start)
+	s.report(ctx, result)
+	s.last.Lock()
+	s.last.result = result
+	s.last.Unlock()
+	return result, nil
+}
+
+// checkpoints returns the checkpoints of the running TN, or the ones
+// recorded in the latest checkpoint meta file if it runs offline.
+func (s *Scrubber) checkpoints(ctx context.Context) ([]*checkpoint.CheckpointEntry, error) {
+	if s.ckpClient != nil {
+		entries := s.ckpClient.GetAllGlobalCheckpoints()
+		return append(entries, s.ckpClient.GetAllIncrementalCheckpoints()...), nil
+	}
+	files, idx, err := checkpoint.ListSnapshotMeta(ctx, s.fs.Service, types.MaxTs(), checkpoint.SpecifiedCheckpoint)
+	if err != nil || len(files) == 0 {
+		return nil, err
+	}
+	return checkpoint.ListSnapshotCheckpointWithMeta(ctx, s.sid, s.fs.Service, files, idx, types.TS{}, true)
+}
+
+// collectCheckpoints collects the objects referenced by the checkpoints.
+// The objects alive in the latest global checkpoint and the incremental
+// checkpoints after it are required, unless they are dropped later.
+func (s *Scrubber) collectCheckpoints(ctx context.Context, refs *scrubRefs) (int, error) {
+	entries, err := s.checkpoints(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var global *checkpoint.CheckpointEntry
+	for _, entry := range entries {
+		if !entry.IsFinished() || entry.IsIncremental() {
+			continue
+		}
+		if global == nil {
+			global = entry
+			continue
+		}
+		if end, globalEnd := entry.GetEnd(), global.GetEnd(); end.Greater(&globalEnd) {
+			global = entry
+		}
+	}
+
+	alive := make(map[string]bool)
+	count := 0
+	for _, entry := range entries {
+		if !entry.IsFinished() {
+			continue
+		}
+		locations, data, err := logtail.LoadCheckpointEntriesFromKey(
+			ctx, s.sid, s.fs.Service, entry.GetLocation(), entry.GetVersion(), nil, &types.TS{})
+		if err != nil {
+			return 0, err
+		}
+		data.Close()
+		count++
+		latest := global == nil || entry == global
+		if !latest && entry.IsIncremental() {
+			end, globalEnd := entry.GetEnd(), global.GetEnd()
+			latest = end.Greater(&globalEnd)
+		}
+		for _, location := range locations {
+			name := location.Location.Name().String()
+			refs.refer(name, false)
+			if !latest {
+				continue
+			}
+			if !location.DropTS.IsEmpty() {
+				alive[name] = false
+			} else if _, ok := alive[name]; !ok {
+				alive[name] = true
+			}
+		}
+		// the files of the checkpoint itself
+		refs.refer(entry.GetLocation().Name().String(), latest)
+		if tnLocation := entry.GetTNLocation(); !tnLocation.IsEmpty() {
+			refs.refer(tnLocation.Name().String(), latest)
+		}
+	}
+	for name, ok := range alive {
+		if ok {
+			refs.required[name] = struct{}{}
+		}
+	}
+	return count, nil
+}
+
+// collectCatalog collects the objects and the delta locations in the
+// catalog. The committed non-appendable objects not dropped are required.
+func (s *Scrubber) collectCatalog(ctx context.Context, refs *scrubRefs) {
+	if s.ckpClient == nil {
+		return
+	}
+	c := s.ckpClient.GetCatalog()
+	if c == nil {
+		return
+	}
+	dropped := make(map[string]bool)
+	bat := makeRespBatchFromSchema(logtail.BlkMetaSchema, common.DebugAllocator)
+	defer bat.Close()
+	end := types.BuildTS(s.now().UnixNano(), 0)
+	it := c.MakeDBIt(true)
+	for ; it.Valid(); it.Next() {
+		db := it.Get().GetPayload()
+		itTable := db.MakeTableIt(true)
+		for ; itTable.Valid(); itTable.Next() {
+			table := itTable.Get().GetPayload()
+			itObject := table.MakeObjectIt(true)
+			for itObject.Next() {
+				entry := itObject.Item()
+				stats := entry.GetObjectStats()
+				name := stats.ObjectName().String()
+				refs.referenced[name] = struct{}{}
+				if entry.IsAppendable() || entry.IsCreatingOrAborted() || entry.HasDropIntent() {
+					dropped[name] = true
+				} else if _, ok := dropped[name]; !ok {
+					dropped[name] = false
+				}
+			}
+			itObject.Release()
+			for _, item := range table.GetDeleteList().Items() {
+				if _, _, _, err := item.VisitDeletes(ctx, types.TS{}, end, bat, nil, true, false); err != nil {
+					logutil.Warn("[Scrub]", common.OperationField("visit deletes"), common.ErrorField(err))
+				}
+			}
+		}
+	}
+	for name, ok := range dropped {
+		if !ok {
+			refs.required[name] = struct{}{}
+		}
+	}
+	for i := 0; i < bat.Length(); i++ {
+		deltaLoc := objectio.Location(bat.GetVectorByName(catalog2.BlockMeta_DeltaLoc).Get(i).([]byte))
+		if !deltaLoc.IsEmpty() {
+			refs.referenced[deltaLoc.Name().String()] = struct{}{}
+		}
+	}
+}
+
+// checkOrphan reports the object not referenced if it is older than the
+// GC window. The age is taken from the UUIDv7 of the object name, the
+// files not named as objects are skipped.
+func (s *Scrubber) checkOrphan(name string, size int64) *ScrubIssue {
+	created, ok := objectCreateTime(name)
+	if !ok {
+		return nil
+	}
+	age := s.now().Sub(created)
+	if age < s.gcWindow {
+		return nil
+	}
+	return &ScrubIssue{
+		Kind:   ScrubOrphan,
+		Object: name,
+		Detail: "not referenced by the catalog or the checkpoints",
+		Size:   size,
+		Age:    age.Truncate(time.Second).String(),
+	}
+}
+
+func objectCreateTime(name string) (time.Time, bool) {
+	pos := strings.LastIndexByte(name, '_')
+	if pos < 0 {
+		return time.Time{}, false
+	}
+	id, err := types.ParseUuid(name[:pos])
+	if err != nil || id[6]>>4 != 7 {
+		return time.Time{}, false
+	}
+	var ms [8]byte
+	copy(ms[2:], id[:6])
+	return time.UnixMilli(int64(binary.BigEndian.Uint64(ms[:]))), true
+}
+
+func (s *Scrubber) report(ctx context.Context, result *ScrubResult) {
+	logutil.Info("[Scrub]", common.OperationField("scrub"),
+		common.AnyField("checkpoints", result.Checkpoints),
+		common.AnyField("objects", result.Objects),
+		common.AnyField("verified", result.Verified),
+		common.AnyField("extents", result.Extents),
+		common.AnyField("issues", len(result.Issues)),
+		common.AnyField("cost", result.Duration))
+	for i := range result.Issues {
+		issue := &result.Issues[i]
+		logutil.Warn("[Scrub]", common.OperationField(issue.Kind),
+			common.OperandField(issue.Object),
+			common.AnyField("detail", issue.Detail))
+		extra, _ := json.Marshal(issue)
+		motrace.ReportView(ctx, &motrace.MOReportHolder{
+			View:      motrace.ScrubReportView,
+			Level:     issue.level(),
+			Name:      issue.Kind,
+			Message:   issue.Object,
+			Detail:    issue.Detail,
+			Extra:     string(extra),
+			Timestamp: result.Start,
+		})
+	}
+}
@@ -140,6 +140,7 @@ func (t *GCTable) SoftGC(
 	table *GCTable,
 	ts types.TS,
 	snapShotList map[uint32]containers.Vector,
+	pitrs []logtail.PITRRange,
 	meta *logtail.SnapshotMeta,
 ) ([]string, map[uint32][]types.TS) {
 	gc := make([]string, 0)
@@ -149,16 +150,8 @@ func (t *GCTable) SoftGC(
 		snapList[acct] = vector.MustFixedCol[types.TS](snap.GetDownstreamVector())
 	}
 	for name, entry := range objects {
-		objectEntry := table.objects[name]
 		tsList := meta.GetSnapshotList(snapList, entry.table)
-		if tsList == nil {
-			if objectEntry == nil && entry.commitTS.Less(&ts) {
-				gc = append(gc, name)
-				t.deleteObject(name)
-			}
-			continue
-		}
-		if objectEntry == nil && entry.commitTS.Less(&ts) && !isSnapshotRefers(entry, tsList, name) {
+		if _, _, retain := retainObject(name, entry, table.objects[name] != nil, ts, tsList, pitrs, meta); !retain {
 			gc = append(gc, name)
 			t.deleteObject(name)
 		}
@@ -167,47 +160,112 @@ func (t *GCTable) SoftGC(
 	objects = t.getObjects()
 	tombstones := t.getTombstones()
 	for name, tombstone := range tombstones {
-		ok := true
-		sameName := false
-		if tombstone.commitTS.GreaterEq(&ts) {
-			continue
-		}
-		for obj := range tombstone.objects {
-			if obj == name {
-				// tombstone for aObject is same as aObject, skip it
-				sameName = true
-			}
-			objectEntry := objects[obj]
-			if objectEntry != nil {
+		reason, detail, retain := retainTombstone(tombstone, ts, func(obj string) bool {
+			return objects[obj] != nil
+		})
+		if retain {
+			if reason == RetainReferenced {
 				// TODO: remove log
 				logutil.Debug("[soft GC] Refers object",
 					zap.String("tombstone", name),
-					zap.String("obj", obj),
-					zap.Bool("objectEntry", objectEntry != nil))
-				ok = false
-				break
+					zap.String("obj", detail))
 			}
+			continue
 		}
-		if ok {
-			if !sameName {
-				gc = append(gc, name)
-			}
-			// TODO: remove log
-			logutil.Debug("[soft GC] Delete tombstone",
-				zap.String("tombstone", name),
-				zap.Int("tombstone", len(t.tombstones)),
-				zap.Int("object", len(tombstone.objects)),
-				zap.Bool("same", sameName),
-				zap.Int("data", len(objects)))
-			t.deleteTombstone(name)
+		_, sameName := tombstone.objects[name]
+		if !sameName {
+			// tombstone for aObject is same as aObject, skip it
+			gc = append(gc, name)
 		}
+		// TODO: remove log
+		logutil.Debug("[soft GC] Delete tombstone",
+			zap.String("tombstone", name),
+			zap.Int("tombstone", len(t.tombstones)),
+			zap.Int("object", len(tombstone.objects)),
+			zap.Bool("same", sameName),
+			zap.Int("data", len(objects)))
+		t.deleteTombstone(name)
 	}
 	return gc, snapList
 }
 
+// retainObject decides whether GC retains the object and why. SoftGC and
+// the dry run both decide by it, so the dry run deletes what GC deletes.
+func retainObject(
+	name string,
+	entry *ObjectEntry,
+	alive bool,
+	ts types.TS,
+	tsList []types.TS,
+	pitrs []logtail.PITRRange,
+	meta *logtail.SnapshotMeta,
+) (reason, detail string, retain bool) {
+	if alive {
+		return RetainAlive, "", true
+	}
+	if !entry.commitTS.Less(&ts) {
+		return RetainCheckpoint, fmt.Sprintf("committed at %s after %s",
+			entry.commitTS.ToString(), ts.ToString()), true
+	}
+	if snapTS, ok := snapshotRefersAt(entry, tsList, name); ok {
+		return RetainSnapshot, fmt.Sprintf("snapshot at %s", snapTS.ToString()), true
+	}
+	if pitr := pitrRefers(entry, pitrs, meta); pitr != nil {
+		return RetainPITR, pitr.String(), true
+	}
+	return "", "", false
+}
+
+// retainTombstone decides whether GC retains the tombstone and why, retained
+// tells whether an object the tombstone refers is retained
+func retainTombstone(
+	tombstone *TombstoneEntry,
+	ts types.TS,
+	retained func(obj string) bool,
+) (reason, detail string, retain bool) {
+	if !tombstone.commitTS.Less(&ts) {
+		return RetainCheckpoint, fmt.Sprintf("committed at %s after %s",
+			tombstone.commitTS.ToString(), ts.ToString()), true
+	}
+	for obj := range tombstone.objects {
+		if retained(obj) {
+			return RetainReferenced, obj, true
+		}
+	}
+	return "", "", false
+}
+
+// pitrRefers returns the PITR that needs the object, which is dropped in its
+// range
+func pitrRefers(obj *ObjectEntry, pitrs []logtail.PITRRange, meta *logtail.SnapshotMeta) *logtail.PITRRange {
+	if len(pitrs) == 0 {
+		return nil
+	}
+	account, known := meta.GetAccountId(obj.table)
+	for i := range pitrs {
+		start := types.BuildTS(pitrs[i].Start, 0)
+		if !obj.dropTS.IsEmpty() && obj.dropTS.Less(&start) {
+			continue
+		}
+		if pitrs[i].Level == logtail.PITRLevelAccount && !known {
+			continue
+		}
+		if pitrs[i].Covers(account, obj.db, obj.table) {
+			return &pitrs[i]
+		}
+	}
+	return nil
+}
+
 func isSnapshotRefers(obj *ObjectEntry, snapVec []types.TS, name string) bool {
+	_, ok := snapshotRefersAt(obj, snapVec, name)
+	return ok
+}
+
+// snapshotRefersAt returns the snapshot that refers the object
+func snapshotRefersAt(obj *ObjectEntry, snapVec []types.TS, name string) (types.TS, bool) {
 	if len(snapVec) == 0 {
-		return false
+		return types.TS{}, false
 	}
 	left, right := 0, len(snapVec)-1
 	for left <= right {
@@ -216,14 +274,14 @@ func isSnapshotRefers(obj *ObjectEntry, snapVec []types.TS, name string) bool {
 		if snapTS.GreaterEq(&obj.createTS) && (obj.dropTS.IsEmpty() || snapTS.Less(&obj.dropTS)) {
 			logutil.Debug("[soft GC]Snapshot Refers", zap.String("name", name), zap.String("snapTS", snapTS.ToString()),
 				zap.String("createTS", obj.createTS.ToString()), zap.String("dropTS", obj.dropTS.ToString()))
-			return true
+			return snapTS, true
 		} else if snapTS.Less(&obj.createTS) {
 			left = mid + 1
 		} else {
 			right = mid - 1
 		}
 	}
-	return false
+	return types.TS{}, false
 }
 
 func (t *GCTable) UpdateTable(data *logtail.CheckpointData) {
@@ -15,10 +15,13 @@
 package v1
 
 import (
+	"context"
+
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
 )
 
 const (
@@ -69,6 +72,7 @@ const (
 const (
 	AddChecker    = "add_checker"
 	RemoveChecker = "remove_checker"
+	DryRun        = "dry_run"
 )
 
 const (
@@ -162,10 +166,13 @@ type Cleaner interface {
 	GetMinMerged() *checkpoint.CheckpointEntry
 	CheckGC() error
 	GetInputs() *GCTable
+	GetGCTables() []*GCTable
+	DryRunGC(ctx context.Context) (*DryRunReport, error)
 	SetTid(tid uint64)
 	EnableGCForTest()
 	DisableGCForTest()
 	SetCheckGC(enable bool)
 	GetMPool() *mpool.MPool
 	GetSnapshots() (map[uint32]containers.Vector, error)
+	GetPITRs() ([]logtail.PITRRange, error)
 }
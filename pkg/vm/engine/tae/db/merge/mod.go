@@ -216,6 +216,10 @@ func NewUpdatePolicyReq(c *BasicPolicyConfig) *api.AlterTableReq {
 				MaxOsizeMergedObj: uint32(c.MaxOsizeMergedObj),
 				MinCnMergeSize:    uint64(c.MinCNMergeSize),
 				Hints:             c.MergeHints,
+				Policy:            c.Policy,
+				SizeRatio:         c.SizeRatio,
+				TimeColumn:        c.TimeColumn,
+				TimeWindow:        int64(c.TimeWindow),
 			},
 		},
 	}
@@ -17,21 +17,19 @@ package merge
 import (
 	"bytes"
 	"cmp"
-	"context"
 	"fmt"
 	"slices"
 	"sync"
+	"time"
 
-	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/pb/api"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
-	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
 )
 
 var (
-	_                  Policy = (*basic)(nil)
-	defaultBasicConfig        = &BasicPolicyConfig{
+	_                  TablePolicy = (*basic)(nil)
+	defaultBasicConfig             = &BasicPolicyConfig{
 		MergeMaxOneRun:    common.DefaultMaxMergeObjN,
 		MaxOsizeMergedObj: common.DefaultMaxOsizeObjMB * common.Const1MBytes,
 		ObjectMinOsize:    common.DefaultMinOsizeQualifiedMB * common.Const1MBytes,
@@ -49,17 +47,41 @@ type BasicPolicyConfig struct {
 	MinCNMergeSize    uint64
 	FromUser          bool
 	MergeHints        []api.MergeHint
+
+	// Policy is the registered name of the merge policy, empty for basic
+	Policy string
+	// SizeRatio is the size ratio between tiers of the tiered policy
+	SizeRatio uint32
+	// TimeColumn and TimeWindow bucket objects for the time window policy
+	TimeColumn string
+	TimeWindow time.Duration
 }
 
 func (c *BasicPolicyConfig) String() string {
-	return fmt.Sprintf(
-		"minOsizeObj:%v, maxOneRun:%v, maxOsizeMergedObj: %v, offloadToCNSize:%v, hints: %v",
+	s := fmt.Sprintf(
+		"policy:%v, minOsizeObj:%v, maxOneRun:%v, maxOsizeMergedObj: %v, offloadToCNSize:%v, hints: %v",
+		c.PolicyName(),
 		common.HumanReadableBytes(int(c.ObjectMinOsize)),
 		c.MergeMaxOneRun,
 		common.HumanReadableBytes(int(c.MaxOsizeMergedObj)),
 		common.HumanReadableBytes(int(c.MinCNMergeSize)),
 		c.MergeHints,
 	)
+	switch c.PolicyName() {
+	case PolicyTiered:
+		s += fmt.Sprintf(", sizeRatio: %v", c.SizeRatio)
+	case PolicyTimeWindow:
+		s += fmt.Sprintf(", timeColumn: %v, timeWindow: %v", c.TimeColumn, c.TimeWindow)
+	}
+	return s
+}
+
+// PolicyName returns the merge policy of the table.
+func (c *BasicPolicyConfig) PolicyName() string {
+	if c.Policy == "" {
+		return PolicyBasic
+	}
+	return c.Policy
 }
 
 type customConfigProvider struct {
@@ -80,7 +102,7 @@ func (o *customConfigProvider) GetConfig(tbl *catalog.TableEntry) *BasicPolicyCo
 	if !ok {
 		// load from an atomic value
 		extra := tbl.GetLastestSchemaLocked().Extra
-		if extra.MaxObjOnerun != 0 || extra.MinOsizeQuailifed != 0 {
+		if extra.MaxObjOnerun != 0 || extra.MinOsizeQuailifed != 0 || extra.MergePolicy != "" {
 			// compatible with old version
 			cnSize := extra.MinCnMergeSize
 			if cnSize == 0 {
@@ -102,6 +124,10 @@ func (o *customConfigProvider) GetConfig(tbl *catalog.TableEntry) *BasicPolicyCo
 				MinCNMergeSize:    cnSize,
 				FromUser:          true,
 				MergeHints:        extra.Hints,
+				Policy:            extra.MergePolicy,
+				SizeRatio:         extra.SizeRatio,
+				TimeColumn:        extra.TimeColumn,
+				TimeWindow:        time.Duration(extra.TimeWindow),
 			}
 			o.configs[tbl.ID] = p
 		} else {
@@ -136,7 +162,7 @@ func (o *customConfigProvider) String() string {
 	buf.WriteString("customConfigProvider: ")
 	for _, k := range keys {
 		c := o.configs[k]
-		buf.WriteString(fmt.Sprintf("%d-%v:%v,%v | ", k, c.name, c.ObjectMinOsize, c.MergeMaxOneRun))
+		buf.WriteString(fmt.Sprintf("%d-%v:%v,%v,%v | ", k, c.name, c.PolicyName(), c.ObjectMinOsize, c.MergeMaxOneRun))
 	}
 	return buf.String()
 }
@@ -155,21 +181,19 @@ type basic struct {
 	guessType common.WorkloadKind
 	accBuf    []int
 
-	config         *BasicPolicyConfig
-	configProvider *customConfigProvider
+	config *BasicPolicyConfig
 }
 
-func NewBasicPolicy() Policy {
+func newBasicPolicy() TablePolicy {
 	return &basic{
 		objHeap: &heapBuilder[*catalog.ObjectEntry]{
 			items: make(itemSet[*catalog.ObjectEntry], 0, 32),
 		},
-		accBuf:         make([]int, 1, 32),
-		configProvider: newCustomConfigProvider(),
+		accBuf: make([]int, 1, 32),
 	}
 }
 
-// impl Policy for Basic
+// impl TablePolicy for Basic
 func (o *basic) OnObject(obj *catalog.ObjectEntry, force bool) {
 	rowsLeftOnObj := obj.GetRemainingRows()
 	osize := obj.GetOriginSize()
@@ -198,91 +222,14 @@ func (o *basic) OnObject(obj *catalog.ObjectEntry, force bool) {
 	}
 }
 
-func (o *basic) SetConfig(tbl *catalog.TableEntry, f func() txnif.AsyncTxn, c any) {
-	txn := f()
-	if tbl == nil || txn == nil {
-		return
-	}
-	db, err := txn.GetDatabaseByID(tbl.GetDB().ID)
-	if err != nil {
-		return
-	}
-	tblHandle, err := db.GetRelationByID(tbl.ID)
-	if err != nil {
-		return
-	}
-	cfg := c.(*BasicPolicyConfig)
-	ctx := context.Background()
-	tblHandle.AlterTable(
-		ctx,
-		NewUpdatePolicyReq(cfg),
-	)
-	logutil.Infof("mergeblocks set %v-%v config: %v", tbl.ID, tbl.GetLastestSchemaLocked().Name, cfg)
-	txn.Commit(ctx)
-	o.configProvider.InvalidCache(tbl)
-}
-
-func (o *basic) GetConfig(tbl *catalog.TableEntry) any {
-	r := o.configProvider.GetConfig(tbl)
-	if r == nil {
-		r = &BasicPolicyConfig{
-			ObjectMinOsize:    common.RuntimeOsizeRowsQualified.Load(),
-			MaxOsizeMergedObj: common.RuntimeMaxObjOsize.Load(),
-			MergeMaxOneRun:    int(common.RuntimeMaxMergeObjN.Load()),
-			MinCNMergeSize:    common.RuntimeMinCNMergeSize.Load(),
-		}
-	}
-	return r
-}
-
 func (o *basic) Revise(cpu, mem int64) ([]*catalog.ObjectEntry, TaskHostKind) {
 	objs := o.objHeap.finish()
 	slices.SortFunc(objs, func(a, b *catalog.ObjectEntry) int {
 		return cmp.Compare(a.GetRemainingRows(), b.GetRemainingRows())
 	})
-
-	isStandalone := common.IsStandaloneBoost.Load()
-	mergeOnDNIfStandalone := !common.ShouldStandaloneCNTakeOver.Load()
-
-	dnobjs := o.controlMem(objs, mem)
-	dnobjs = o.optimize(dnobjs)
-
-	dnosize, _, _ := estimateMergeConsume(dnobjs)
-
-	schedDN := func() ([]*catalog.ObjectEntry, TaskHostKind) {
-		if cpu > 85 {
-			if dnosize > 25*common.Const1MBytes {
-				logutil.Infof("mergeblocks skip big merge for high level cpu usage, %d", cpu)
-				return nil, TaskHostDN
-			}
-		}
-		return dnobjs, TaskHostDN
-	}
-
-	schedCN := func() ([]*catalog.ObjectEntry, TaskHostKind) {
-		cnobjs := o.controlMem(objs, int64(common.RuntimeCNMergeMemControl.Load()))
-		cnobjs = o.optimize(cnobjs)
-		return cnobjs, TaskHostCN
-	}
-
-	if isStandalone && mergeOnDNIfStandalone {
-		return schedDN()
-	}
-
-	// CNs come into the picture in two cases:
-	// 1.cluster deployed
-	// 2.standalone deployed but it's asked to merge on cn
-	if common.RuntimeCNTakeOverAll.Load() || dnosize > int(common.RuntimeMinCNMergeSize.Load()) {
-		return schedCN()
-	}
-
-	// CNs don't take over the task, leave it on dn.
-	return schedDN()
-}
-
-func (o *basic) ConfigString() string {
-	r := o.configProvider.String()
-	return r
+	return reviseHost(objs, cpu, mem, func(objs []*catalog.ObjectEntry, mem int64) []*catalog.ObjectEntry {
+		return o.optimize(controlMem(objs, mem))
+	})
 }
 
 func (o *basic) optimize(objs []*catalog.ObjectEntry) []*catalog.ObjectEntry {
@@ -319,35 +266,11 @@ func (o *basic) optimize(objs []*catalog.ObjectEntry) []*catalog.ObjectEntry {
 	return objs
 }
 
-func (o *basic) controlMem(objs []*catalog.ObjectEntry, mem int64) []*catalog.ObjectEntry {
-	if mem > constMaxMemCap {
-		mem = constMaxMemCap
-	}
-
-	needPopout := func(ss []*catalog.ObjectEntry) bool {
-		_, esize, _ := estimateMergeConsume(ss)
-		if esize > int(2*mem/3) {
-			return true
-		}
-
-		if len(ss) <= 2 {
-			return false
-		}
-		return false
-	}
-	for needPopout(objs) {
-		objs = objs[:len(objs)-1]
-	}
-
-	return objs
-}
-
-func (o *basic) ResetForTable(entry *catalog.TableEntry) {
+func (o *basic) ResetForTable(entry *catalog.TableEntry, config *BasicPolicyConfig) {
 	o.id = entry.ID
 	o.schema = entry.GetLastestSchemaLocked()
 	o.hist = entry.Stats.GetLastMerge()
 	o.guessType = entry.Stats.GetWorkloadGuess()
 	o.objHeap.reset()
-
-	o.config = o.configProvider.GetConfig(entry)
+	o.config = config
 }
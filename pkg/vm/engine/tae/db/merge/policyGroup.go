@@ -0,0 +1,246 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"context"
+	"sync"
+
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	PolicyBasic      = "basic"
+	PolicyTiered     = "tiered"
+	PolicyTimeWindow = "timewindow"
+)
+
+// TablePolicy picks the objects to merge for one table at a time. The
+// policy of a table is chosen by the Policy of its BasicPolicyConfig.
+type TablePolicy interface {
+	ResetForTable(*catalog.TableEntry, *BasicPolicyConfig)
+	OnObject(obj *catalog.ObjectEntry, force bool)
+	Revise(cpu, mem int64) ([]*catalog.ObjectEntry, TaskHostKind)
+}
+
+var policyRegistry = struct {
+	sync.RWMutex
+	factories map[string]func() TablePolicy
+}{
+	factories: map[string]func() TablePolicy{
+		PolicyBasic:      newBasicPolicy,
+		PolicyTiered:     newTieredPolicy,
+		PolicyTimeWindow: newTimeWindowPolicy,
+	},
+}
+
+// RegisterPolicy registers a merge policy by name, policy groups created
+// afterwards can select it.
+func RegisterPolicy(name string, factory func() TablePolicy) {
+	policyRegistry.Lock()
+	defer policyRegistry.Unlock()
+	policyRegistry.factories[name] = factory
+}
+
+// IsRegisteredPolicy reports whether a merge policy is registered by name.
+func IsRegisteredPolicy(name string) bool {
+	policyRegistry.RLock()
+	defer policyRegistry.RUnlock()
+	_, ok := policyRegistry.factories[name]
+	return ok
+}
+
+type policyMetrics struct {
+	scheduled prometheus.Counter
+	objects   prometheus.Counter
+}
+
+// policyGroup dispatches each table to its registered policy and keeps the
+// table configs shared by all policies.
+type policyGroup struct {
+	policies map[string]TablePolicy
+	metrics  map[string]policyMetrics
+	current  string
+	forced   map[*catalog.ObjectEntry]struct{}
+
+	configProvider *customConfigProvider
+}
+
+func NewPolicyGroup() Policy {
+	g := &policyGroup{
+		policies:       make(map[string]TablePolicy),
+		metrics:        make(map[string]policyMetrics),
+		forced:         make(map[*catalog.ObjectEntry]struct{}),
+		configProvider: newCustomConfigProvider(),
+	}
+	policyRegistry.RLock()
+	defer policyRegistry.RUnlock()
+	for name, factory := range policyRegistry.factories {
+		g.policies[name] = factory()
+		g.metrics[name] = policyMetrics{
+			scheduled: v2.NewTaskMergePolicyScheduledCounterByName(name),
+			objects:   v2.NewTaskMergePolicyObjectCounterByName(name),
+		}
+	}
+	return g
+}
+
+func (g *policyGroup) ResetForTable(entry *catalog.TableEntry) {
+	config := g.configProvider.GetConfig(entry)
+	g.current = config.PolicyName()
+	if _, ok := g.policies[g.current]; !ok {
+		logutil.Warnf("mergeblocks unknown policy %q of %v-%v, fallback to basic",
+			g.current, entry.ID, entry.GetLastestSchemaLocked().Name)
+		g.current = PolicyBasic
+	}
+	clear(g.forced)
+	g.policies[g.current].ResetForTable(entry, config)
+}
+
+func (g *policyGroup) OnObject(obj *catalog.ObjectEntry, force bool) {
+	if force {
+		g.forced[obj] = struct{}{}
+	}
+	g.policies[g.current].OnObject(obj, force)
+}
+
+func (g *policyGroup) Revise(cpu, mem int64) ([]*catalog.ObjectEntry, TaskHostKind) {
+	objs, kind := g.policies[g.current].Revise(cpu, mem)
+	if len(objs) > 1 || (len(objs) == 1 && g.isForced(objs[0])) {
+		m := g.metrics[g.current]
+		m.scheduled.Inc()
+		m.objects.Add(float64(len(objs)))
+	}
+	return objs, kind
+}
+
+func (g *policyGroup) isForced(obj *catalog.ObjectEntry) bool {
+	_, ok := g.forced[obj]
+	return ok
+}
+
+func (g *policyGroup) SetConfig(tbl *catalog.TableEntry, f func() txnif.AsyncTxn, c any) {
+	txn := f()
+	if tbl == nil || txn == nil {
+		return
+	}
+	db, err := txn.GetDatabaseByID(tbl.GetDB().ID)
+	if err != nil {
+		return
+	}
+	tblHandle, err := db.GetRelationByID(tbl.ID)
+	if err != nil {
+		return
+	}
+	cfg := c.(*BasicPolicyConfig)
+	ctx := context.Background()
+	tblHandle.AlterTable(
+		ctx,
+		NewUpdatePolicyReq(cfg),
+	)
+	logutil.Infof("mergeblocks set %v-%v config: %v", tbl.ID, tbl.GetLastestSchemaLocked().Name, cfg)
+	txn.Commit(ctx)
+	g.configProvider.InvalidCache(tbl)
+}
+
+func (g *policyGroup) GetConfig(tbl *catalog.TableEntry) any {
+	r := g.configProvider.GetConfig(tbl)
+	if r == nil {
+		r = &BasicPolicyConfig{
+			ObjectMinOsize:    common.RuntimeOsizeRowsQualified.Load(),
+			MaxOsizeMergedObj: common.RuntimeMaxObjOsize.Load(),
+			MergeMaxOneRun:    int(common.RuntimeMaxMergeObjN.Load()),
+			MinCNMergeSize:    common.RuntimeMinCNMergeSize.Load(),
+		}
+	}
+	return r
+}
+
+func (g *policyGroup) ConfigString() string {
+	r := g.configProvider.String()
+	return r
+}
+
+// reviseHost decides where to merge the objects picked by a policy, pick
+// trims the objects to fit the memory of the host.
+func reviseHost(
+	objs []*catalog.ObjectEntry,
+	cpu, mem int64,
+	pick func([]*catalog.ObjectEntry, int64) []*catalog.ObjectEntry,
+) ([]*catalog.ObjectEntry, TaskHostKind) {
+	isStandalone := common.IsStandaloneBoost.Load()
+	mergeOnDNIfStandalone := !common.ShouldStandaloneCNTakeOver.Load()
+
+	dnobjs := pick(objs, mem)
+
+	dnosize, _, _ := estimateMergeConsume(dnobjs)
+
+	schedDN := func() ([]*catalog.ObjectEntry, TaskHostKind) {
+		if cpu > 85 {
+			if dnosize > 25*common.Const1MBytes {
+				logutil.Infof("mergeblocks skip big merge for high level cpu usage, %d", cpu)
+				return nil, TaskHostDN
+			}
+		}
+		return dnobjs, TaskHostDN
+	}
+
+	schedCN := func() ([]*catalog.ObjectEntry, TaskHostKind) {
+		cnobjs := pick(objs, int64(common.RuntimeCNMergeMemControl.Load()))
+		return cnobjs, TaskHostCN
+	}
+
+	if isStandalone && mergeOnDNIfStandalone {
+		return schedDN()
+	}
+
+	// CNs come into the picture in two cases:
+	// 1.cluster deployed
+	// 2.standalone deployed but it's asked to merge on cn
+	if common.RuntimeCNTakeOverAll.Load() || dnosize > int(common.RuntimeMinCNMergeSize.Load()) {
+		return schedCN()
+	}
+
+	// CNs don't take over the task, leave it on dn.
+	return schedDN()
+}
+
+func controlMem(objs []*catalog.ObjectEntry, mem int64) []*catalog.ObjectEntry {
+	if mem > constMaxMemCap {
+		mem = constMaxMemCap
+	}
+
+	needPopout := func(ss []*catalog.ObjectEntry) bool {
+		_, esize, _ := estimateMergeConsume(ss)
+		if esize > int(2*mem/3) {
+			return true
+		}
+
+		if len(ss) <= 2 {
+			return false
+		}
+		return false
+	}
+	for needPopout(objs) {
+		objs = objs[:len(objs)-1]
+	}
+
+	return objs
+}
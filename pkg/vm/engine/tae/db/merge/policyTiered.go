@@ -0,0 +1,99 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+)
+
+const (
+	constDefaultSizeRatio = 4
+	// a tier is merged once it holds this many objects
+	constTierMinObjs = 4
+)
+
+var _ TablePolicy = (*tiered)(nil)
+
+// tiered merges objects of similar sizes. Objects are sorted by size and
+// cut into tiers, the sizes of a tier are within SizeRatio of its smallest
+// object. Objects smaller than ObjectMinOsize all fall into the first tier.
+// Every object is rewritten about log(SizeRatio) times of the table growth,
+// which suits append-heavy tables.
+type tiered struct {
+	config *BasicPolicyConfig
+	objs   []*catalog.ObjectEntry
+	forced []*catalog.ObjectEntry
+}
+
+func newTieredPolicy() TablePolicy {
+	return &tiered{}
+}
+
+func (o *tiered) ResetForTable(entry *catalog.TableEntry, config *BasicPolicyConfig) {
+	o.config = config
+	o.objs = o.objs[:0]
+	o.forced = o.forced[:0]
+}
+
+func (o *tiered) OnObject(obj *catalog.ObjectEntry, force bool) {
+	if force {
+		o.forced = append(o.forced, obj)
+		return
+	}
+	// big objects have reached the last tier
+	if obj.GetOriginSize() >= int(o.config.MaxOsizeMergedObj) {
+		return
+	}
+	o.objs = append(o.objs, obj)
+}
+
+func (o *tiered) Revise(cpu, mem int64) ([]*catalog.ObjectEntry, TaskHostKind) {
+	objs := o.forced
+	if len(objs) == 0 {
+		objs = o.pickTier()
+	}
+	if len(objs) > o.config.MergeMaxOneRun {
+		objs = objs[:o.config.MergeMaxOneRun]
+	}
+	return reviseHost(objs, cpu, mem, controlMem)
+}
+
+// pickTier returns the objects of the smallest tier that is full.
+func (o *tiered) pickTier() []*catalog.ObjectEntry {
+	ratio := int(o.config.SizeRatio)
+	if ratio < 2 {
+		ratio = constDefaultSizeRatio
+	}
+	size := func(obj *catalog.ObjectEntry) int {
+		return max(obj.GetOriginSize(), int(o.config.ObjectMinOsize))
+	}
+	slices.SortFunc(o.objs, func(a, b *catalog.ObjectEntry) int {
+		return cmp.Compare(a.GetOriginSize(), b.GetOriginSize())
+	})
+	for start := 0; start < len(o.objs); {
+		end := start + 1
+		for end < len(o.objs) && size(o.objs[end]) <= ratio*size(o.objs[start]) {
+			end++
+		}
+		if end-start >= constTierMinObjs {
+			return o.objs[start:end]
+		}
+		start = end
+	}
+	return nil
+}
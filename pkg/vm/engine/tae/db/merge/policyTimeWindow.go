@@ -0,0 +1,165 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
+)
+
+var _ TablePolicy = (*timeWindow)(nil)
+
+// timeWindow merges small objects only with the objects of the same time
+// window, the window of an object is decided by the zonemap of TimeColumn.
+// Objects spanning several windows are left alone, so old windows are not
+// rewritten by the merges of the newly appended data.
+type timeWindow struct {
+	config    *BasicPolicyConfig
+	seqnum    uint16
+	isSortKey bool
+	window    int64 // in microseconds
+	valid     bool
+	buckets   map[int64][]*catalog.ObjectEntry
+	forced    []*catalog.ObjectEntry
+}
+
+func newTimeWindowPolicy() TablePolicy {
+	return &timeWindow{
+		buckets: make(map[int64][]*catalog.ObjectEntry),
+	}
+}
+
+// IsTimeWindowColumnType reports whether a column of the type can bucket
+// objects for the time window policy.
+func IsTimeWindowColumnType(oid types.T) bool {
+	return oid == types.T_timestamp || oid == types.T_datetime || oid == types.T_date
+}
+
+func (o *timeWindow) ResetForTable(entry *catalog.TableEntry, config *BasicPolicyConfig) {
+	o.config = config
+	clear(o.buckets)
+	o.forced = o.forced[:0]
+
+	schema := entry.GetLastestSchemaLocked()
+	o.window = config.TimeWindow.Microseconds()
+	idx := schema.GetColIdx(strings.ToLower(config.TimeColumn))
+	o.valid = o.window > 0 && idx >= 0 && IsTimeWindowColumnType(schema.ColDefs[idx].Type.Oid)
+	if !o.valid {
+		return
+	}
+	o.seqnum = schema.ColDefs[idx].SeqNum
+	o.isSortKey = schema.HasSortKey() && schema.GetSingleSortKeyIdx() == idx
+}
+
+func (o *timeWindow) OnObject(obj *catalog.ObjectEntry, force bool) {
+	if force {
+		o.forced = append(o.forced, obj)
+		return
+	}
+	if !o.valid {
+		return
+	}
+	if obj.GetRemainingRows() >= obj.GetRows()/2 &&
+		obj.GetOriginSize() >= int(o.config.ObjectMinOsize) {
+		return
+	}
+	bucket, ok := o.bucketOf(obj)
+	if !ok {
+		return
+	}
+	o.buckets[bucket] = append(o.buckets[bucket], obj)
+}
+
+// bucketOf returns the window holding all values of the object.
+func (o *timeWindow) bucketOf(obj *catalog.ObjectEntry) (int64, bool) {
+	var zm index.ZM
+	if o.isSortKey {
+		zm = obj.GetSortKeyZonemap()
+	} else {
+		stats := obj.GetObjectStats()
+		location := stats.ObjectLocation()
+		meta, err := objectio.FastLoadObjectMeta(
+			context.Background(), &location, false, obj.GetObjectData().GetFs().Service)
+		if err != nil {
+			return 0, false
+		}
+		zm = meta.MustDataMeta().MustGetColumn(o.seqnum).ZoneMap()
+	}
+	lo, hi, ok := zonemapMicros(zm)
+	if !ok {
+		return 0, false
+	}
+	lo, hi = floorDiv(lo, o.window), floorDiv(hi, o.window)
+	return lo, lo == hi
+}
+
+func (o *timeWindow) Revise(cpu, mem int64) ([]*catalog.ObjectEntry, TaskHostKind) {
+	objs := o.forced
+	if len(objs) == 0 {
+		// the fullest window, the older one if even
+		var picked int64
+		for bucket, bobjs := range o.buckets {
+			if len(bobjs) < 2 {
+				continue
+			}
+			if len(bobjs) > len(objs) || (len(bobjs) == len(objs) && bucket < picked) {
+				objs, picked = bobjs, bucket
+			}
+		}
+	}
+	slices.SortFunc(objs, func(a, b *catalog.ObjectEntry) int {
+		return cmp.Compare(a.GetRemainingRows(), b.GetRemainingRows())
+	})
+	if len(objs) > o.config.MergeMaxOneRun {
+		objs = objs[:o.config.MergeMaxOneRun]
+	}
+	return reviseHost(objs, cpu, mem, controlMem)
+}
+
+// zonemapMicros returns the bounds of a time zonemap in microseconds.
+func zonemapMicros(zm index.ZM) (lo, hi int64, ok bool) {
+	if !zm.IsInited() {
+		return
+	}
+	switch zm.GetType() {
+	case types.T_timestamp:
+		lo = int64(types.DecodeFixed[types.Timestamp](zm.GetMinBuf()))
+		hi = int64(types.DecodeFixed[types.Timestamp](zm.GetMaxBuf()))
+	case types.T_datetime:
+		lo = int64(types.DecodeFixed[types.Datetime](zm.GetMinBuf()))
+		hi = int64(types.DecodeFixed[types.Datetime](zm.GetMaxBuf()))
+	case types.T_date:
+		lo = int64(types.DecodeFixed[types.Date](zm.GetMinBuf()).ToDatetime())
+		hi = int64(types.DecodeFixed[types.Date](zm.GetMaxBuf()).ToDatetime())
+	default:
+		return
+	}
+	return lo, hi, true
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && a < 0 {
+		q--
+	}
+	return q
+}
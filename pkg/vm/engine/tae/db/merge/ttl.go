@@ -0,0 +1,66 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"context"
+	"time"
+
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/fileservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
+)
+
+// TTLChecker finds the objects of a TTL table that hold expired rows, so
+// that they are merged and the expired rows are dropped physically. Once
+// all rows of an object are expired, the merge creates nothing and the
+// object file is removed by GC.
+type TTLChecker struct {
+	ttl       pkgcatalog.TableTTL
+	seqnum    uint16
+	isSortKey bool
+	now       time.Time
+	fs        fileservice.FileService
+}
+
+// NewTTLChecker returns nil if the table has no TTL.
+func NewTTLChecker(schema *catalog.Schema, fs fileservice.FileService, now time.Time) *TTLChecker {
+	ttl, colIdx, ok := schema.GetTTL()
+	if !ok {
+		return nil
+	}
+	return &TTLChecker{
+		ttl:       ttl,
+		seqnum:    schema.ColDefs[colIdx].SeqNum,
+		isSortKey: schema.HasSortKey() && schema.GetSingleSortKeyIdx() == colIdx,
+		now:       now,
+		fs:        fs,
+	}
+}
+
+// HasExpiredRows checks the zonemap of the ttl column of the object.
+func (c *TTLChecker) HasExpiredRows(ctx context.Context, obj *catalog.ObjectEntry) bool {
+	if c.isSortKey {
+		return c.ttl.AnyExpired(obj.GetSortKeyZonemap(), c.now)
+	}
+	stats := obj.GetObjectStats()
+	location := stats.ObjectLocation()
+	meta, err := objectio.FastLoadObjectMeta(ctx, &location, false, c.fs)
+	if err != nil {
+		return false
+	}
+	return c.ttl.AnyExpired(meta.MustDataMeta().MustGetColumn(c.seqnum).ZoneMap(), c.now)
+}
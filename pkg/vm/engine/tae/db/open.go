@@ -35,6 +35,7 @@ import (
 	gc2 "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/gc/v1"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/merge"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/gc"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/driver/logservicedriver"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
@@ -116,7 +117,16 @@ func Open(ctx context.Context, dirname string, opts *options.Options) (db *DB, e
 	case options.LogstoreBatchStore:
 		db.Wal = wal.NewDriverWithBatchStore(opts.Ctx, dirname, WALDir, nil)
 	case options.LogstoreLogservice:
-		db.Wal = wal.NewDriverWithLogservice(opts.Ctx, opts.Lc)
+		walCfg := logservicedriver.NewDefaultConfig(opts.Lc)
+		walCfg.Compression = opts.WalCfg.Compression
+		walCfg.CompressionMinSize = opts.WalCfg.CompressionMinSize
+		walCfg.GroupCommitLatency = opts.WalCfg.GroupCommitLatency
+		walCfg.GroupCommitSize = opts.WalCfg.GroupCommitSize
+		walCfg.ReadOnlyClientFactory = opts.StandbyLc
+		if err = walCfg.Validate(); err != nil {
+			return
+		}
+		db.Wal = wal.NewDriverWithLogservice(opts.Ctx, walCfg)
 	}
 	scheduler := newTaskScheduler(db, db.Opts.SchedulerCfg.AsyncWorkers, db.Opts.SchedulerCfg.IOWorkers)
 	db.Runtime = dbutils.NewRuntime(
@@ -184,6 +194,14 @@ func Open(ctx context.Context, dirname string, opts *options.Options) (db *DB, e
 		common.AnyField("cost", time.Since(now)),
 		common.AnyField("checkpointed", checkpointed.ToString()))
 
+	db.DBLocker, dbLocker = dbLocker, nil
+
+	if opts.StandbyLc != nil {
+		// the rest is done when promoted
+		db.startStandby(ctx, dataFactory, checkpointed, ckpLSN, valid)
+		return
+	}
+
 	now = time.Now()
 	db.Replay(dataFactory, checkpointed, ckpLSN, valid)
 	db.Catalog.ReplayTableRows()
@@ -193,7 +211,18 @@ func Open(ctx context.Context, dirname string, opts *options.Options) (db *DB, e
 		common.OperandField("wal"),
 		common.AnyField("cost", time.Since(now)))
 
-	db.DBLocker, dbLocker = dbLocker, nil
+	db.start(ctx)
+
+	// For debug or test
+	// logutil.Info(db.Catalog.SimplePPString(common.PPL2))
+	return
+}
+
+// start starts the background services of the replayed db.
+func (db *DB) start(ctx context.Context) {
+	opts := db.Opts
+	fs := db.Runtime.Fs
+	transferTable := db.Runtime.TransferTable
 
 	// Init timed scanner
 	scanner := NewDBScanner(db, nil)
@@ -218,6 +247,7 @@ func Open(ctx context.Context, dirname string, opts *options.Options) (db *DB, e
 		}, gc2.CheckerKeyTTL)
 	db.DiskCleaner = gc2.NewDiskCleaner(cleaner)
 	db.DiskCleaner.Start()
+	db.Scrubber = gc2.NewScrubber(opts.SID, fs, db.BGCheckpointRunner, cleaner, opts.GCCfg.GCTTL)
 	// Init gc manager at last
 	// TODO: clean-try-gc requires configuration parameters
 	cronJobs := []func(*gc.Manager){
@@ -289,15 +319,21 @@ func Open(ctx context.Context, dirname string, opts *options.Options) (db *DB, e
 					return nil
 				}))
 	}
+	if opts.GCCfg.ScrubInterval != 0 {
+		cronJobs = append(cronJobs,
+			gc.WithCronJob(
+				"object-scrub",
+				opts.GCCfg.ScrubInterval,
+				func(ctx context.Context) error {
+					_, err := db.Scrubber.Scrub(ctx)
+					return err
+				}))
+	}
 	db.GCManager = gc.NewManager(cronJobs...)
 
 	db.GCManager.Start()
 
 	go TaeMetricsTask(ctx)
-
-	// For debug or test
-	// logutil.Info(db.Catalog.SimplePPString(common.PPL2))
-	return
 }
 
 // TODO: remove it
@@ -73,6 +73,20 @@ func (m *DiskCleaner) UnmarshalBinary(data []byte) error {
 	return m.Unmarshal(data)
 }
 
+// DiskCleanerResp is the response of a disk cleaner op, Summary is the
+// summary in json of a dry run, empty for the other ops
+type DiskCleanerResp struct {
+	Summary string
+}
+
+func (m *DiskCleanerResp) MarshalBinary() ([]byte, error) {
+	return m.Marshal()
+}
+
+func (m *DiskCleanerResp) UnmarshalBinary(data []byte) error {
+	return m.Unmarshal(data)
+}
+
 type Checkpoint struct {
 	FlushDuration time.Duration
 }
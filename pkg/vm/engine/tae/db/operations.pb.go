@@ -188,11 +188,51 @@ func (m *DiskCleaner) GetValue() string {
 	return ""
 }
 
+func (m *DiskCleanerResp) Reset()         { *m = DiskCleanerResp{} }
+func (m *DiskCleanerResp) String() string { return proto.CompactTextString(m) }
+func (*DiskCleanerResp) ProtoMessage()    {}
+func (*DiskCleanerResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b4a5877375e491e, []int{3}
+}
+func (m *DiskCleanerResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiskCleanerResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiskCleanerResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DiskCleanerResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiskCleanerResp.Merge(m, src)
+}
+func (m *DiskCleanerResp) XXX_Size() int {
+	return m.ProtoSize()
+}
+func (m *DiskCleanerResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiskCleanerResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiskCleanerResp proto.InternalMessageInfo
+
+func (m *DiskCleanerResp) GetSummary() string {
+	if m != nil {
+		return m.Summary
+	}
+	return ""
+}
+
 func (m *Checkpoint) Reset()         { *m = Checkpoint{} }
 func (m *Checkpoint) String() string { return proto.CompactTextString(m) }
 func (*Checkpoint) ProtoMessage()    {}
 func (*Checkpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{3}
+	return fileDescriptor_1b4a5877375e491e, []int{4}
 }
 func (m *Checkpoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,7 +272,7 @@ func (m *InterceptCommit) Reset()         { *m = InterceptCommit{} }
 func (m *InterceptCommit) String() string { return proto.CompactTextString(m) }
 func (*InterceptCommit) ProtoMessage()    {}
 func (*InterceptCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{4}
+	return fileDescriptor_1b4a5877375e491e, []int{5}
 }
 func (m *InterceptCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +312,7 @@ func (m *InspectTN) Reset()         { *m = InspectTN{} }
 func (m *InspectTN) String() string { return proto.CompactTextString(m) }
 func (*InspectTN) ProtoMessage()    {}
 func (*InspectTN) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{5}
+	return fileDescriptor_1b4a5877375e491e, []int{6}
 }
 func (m *InspectTN) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +359,7 @@ func (m *InspectResp) Reset()         { *m = InspectResp{} }
 func (m *InspectResp) String() string { return proto.CompactTextString(m) }
 func (*InspectResp) ProtoMessage()    {}
 func (*InspectResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{6}
+	return fileDescriptor_1b4a5877375e491e, []int{7}
 }
 func (m *InspectResp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -373,7 +413,7 @@ func (m *CatalogResp) Reset()         { *m = CatalogResp{} }
 func (m *CatalogResp) String() string { return proto.CompactTextString(m) }
 func (*CatalogResp) ProtoMessage()    {}
 func (*CatalogResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{7}
+	return fileDescriptor_1b4a5877375e491e, []int{8}
 }
 func (m *CatalogResp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -420,7 +460,7 @@ func (m *FaultPoint) Reset()         { *m = FaultPoint{} }
 func (m *FaultPoint) String() string { return proto.CompactTextString(m) }
 func (*FaultPoint) ProtoMessage()    {}
 func (*FaultPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{8}
+	return fileDescriptor_1b4a5877375e491e, []int{9}
 }
 func (m *FaultPoint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -488,7 +528,7 @@ func (m *TraceSpan) Reset()         { *m = TraceSpan{} }
 func (m *TraceSpan) String() string { return proto.CompactTextString(m) }
 func (*TraceSpan) ProtoMessage()    {}
 func (*TraceSpan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{9}
+	return fileDescriptor_1b4a5877375e491e, []int{10}
 }
 func (m *TraceSpan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -542,7 +582,7 @@ func (m *BlockMetaInfo) Reset()         { *m = BlockMetaInfo{} }
 func (m *BlockMetaInfo) String() string { return proto.CompactTextString(m) }
 func (*BlockMetaInfo) ProtoMessage()    {}
 func (*BlockMetaInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{10}
+	return fileDescriptor_1b4a5877375e491e, []int{11}
 }
 func (m *BlockMetaInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -582,7 +622,7 @@ func (m *CkpMetaInfo) Reset()         { *m = CkpMetaInfo{} }
 func (m *CkpMetaInfo) String() string { return proto.CompactTextString(m) }
 func (*CkpMetaInfo) ProtoMessage()    {}
 func (*CkpMetaInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{11}
+	return fileDescriptor_1b4a5877375e491e, []int{12}
 }
 func (m *CkpMetaInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -629,7 +669,7 @@ func (m *StorageUsageResp_V0) Reset()         { *m = StorageUsageResp_V0{} }
 func (m *StorageUsageResp_V0) String() string { return proto.CompactTextString(m) }
 func (*StorageUsageResp_V0) ProtoMessage()    {}
 func (*StorageUsageResp_V0) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{12}
+	return fileDescriptor_1b4a5877375e491e, []int{13}
 }
 func (m *StorageUsageResp_V0) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +723,7 @@ func (m *StorageUsageReq) Reset()         { *m = StorageUsageReq{} }
 func (m *StorageUsageReq) String() string { return proto.CompactTextString(m) }
 func (*StorageUsageReq) ProtoMessage()    {}
 func (*StorageUsageReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{13}
+	return fileDescriptor_1b4a5877375e491e, []int{14}
 }
 func (m *StorageUsageReq) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -723,7 +763,7 @@ func (m *StorageUsageResp) Reset()         { *m = StorageUsageResp{} }
 func (m *StorageUsageResp) String() string { return proto.CompactTextString(m) }
 func (*StorageUsageResp) ProtoMessage()    {}
 func (*StorageUsageResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b4a5877375e491e, []int{14}
+	return fileDescriptor_1b4a5877375e491e, []int{15}
 }
 func (m *StorageUsageResp) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -784,6 +824,7 @@ func init() {
 	proto.RegisterType((*AccessInfo)(nil), "db.AccessInfo")
 	proto.RegisterType((*FlushTable)(nil), "db.FlushTable")
 	proto.RegisterType((*DiskCleaner)(nil), "db.DiskCleaner")
+	proto.RegisterType((*DiskCleanerResp)(nil), "db.DiskCleanerResp")
 	proto.RegisterType((*Checkpoint)(nil), "db.Checkpoint")
 	proto.RegisterType((*InterceptCommit)(nil), "db.InterceptCommit")
 	proto.RegisterType((*InspectTN)(nil), "db.InspectTN")
@@ -801,55 +842,56 @@ func init() {
 func init() { proto.RegisterFile("operations.proto", fileDescriptor_1b4a5877375e491e) }
 
 var fileDescriptor_1b4a5877375e491e = []byte{
-	// 762 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xbb, 0x6e, 0xdb, 0x48,
-	0x14, 0x95, 0x44, 0xfa, 0xa1, 0x2b, 0xcb, 0x8f, 0xd9, 0xc5, 0x42, 0x6b, 0x18, 0x94, 0xd7, 0x95,
-	0xb7, 0x58, 0x69, 0xe1, 0x5d, 0x23, 0x40, 0x3a, 0x4b, 0x8a, 0x01, 0xc6, 0xf0, 0x03, 0x23, 0xd9,
-	0x29, 0x93, 0xe1, 0x68, 0x4c, 0x11, 0xa2, 0x38, 0x0c, 0x67, 0x68, 0xc0, 0x2e, 0xf3, 0x05, 0xf9,
-	0x81, 0x00, 0xc9, 0xdf, 0xb8, 0x74, 0x99, 0xca, 0x48, 0xac, 0x1f, 0x48, 0xed, 0x2a, 0x98, 0xe1,
-	0xd0, 0x94, 0x52, 0xa4, 0x48, 0x77, 0xcf, 0xe1, 0xdc, 0x73, 0x1f, 0xe7, 0x82, 0xb0, 0xce, 0x63,
-	0x96, 0x10, 0x19, 0xf0, 0x48, 0xb4, 0xe2, 0x84, 0x4b, 0x8e, 0x2a, 0x43, 0x6f, 0xf3, 0x1f, 0x3f,
-	0x90, 0xa3, 0xd4, 0x6b, 0x51, 0x3e, 0x69, 0xfb, 0xdc, 0xe7, 0x6d, 0xfd, 0xc9, 0x4b, 0x2f, 0x35,
-	0xd2, 0x40, 0x47, 0x59, 0xca, 0xce, 0x1b, 0x80, 0x03, 0x4a, 0x99, 0x10, 0x6e, 0x74, 0xc9, 0xd1,
-	0x16, 0x54, 0x0f, 0x28, 0xe5, 0x69, 0x24, 0xdd, 0x5e, 0xa3, 0xbc, 0x5d, 0xde, 0xad, 0xe3, 0x82,
-	0x40, 0x7f, 0xc0, 0xe2, 0xb9, 0x60, 0x89, 0xdb, 0x6b, 0x54, 0xf4, 0x27, 0x83, 0x14, 0x8f, 0x79,
-	0xc8, 0xdc, 0x5e, 0xc3, 0xca, 0xf8, 0x0c, 0x3d, 0xb7, 0xbf, 0x7d, 0x6a, 0x96, 0x76, 0xde, 0x95,
-	0x01, 0x0e, 0xc3, 0x54, 0x8c, 0x06, 0xc4, 0x0b, 0x19, 0xfa, 0x7f, 0xb6, 0xa0, 0xae, 0x51, 0xdb,
-	0x5b, 0x6d, 0x0d, 0xbd, 0x56, 0xc1, 0x76, 0xec, 0xdb, 0xfb, 0x66, 0x09, 0xcf, 0x36, 0xe6, 0x00,
-	0xf4, 0x88, 0x24, 0x1e, 0x11, 0xcc, 0x94, 0xb7, 0xf1, 0x0c, 0x83, 0x1a, 0xb0, 0xa4, 0xe5, 0x4d,
-	0x0f, 0x36, 0xce, 0xa1, 0x69, 0xe2, 0x08, 0x6a, 0xbd, 0x40, 0x8c, 0xbb, 0x21, 0x23, 0x11, 0x4b,
-	0xd0, 0x2a, 0x54, 0x4e, 0x63, 0x5d, 0xbc, 0x8a, 0x2b, 0xa7, 0x31, 0x5a, 0x07, 0xeb, 0x88, 0x5d,
-	0x6b, 0xdd, 0x2a, 0x56, 0x21, 0xfa, 0x1d, 0x16, 0x2e, 0x48, 0x98, 0x32, 0x2d, 0x57, 0xc5, 0x19,
-	0x78, 0x12, 0x83, 0xee, 0x88, 0xd1, 0x71, 0xcc, 0x83, 0x48, 0xa2, 0x67, 0x50, 0xd7, 0xe3, 0xf5,
-	0xd2, 0xcc, 0x0c, 0x2d, 0x6b, 0x75, 0x36, 0x1e, 0xef, 0x9b, 0x75, 0x19, 0x4c, 0x58, 0x2b, 0xff,
-	0x80, 0xe7, 0xdf, 0x19, 0xb1, 0x7d, 0x58, 0x73, 0x23, 0xc9, 0x12, 0xca, 0x62, 0xd9, 0xe5, 0x93,
-	0x49, 0x20, 0x95, 0x0b, 0xba, 0xfb, 0x13, 0x32, 0x61, 0xa6, 0xc9, 0x82, 0x30, 0x69, 0x0c, 0xaa,
-	0x6e, 0x24, 0x62, 0x46, 0xe5, 0xe0, 0xe4, 0x17, 0x77, 0xba, 0x05, 0xd5, 0xd3, 0xfc, 0x82, 0xcc,
-	0xe8, 0x05, 0x61, 0xca, 0x78, 0x50, 0x33, 0x65, 0x30, 0x13, 0x31, 0xfa, 0x13, 0xac, 0xc1, 0x75,
-	0xb6, 0xb8, 0x85, 0xce, 0xd2, 0xe3, 0x7d, 0xd3, 0x0a, 0x22, 0x89, 0x15, 0xa7, 0x1c, 0x38, 0x66,
-	0x42, 0x10, 0x9f, 0x19, 0xad, 0x1c, 0xaa, 0x2f, 0x67, 0xe4, 0x3a, 0xe4, 0x64, 0xa8, 0x97, 0xb9,
-	0x82, 0x73, 0x68, 0x6a, 0xbc, 0x84, 0x5a, 0x97, 0x48, 0x12, 0x72, 0x5f, 0xd7, 0x40, 0x60, 0xbb,
-	0x92, 0x4d, 0xcc, 0xe0, 0x3a, 0x46, 0x7f, 0x81, 0xd5, 0x4f, 0xbd, 0x46, 0x65, 0xdb, 0xda, 0xad,
-	0xed, 0xad, 0xa9, 0xc9, 0x66, 0x32, 0xb0, 0xfa, 0x66, 0xb4, 0x6e, 0x00, 0x0e, 0x49, 0x1a, 0xca,
-	0x33, 0x6d, 0x0d, 0x02, 0x7b, 0x66, 0x87, 0x3a, 0x56, 0xdc, 0x61, 0xc2, 0xde, 0x9a, 0x26, 0x75,
-	0xac, 0x0e, 0xf8, 0x80, 0xea, 0x35, 0x64, 0x6e, 0x1b, 0xa4, 0x5b, 0x21, 0x89, 0xdf, 0xb0, 0x95,
-	0xa3, 0x58, 0xc7, 0x8a, 0xeb, 0x2b, 0x6e, 0x21, 0xcb, 0x57, 0xb1, 0xa9, 0xfd, 0x0a, 0xaa, 0x83,
-	0x84, 0x50, 0xd6, 0x8f, 0x49, 0xa4, 0x2e, 0x8a, 0x4e, 0x86, 0xa6, 0xb2, 0x0a, 0xd5, 0x45, 0x89,
-	0x98, 0x44, 0xc2, 0x54, 0xce, 0x80, 0x32, 0x41, 0x8e, 0x12, 0x26, 0x46, 0x3c, 0xcc, 0xd6, 0x63,
-	0xe1, 0x82, 0x30, 0xc2, 0x7f, 0x43, 0xbd, 0x13, 0x72, 0x3a, 0x3e, 0x66, 0x92, 0x68, 0xe7, 0x10,
-	0xd8, 0x41, 0xe6, 0xb4, 0xb5, 0x6b, 0x63, 0x1d, 0x9b, 0xa7, 0x2e, 0xd4, 0xba, 0xe3, 0xf8, 0xe9,
-	0x61, 0x03, 0x96, 0xae, 0x58, 0x22, 0xf2, 0xab, 0xac, 0xe3, 0x1c, 0xa2, 0x4d, 0x58, 0x0e, 0x39,
-	0x2d, 0xbc, 0x5f, 0xc1, 0x4f, 0xd8, 0x48, 0x7d, 0x28, 0xc3, 0x6f, 0x7d, 0xc9, 0x13, 0xe2, 0xb3,
-	0x73, 0xe5, 0xa3, 0x5a, 0xf5, 0xeb, 0x8b, 0x7f, 0x95, 0x66, 0x3f, 0xa5, 0x94, 0xb1, 0x6c, 0xba,
-	0x65, 0x9c, 0x43, 0xd4, 0x06, 0xe8, 0x8e, 0xe3, 0x17, 0x91, 0x4c, 0x02, 0x26, 0xe6, 0xcc, 0x2a,
-	0x5a, 0xc2, 0x33, 0x4f, 0xd0, 0x3e, 0xac, 0xe8, 0xc1, 0xf2, 0x14, 0x4b, 0xa7, 0x6c, 0xa8, 0x94,
-	0xb9, 0x81, 0xf1, 0xdc, 0x33, 0xd3, 0x5f, 0x1b, 0xd6, 0xe6, 0xdb, 0x33, 0x3e, 0x52, 0x77, 0x28,
-	0xf4, 0x66, 0x2c, 0x6c, 0x90, 0x49, 0xb8, 0x82, 0xf5, 0x1f, 0xe7, 0xf9, 0xc9, 0x30, 0x85, 0x56,
-	0x65, 0x56, 0x4b, 0xd9, 0xd8, 0x0f, 0x6e, 0x4c, 0xb3, 0x36, 0xce, 0x80, 0x62, 0x8f, 0x89, 0x1f,
-	0x50, 0x7d, 0x2a, 0x36, 0xce, 0x40, 0x56, 0xb7, 0xb3, 0x7d, 0xf7, 0xd5, 0x29, 0xdd, 0x3e, 0x38,
-	0xe5, 0xbb, 0x07, 0xa7, 0xfc, 0xe5, 0xc1, 0x29, 0xbd, 0x9f, 0x3a, 0xa5, 0x8f, 0x53, 0xa7, 0x7c,
-	0x37, 0x75, 0x4a, 0x9f, 0xa7, 0x4e, 0xc9, 0x5b, 0xd4, 0xff, 0xe2, 0xff, 0xbe, 0x07, 0x00, 0x00,
-	0xff, 0xff, 0x05, 0x30, 0x11, 0x19, 0xd2, 0x05, 0x00, 0x00,
+	// 781 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xb6, 0x3d, 0x93, 0xcd, 0xba, 0x1c, 0xaf, 0xb3, 0x0d, 0x42, 0x66, 0xb5, 0x1a, 0x87, 0x3d,
+	0x85, 0x03, 0x36, 0x2c, 0xac, 0x90, 0xb8, 0xc5, 0x36, 0x91, 0x86, 0x55, 0x7e, 0xd4, 0x76, 0xc2,
+	0x11, 0x7a, 0xda, 0x9d, 0xf1, 0xc8, 0x33, 0xd3, 0xc3, 0x74, 0x4f, 0x24, 0xe7, 0xc8, 0x13, 0xf0,
+	0x02, 0x48, 0xf0, 0x36, 0x39, 0xe6, 0xc8, 0x29, 0x82, 0xf8, 0x05, 0x38, 0xe7, 0x84, 0xfa, 0x67,
+	0x32, 0x63, 0x0e, 0x1c, 0xb8, 0xd5, 0x57, 0xd3, 0xf5, 0x7d, 0x55, 0xf5, 0x95, 0x0d, 0xfb, 0x3c,
+	0x63, 0x39, 0x91, 0x11, 0x4f, 0xc5, 0x30, 0xcb, 0xb9, 0xe4, 0xa8, 0xb5, 0x08, 0x5e, 0x7d, 0x16,
+	0x46, 0x72, 0x59, 0x04, 0x43, 0xca, 0x93, 0x51, 0xc8, 0x43, 0x3e, 0xd2, 0x9f, 0x82, 0xe2, 0x4a,
+	0x23, 0x0d, 0x74, 0x64, 0x4a, 0xde, 0xfc, 0x08, 0x70, 0x44, 0x29, 0x13, 0xc2, 0x4f, 0xaf, 0x38,
+	0x7a, 0x0d, 0xed, 0x23, 0x4a, 0x79, 0x91, 0x4a, 0x7f, 0xda, 0x6f, 0x1e, 0x34, 0x0f, 0xbb, 0xb8,
+	0x4a, 0xa0, 0x8f, 0xe0, 0xd9, 0x85, 0x60, 0xb9, 0x3f, 0xed, 0xb7, 0xf4, 0x27, 0x8b, 0x54, 0x1e,
+	0xf3, 0x98, 0xf9, 0xd3, 0xbe, 0x63, 0xf2, 0x06, 0x7d, 0xe3, 0xfe, 0xfd, 0xfb, 0xa0, 0xf1, 0xe6,
+	0xe7, 0x26, 0xc0, 0x71, 0x5c, 0x88, 0xe5, 0x9c, 0x04, 0x31, 0x43, 0x5f, 0xd5, 0x05, 0xb5, 0x46,
+	0xe7, 0xed, 0x8b, 0xe1, 0x22, 0x18, 0x56, 0xd9, 0xb1, 0x7b, 0x7b, 0x3f, 0x68, 0xe0, 0x7a, 0x63,
+	0x1e, 0xc0, 0x94, 0x48, 0x12, 0x10, 0xc1, 0xac, 0xbc, 0x8b, 0x6b, 0x19, 0xd4, 0x87, 0x5d, 0x4d,
+	0x6f, 0x7b, 0x70, 0x71, 0x09, 0x6d, 0x13, 0xef, 0xa1, 0x33, 0x8d, 0xc4, 0x6a, 0x12, 0x33, 0x92,
+	0xb2, 0x1c, 0xbd, 0x80, 0xd6, 0x59, 0xa6, 0xc5, 0xdb, 0xb8, 0x75, 0x96, 0xa1, 0x7d, 0x70, 0xde,
+	0xb3, 0xb5, 0xe6, 0x6d, 0x63, 0x15, 0xa2, 0x0f, 0x61, 0xe7, 0x92, 0xc4, 0x05, 0xd3, 0x74, 0x6d,
+	0x6c, 0x80, 0x25, 0xfb, 0x02, 0x7a, 0x35, 0x32, 0xcc, 0x44, 0xa6, 0xf4, 0x67, 0x45, 0x92, 0x90,
+	0x7c, 0x6d, 0x59, 0x4b, 0xf8, 0xa4, 0x0f, 0x93, 0x25, 0xa3, 0xab, 0x8c, 0x47, 0xa9, 0x44, 0x5f,
+	0x43, 0x57, 0x6f, 0x64, 0x5a, 0x18, 0xff, 0x74, 0x8d, 0x33, 0x7e, 0xf9, 0x78, 0x3f, 0xe8, 0xca,
+	0x28, 0x61, 0xc3, 0xf2, 0x03, 0xde, 0x7e, 0x67, 0xc9, 0xde, 0x41, 0xcf, 0x4f, 0x25, 0xcb, 0x29,
+	0xcb, 0xe4, 0x84, 0x27, 0x49, 0x24, 0x95, 0x71, 0x7a, 0xe0, 0x53, 0x92, 0x30, 0xdb, 0x41, 0x95,
+	0xb0, 0x65, 0x0c, 0xda, 0x7e, 0x2a, 0x32, 0x46, 0xe5, 0xfc, 0xf4, 0x7f, 0xda, 0xf0, 0x1a, 0xda,
+	0x67, 0xe5, 0xd1, 0xd9, 0x6d, 0x55, 0x09, 0x2b, 0x13, 0x40, 0xc7, 0xca, 0xe8, 0xcd, 0x7c, 0x0c,
+	0xce, 0x7c, 0x6d, 0x76, 0xbd, 0x33, 0xde, 0x7d, 0xbc, 0x1f, 0x38, 0x51, 0x2a, 0xb1, 0xca, 0xa9,
+	0xa5, 0x9d, 0x30, 0x21, 0x48, 0xc8, 0x2c, 0x57, 0x09, 0xd5, 0x97, 0x73, 0xb2, 0x8e, 0x39, 0x59,
+	0xe8, 0xfd, 0xef, 0xe1, 0x12, 0x5a, 0x8d, 0xef, 0xa0, 0x33, 0x21, 0x92, 0xc4, 0x3c, 0xd4, 0x1a,
+	0x08, 0x5c, 0x5f, 0xb2, 0xc4, 0x0e, 0xae, 0x63, 0xf4, 0x09, 0x38, 0xb3, 0x22, 0xe8, 0xb7, 0x0e,
+	0x9c, 0xc3, 0xce, 0xdb, 0x9e, 0x9a, 0xac, 0x56, 0x81, 0xd5, 0x37, 0xcb, 0x75, 0x03, 0x70, 0x4c,
+	0x8a, 0x58, 0x9e, 0x6b, 0x6b, 0x10, 0xb8, 0xb5, 0x1d, 0xea, 0x58, 0xe5, 0x8e, 0x73, 0xf6, 0x93,
+	0x6d, 0x52, 0xc7, 0xea, 0xe6, 0x8f, 0xa8, 0x5e, 0x83, 0x39, 0x10, 0x8b, 0x74, 0x2b, 0x24, 0x0f,
+	0xfb, 0xae, 0x72, 0x14, 0xeb, 0x58, 0xe5, 0x66, 0x2a, 0xb7, 0x63, 0xea, 0x55, 0x6c, 0xb5, 0xbf,
+	0x87, 0xf6, 0x3c, 0x27, 0x94, 0xcd, 0x32, 0x92, 0xaa, 0x23, 0xa4, 0xc9, 0xc2, 0x2a, 0xab, 0x50,
+	0x1d, 0xa1, 0xc8, 0x48, 0x2a, 0xac, 0xb2, 0x01, 0xca, 0x04, 0xb9, 0xcc, 0x99, 0x58, 0xf2, 0xd8,
+	0xac, 0xc7, 0xc1, 0x55, 0xc2, 0x12, 0x7f, 0x0a, 0xdd, 0x71, 0xcc, 0xe9, 0xea, 0x84, 0x49, 0xa2,
+	0x9d, 0x43, 0xe0, 0x46, 0xc6, 0x69, 0xe7, 0xd0, 0xc5, 0x3a, 0xb6, 0x4f, 0x7d, 0xe8, 0x4c, 0x56,
+	0xd9, 0xd3, 0xc3, 0x3e, 0xec, 0x5e, 0xb3, 0x5c, 0x94, 0x57, 0xd9, 0xc5, 0x25, 0x44, 0xaf, 0xe0,
+	0x79, 0xcc, 0x69, 0xe5, 0xfd, 0x1e, 0x7e, 0xc2, 0x96, 0xea, 0xd7, 0x26, 0x7c, 0x30, 0x93, 0x3c,
+	0x27, 0x21, 0xbb, 0x50, 0x3e, 0xaa, 0x55, 0xff, 0x70, 0xf9, 0xb9, 0xf9, 0x75, 0x50, 0xca, 0x98,
+	0x99, 0xee, 0x39, 0x2e, 0x21, 0x1a, 0x01, 0x4c, 0x56, 0xd9, 0xb7, 0xa9, 0xcc, 0x23, 0x26, 0xb6,
+	0xcc, 0xaa, 0x5a, 0xc2, 0xb5, 0x27, 0xe8, 0x1d, 0xec, 0xe9, 0xc1, 0xca, 0x12, 0x47, 0x97, 0xbc,
+	0x54, 0x25, 0x5b, 0x03, 0xe3, 0xad, 0x67, 0xb6, 0xbf, 0x11, 0xf4, 0xb6, 0xdb, 0xb3, 0x3e, 0x52,
+	0x7f, 0x21, 0xf4, 0x66, 0x1c, 0x6c, 0x91, 0x2d, 0xb8, 0x86, 0xfd, 0x7f, 0xcf, 0xf3, 0x1f, 0xc3,
+	0x54, 0x5c, 0xad, 0x3a, 0x97, 0xb2, 0x71, 0x16, 0xdd, 0xd8, 0x66, 0x5d, 0x6c, 0x80, 0xca, 0x9e,
+	0x90, 0x30, 0xa2, 0xfa, 0x54, 0x5c, 0x6c, 0x80, 0xd1, 0x1d, 0x1f, 0xdc, 0xfd, 0xe5, 0x35, 0x6e,
+	0x1f, 0xbc, 0xe6, 0xdd, 0x83, 0xd7, 0xfc, 0xf3, 0xc1, 0x6b, 0xfc, 0xb2, 0xf1, 0x1a, 0xbf, 0x6d,
+	0xbc, 0xe6, 0xdd, 0xc6, 0x6b, 0xfc, 0xb1, 0xf1, 0x1a, 0xc1, 0x33, 0xfd, 0xf7, 0xfd, 0xe5, 0x3f,
+	0x01, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xf7, 0x19, 0xd4, 0x05, 0x06, 0x00, 0x00,
 }
 
 func (m *AccessInfo) Marshal() (dAtA []byte, err error) {
@@ -977,6 +1019,36 @@ func (m *DiskCleaner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DiskCleanerResp) Marshal() (dAtA []byte, err error) {
+	size := m.ProtoSize()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiskCleanerResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.ProtoSize()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiskCleanerResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Summary) > 0 {
+		i -= len(m.Summary)
+		copy(dAtA[i:], m.Summary)
+		i = encodeVarintOperations(dAtA, i, uint64(len(m.Summary)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
 	size := m.ProtoSize()
 	dAtA = make([]byte, size)
@@ -1580,6 +1652,19 @@ func (m *DiskCleaner) ProtoSize() (n int) {
 	return n
 }
 
+func (m *DiskCleanerResp) ProtoSize() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Summary)
+	if l > 0 {
+		n += 1 + l + sovOperations(uint64(l))
+	}
+	return n
+}
+
 func (m *Checkpoint) ProtoSize() (n int) {
 	if m == nil {
 		return 0
@@ -2188,6 +2273,88 @@ func (m *DiskCleaner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DiskCleanerResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOperations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiskCleanerResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiskCleanerResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOperations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOperations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOperations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOperations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
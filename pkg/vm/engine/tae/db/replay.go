@@ -40,6 +40,7 @@ type Replayer struct {
 	db            *DB
 	maxTs         types.TS
 	once          sync.Once
+	applying      sync.Once
 	ckpedTS       types.TS
 	wg            sync.WaitGroup
 	applyDuration time.Duration
@@ -104,8 +105,7 @@ func (replayer *Replayer) postReplayWal() {
 	}
 }
 func (replayer *Replayer) Replay() {
-	replayer.wg.Add(1)
-	go replayer.applyTxnCmds()
+	replayer.startApply()
 	if err := replayer.db.Wal.Replay(replayer.OnReplayEntry); err != nil {
 		panic(err)
 	}
@@ -120,6 +120,28 @@ func (replayer *Replayer) Replay() {
 		common.AnyField("apply count", replayer.applyCount))
 }
 
+// Tail applies the WAL entries appended since the last call, it keeps a
+// hot-standby warm before the final Replay.
+func (replayer *Replayer) Tail() error {
+	replayer.startApply()
+	return replayer.db.Wal.Tail(replayer.OnReplayEntry)
+}
+
+// closeTail stops applying the entries of Tail without Replay.
+func (replayer *Replayer) closeTail() {
+	replayer.startApply()
+	replayer.txnCmdChan <- txnbase.NewLastTxnCmd()
+	close(replayer.txnCmdChan)
+	replayer.wg.Wait()
+}
+
+func (replayer *Replayer) startApply() {
+	replayer.applying.Do(func() {
+		replayer.wg.Add(1)
+		go replayer.applyTxnCmds()
+	})
+}
+
 func (replayer *Replayer) OnReplayEntry(group uint32, lsn uint64, payload []byte, typ uint16, info any) {
 	replayer.once.Do(replayer.PreReplayWal)
 	if group != wal.GroupPrepare && group != wal.GroupC {
@@ -15,6 +15,9 @@
 package db
 
 import (
+	"context"
+
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/util"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
@@ -42,6 +45,8 @@ type MergeTaskBuilder struct {
 	objDeltaLocRowCnt map[*catalog.ObjectEntry]uint32
 	distinctDeltaLocs map[string]struct{}
 	mergingObjs       map[*catalog.ObjectEntry]struct{}
+	expiredObjs       map[*catalog.ObjectEntry]struct{}
+	ttlChecker        *merge.TTLChecker
 
 	objPolicy   merge.Policy
 	executor    *merge.MergeExecutor
@@ -55,12 +60,13 @@ func newMergeTaskBuilder(db *DB) *MergeTaskBuilder {
 	op := &MergeTaskBuilder{
 		db:                db,
 		LoopProcessor:     new(catalog.LoopProcessor),
-		objPolicy:         merge.NewBasicPolicy(),
+		objPolicy:         merge.NewPolicyGroup(),
 		executor:          merge.NewMergeExecutor(db.Runtime, db.CNMergeSched),
 		objDeltaLocRowCnt: make(map[*catalog.ObjectEntry]uint32),
 		distinctDeltaLocs: make(map[string]struct{}),
 		objDeltaLocCnt:    make(map[*catalog.ObjectEntry]int),
 		mergingObjs:       make(map[*catalog.ObjectEntry]struct{}),
+		expiredObjs:       make(map[*catalog.ObjectEntry]struct{}),
 	}
 
 	op.DatabaseFn = op.onDataBase
@@ -95,6 +101,10 @@ func (s *MergeTaskBuilder) resetForTable(entry *catalog.TableEntry) {
 		clear(s.objDeltaLocCnt)
 		clear(s.objDeltaLocRowCnt)
 		clear(s.distinctDeltaLocs)
+		clear(s.expiredObjs)
+		s.ttlChecker = merge.NewTTLChecker(
+			entry.GetLastestSchemaLocked(), s.db.Runtime.Fs.Service,
+			pkgcatalog.TTLNow(s.db.TxnMgr.Now().ToTimestamp()))
 	}
 	s.objPolicy.ResetForTable(entry)
 }
@@ -207,7 +217,7 @@ func (s *MergeTaskBuilder) onPostTable(tableEntry *catalog.TableEntry) (err erro
 	// delObjs := s.ObjectHelper.finish()
 
 	mobjs, kind := s.objPolicy.Revise(s.executor.CPUPercent(), int64(s.executor.MemAvailBytes()))
-	if len(mobjs) > 1 {
+	if len(mobjs) > 1 || (len(mobjs) == 1 && s.isExpired(mobjs[0])) {
 		for _, m := range mobjs {
 			s.mergingObjs[m] = struct{}{}
 		}
@@ -216,6 +226,13 @@ func (s *MergeTaskBuilder) onPostTable(tableEntry *catalog.TableEntry) (err erro
 	return
 }
 
+// isExpired tells whether a single object is worth rewriting to drop the
+// expired rows of a ttl table.
+func (s *MergeTaskBuilder) isExpired(obj *catalog.ObjectEntry) bool {
+	_, ok := s.expiredObjs[obj]
+	return ok
+}
+
 func (s *MergeTaskBuilder) onObject(objectEntry *catalog.ObjectEntry) (err error) {
 	if _, ok := s.mergingObjs[objectEntry]; ok {
 		return moerr.GetOkStopCurrRecur()
@@ -238,6 +255,9 @@ func (s *MergeTaskBuilder) onObject(objectEntry *catalog.ObjectEntry) (err error
 			"[DeltaLoc Merge] tblId: %s(%d), obj: %s, deltaLoc: %d, rows: %d, deltaLocRows: %d, rate: %f",
 			s.name, s.tid, objectEntry.ID().String(), deltaLocCnt, remainingRows, deltaLocRows, rate)
 		s.objPolicy.OnObject(objectEntry, true)
+	} else if s.ttlChecker != nil && s.ttlChecker.HasExpiredRows(context.Background(), objectEntry) {
+		s.expiredObjs[objectEntry] = struct{}{}
+		s.objPolicy.OnObject(objectEntry, true)
 	} else {
 		s.objPolicy.OnObject(objectEntry, false)
 	}
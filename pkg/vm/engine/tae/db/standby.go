@@ -0,0 +1,140 @@
+// Copyright 2021 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package db
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/common/stopper"
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/matrixorigin/matrixone/pkg/logutil"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/driver/logservicedriver"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/tables"
+	"go.uber.org/zap"
+)
+
+// standby keeps a hot-standby db up to date by tailing the WAL appended by
+// the working TN. The log shard is read without taking the TN lease, so the
+// working TN is not fenced until the standby is promoted.
+type standby struct {
+	ctx      context.Context
+	replayer *Replayer
+	stopper  *stopper.Stopper
+
+	mu struct {
+		sync.Mutex
+		err error
+	}
+}
+
+func (db *DB) startStandby(
+	ctx context.Context,
+	dataFactory *tables.DataFactory,
+	maxTs types.TS,
+	lsn uint64,
+	valid bool,
+) {
+	if !valid {
+		logutil.Infof("checkpoint version is too small, LSN check is disable")
+	}
+	replayer := newReplayer(dataFactory, db, maxTs, lsn, valid)
+	replayer.OnTimeStamp(maxTs)
+	s := &standby{
+		ctx:      ctx,
+		replayer: replayer,
+		stopper:  stopper.NewStopper("TAEStandby"),
+	}
+	db.standby.Store(s)
+	interval := db.Opts.WalCfg.TailInterval
+	if err := s.stopper.RunNamedTask("tail-wal", func(ctx context.Context) {
+		s.tail(ctx, interval)
+	}); err != nil {
+		panic(err)
+	}
+	logutil.Info("open-tae", common.OperationField("standby"),
+		common.AnyField("tail-interval", interval))
+}
+
+func (s *standby) tail(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := s.replayer.Tail()
+			if err == nil {
+				continue
+			}
+			logutil.Error("standby-tail-wal", zap.Error(err))
+			// the records are gone, the standby can never catch up
+			if err == logservicedriver.ErrLogTruncated {
+				s.mu.Lock()
+				s.mu.err = err
+				s.mu.Unlock()
+				return
+			}
+		}
+	}
+}
+
+func (s *standby) getErr() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.mu.err
+}
+
+func (s *standby) close() {
+	s.stopper.Stop()
+	s.replayer.closeTail()
+}
+
+// IsStandby returns true if the db tails the WAL of another TN.
+func (db *DB) IsStandby() bool {
+	return db.standby.Load() != nil
+}
+
+// Promote turns the hot-standby db into a working one. The rest of the WAL
+// is replayed with a read-write log client, which fences the previous TN,
+// and then the background services are started.
+func (db *DB) Promote() error {
+	s := db.standby.Load()
+	if s == nil {
+		return moerr.NewInvalidStateNoCtx("tae is not a standby")
+	}
+	s.stopper.Stop()
+	if err := s.getErr(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	db.replayWal(s.replayer)
+	db.Catalog.ReplayTableRows()
+	// checkpoints may be made by the previous TN since the standby is opened
+	if err := db.BGCheckpointRunner.ReplayMetadata(); err != nil {
+		return err
+	}
+	db.start(s.ctx)
+	db.standby.Store(nil)
+	logutil.Info("promote-tae", common.OperationField("replay"),
+		common.OperandField("wal"),
+		common.AnyField("cost", time.Since(now)))
+	return nil
+}
@@ -17,8 +17,10 @@ package test
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
 	"reflect"
 	"strings"
@@ -32,6 +34,7 @@ import (
 	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
@@ -39,8 +42,10 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/pb/api"
+	"github.com/matrixorigin/matrixone/pkg/pb/plan"
 	"github.com/matrixorigin/matrixone/pkg/pb/timestamp"
 	"github.com/matrixorigin/matrixone/pkg/util/fault"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/blockio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/catalog"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
@@ -49,9 +54,11 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/dbutils"
 	gc "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/gc/v1"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/merge"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/testutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/handle"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/iface/txnif"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/index"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/model"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/options"
@@ -2342,6 +2349,325 @@ func TestMergeBlocks(t *testing.T) {
 	assert.Nil(t, txn.Commit(context.Background()))
 }
 
+func TestCompressAlg(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+
+	tae := testutil.InitTestDB(ctx, ModuleName, t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(3, 2)
+	schema.BlockMaxRows = 10
+	schema.ObjectMaxBlocks = 3
+	cstr := &engine.ConstraintDef{}
+	require.NoError(t, cstr.UnmarshalBinary(schema.Constraint))
+	cstr.Cts = append(cstr.Cts, &engine.StreamConfigsDef{
+		Configs: []*plan.Property{{Key: pkgcatalog.PropCompression, Value: "zstd"}},
+	})
+	data, err := cstr.MarshalBinary()
+	require.NoError(t, err)
+	require.NoError(t, schema.ApplyAlterTable(api.NewUpdateConstraintReq(0, 0, string(data))))
+	require.Equal(t, uint8(compress.Zstd), schema.GetCompressAlg())
+	bat := catalog.MockBatch(schema, 30)
+	defer bat.Close()
+
+	testutil.CreateRelationAndAppend(t, 0, tae, "db", schema, bat, true)
+	testutil.CompactBlocks(t, 0, tae, "db", schema, false)
+	testutil.MergeBlocks(t, 0, tae, "db", schema, false)
+
+	txn, err := tae.StartTxn(nil)
+	require.NoError(t, err)
+	db, err := txn.GetDatabase("db")
+	require.NoError(t, err)
+	rel, err := db.GetRelationByName(schema.Name)
+	require.NoError(t, err)
+	testutil.CheckAllColRowsByScan(t, rel, bat.Length(), false)
+	it := rel.MakeObjectIt()
+	cnt := 0
+	for it.Next() {
+		meta := it.GetObject().GetMeta().(*catalog.ObjectEntry)
+		if !meta.HasPersistedData() {
+			continue
+		}
+		loc := meta.GetLocation()
+		objMeta, err := objectio.FastLoadObjectMeta(ctx, &loc, false, tae.Runtime.Fs.Service)
+		require.NoError(t, err)
+		dataMeta := objMeta.MustDataMeta()
+		for i := uint32(0); i < dataMeta.BlockCount(); i++ {
+			blkMeta := dataMeta.GetBlockMeta(i)
+			for seqnum := uint16(0); seqnum < 3; seqnum++ {
+				require.Equal(t, uint8(compress.Zstd), blkMeta.MustGetColumn(seqnum).Location().Alg())
+			}
+		}
+		cnt++
+	}
+	require.Less(t, 0, cnt)
+	require.NoError(t, txn.Commit(ctx))
+}
+
+func TestColumnFilters(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+
+	tae := testutil.InitTestDB(ctx, ModuleName, t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(14, 3)
+	schema.BlockMaxRows = 10
+	schema.ObjectMaxBlocks = 3
+	cstr := &engine.ConstraintDef{}
+	require.NoError(t, cstr.UnmarshalBinary(schema.Constraint))
+	cstr.Cts = append(cstr.Cts, &engine.StreamConfigsDef{
+		Configs: []*plan.Property{
+			{Key: pkgcatalog.PropBloomFilterColumns, Value: "mock_1,mock_3"},
+			{Key: pkgcatalog.PropNgramFilterColumns, Value: "mock_12"},
+		},
+	})
+	var err error
+	schema.Constraint, err = cstr.MarshalBinary()
+	require.NoError(t, err)
+	bloom, ngram := schema.GetColumnFilters()
+	require.Equal(t, []uint16{1, 3}, bloom)
+	require.Equal(t, []uint16{12}, ngram)
+	bat := catalog.MockBatch(schema, 30)
+	defer bat.Close()
+
+	testutil.CreateRelationAndAppend(t, 0, tae, "db", schema, bat, true)
+	testutil.CompactBlocks(t, 0, tae, "db", schema, false)
+	testutil.MergeBlocks(t, 0, tae, "db", schema, false)
+
+	txn, err := tae.StartTxn(nil)
+	require.NoError(t, err)
+	db, err := txn.GetDatabase("db")
+	require.NoError(t, err)
+	rel, err := db.GetRelationByName(schema.Name)
+	require.NoError(t, err)
+	testutil.CheckAllColRowsByScan(t, rel, bat.Length(), false)
+	it := rel.MakeObjectIt()
+	cnt := 0
+	for it.Next() {
+		meta := it.GetObject().GetMeta().(*catalog.ObjectEntry)
+		if !meta.HasPersistedData() {
+			continue
+		}
+		loc := meta.GetLocation()
+		objMeta, err := objectio.FastLoadObjectMeta(ctx, &loc, false, tae.Runtime.Fs.Service)
+		require.NoError(t, err)
+		dataMeta := objMeta.MustDataMeta()
+		filters, err := objectio.LoadColumnFiltersWithMeta(ctx, dataMeta, loc, tae.Runtime.Fs.Service)
+		require.NoError(t, err)
+		for i := uint32(0); i < dataMeta.BlockCount(); i++ {
+			_, ok := filters.GetFilter(i, 1, index.BF)
+			require.True(t, ok)
+			_, ok = filters.GetFilter(i, 1, index.NGF)
+			require.False(t, ok)
+			_, ok = filters.GetFilter(i, 12, index.NGF)
+			require.True(t, ok)
+			// the primary key has its own bloom filter
+			_, ok = filters.GetFilter(i, 3, index.BF)
+			require.False(t, ok)
+		}
+		cnt++
+	}
+	require.Less(t, 0, cnt)
+	require.NoError(t, txn.Commit(ctx))
+}
+
+func TestRowTTL(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+
+	tae := testutil.InitTestDB(ctx, ModuleName, t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(14, 3)
+	schema.BlockMaxRows = 10
+	schema.ObjectMaxBlocks = 3
+	cstr := &engine.ConstraintDef{}
+	require.NoError(t, cstr.UnmarshalBinary(schema.Constraint))
+	cstr.Cts = append(cstr.Cts, &engine.StreamConfigsDef{
+		Configs: []*plan.Property{
+			{Key: pkgcatalog.PropTTLColumn, Value: "mock_11"},
+			{Key: pkgcatalog.PropTTLInterval, Value: "1 day"},
+		},
+	})
+	data, err := cstr.MarshalBinary()
+	require.NoError(t, err)
+	require.NoError(t, schema.ApplyAlterTable(api.NewUpdateConstraintReq(0, 0, string(data))))
+	ttl, colIdx, ok := schema.GetTTL()
+	require.True(t, ok)
+	require.Equal(t, 11, colIdx)
+	require.Equal(t, 24*time.Hour, ttl.Interval)
+
+	// 20 fresh rows and 10 rows expired a day ago
+	bat := catalog.MockBatch(schema, 30)
+	defer bat.Close()
+	now := time.Now()
+	for i := 0; i < 30; i++ {
+		at := now
+		if i >= 20 {
+			at = now.Add(-48 * time.Hour)
+		}
+		dt := types.UnixNanoToTimestamp(at.UnixNano()).ToDatetime(time.UTC)
+		bat.Vecs[11].Update(i, dt, false)
+	}
+
+	testutil.CreateRelationAndAppend(t, 0, tae, "db", schema, bat, true)
+	testutil.CompactBlocks(t, 0, tae, "db", schema, false)
+
+	hasExpired := func() bool {
+		checker := merge.NewTTLChecker(schema, tae.Runtime.Fs.Service, time.Now())
+		txn, rel := testutil.GetRelation(t, 0, tae, "db", schema.Name)
+		defer func() { require.NoError(t, txn.Commit(ctx)) }()
+		it := rel.MakeObjectIt()
+		expired := false
+		for it.Next() {
+			meta := it.GetObject().GetMeta().(*catalog.ObjectEntry)
+			if meta.HasPersistedData() && !meta.IsAppendable() {
+				expired = expired || checker.HasExpiredRows(ctx, meta)
+			}
+		}
+		return expired
+	}
+	require.True(t, hasExpired())
+
+	// the primary key dedup ignores the expired rows before the merge drops
+	// them, both in the persisted and in the appendable objects
+	reinsert := bat.CloneWindow(26, 1)
+	defer reinsert.Close()
+	reinsert.Vecs[11].Update(0, bat.Vecs[11].Get(0), false)
+	txn, rel := testutil.GetRelation(t, 0, tae, "db", schema.Name)
+	require.NoError(t, rel.Append(ctx, reinsert))
+	require.NoError(t, txn.Commit(ctx))
+	extra := catalog.MockBatch(schema, 31)
+	defer extra.Close()
+	expired := extra.CloneWindow(30, 1)
+	defer expired.Close()
+	expired.Vecs[11].Update(0, bat.Vecs[11].Get(20), false)
+	for i := 0; i < 2; i++ {
+		txn, rel = testutil.GetRelation(t, 0, tae, "db", schema.Name)
+		require.NoError(t, rel.Append(ctx, expired))
+		require.NoError(t, txn.Commit(ctx))
+	}
+
+	// merge with the deletes committed during the merge on a fresh row and
+	// on an expired row. The former is transferred, the latter is skipped
+	// because the merge drops the row.
+	txn, rel = testutil.GetRelation(t, 0, tae, "db", schema.Name)
+	var objs []*catalog.ObjectEntry
+	it := rel.MakeObjectIt()
+	for it.Next() {
+		meta := it.GetObject().GetMeta().(*catalog.ObjectEntry)
+		if !meta.IsAppendable() {
+			objs = append(objs, meta)
+		}
+	}
+	it.Close()
+	task, err := jobs.NewMergeObjectsTask(nil, txn, objs, tae.Runtime, 0)
+	require.NoError(t, err)
+	for _, row := range []int{5, 25} {
+		txn2, rel2 := testutil.GetRelation(t, 0, tae, "db", schema.Name)
+		filter := handle.NewEQFilter(testutil.GetSingleSortKeyValue(bat, schema, row))
+		require.NoError(t, rel2.DeleteByFilter(ctx, filter))
+		require.NoError(t, txn2.Commit(ctx))
+	}
+	require.NoError(t, task.OnExec(ctx))
+	require.Len(t, task.GetCommitEntry().ExpiredRows, 1)
+	require.NoError(t, txn.Commit(ctx))
+	require.False(t, hasExpired())
+
+	txn, rel = testutil.GetRelation(t, 0, tae, "db", schema.Name)
+	testutil.CheckAllColRowsByScan(t, rel, 22, true)
+	err = rel.Append(ctx, reinsert)
+	require.True(t, moerr.IsMoErrCode(err, moerr.ErrDuplicateEntry))
+	require.NoError(t, txn.Rollback(ctx))
+}
+
+func TestMergePolicies(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	ctx := context.Background()
+
+	tae := testutil.InitTestDB(ctx, ModuleName, t, nil)
+	defer tae.Close()
+	schema := catalog.MockSchemaAll(14, 3)
+	schema.BlockMaxRows = 10
+	schema.ObjectMaxBlocks = 3
+	testutil.CreateRelation(t, tae, "db", schema, true)
+
+	// 4 objects, two of them on each day
+	day := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 4; i++ {
+		bat := catalog.MockBatch(schema, 10)
+		for j := 0; j < 10; j++ {
+			bat.Vecs[3].Update(j, int64(i*10+j), false)
+			at := day.Add(time.Duration(i/2)*24*time.Hour + time.Duration(j)*time.Minute)
+			bat.Vecs[11].Update(j, types.UnixNanoToTimestamp(at.UnixNano()).ToDatetime(time.UTC), false)
+		}
+		testutil.AppendClosure(t, bat, schema.Name, tae, nil)()
+		bat.Close()
+		testutil.CompactBlocks(t, 0, tae, "db", schema, false)
+	}
+
+	txn, rel := testutil.GetRelation(t, 0, tae, "db", schema.Name)
+	tbl := rel.GetMeta().(*catalog.TableEntry)
+	require.NoError(t, txn.Commit(ctx))
+	var objs []*catalog.ObjectEntry
+	it := tbl.MakeObjectIt(true)
+	for it.Next() {
+		obj := it.Item()
+		if obj.IsAppendable() || !obj.HasPersistedData() {
+			continue
+		}
+		rows, err := obj.GetObjectData().Rows()
+		require.NoError(t, err)
+		obj.SetRemainingRows(rows)
+		objs = append(objs, obj)
+	}
+	it.Release()
+	require.Equal(t, 4, len(objs))
+
+	newTxn := func() txnif.AsyncTxn {
+		txn, _ := tae.StartTxn(nil)
+		return txn
+	}
+	revise := func(p merge.Policy) []*catalog.ObjectEntry {
+		p.ResetForTable(tbl)
+		for _, obj := range objs {
+			p.OnObject(obj, false)
+		}
+		ret, kind := p.Revise(0, math.MaxInt64)
+		require.Equal(t, merge.TaskHostDN, kind)
+		return ret
+	}
+
+	policy := merge.NewPolicyGroup()
+	cfg := *policy.GetConfig(tbl).(*merge.BasicPolicyConfig)
+	cfg.Policy = merge.PolicyTimeWindow
+	cfg.TimeColumn = "mock_11"
+	cfg.TimeWindow = 24 * time.Hour
+	policy.SetConfig(tbl, newTxn, &cfg)
+	require.Equal(t, merge.PolicyTimeWindow, policy.GetConfig(tbl).(*merge.BasicPolicyConfig).Policy)
+	picked := revise(policy)
+	require.Equal(t, 2, len(picked))
+	// the older window is merged first
+	maxKey := int64(19)
+	for _, obj := range picked {
+		require.True(t, obj.GetSortKeyZonemap().AnyLEByValue(types.EncodeInt64(&maxKey)))
+	}
+
+	cfg.Policy = merge.PolicyTiered
+	cfg.SizeRatio = 4
+	policy.SetConfig(tbl, newTxn, &cfg)
+	require.Equal(t, 4, len(revise(policy)))
+
+	// an unknown policy falls back to basic
+	cfg.Policy = "unknown"
+	policy.SetConfig(tbl, newTxn, &cfg)
+	require.Equal(t, "unknown", policy.GetConfig(tbl).(*merge.BasicPolicyConfig).PolicyName())
+	revise(policy)
+}
+
 type dummyCpkGetter struct{}
 
 func (c *dummyCpkGetter) CollectCheckpointsInRange(ctx context.Context, start, end types.TS) (ckpLoc string, lastEnd types.TS, err error) {
@@ -8874,3 +9200,188 @@ func TestClearPersistTransferTable(t *testing.T) {
 		},
 	)
 }
+
+func TestScrubObjects(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	ctx := context.Background()
+
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := testutil.NewTestEngine(ctx, ModuleName, t, opts)
+	defer tae.Close()
+
+	schema := catalog.MockSchemaAll(3, 1)
+	schema.BlockMaxRows = 10
+	schema.ObjectMaxBlocks = 2
+	tae.BindSchema(schema)
+	bat := catalog.MockBatch(schema, 21)
+	defer bat.Close()
+	tae.CreateRelAndAppend(bat, true)
+	tae.CompactBlocks(false)
+	err := tae.BGCheckpointRunner.ForceIncrementalCheckpoint(tae.TxnMgr.Now(), false)
+	require.NoError(t, err)
+
+	result, err := tae.Scrubber.Scrub(ctx)
+	require.NoError(t, err)
+	require.Empty(t, result.Issues)
+	require.Greater(t, result.Verified, 0)
+	require.Greater(t, result.Extents, result.Verified)
+	require.Equal(t, result, tae.Scrubber.LastResult())
+
+	var required string
+	txn, rel := tae.GetRelation()
+	it := rel.MakeObjectIt()
+	for it.Next() {
+		meta := it.GetObject().GetMeta().(*catalog.ObjectEntry)
+		if !meta.IsAppendable() && !meta.HasDropCommitted() {
+			required = meta.ObjectStats.ObjectName().String()
+		}
+	}
+	it.Close()
+	require.NoError(t, txn.Commit(ctx))
+	require.NotEmpty(t, required)
+
+	fs := tae.Runtime.Fs
+	require.NoError(t, fs.Delete(required))
+	orphan := objectio.BuildObjectName(objectio.NewSegmentid(), 0).String()
+	require.NoError(t, fs.Service.Write(ctx, fileservice.IOVector{
+		FilePath: orphan,
+		Entries: []fileservice.IOEntry{
+			{Offset: 0, Size: 3, Data: []byte("foo")},
+		},
+	}))
+
+	scrubber := gc.NewScrubber("", fs, tae.BGCheckpointRunner, nil, 0)
+	result, err = scrubber.Scrub(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(result.Issues))
+	kinds := map[string]string{}
+	for _, issue := range result.Issues {
+		kinds[issue.Object] = issue.Kind
+	}
+	require.Equal(t, gc.ScrubDangling, kinds[required])
+	require.Equal(t, gc.ScrubOrphan, kinds[orphan])
+	t.Log(result.String())
+}
+
+func TestDryRunGC(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	ctx := context.Background()
+
+	opts := config.WithLongScanAndCKPOpts(nil)
+	tae := testutil.NewTestEngine(ctx, ModuleName, t, opts)
+	defer tae.Close()
+	cleaner := gc.NewCheckpointCleaner(ctx, "", tae.Runtime.Fs, tae.BGCheckpointRunner, true)
+
+	schema := catalog.MockSchemaAll(3, 1)
+	schema.BlockMaxRows = 10
+	schema.ObjectMaxBlocks = 2
+	tae.BindSchema(schema)
+	bat := catalog.MockBatch(schema, 21)
+	defer bat.Close()
+	tae.CreateRelAndAppend(bat, true)
+	tae.CompactBlocks(false)
+	tae.DropRelation(t)
+	// the objects of the table dropped are not in the global checkpoint out
+	// of the interval
+	time.Sleep(200 * time.Millisecond)
+	err := tae.BGCheckpointRunner.ForceIncrementalCheckpoint(tae.TxnMgr.Now(), false)
+	require.NoError(t, err)
+
+	// no global checkpoint yet
+	report, err := cleaner.DryRunGC(ctx)
+	require.NoError(t, err)
+	require.Empty(t, report.Checkpoint)
+
+	cleaner.Process()
+	require.NotNil(t, cleaner.GetMaxConsumed())
+	err = tae.BGCheckpointRunner.ForceGlobalCheckpointSynchronously(ctx, tae.TxnMgr.Now(), 100*time.Millisecond)
+	require.NoError(t, err)
+
+	report, err = cleaner.DryRunGC(ctx)
+	require.NoError(t, err)
+	t.Log(report.String())
+	require.NotEmpty(t, report.Checkpoint)
+	require.Greater(t, report.Deletes, 0)
+	// the decisions are in system.gc_report, not in the summary
+	var summary gc.DryRunReport
+	require.NoError(t, json.Unmarshal([]byte(report.Summary()), &summary))
+	require.Equal(t, report.Deletes, summary.Deletes)
+	require.Empty(t, summary.Decisions)
+	deletes := make([]string, 0, report.Deletes)
+	for _, d := range report.Decisions {
+		if d.Decision == gc.GCDecisionDelete {
+			deletes = append(deletes, d.Object)
+			// nothing is deleted
+			_, err = tae.Runtime.Fs.Service.StatFile(ctx, d.Object)
+			require.NoError(t, err)
+		}
+	}
+
+	// GC deletes the same objects
+	require.NoError(t, cleaner.TryGC())
+	objects := cleaner.GetInputs().String()
+	for _, name := range deletes {
+		require.NotContains(t, objects, name)
+	}
+	for _, d := range report.Decisions {
+		if d.Decision == gc.GCDecisionRetain && !d.Tombstone {
+			require.Contains(t, objects, d.Object)
+		}
+	}
+
+	// a cleaner knowing a cluster PITR retains the objects dropped in its
+	// range, both in the dry run and in GC
+	pitrSchema := catalog.MockPITRSchema()
+	pitrSchema.BlockMaxRows = 10
+	{
+		txn, err := tae.StartTxn(nil)
+		require.NoError(t, err)
+		database, err := txn.GetDatabase(testutil.DefaultTestDB)
+		require.NoError(t, err)
+		rel, err := database.CreateRelation(pitrSchema)
+		require.NoError(t, err)
+		pitr := containers.BuildBatch(pitrSchema.Attrs(), pitrSchema.Types(), containers.Options{})
+		defer pitr.Close()
+		pitr.Vecs[0].Append(types.Uuid{}, false)
+		pitr.Vecs[1].Append([]byte("p1"), false)
+		pitr.Vecs[2].Append(uint64(0), false)
+		pitr.Vecs[3].Append(types.Timestamp(0), false)
+		pitr.Vecs[4].Append(types.Timestamp(0), false)
+		pitr.Vecs[5].Append([]byte(logtail.PITRLevelCluster), false)
+		pitr.Vecs[6].Append(uint64(0), false)
+		pitr.Vecs[7].Append([]byte(""), false)
+		pitr.Vecs[8].Append([]byte(""), false)
+		pitr.Vecs[9].Append([]byte(""), false)
+		pitr.Vecs[10].Append(uint64(0), false)
+		pitr.Vecs[11].Append(uint8(1), false)
+		pitr.Vecs[12].Append([]byte("d"), false)
+		require.NoError(t, rel.Append(ctx, pitr))
+		require.NoError(t, txn.Commit(ctx))
+	}
+	testutil.CompactBlocks(t, 0, tae.DB, testutil.DefaultTestDB, pitrSchema, false)
+	err = tae.BGCheckpointRunner.ForceIncrementalCheckpoint(tae.TxnMgr.Now(), false)
+	require.NoError(t, err)
+
+	cleaner = gc.NewCheckpointCleaner(ctx, "", tae.Runtime.Fs, tae.BGCheckpointRunner, true)
+	cleaner.Process()
+	pitrs, err := cleaner.GetPITRs()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pitrs))
+	require.Equal(t, "p1", pitrs[0].Name)
+	report, err = cleaner.DryRunGC(ctx)
+	require.NoError(t, err)
+	t.Log(report.String())
+	require.Equal(t, 0, report.Deletes)
+	retains := make(map[string]string)
+	for _, d := range report.Decisions {
+		retains[d.Object] = d.Reason
+	}
+	for _, name := range deletes {
+		require.Equal(t, gc.RetainPITR, retains[name])
+	}
+	require.NoError(t, cleaner.TryGC())
+	objects = cleaner.GetInputs().String()
+	for _, name := range deletes {
+		require.Contains(t, objects, name)
+	}
+}
@@ -142,6 +142,6 @@ type Handler interface {
 		ctx context.Context,
 		meta txn.TxnMeta,
 		req *db.DiskCleaner,
-		resp *apipb.SyncLogTailResp,
+		resp *db.DiskCleanerResp,
 	) (cb func(), err error)
 }
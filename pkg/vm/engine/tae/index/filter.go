@@ -44,6 +44,8 @@ func NewEmptyBloomFilterWithType(t uint8) StaticFilter {
 		return &prefixBloomFilter{}
 	} else if t == HBF {
 		return &hybridFilter{}
+	} else if t == NGF {
+		return &ngramFilter{}
 	} else {
 		return nil
 	}
@@ -312,3 +312,54 @@ func TestStaticFilterString(t *testing.T) {
 	require.Equal(t, 40000, positive.GetCardinality())
 	require.True(t, exist)
 }
+
+func TestNgramFilter(t *testing.T) {
+	defer testutils.AfterTest(t)()
+	testutils.EnsureNoLeak(t)
+	data := containers.MakeVector(types.T_varchar.ToType(), common.DefaultAllocator)
+	defer data.Close()
+	data.Append([]byte("hello world"), false)
+	data.Append([]byte("matrixone"), false)
+	data.Append([]byte("no"), false)
+	data.Append(nil, true)
+
+	sf, err := NewNgramFilter(data)
+	require.NoError(t, err)
+	require.Equal(t, uint8(NGF), sf.GetType())
+
+	buf, err := sf.Marshal()
+	require.NoError(t, err)
+	sf2 := NewEmptyBloomFilterWithType(NGF)
+	require.NoError(t, sf2.Unmarshal(buf))
+
+	for _, key := range []string{"hello world", "world", "trixo", "ix", ""} {
+		ok, err := sf2.MayContainsKey([]byte(key))
+		require.NoError(t, err)
+		require.True(t, ok, key)
+	}
+	ok, err := sf2.MayContainsKey([]byte("database"))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	keys := containers.MakeVector(types.T_varchar.ToType(), common.DefaultAllocator)
+	defer keys.Close()
+	keys.Append([]byte("database"), false)
+	keys.Append([]byte("matrix"), false)
+	exist, positive, err := sf2.MayContainsAnyKeys(keys)
+	require.NoError(t, err)
+	require.True(t, exist)
+	require.False(t, positive.Contains(0))
+	require.True(t, positive.Contains(1))
+	require.True(t, sf2.MayContainsAny(keys.GetDownstreamVector(), 1, 2))
+	require.False(t, sf2.MayContainsAny(keys.GetDownstreamVector(), 0, 1))
+
+	// a block without any ngram contains no key longer than NgramSize
+	short := containers.MakeVector(types.T_varchar.ToType(), common.DefaultAllocator)
+	defer short.Close()
+	short.Append([]byte("ab"), false)
+	sf, err = NewNgramFilter(short)
+	require.NoError(t, err)
+	ok, err = sf.MayContainsKey([]byte("abc"))
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -0,0 +1,120 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package index
+
+import (
+	"strconv"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
+	"github.com/matrixorigin/matrixone/pkg/container/vector"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/containers"
+	"github.com/samber/lo"
+)
+
+// NgramSize is the length of the substrings hashed by an ngram filter.
+// Keys shorter than it cannot be checked and are always reported as
+// possibly contained.
+const NgramSize = 3
+
+// ngramFilter is a bloom filter built over every NgramSize-gram of the
+// non-null values of a column. A key may be contained as a substring of
+// some value only if all of its ngrams are in the filter, so it prunes
+// both equality and LIKE '%...%' predicates.
+type ngramFilter struct {
+	bloomFilter
+}
+
+func NewNgramFilter(data containers.Vector) (StaticFilter, error) {
+	hashes := make([]uint64, 0)
+	op := func(v []byte, isNull bool, _ int) error {
+		if isNull {
+			return nil
+		}
+		for i := 0; i+NgramSize <= len(v); i++ {
+			hashes = append(hashes, hashV1(v[i:i+NgramSize]))
+		}
+		return nil
+	}
+	if err := containers.ForeachWindowBytes(
+		data.GetDownstreamVector(), 0, data.Length(), op, nil,
+	); err != nil {
+		return nil, err
+	}
+	bf, err := buildFuseFilter(lo.Uniq(hashes))
+	if err != nil {
+		return nil, err
+	}
+	return &ngramFilter{bloomFilter: *bf}, nil
+}
+
+func (filter *ngramFilter) GetType() uint8 {
+	return NGF
+}
+
+// MayContainsKey returns false only if no value in the block can contain
+// key as a substring
+func (filter *ngramFilter) MayContainsKey(key []byte) (bool, error) {
+	for i := 0; i+NgramSize <= len(key); i++ {
+		if !filter.Contains(hashV1(key[i : i+NgramSize])) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (filter *ngramFilter) MayContainsAny(keys *vector.Vector, lowerBound int, upperBound int) bool {
+	found := false
+	op := func(v []byte, _ bool, _ int) error {
+		if ok, _ := filter.MayContainsKey(v); ok {
+			found = true
+			return moerr.GetOkExpectedEOB()
+		}
+		return nil
+	}
+	_ = containers.ForeachWindowBytes(keys, lowerBound, upperBound-lowerBound, op, nil)
+	return found
+}
+
+func (filter *ngramFilter) MayContainsAnyKeys(keys containers.Vector) (bool, *nulls.Bitmap, error) {
+	var positive *nulls.Bitmap
+
+	row := uint32(0)
+	op := func(v []byte, _ bool, _ int) error {
+		if ok, _ := filter.MayContainsKey(v); ok {
+			if positive == nil {
+				positive = nulls.NewWithSize(int(row) + 1)
+			}
+			positive.Add(uint64(row))
+		}
+		row++
+		return nil
+	}
+
+	if err := containers.ForeachWindowBytes(keys.GetDownstreamVector(), 0, keys.Length(), op, nil); err != nil {
+		return false, nil, err
+	}
+	return !positive.IsEmpty(), positive, nil
+}
+
+func (filter *ngramFilter) String() string {
+	s := "<NGF>\n"
+	s += strconv.Itoa(int(filter.SegmentCount))
+	s += "\n"
+	s += strconv.Itoa(len(filter.Fingerprints))
+	s += "\n"
+	s += "</NGF>"
+	return s
+}
@@ -31,6 +31,7 @@ const (
 	BF = iota
 	PBF
 	HBF
+	NGF
 )
 
 type PrefixFn struct {
@@ -19,6 +19,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/driver"
@@ -283,6 +284,10 @@ func (bs *baseStore) Replay(h driver.ApplyHandle) error {
 	return nil
 }
 
+func (bs *baseStore) Tail(h driver.ApplyHandle) error {
+	return moerr.NewNotSupportedNoCtx("tail batchstore")
+}
+
 func (bs *baseStore) Read(lsn uint64) (*entry.Entry, error) {
 	ver, err := bs.retryGetVersionByGLSN(lsn)
 	if err != nil {
@@ -19,6 +19,7 @@ import (
 
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
+	v2 "github.com/matrixorigin/matrixone/pkg/util/metric/v2"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/driver/entry"
 )
 
@@ -43,6 +44,7 @@ func (d *LogServiceDriver) getAppender() *driverAppender {
 }
 
 func (d *LogServiceDriver) appendAppender() {
+	d.groupCommit.onAppend(len(d.appendable.entry.entries))
 	d.appendtimes++
 	d.onAppendQueue(d.appendable)
 	d.appendedQueue <- d.appendable
@@ -50,18 +52,101 @@ func (d *LogServiceDriver) appendAppender() {
 }
 
 func (d *LogServiceDriver) onPreAppend(items ...any) {
+	timeout := false
 	for _, item := range items {
-		e := item.(*entry.Entry)
-		appender := d.getAppender()
-		appender.appendEntry(e)
+		switch v := item.(type) {
+		case *entry.Entry:
+			appender := d.getAppender()
+			appender.appendEntry(v)
+		case groupCommitTimeout:
+			timeout = timeout || v.appender == d.appendable
+		}
+	}
+	if len(d.appendable.entry.entries) == 0 {
+		return
+	}
+	if !timeout && d.groupCommit.wait(d.appendable, d.notifyGroupCommit) {
+		return
 	}
 	d.appendAppender()
 }
 
+func (d *LogServiceDriver) notifyGroupCommit(v groupCommitTimeout) {
+	// the error is ignored as the pending entries are appended in Close
+	_, _ = d.preAppendLoop.Enqueue(v)
+}
+
+const (
+	// the pending entries wait only if the records have more entries than
+	// groupCommitMinEntries on average, it means the commits are concurrent.
+	groupCommitMinEntries = 2
+	groupCommitAlpha      = 0.2
+)
+
+// groupCommitTimeout is enqueued into the preAppendLoop when the appender
+// has waited for the group commit latency.
+type groupCommitTimeout struct {
+	appender *driverAppender
+}
+
+// groupCommit coalesces the concurrent small entries into one record within
+// the latency budget. It's only accessed in the preAppendLoop.
+type groupCommit struct {
+	latency time.Duration
+	size    int
+	// entries is the moving average of the number of entries per record.
+	entries float64
+	start   time.Time
+	timer   *time.Timer
+}
+
+func newGroupCommit(cfg *Config) groupCommit {
+	g := groupCommit{
+		latency: cfg.GroupCommitLatency,
+		size:    cfg.GroupCommitSize,
+	}
+	if g.size <= 0 || g.size > cfg.RecordSize {
+		g.size = cfg.RecordSize
+	}
+	return g
+}
+
+// wait returns true if the appender should wait for more entries. notify is
+// called once the appender has waited for the latency.
+func (g *groupCommit) wait(
+	appender *driverAppender,
+	notify func(groupCommitTimeout),
+) bool {
+	if g.latency <= 0 ||
+		g.entries < groupCommitMinEntries ||
+		int(appender.entry.payloadSize) >= g.size {
+		return false
+	}
+	if g.timer == nil {
+		g.start = time.Now()
+		g.timer = time.AfterFunc(g.latency, func() {
+			notify(groupCommitTimeout{appender: appender})
+		})
+	}
+	return true
+}
+
+func (g *groupCommit) onAppend(entries int) {
+	if g.timer != nil {
+		g.timer.Stop()
+		g.timer = nil
+		v2.LogTailGroupCommitWaitDurationHistogram.Observe(time.Since(g.start).Seconds())
+	}
+	g.entries = g.entries*(1-groupCommitAlpha) + float64(entries)*groupCommitAlpha
+	v2.LogTailAppendEntriesHistogram.Observe(float64(entries))
+}
+
 func (d *LogServiceDriver) onAppendQueue(appender *driverAppender) {
 	appender.client, appender.appendlsn = d.getClient()
 	appender.entry.SetAppended(d.getSynced())
 	appender.contextDuration = d.config.NewClientDuration
+	appender.compression = d.compression
+	appender.compressionMinSize = d.config.CompressionMinSize
 	appender.wg.Add(1)
 	d.appendPool.Submit(func() {
 		appender.append(d.config.RetryTimeout, d.config.ClientAppendDuration)
@@ -34,6 +34,9 @@ type driverAppender struct {
 	entry           *recordEntry
 	contextDuration time.Duration
 	wg              sync.WaitGroup //wait client
+
+	compression        int
+	compressionMinSize int
 }
 
 func newDriverAppender() *driverAppender {
@@ -56,6 +59,9 @@ func (a *driverAppender) append(retryTimout, appendTimeout time.Duration) {
 	}()
 
 	size := a.entry.prepareRecord()
+	v2.LogTailAppendRawBytesHistogram.Observe(float64(size))
+	size = a.entry.compressRecord(a.compression, a.compressionMinSize)
+	v2.LogTailAppendCompressedBytesHistogram.Observe(float64(size))
 	// if size > int(common.K)*20 { //todo
 	// 	panic(moerr.NewInternalError("record size %d, larger than max size 20K", size))
 	// }
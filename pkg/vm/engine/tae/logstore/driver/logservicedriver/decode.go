@@ -0,0 +1,138 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logservicedriver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/logservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/driver/entry"
+)
+
+// RecordSummary describes the WAL entries in a log service record, it's
+// used by tools to inspect the log shards offline.
+type RecordSummary struct {
+	Type     MetaType
+	Appended uint64
+	Entries  []EntrySummary
+	// SkipLsns maps the skipped driver Lsns to their log service Lsns, only
+	// for the replay records.
+	SkipLsns map[uint64]uint64
+}
+
+// EntrySummary describes a WAL entry.
+type EntrySummary struct {
+	Lsn  uint64
+	Type uint16
+	Size int
+	Info string
+}
+
+func (s RecordSummary) String() string {
+	var buf bytes.Buffer
+	switch s.Type {
+	case TNormal:
+		fmt.Fprintf(&buf, "normal record, appended %d, %d entries", s.Appended, len(s.Entries))
+		for _, e := range s.Entries {
+			fmt.Fprintf(&buf, "\n  lsn %d, type %d, size %d, %s", e.Lsn, e.Type, e.Size, e.Info)
+		}
+	case TReplay:
+		lsns := make([]uint64, 0, len(s.SkipLsns))
+		for lsn := range s.SkipLsns {
+			lsns = append(lsns, lsn)
+		}
+		sort.Slice(lsns, func(i, j int) bool { return lsns[i] < lsns[j] })
+		fmt.Fprintf(&buf, "replay record, appended %d, %d skipped", s.Appended, len(lsns))
+		for _, lsn := range lsns {
+			fmt.Fprintf(&buf, "\n  skip lsn %d at %d", lsn, s.SkipLsns[lsn])
+		}
+	default:
+		fmt.Fprintf(&buf, "unknown record type %d", s.Type)
+	}
+	return buf.String()
+}
+
+// DecodeRecord decodes the WAL entries in the user record of the log
+// service.
+func DecodeRecord(r logservice.LogRecord) (summary RecordSummary, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = moerr.ConvertPanicError(context.Background(), e)
+		}
+	}()
+
+	data := r.Payload()
+	if len(data) < objectio.IOEntryHeaderSize {
+		return summary, moerr.NewInternalErrorNoCtx("WAL record size %d", len(data))
+	}
+	head := objectio.DecodeIOEntryHeader(data[:objectio.IOEntryHeaderSize])
+	if head.Type != IOET_WALRecord {
+		return summary, moerr.NewInternalErrorNoCtx("not a WAL record, IO entry type %d", head.Type)
+	}
+	body := data[objectio.IOEntryHeaderSize:]
+	switch head.Version {
+	case IOET_WALRecord_V1:
+	case IOET_WALRecord_V2:
+		if body, err = decompressRecord(body); err != nil {
+			return summary, err
+		}
+	default:
+		return summary, moerr.NewNotSupportedNoCtx("WAL record version %d", head.Version)
+	}
+
+	m := newMeta()
+	n, err := m.ReadFrom(bytes.NewBuffer(body))
+	if err != nil {
+		return summary, err
+	}
+	summary.Type = m.metaType
+	summary.Appended = m.appended
+
+	switch m.metaType {
+	case TNormal:
+		payload := body[len(body)-int(m.payloadSize):]
+		lsns := make([]uint64, 0, len(m.addr))
+		for lsn := range m.addr {
+			lsns = append(lsns, lsn)
+		}
+		sort.Slice(lsns, func(i, j int) bool { return lsns[i] < lsns[j] })
+		for _, lsn := range lsns {
+			e := entry.NewEmptyEntry()
+			if _, err := e.UnmarshalBinary(payload[m.addr[lsn]:]); err != nil {
+				return summary, err
+			}
+			summary.Entries = append(summary.Entries, EntrySummary{
+				Lsn:  lsn,
+				Type: e.Entry.GetType(),
+				Size: e.GetSize(),
+				Info: strings.TrimSpace(e.Info.ToString()),
+			})
+			e.Entry.Free()
+		}
+	case TReplay:
+		cmd := NewEmptyReplayCmd()
+		if err := cmd.Unmarshal(body[n:]); err != nil {
+			return summary, err
+		}
+		summary.SkipLsns = cmd.skipLsns
+	}
+	return summary, nil
+}
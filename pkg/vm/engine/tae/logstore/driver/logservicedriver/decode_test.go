@@ -0,0 +1,67 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logservicedriver
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matrixorigin/matrixone/pkg/logservice"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/driver/entry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func toLogRecord(r *recordEntry) logservice.LogRecord {
+	size := r.prepareRecord()
+	data := make([]byte, pb.HeaderSize+8+size)
+	copy(data[pb.HeaderSize+8:], r.payload)
+	return logservice.LogRecord{Data: data}
+}
+
+func TestDecodeRecord(t *testing.T) {
+	r := newRecordEntry()
+	r.meta.SetAppended(10)
+	for i := 0; i < 3; i++ {
+		e := entry.MockEntryWithPayload([]byte(fmt.Sprintf("payload %d", i)))
+		e.Lsn = uint64(i + 1)
+		r.append(e)
+		defer e.Entry.Free()
+	}
+	summary, err := DecodeRecord(toLogRecord(r))
+	require.NoError(t, err)
+	assert.Equal(t, TNormal, summary.Type)
+	assert.Equal(t, uint64(10), summary.Appended)
+	require.Equal(t, 3, len(summary.Entries))
+	for i, e := range summary.Entries {
+		assert.Equal(t, uint64(i+1), e.Lsn)
+		assert.Equal(t, r.entries[i].GetSize(), e.Size)
+	}
+	assert.Contains(t, summary.String(), "3 entries")
+
+	replay := newRecordEntry()
+	replay.meta.metaType = TReplay
+	replay.cmd = NewReplayCmd(map[uint64]uint64{5: 20})
+	summary, err = DecodeRecord(toLogRecord(replay))
+	require.NoError(t, err)
+	assert.Equal(t, TReplay, summary.Type)
+	assert.Equal(t, map[uint64]uint64{5: 20}, summary.SkipLsns)
+
+	_, err = DecodeRecord(logservice.LogRecord{Data: make([]byte, pb.HeaderSize+8+2)})
+	assert.Error(t, err)
+	_, err = DecodeRecord(logservice.LogRecord{Data: make([]byte, pb.HeaderSize+8+16)})
+	assert.Error(t, err)
+}
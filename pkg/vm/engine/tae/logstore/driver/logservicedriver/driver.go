@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/common"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/driver"
@@ -66,20 +67,39 @@ type LogServiceDriver struct {
 
 	truncateQueue sm.Queue
 
+	compression int
+	groupCommit groupCommit
+
+	// readOnly is true if the clients are read-only, see
+	// Config.ReadOnlyClientFactory.
+	readOnly bool
+	tailer   *replayer
+
 	flushtimes  int
 	appendtimes int
 
 	readDuration time.Duration
 }
 
-func NewLogServiceDriver(cfg *Config) *LogServiceDriver {
-	clientpoolConfig := &clientConfig{
+func newClientConfig(cfg *Config, factory LogServiceClientFactory) *clientConfig {
+	return &clientConfig{
 		cancelDuration:        cfg.NewClientDuration,
 		recordSize:            cfg.RecordSize,
-		clientFactory:         cfg.ClientFactory,
+		clientFactory:         factory,
 		GetClientRetryTimeOut: cfg.GetClientRetryTimeOut,
 		retryDuration:         cfg.RetryTimeout,
 	}
+}
+
+func NewLogServiceDriver(cfg *Config) *LogServiceDriver {
+	if err := cfg.Validate(); err != nil {
+		panic(err)
+	}
+	factory := cfg.ClientFactory
+	if cfg.ReadOnlyClientFactory != nil {
+		factory = cfg.ReadOnlyClientFactory
+	}
+	clientpoolConfig := newClientConfig(cfg, factory)
 
 	// the tasks submitted to LogServiceDriver.appendPool append entries to logservice,
 	// and we hope the task will crash all the tn service if append failed.
@@ -99,6 +119,12 @@ func NewLogServiceDriver(cfg *Config) *LogServiceDriver {
 		appendedQueue:   make(chan any, 10000),
 		postAppendQueue: make(chan any, 10000),
 		appendPool:      pool,
+		compression:     compress.None,
+		groupCommit:     newGroupCommit(cfg),
+		readOnly:        cfg.ReadOnlyClientFactory != nil,
+	}
+	if cfg.Compression != "" {
+		d.compression = compress.Algorithms[cfg.Compression]
 	}
 	d.closeCtx, d.closeCancel = context.WithCancel(context.Background())
 	d.preAppendLoop = sm.NewSafeQueue(10000, 10000, d.onPreAppend)
@@ -113,10 +139,14 @@ func NewLogServiceDriver(cfg *Config) *LogServiceDriver {
 }
 
 func (d *LogServiceDriver) Close() error {
+	d.preAppendLoop.Stop()
+	// append the entries waiting for group commit
+	if len(d.appendable.entry.entries) > 0 {
+		d.appendAppender()
+	}
 	logutil.Infof("append%d,flush%d", d.appendtimes, d.flushtimes)
 	d.clientPool.Close()
 	d.closeCancel()
-	d.preAppendLoop.Stop()
 	d.appendedLoop.Stop()
 	d.postAppendLoop.Stop()
 	d.truncateQueue.Stop()
@@ -127,9 +157,31 @@ func (d *LogServiceDriver) Close() error {
 	return nil
 }
 
+// Tail applies the entries appended to the log since the last call, without
+// writing anything to the log. It keeps a standby driver up to date with the
+// log written by others, and the final Replay continues from where it stops.
+func (d *LogServiceDriver) Tail(h driver.ApplyHandle) error {
+	if d.tailer == nil {
+		d.PreReplay()
+		d.tailer = newReplayer(h, ReplayReadSize, d)
+	}
+	return d.tailer.tail()
+}
+
 func (d *LogServiceDriver) Replay(h driver.ApplyHandle) error {
-	d.PreReplay()
-	r := newReplayer(h, ReplayReadSize, d)
+	// take the lease of the log shard before the last read, so that no more
+	// entries can be appended by others.
+	if d.readOnly {
+		d.clientPool.Close()
+		d.clientPool = newClientPool(d.config.ClientMaxCount, newClientConfig(d.config, d.config.ClientFactory))
+		d.readOnly = false
+	}
+	r := d.tailer
+	if r == nil {
+		d.PreReplay()
+		r = newReplayer(h, ReplayReadSize, d)
+	}
+	d.tailer = nil
 	r.replay()
 	d.onReplay(r)
 	r.d.resetReadCache()
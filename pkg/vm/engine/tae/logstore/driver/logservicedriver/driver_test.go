@@ -18,7 +18,9 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/lni/vfs"
 	"github.com/matrixorigin/matrixone/pkg/common/runtime"
@@ -172,3 +174,109 @@ func TestReplay2(t *testing.T) {
 
 	driver.Close()
 }
+
+func TestReplayCompressedGroupCommit(t *testing.T) {
+	service, ccfg := initTest(t)
+	defer service.Close()
+
+	cfg := NewTestConfig("", ccfg)
+	cfg.Compression = "zstd"
+	cfg.CompressionMinSize = 0
+	cfg.GroupCommitLatency = time.Millisecond
+	driver := NewLogServiceDriver(cfg)
+
+	entryCount := 1001
+	entries := make([]*entry.Entry, entryCount)
+	// the first record is appended before the concurrent ones, so that it's
+	// the first one in logservice
+	entries[0] = entry.MockEntryWithPayload([]byte("payload 0"))
+	driver.Append(entries[0])
+	entries[0].WaitDone()
+
+	var wg sync.WaitGroup
+	for w := 0; w < 10; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w + 1; i < entryCount; i += 10 {
+				payload := []byte(fmt.Sprintf("payload %d", i))
+				e := entry.MockEntryWithPayload(payload)
+				entries[i] = e
+				driver.Append(e)
+				e.WaitDone()
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	replayed := make(map[uint64][]byte)
+	driver = restartDriver(t, driver, func(e *entry.Entry) {
+		replayed[e.Lsn] = append([]byte(nil), e.Entry.GetPayload()...)
+	})
+	assert.Equal(t, entryCount, len(replayed))
+	for _, e := range entries {
+		assert.Equal(t, e.Entry.GetPayload(), replayed[e.Lsn])
+	}
+
+	for _, e := range entries {
+		e.Entry.Free()
+	}
+	driver.Close()
+}
+
+func TestTailAndPromote(t *testing.T) {
+	service, ccfg := initTest(t)
+	defer service.Close()
+
+	driver := NewLogServiceDriver(NewTestConfig("", ccfg))
+
+	standbyCcfg := *ccfg
+	standbyCcfg.TNReplicaID = 11
+	standbyCfg := NewTestConfig("", &standbyCcfg)
+	readOnlyCcfg := standbyCcfg
+	readOnlyCcfg.ReadOnly = true
+	standbyCfg.ReadOnlyClientFactory = NewTestConfig("", &readOnlyCcfg).ClientFactory
+	standby := NewLogServiceDriver(standbyCfg)
+
+	replayed := make(map[uint64][]byte)
+	h := func(e *entry.Entry) {
+		replayed[e.Lsn] = append([]byte(nil), e.Entry.GetPayload()...)
+	}
+
+	var entries []*entry.Entry
+	appendEntries := func(n int) {
+		for i := 0; i < n; i++ {
+			payload := []byte(fmt.Sprintf("payload %d", len(entries)))
+			e := entry.MockEntryWithPayload(payload)
+			driver.Append(e)
+			e.WaitDone()
+			entries = append(entries, e)
+		}
+	}
+
+	appendEntries(100)
+	assert.NoError(t, standby.Tail(h))
+	assert.Equal(t, 100, len(replayed))
+
+	appendEntries(100)
+	assert.NoError(t, standby.Tail(h))
+	assert.Equal(t, 200, len(replayed))
+
+	// entries appended after the last tail are applied on promotion
+	appendEntries(50)
+	assert.NoError(t, driver.Close())
+	assert.NoError(t, standby.Replay(h))
+	assert.Equal(t, 250, len(replayed))
+	for _, e := range entries {
+		assert.Equal(t, e.Entry.GetPayload(), replayed[e.Lsn])
+		e.Entry.Free()
+	}
+
+	// the promoted driver is writable
+	e := entry.MockEntryWithPayload([]byte("promoted"))
+	assert.NoError(t, standby.Append(e))
+	assert.NoError(t, e.WaitDone())
+	assert.Equal(t, uint64(251), e.Lsn)
+	e.Entry.Free()
+	assert.NoError(t, standby.Close())
+}
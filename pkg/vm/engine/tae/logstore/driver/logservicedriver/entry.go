@@ -21,6 +21,8 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
@@ -38,11 +40,23 @@ const (
 
 const (
 	IOET_WALRecord_V1 uint16 = 1
+	// IOET_WALRecord_V2 is a compressed V1 record. The header is followed by
+	// the compression type, the size of the V1 body and the compressed body.
+	IOET_WALRecord_V2 uint16 = 2
 	IOET_WALRecord    uint16 = 1000
 
 	IOET_WALRecord_CurrVer = IOET_WALRecord_V1
 )
 
+// compressed header: compression type (1 byte) | size of the V1 body (4 bytes)
+const compressedHeaderSize = 5
+
+// maxCompressedBodySize bounds the V1 body of a compressed record, which is
+// the default max message size of the log service. Larger records are not
+// compressed, so the size in a compressed header is checked against it
+// before the buffer of the decompressed body is allocated.
+const maxCompressedBodySize = 100 * 1024 * 1024
+
 func init() {
 	objectio.RegisterIOEnrtyCodec(
 		objectio.IOEntryHeader{
@@ -60,6 +74,50 @@ func init() {
 			return record, err
 		},
 	)
+	objectio.RegisterIOEnrtyCodec(
+		objectio.IOEntryHeader{
+			Type:    IOET_WALRecord,
+			Version: IOET_WALRecord_V2,
+		},
+		// V2 records are encoded by recordEntry.compressRecord
+		nil,
+		func(b []byte) (any, error) {
+			body, err := decompressRecord(b)
+			if err != nil {
+				return nil, err
+			}
+			record := &baseEntry{
+				meta: &meta{},
+			}
+			err = record.Unmarshal(body)
+			return record, err
+		},
+	)
+}
+
+// decompressRecord returns the V1 body of the V2 record body b.
+func decompressRecord(b []byte) ([]byte, error) {
+	if len(b) < compressedHeaderSize {
+		return nil, moerr.NewInternalErrorNoCtx("compressed WAL record size %d", len(b))
+	}
+	typ := int(b[0])
+	size := types.DecodeUint32(b[1:compressedHeaderSize])
+	if typ != compress.Lz4 && typ != compress.Zstd {
+		return nil, moerr.NewNotSupportedNoCtx("WAL record compression %s", compress.T(typ))
+	}
+	if size > maxCompressedBodySize {
+		return nil, moerr.NewInternalErrorNoCtx(
+			"WAL record decompressed size %d exceeds %d", size, maxCompressedBodySize)
+	}
+	body, err := compress.Decompress(b[compressedHeaderSize:], make([]byte, size), typ)
+	if err != nil {
+		return nil, err
+	}
+	if len(body) != int(size) {
+		return nil, moerr.NewInternalErrorNoCtx(
+			"WAL record decompressed size %d, %d expected", len(body), size)
+	}
+	return body, nil
 }
 
 type meta struct {
@@ -291,7 +349,7 @@ func (r *recordEntry) replay(replayer *replayer) (addr *common.ClosedIntervals)
 			panic(err)
 		}
 		offset += n
-		replayer.recordChan <- e
+		replayer.apply(e)
 	}
 	intervals := common.NewClosedIntervalsBySlice(lsns)
 	return intervals
@@ -311,6 +369,33 @@ func (r *recordEntry) prepareRecord() (size int) {
 	return len(r.payload)
 }
 
+// compressRecord compresses the prepared record into a V2 record if it's not
+// smaller than minSize and compression does save space.
+func (r *recordEntry) compressRecord(typ int, minSize int) (size int) {
+	if typ == compress.None || len(r.payload) < minSize ||
+		len(r.payload) > maxCompressedBodySize {
+		return len(r.payload)
+	}
+	body := r.payload[objectio.IOEntryHeaderSize:]
+	prefix := objectio.IOEntryHeaderSize + compressedHeaderSize
+	buf := make([]byte, prefix+compress.CompressBlockBound(len(body), typ))
+	data, err := compress.Compress(body, buf[prefix:], typ)
+	// lz4 returns no data if the body is incompressible
+	if err != nil || len(data) == 0 || prefix+len(data) >= len(r.payload) {
+		return len(r.payload)
+	}
+	head := objectio.IOEntryHeader{
+		Type:    IOET_WALRecord,
+		Version: IOET_WALRecord_V2,
+	}
+	copy(buf, objectio.EncodeIOEntryHeader(&head))
+	buf[objectio.IOEntryHeaderSize] = uint8(typ)
+	bodySize := uint32(len(body))
+	copy(buf[objectio.IOEntryHeaderSize+1:], types.EncodeUint32(&bodySize))
+	r.payload = buf[:prefix+len(data)]
+	return len(r.payload)
+}
+
 func (r *recordEntry) unmarshal() {
 	if r.unmarshaled.Load() == 1 {
 		return
@@ -0,0 +1,121 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logservicedriver
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/compress"
+	"github.com/matrixorigin/matrixone/pkg/logservice"
+	"github.com/matrixorigin/matrixone/pkg/objectio"
+	pb "github.com/matrixorigin/matrixone/pkg/pb/logservice"
+	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/driver/entry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mockRecord(t *testing.T, count int, size int) *recordEntry {
+	r := newRecordEntry()
+	for i := 0; i < count; i++ {
+		payload := bytes.Repeat([]byte(fmt.Sprintf("payload %d", i)), size)
+		e := entry.MockEntryWithPayload(payload)
+		e.Lsn = uint64(i + 1)
+		r.append(e)
+		t.Cleanup(e.Entry.Free)
+	}
+	return r
+}
+
+func TestCompressRecord(t *testing.T) {
+	for _, typ := range []int{compress.Lz4, compress.Zstd} {
+		r := mockRecord(t, 3, 100)
+		size := r.prepareRecord()
+		compressed := r.compressRecord(typ, 0)
+		assert.Less(t, compressed, size)
+		head := objectio.DecodeIOEntryHeader(r.payload[:objectio.IOEntryHeaderSize])
+		assert.Equal(t, IOET_WALRecord_V2, head.Version)
+
+		data := make([]byte, pb.HeaderSize+8+compressed)
+		copy(data[pb.HeaderSize+8:], r.payload)
+		read := newEmptyRecordEntry(logservice.LogRecord{Data: data})
+		for i, e := range r.entries {
+			readed := read.readEntry(e.Lsn)
+			assert.Equal(t, e.Entry.GetPayload(), readed.Entry.GetPayload(), i)
+			readed.Entry.Free()
+		}
+
+		summary, err := DecodeRecord(logservice.LogRecord{Data: data})
+		require.NoError(t, err)
+		assert.Equal(t, 3, len(summary.Entries))
+	}
+}
+
+func TestCompressRecordSkipped(t *testing.T) {
+	r := mockRecord(t, 1, 100)
+	size := r.prepareRecord()
+	assert.Equal(t, size, r.compressRecord(compress.None, 0))
+	assert.Equal(t, size, r.compressRecord(compress.Lz4, size+1))
+	head := objectio.DecodeIOEntryHeader(r.payload[:objectio.IOEntryHeaderSize])
+	assert.Equal(t, IOET_WALRecord_V1, head.Version)
+
+	_, err := decompressRecord([]byte{compress.Lz4, 1})
+	assert.Error(t, err)
+	_, err = decompressRecord([]byte{compress.None, 1, 0, 0, 0, 0})
+	assert.Error(t, err)
+	// the size in the header is bounded before the allocation
+	_, err = decompressRecord([]byte{compress.Zstd, 0xff, 0xff, 0xff, 0xff, 0})
+	assert.Error(t, err)
+
+	cfg := &Config{Compression: "snappy"}
+	assert.Error(t, cfg.Validate())
+	cfg.Compression = "lz4"
+	assert.NoError(t, cfg.Validate())
+}
+
+func TestGroupCommit(t *testing.T) {
+	g := newGroupCommit(&Config{
+		RecordSize:         1024,
+		GroupCommitLatency: time.Millisecond,
+	})
+	assert.Equal(t, 1024, g.size)
+
+	notified := make(chan groupCommitTimeout, 1)
+	notify := func(v groupCommitTimeout) { notified <- v }
+
+	// commits are not concurrent
+	appender := newDriverAppender()
+	assert.False(t, g.wait(appender, notify))
+
+	g.entries = 10
+	assert.True(t, g.wait(appender, notify))
+	assert.True(t, g.wait(appender, notify))
+	v := <-notified
+	assert.Equal(t, appender, v.appender)
+	g.onAppend(1)
+	assert.Nil(t, g.timer)
+
+	// the waiting entries are large enough
+	appender = newDriverAppender()
+	appender.entry.payloadSize = 1024
+	assert.False(t, g.wait(appender, notify))
+
+	for i := 0; i < 20; i++ {
+		g.onAppend(1)
+	}
+	assert.Less(t, g.entries, float64(groupCommitMinEntries))
+}
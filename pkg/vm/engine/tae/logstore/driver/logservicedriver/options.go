@@ -18,7 +18,9 @@ import (
 	"context"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/compress"
 	"github.com/matrixorigin/matrixone/pkg/logservice"
 )
 
@@ -38,7 +40,33 @@ type Config struct {
 	GetTruncateDuration time.Duration
 	ReadDuration        time.Duration
 
+	// Compression is the compression algorithm of the records, one of
+	// "none", "lz4" and "zstd".
+	Compression string
+	// CompressionMinSize is the min size of the records to compress.
+	CompressionMinSize int
+	// GroupCommitLatency is the max duration an entry waits to be appended
+	// with the concurrent ones in the same record, 0 disables group commit.
+	GroupCommitLatency time.Duration
+	// GroupCommitSize is the size of the pending entries to stop waiting,
+	// RecordSize is used if it's 0.
+	GroupCommitSize int
+
 	ClientFactory LogServiceClientFactory
+	// ReadOnlyClientFactory creates the clients of a standby driver, which
+	// tails the log without taking the lease of the log shard. If it's set,
+	// the driver switches to ClientFactory on Replay.
+	ReadOnlyClientFactory LogServiceClientFactory
+}
+
+// Validate checks the config before the driver is created.
+func (cfg *Config) Validate() error {
+	if cfg.Compression != "" {
+		if _, ok := compress.Algorithms[cfg.Compression]; !ok {
+			return moerr.NewBadConfigNoCtx("unknown WAL compression %s", cfg.Compression)
+		}
+	}
+	return nil
 }
 
 type LogServiceClientFactory logservice.ClientFactory
@@ -57,6 +85,8 @@ func NewDefaultConfig(clientFactory LogServiceClientFactory) *Config {
 		TruncateDuration:     time.Second * 10,
 		GetTruncateDuration:  time.Second * 5,
 		ReadDuration:         time.Second * 5,
+		Compression:          "none",
+		CompressionMinSize:   int(mpool.KB * 4),
 		ClientFactory:        clientFactory,
 	}
 }
@@ -75,6 +105,8 @@ func NewTestConfig(sid string, ccfg *logservice.ClientConfig) *Config {
 		TruncateDuration:     time.Second,
 		GetTruncateDuration:  time.Second,
 		ReadDuration:         time.Second,
+		Compression:          "none",
+		CompressionMinSize:   int(mpool.KB * 4),
 	}
 	cfg.ClientFactory = func() (logservice.Client, error) {
 		ctx, cancel := context.WithTimeout(context.Background(), cfg.NewClientDuration)
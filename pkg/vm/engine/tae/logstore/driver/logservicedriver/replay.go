@@ -23,12 +23,15 @@ import (
 	"sync"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/driver"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logstore/driver/entry"
 )
 
+var ErrLogTruncated = moerr.NewInternalErrorNoCtx("driver tail: log truncated before read")
+
 type replayer struct {
 	readMaxSize int
 
@@ -46,6 +49,7 @@ type replayer struct {
 	nextToReadLsn uint64
 	d             *LogServiceDriver
 	appended      []uint64
+	applying      bool
 
 	recordChan chan *entry.Entry
 
@@ -77,8 +81,7 @@ func newReplayer(h driver.ApplyHandle, readmaxsize int, d *LogServiceDriver) *re
 
 func (r *replayer) replay() {
 	var err error
-	r.wg.Add(1)
-	go r.replayRecords()
+	r.startApply()
 	for !r.readRecords() {
 		for r.replayedLsn < r.safeLsn {
 			err := r.replayLogserviceEntry(r.replayedLsn+1, true)
@@ -98,8 +101,64 @@ func (r *replayer) replay() {
 	close(r.recordChan)
 }
 
+// tail reads the new records and applies the entries in the order of driver
+// lsn as long as they are contiguous. Unlike replay, it never appends to the
+// log, so the entries missing from the log are left to the final replay. The
+// entries are applied before it returns.
+func (r *replayer) tail() error {
+	truncated, err := r.getTruncated()
+	if err != nil {
+		return err
+	}
+	if truncated >= r.nextToReadLsn {
+		logutil.Errorf("tail wal: log truncated at %d, records from %d are not read",
+			truncated, r.nextToReadLsn)
+		return ErrLogTruncated
+	}
+	for !r.readRecords() {
+		for r.replayedLsn < r.safeLsn {
+			if err := r.replayLogserviceEntry(r.replayedLsn+1, true); err != nil {
+				return err
+			}
+		}
+	}
+	for {
+		if _, ok := r.driverLsnLogserviceLsnMap[r.replayedLsn+1]; !ok {
+			break
+		}
+		if err := r.replayLogserviceEntry(r.replayedLsn+1, false); err != nil {
+			return err
+		}
+	}
+	// the applied records are dropped from the read cache already
+	r.d.lsns = r.d.lsns[:0]
+	return nil
+}
+
+func (r *replayer) getTruncated() (uint64, error) {
+	client, err := r.d.clientPool.Get()
+	if err != nil {
+		return 0, err
+	}
+	defer r.d.clientPool.Put(client)
+	ctx, cancel := context.WithTimeout(context.Background(), r.d.config.GetTruncateDuration)
+	defer cancel()
+	return client.c.GetTruncatedLsn(ctx)
+}
+
+func (r *replayer) startApply() {
+	if r.applying {
+		return
+	}
+	r.applying = true
+	r.wg.Add(1)
+	go r.replayRecords()
+}
+
 func (r *replayer) readRecords() (readEnd bool) {
+	maxLsn := uint64(0)
 	nextLsn, safeLsn := r.d.readFromLogServiceInReplay(r.nextToReadLsn, r.readMaxSize, func(lsn uint64, record *recordEntry) {
+		maxLsn = lsn
 		r.readCount++
 		if record.meta.metaType == TReplay {
 			r.internalCount++
@@ -118,6 +177,11 @@ func (r *replayer) readRecords() (readEnd bool) {
 		}
 	})
 	if nextLsn == r.nextToReadLsn {
+		// the end of the log is reached, and the next lsn is the one to read.
+		// skip the records read here, or tail reads them again.
+		if maxLsn >= r.nextToReadLsn {
+			r.nextToReadLsn = maxLsn + 1
+		}
 		return true
 	}
 	r.nextToReadLsn = nextLsn
@@ -142,13 +206,27 @@ func (r *replayer) replayRecords() {
 		if e.IsEnd() {
 			break
 		}
-		t0 := time.Now()
-		r.replayHandle(e)
-		e.Entry.Free()
-		r.applyDuration += time.Since(t0)
+		r.applyEntry(e)
 	}
 }
 
+// apply applies the entry in the apply goroutine, or right away if it's not
+// started when tailing.
+func (r *replayer) apply(e *entry.Entry) {
+	if !r.applying {
+		r.applyEntry(e)
+		return
+	}
+	r.recordChan <- e
+}
+
+func (r *replayer) applyEntry(e *entry.Entry) {
+	t0 := time.Now()
+	r.replayHandle(e)
+	e.Entry.Free()
+	r.applyDuration += time.Since(t0)
+}
+
 func (r *replayer) replayLogserviceEntry(lsn uint64, safe bool) error {
 	logserviceLsn, ok := r.driverLsnLogserviceLsnMap[lsn]
 	if !ok {
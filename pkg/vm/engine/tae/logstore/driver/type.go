@@ -23,6 +23,9 @@ type Driver interface {
 	Read(lsn uint64) (*entry.Entry, error)
 	Close() error
 	Replay(h ApplyHandle) error
+	// Tail applies the entries appended by others since the last call, it
+	// keeps a standby up to date before the final Replay.
+	Tail(h ApplyHandle) error
 	GetCurrSeqNum() uint64
 }
 
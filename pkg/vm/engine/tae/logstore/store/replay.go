@@ -58,6 +58,17 @@ func (w *StoreImpl) Replay(h ApplyHandle) error {
 	return nil
 }
 
+// Tail applies the entries appended by others since the last call, the
+// final Replay continues from where it stops.
+func (w *StoreImpl) Tail(h ApplyHandle) error {
+	return w.driver.Tail(func(e *entry.Entry) {
+		err := w.replayEntry(e, h)
+		if err != nil {
+			panic(err)
+		}
+	})
+}
+
 func (w *StoreImpl) onReplayLsn(g uint32, lsn uint64) {
 	_, ok := w.minLsn[g]
 	if !ok {
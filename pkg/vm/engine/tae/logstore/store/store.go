@@ -45,8 +45,7 @@ type StoreImpl struct {
 	truncateQueue   sm.Queue
 }
 
-func NewStoreWithLogserviceDriver(factory logservicedriver.LogServiceClientFactory) Store {
-	cfg := logservicedriver.NewDefaultConfig(factory)
+func NewStoreWithLogserviceDriver(cfg *logservicedriver.Config) Store {
 	driver := logservicedriver.NewLogServiceDriver(cfg)
 	return NewStore(driver)
 }
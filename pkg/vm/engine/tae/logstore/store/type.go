@@ -34,6 +34,7 @@ type Store interface {
 	GetCheckpointed(gid uint32) (lsn uint64)
 
 	Replay(h ApplyHandle) error
+	Tail(h ApplyHandle) error
 	Close() error
 }
 
@@ -22,7 +22,9 @@ import (
 	"time"
 
 	catalog2 "github.com/matrixorigin/matrixone/pkg/catalog"
+	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/batch"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
@@ -54,6 +56,31 @@ const (
 	ColObjId
 )
 
+// mo_pitr's schema
+const (
+	ColPitrId uint16 = iota
+	ColPitrName
+	ColPitrCreateAccount
+	ColPitrCreateTime
+	ColPitrModifiedTime
+	ColPitrLevel
+	ColPitrAccountId
+	ColPitrAccountName
+	ColPitrDatabaseName
+	ColPitrTableName
+	ColPitrObjId
+	ColPitrLength
+	ColPitrUnit
+)
+
+// the levels of PITR, same as the ones in mo_catalog.mo_pitr
+const (
+	PITRLevelCluster  = "cluster"
+	PITRLevelAccount  = "account"
+	PITRLevelDatabase = "database"
+	PITRLevelTable    = "table"
+)
+
 var (
 	objectInfoSchemaAttr = []string{
 		catalog.ObjectAttr_ObjectStats,
@@ -108,8 +135,73 @@ var (
 		types.New(types.T_varchar, types.MaxVarcharLen, 0),
 		types.New(types.T_uint64, 0, 0),
 	}
+
+	pitrSchemaTypes = []types.Type{
+		types.New(types.T_uuid, 0, 0),
+		types.New(types.T_varchar, 5000, 0),
+		types.New(types.T_uint64, 0, 0),
+		types.New(types.T_timestamp, 0, 0),
+		types.New(types.T_timestamp, 0, 0),
+		types.New(types.T_varchar, 10, 0),
+		types.New(types.T_uint64, 0, 0),
+		types.New(types.T_varchar, 300, 0),
+		types.New(types.T_varchar, 5000, 0),
+		types.New(types.T_varchar, 5000, 0),
+		types.New(types.T_uint64, 0, 0),
+		types.New(types.T_uint8, 0, 0),
+		types.New(types.T_varchar, 10, 0),
+	}
 )
 
+// PITRRange is the range of a PITR, the objects dropped after Start are
+// needed to restore the tables it covers. ObjID is the id of the database or
+// the table for the PITR of the database or table level.
+type PITRRange struct {
+	Name    string
+	Level   string
+	Account uint32
+	ObjID   uint64
+	// Start is the physical time in nanoseconds
+	Start int64
+}
+
+// Covers tells whether the PITR covers the table of the account
+func (p *PITRRange) Covers(accID uint32, dbID, tid uint64) bool {
+	switch p.Level {
+	case PITRLevelCluster:
+		return true
+	case PITRLevelAccount:
+		return accID == p.Account
+	case PITRLevelDatabase:
+		return dbID == p.ObjID
+	case PITRLevelTable:
+		return tid == p.ObjID
+	}
+	return false
+}
+
+// String returns the PITR with the start of its range
+func (p *PITRRange) String() string {
+	return fmt.Sprintf("pitr %s of %s level since %s",
+		p.Name, p.Level, time.Unix(0, p.Start).UTC().Format(time.RFC3339))
+}
+
+// pitrStart returns the start of the range of a PITR with the length
+func pitrStart(now time.Time, length int, unit string) (time.Time, error) {
+	switch unit {
+	case "h":
+		return now.Add(time.Duration(-length) * time.Hour), nil
+	case "d":
+		return now.AddDate(0, 0, -length), nil
+	case "mo":
+		return now.AddDate(0, -length, 0), nil
+	case "y":
+		return now.AddDate(-length, 0, 0), nil
+	default:
+		return time.Time{}, moerr.NewInternalErrorNoCtx("unknown pitr unit '%s'", unit)
+	}
+}
+
 type objectInfo struct {
 	stats         objectio.ObjectStats
 	deltaLocation map[uint32]*objectio.Location
@@ -124,6 +216,9 @@ type SnapshotMeta struct {
 	tables      map[uint32]map[uint64]*TableInfo
 	acctIndexes map[uint64]*TableInfo
 	tides       map[uint64]struct{}
+	// pitrTides are the ids of mo_pitr, the objects of which are tracked
+	// with the ones of mo_snapshots
+	pitrTides map[uint64]struct{}
 }
 
 type TableInfo struct {
@@ -140,6 +235,7 @@ func NewSnapshotMeta() *SnapshotMeta {
 		tables:      make(map[uint32]map[uint64]*TableInfo),
 		acctIndexes: make(map[uint64]*TableInfo),
 		tides:       make(map[uint64]struct{}),
+		pitrTides:   make(map[uint64]struct{}),
 	}
 }
 
@@ -185,6 +281,10 @@ func (sm *SnapshotMeta) updateTableInfo(data *CheckpointData) {
 			logutil.Info("[UpdateSnapTable]", zap.Uint64("tid", tid))
 			sm.tides[tid] = struct{}{}
 		}
+		if name == catalog2.MO_PITR {
+			logutil.Info("[UpdatePITRTable]", zap.Uint64("tid", tid))
+			sm.addPITRTid(tid)
+		}
 		accID := insAccIDs[i]
 		if sm.tables[accID] == nil {
 			sm.tables[accID] = make(map[uint64]*TableInfo)
@@ -224,6 +324,21 @@ func (sm *SnapshotMeta) updateTableInfo(data *CheckpointData) {
 	}
 }
 
+// addPITRTid tracks the objects of mo_pitr. Rebuild takes the tables of all
+// the objects saved as mo_snapshots, so the id is moved out of tides.
+func (sm *SnapshotMeta) addPITRTid(tid uint64) {
+	sm.pitrTides[tid] = struct{}{}
+	delete(sm.tides, tid)
+}
+
+func (sm *SnapshotMeta) isTracked(tid uint64) bool {
+	if _, ok := sm.tides[tid]; ok {
+		return true
+	}
+	_, ok := sm.pitrTides[tid]
+	return ok
+}
+
 func (sm *SnapshotMeta) Update(data *CheckpointData) *SnapshotMeta {
 	sm.Lock()
 	defer sm.Unlock()
@@ -232,7 +347,7 @@ func (sm *SnapshotMeta) Update(data *CheckpointData) *SnapshotMeta {
 		logutil.Infof("[UpdateSnapshot] cost %v", time.Since(now))
 	}()
 	sm.updateTableInfo(data)
-	if sm.tid == 0 && len(sm.tides) == 0 {
+	if sm.tid == 0 && len(sm.tides) == 0 && len(sm.pitrTides) == 0 {
 		return sm
 	}
 	ins := data.GetObjectBatchs()
@@ -241,7 +356,7 @@ func (sm *SnapshotMeta) Update(data *CheckpointData) *SnapshotMeta {
 	insTableIDs := vector.MustFixedCol[uint64](ins.GetVectorByName(SnapshotAttr_TID).GetDownstreamVector())
 	for i := 0; i < ins.Length(); i++ {
 		table := insTableIDs[i]
-		if _, ok := sm.tides[table]; !ok {
+		if !sm.isTracked(table) {
 			continue
 		}
 		var objectStats objectio.ObjectStats
@@ -282,7 +397,7 @@ func (sm *SnapshotMeta) Update(data *CheckpointData) *SnapshotMeta {
 		blockID := delBlockIDs[i]
 		tableID := delTableIDs[i]
 		deltaLoc := objectio.Location(del.GetVectorByName(catalog2.BlockMeta_DeltaLoc).Get(i).([]byte))
-		if _, ok := sm.tides[tableID]; !ok {
+		if !sm.isTracked(tableID) {
 			continue
 		}
 		if sm.objects[tableID] == nil {
@@ -298,22 +413,18 @@ func (sm *SnapshotMeta) Update(data *CheckpointData) *SnapshotMeta {
 	return nil
 }
 
-func (sm *SnapshotMeta) GetSnapshot(ctx context.Context, sid string, fs fileservice.FileService, mp *mpool.MPool) (map[uint32]containers.Vector, error) {
-	now := time.Now()
-	defer func() {
-		logutil.Infof("[GetSnapshot] cost %v", time.Since(now))
-	}()
-	sm.RLock()
-	objects := sm.CopyObjectsLocked()
-	tables := sm.CopyTablesLocked()
-	sm.RUnlock()
-	snapshotList := make(map[uint32]containers.Vector)
-	idxes := []uint16{ColTS, ColLevel, ColObjId}
-	colTypes := []types.Type{
-		snapshotSchemaTypes[ColTS],
-		snapshotSchemaTypes[ColLevel],
-		snapshotSchemaTypes[ColObjId],
-	}
+// readObjects reads the columns of the blocks of the objects, the rows
+// deleted are skipped
+func readObjects(
+	ctx context.Context,
+	sid string,
+	objects map[uint64]map[objectio.Segmentid]*objectInfo,
+	idxes []uint16,
+	colTypes []types.Type,
+	fs fileservice.FileService,
+	mp *mpool.MPool,
+	fn func(bat *batch.Batch) error,
+) error {
 	for _, objectMap := range objects {
 		for _, object := range objectMap {
 			location := object.stats.ObjectLocation()
@@ -333,47 +444,80 @@ func (sm *SnapshotMeta) GetSnapshot(ctx context.Context, sid string, fs fileserv
 				bat, err := blockio.BlockRead(ctx, sid, &blk, nil, idxes, colTypes, checkpointTS.ToTimestamp(),
 					nil, nil, blockio.BlockReadFilter{}, fs, mp, nil, fileservice.Policy(0))
 				if err != nil {
-					return nil, err
+					return err
 				}
-				defer bat.Clean(mp)
-				tsList := vector.MustFixedCol[int64](bat.Vecs[0])
-				typeList := vector.MustFixedCol[types.Enum](bat.Vecs[1])
-				acctList := vector.MustFixedCol[uint64](bat.Vecs[2])
-				for r := 0; r < bat.Vecs[0].Length(); r++ {
-					ts := tsList[r]
-					snapTs := types.BuildTS(ts, 0)
-					acct := acctList[r]
-					snapshotType := typeList[r]
-					if snapshotType == SnapshotTypeCluster {
-						for account := range tables {
-							if snapshotList[account] == nil {
-								snapshotList[account] = containers.MakeVector(types.T_TS.ToType(), mp)
-							}
-							err = vector.AppendFixed[types.TS](snapshotList[account].GetDownstreamVector(), snapTs, false, mp)
-							if err != nil {
-								return nil, err
-							}
-							// TODO: info to debug
-							logutil.Info("[GetSnapshot] cluster snapshot",
-								common.OperationField(snapTs.ToString()))
-						}
-						continue
-					}
-					id := uint32(acct)
-					if snapshotList[id] == nil {
-						snapshotList[id] = containers.MakeVector(types.T_TS.ToType(), mp)
+				err = fn(bat)
+				bat.Clean(mp)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (sm *SnapshotMeta) GetSnapshot(ctx context.Context, sid string, fs fileservice.FileService, mp *mpool.MPool) (map[uint32]containers.Vector, error) {
+	now := time.Now()
+	defer func() {
+		logutil.Infof("[GetSnapshot] cost %v", time.Since(now))
+	}()
+	sm.RLock()
+	objects := sm.CopyObjectsLocked()
+	for tid := range sm.pitrTides {
+		delete(objects, tid)
+	}
+	tables := sm.CopyTablesLocked()
+	sm.RUnlock()
+	snapshotList := make(map[uint32]containers.Vector)
+	idxes := []uint16{ColTS, ColLevel, ColObjId}
+	colTypes := []types.Type{
+		snapshotSchemaTypes[ColTS],
+		snapshotSchemaTypes[ColLevel],
+		snapshotSchemaTypes[ColObjId],
+	}
+	err := readObjects(ctx, sid, objects, idxes, colTypes, fs, mp, func(bat *batch.Batch) (err error) {
+		tsList := vector.MustFixedCol[int64](bat.Vecs[0])
+		typeList := vector.MustFixedCol[types.Enum](bat.Vecs[1])
+		acctList := vector.MustFixedCol[uint64](bat.Vecs[2])
+		for r := 0; r < bat.Vecs[0].Length(); r++ {
+			ts := tsList[r]
+			snapTs := types.BuildTS(ts, 0)
+			acct := acctList[r]
+			snapshotType := typeList[r]
+			if snapshotType == SnapshotTypeCluster {
+				for account := range tables {
+					if snapshotList[account] == nil {
+						snapshotList[account] = containers.MakeVector(types.T_TS.ToType(), mp)
 					}
-					// TODO: info to debug
-					logutil.Info("[GetSnapshot] snapshot",
-						zap.Uint32("account", id),
-						zap.String("snap ts", snapTs.ToString()))
-					err = vector.AppendFixed[types.TS](snapshotList[id].GetDownstreamVector(), snapTs, false, mp)
+					err = vector.AppendFixed[types.TS](snapshotList[account].GetDownstreamVector(), snapTs, false, mp)
 					if err != nil {
-						return nil, err
+						return
 					}
+					// TODO: info to debug
+					logutil.Info("[GetSnapshot] cluster snapshot",
+						common.OperationField(snapTs.ToString()))
 				}
+				continue
+			}
+			id := uint32(acct)
+			if snapshotList[id] == nil {
+				snapshotList[id] = containers.MakeVector(types.T_TS.ToType(), mp)
+			}
+			// TODO: info to debug
+			logutil.Info("[GetSnapshot] snapshot",
+				zap.Uint32("account", id),
+				zap.String("snap ts", snapTs.ToString()))
+			err = vector.AppendFixed[types.TS](snapshotList[id].GetDownstreamVector(), snapTs, false, mp)
+			if err != nil {
+				return
 			}
 		}
+		return
+	})
+	if err != nil {
+		CloseSnapshotList(snapshotList)
+		return nil, err
 	}
 	for i := range snapshotList {
 		snapshotList[i].GetDownstreamVector().InplaceSort()
@@ -381,6 +525,55 @@ func (sm *SnapshotMeta) GetSnapshot(ctx context.Context, sid string, fs fileserv
 	return snapshotList, nil
 }
 
+// GetPITR reads the PITRs in mo_pitr and computes the start of their ranges
+// by now
+func (sm *SnapshotMeta) GetPITR(
+	ctx context.Context,
+	sid string,
+	now time.Time,
+	fs fileservice.FileService,
+	mp *mpool.MPool,
+) ([]PITRRange, error) {
+	sm.RLock()
+	objects := make(map[uint64]map[objectio.Segmentid]*objectInfo, len(sm.pitrTides))
+	for tid := range sm.pitrTides {
+		objects[tid] = make(map[objectio.Segmentid]*objectInfo, len(sm.objects[tid]))
+		for id, object := range sm.objects[tid] {
+			objects[tid][id] = object
+		}
+	}
+	sm.RUnlock()
+	idxes := []uint16{ColPitrName, ColPitrLevel, ColPitrAccountId, ColPitrObjId, ColPitrLength, ColPitrUnit}
+	colTypes := make([]types.Type, len(idxes))
+	for i, idx := range idxes {
+		colTypes[i] = pitrSchemaTypes[idx]
+	}
+	var pitrs []PITRRange
+	err := readObjects(ctx, sid, objects, idxes, colTypes, fs, mp, func(bat *batch.Batch) error {
+		accounts := vector.MustFixedCol[uint64](bat.Vecs[2])
+		objIDs := vector.MustFixedCol[uint64](bat.Vecs[3])
+		lengths := vector.MustFixedCol[uint8](bat.Vecs[4])
+		for r := 0; r < bat.Vecs[0].Length(); r++ {
+			start, err := pitrStart(now, int(lengths[r]), bat.Vecs[5].GetStringAt(r))
+			if err != nil {
+				return err
+			}
+			pitrs = append(pitrs, PITRRange{
+				Name:    bat.Vecs[0].GetStringAt(r),
+				Level:   bat.Vecs[1].GetStringAt(r),
+				Account: uint32(accounts[r]),
+				ObjID:   objIDs[r],
+				Start:   start.UnixNano(),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pitrs, nil
+}
+
 func (sm *SnapshotMeta) SetTid(tid uint64) {
 	sm.tid = tid
 }
@@ -453,49 +646,26 @@ func (sm *SnapshotMeta) SaveTableInfo(name string, fs fileservice.FileService) (
 	}
 	bat := containers.NewBatch()
 	snapTableBat := containers.NewBatch()
+	pitrTableBat := containers.NewBatch()
 	for i, attr := range tableInfoSchemaAttr {
 		bat.AddVector(attr, containers.MakeVector(tableInfoSchemaTypes[i], common.DebugAllocator))
 		snapTableBat.AddVector(attr, containers.MakeVector(tableInfoSchemaTypes[i], common.DebugAllocator))
+		pitrTableBat.AddVector(attr, containers.MakeVector(tableInfoSchemaTypes[i], common.DebugAllocator))
 	}
 	for _, entry := range sm.tables {
 		for _, table := range entry {
-			vector.AppendFixed[uint32](
-				bat.GetVectorByName(catalog2.SystemColAttr_AccID).GetDownstreamVector(),
-				table.accID, false, common.DebugAllocator)
-			vector.AppendFixed[uint64](
-				bat.GetVectorByName(catalog2.SystemRelAttr_DBID).GetDownstreamVector(),
-				table.dbID, false, common.DebugAllocator)
-			vector.AppendFixed[uint64](
-				bat.GetVectorByName(SnapshotAttr_TID).GetDownstreamVector(),
-				table.tid, false, common.DebugAllocator)
-			vector.AppendFixed[types.TS](
-				bat.GetVectorByName(catalog2.SystemRelAttr_CreateAt).GetDownstreamVector(),
-				table.createAt, false, common.DebugAllocator)
-			vector.AppendFixed[types.TS](
-				bat.GetVectorByName(catalog.EntryNode_DeleteAt).GetDownstreamVector(),
-				table.deleteAt, false, common.DebugAllocator)
-
+			appendTableInfo(bat, table)
 			if _, ok := sm.tides[table.tid]; ok {
-				vector.AppendFixed[uint32](
-					snapTableBat.GetVectorByName(catalog2.SystemColAttr_AccID).GetDownstreamVector(),
-					table.accID, false, common.DebugAllocator)
-				vector.AppendFixed[uint64](
-					snapTableBat.GetVectorByName(catalog2.SystemRelAttr_DBID).GetDownstreamVector(),
-					table.dbID, false, common.DebugAllocator)
-				vector.AppendFixed[uint64](
-					snapTableBat.GetVectorByName(SnapshotAttr_TID).GetDownstreamVector(),
-					table.tid, false, common.DebugAllocator)
-				vector.AppendFixed[types.TS](
-					snapTableBat.GetVectorByName(catalog2.SystemRelAttr_CreateAt).GetDownstreamVector(),
-					table.createAt, false, common.DebugAllocator)
-				vector.AppendFixed[types.TS](
-					snapTableBat.GetVectorByName(catalog.EntryNode_DeleteAt).GetDownstreamVector(),
-					table.deleteAt, false, common.DebugAllocator)
+				appendTableInfo(snapTableBat, table)
+			}
+			if _, ok := sm.pitrTides[table.tid]; ok {
+				appendTableInfo(pitrTableBat, table)
 			}
 		}
 	}
 	defer bat.Close()
 	defer snapTableBat.Close()
+	defer pitrTableBat.Close()
 	writer, err := objectio.NewObjectWriterSpecial(objectio.WriterGC, name, fs)
 	if err != nil {
 		return 0, err
@@ -506,6 +676,9 @@ func (sm *SnapshotMeta) SaveTableInfo(name string, fs fileservice.FileService) (
 	if _, err = writer.WriteWithoutSeqnum(containers.ToCNBatch(snapTableBat)); err != nil {
 		return 0, err
 	}
+	if _, err = writer.WriteWithoutSeqnum(containers.ToCNBatch(pitrTableBat)); err != nil {
+		return 0, err
+	}
 
 	_, err = writer.WriteEnd(context.Background())
 	if err != nil {
@@ -515,6 +688,24 @@ func (sm *SnapshotMeta) SaveTableInfo(name string, fs fileservice.FileService) (
 	return size, err
 }
 
+func appendTableInfo(bat *containers.Batch, table *TableInfo) {
+	vector.AppendFixed[uint32](
+		bat.GetVectorByName(catalog2.SystemColAttr_AccID).GetDownstreamVector(),
+		table.accID, false, common.DebugAllocator)
+	vector.AppendFixed[uint64](
+		bat.GetVectorByName(catalog2.SystemRelAttr_DBID).GetDownstreamVector(),
+		table.dbID, false, common.DebugAllocator)
+	vector.AppendFixed[uint64](
+		bat.GetVectorByName(SnapshotAttr_TID).GetDownstreamVector(),
+		table.tid, false, common.DebugAllocator)
+	vector.AppendFixed[types.TS](
+		bat.GetVectorByName(catalog2.SystemRelAttr_CreateAt).GetDownstreamVector(),
+		table.createAt, false, common.DebugAllocator)
+	vector.AppendFixed[types.TS](
+		bat.GetVectorByName(catalog.EntryNode_DeleteAt).GetDownstreamVector(),
+		table.deleteAt, false, common.DebugAllocator)
+}
+
 func (sm *SnapshotMeta) RebuildTableInfo(ins *containers.Batch) {
 	sm.Lock()
 	defer sm.Unlock()
@@ -565,6 +756,17 @@ func (sm *SnapshotMeta) RebuildTid(ins *containers.Batch) {
 	}
 }
 
+func (sm *SnapshotMeta) RebuildPITRTid(ins *containers.Batch) {
+	sm.Lock()
+	defer sm.Unlock()
+	insTIDs := vector.MustFixedCol[uint64](ins.GetVectorByName(catalog.SnapshotAttr_TID).GetDownstreamVector())
+	for i := 0; i < ins.Length(); i++ {
+		tid := insTIDs[i]
+		sm.addPITRTid(tid)
+		logutil.Info("[RebuildPITRTid]", zap.Uint64("tid", tid))
+	}
+}
+
 func (sm *SnapshotMeta) Rebuild(ins *containers.Batch) {
 	sm.Lock()
 	defer sm.Unlock()
@@ -719,10 +921,13 @@ func (sm *SnapshotMeta) ReadTableInfo(ctx context.Context, name string, fs files
 			}
 			bat.AddVector(tableInfoSchemaAttr[i], vec)
 		}
-		if id == 0 {
+		switch id {
+		case 0:
 			sm.RebuildTableInfo(bat)
-		} else {
+		case 1:
 			sm.RebuildTid(bat)
+		default:
+			sm.RebuildPITRTid(bat)
 		}
 	}
 	return nil
@@ -758,7 +963,19 @@ func (sm *SnapshotMeta) GetSnapshotList(SnapshotList map[uint32][]types.TS, tid
 	return SnapshotList[accID]
 }
 
-func (sm *SnapshotMeta) MergeTableInfo(SnapshotList map[uint32][]types.TS) error {
+// GetAccountId returns the account of the table, false if the table is unknown
+func (sm *SnapshotMeta) GetAccountId(tid uint64) (uint32, bool) {
+	sm.RLock()
+	defer sm.RUnlock()
+	if sm.acctIndexes[tid] == nil {
+		return 0, false
+	}
+	return sm.acctIndexes[tid].accID, true
+}
+
+// MergeTableInfo removes the tables dropped, unless a snapshot or a PITR
+// refers them
+func (sm *SnapshotMeta) MergeTableInfo(SnapshotList map[uint32][]types.TS, pitrs []PITRRange) error {
 	sm.Lock()
 	defer sm.Unlock()
 	if len(sm.tables) == 0 {
@@ -767,7 +984,7 @@ func (sm *SnapshotMeta) MergeTableInfo(SnapshotList map[uint32][]types.TS) error
 	for accID, tables := range sm.tables {
 		if SnapshotList[accID] == nil {
 			for _, table := range tables {
-				if !table.deleteAt.IsEmpty() {
+				if !table.deleteAt.IsEmpty() && !isPITRRefers(table, pitrs) {
 					logutil.Infof("MergeTableInfo delete table %d", table.tid)
 					delete(sm.tables[accID], table.tid)
 					delete(sm.acctIndexes, table.tid)
@@ -779,7 +996,8 @@ func (sm *SnapshotMeta) MergeTableInfo(SnapshotList map[uint32][]types.TS) error
 			continue
 		}
 		for _, table := range tables {
-			if !table.deleteAt.IsEmpty() && !isSnapshotRefers(table, SnapshotList[accID]) {
+			if !table.deleteAt.IsEmpty() && !isSnapshotRefers(table, SnapshotList[accID]) &&
+				!isPITRRefers(table, pitrs) {
 				logutil.Infof("MergeTableInfo delete table %d", table.tid)
 				delete(sm.tables[accID], table.tid)
 				delete(sm.acctIndexes, table.tid)
@@ -820,6 +1038,17 @@ func isSnapshotRefers(table *TableInfo, snapVec []types.TS) bool {
 	return false
 }
 
+// isPITRRefers tells whether a PITR covers the table dropped in its range
+func isPITRRefers(table *TableInfo, pitrs []PITRRange) bool {
+	for i := range pitrs {
+		start := types.BuildTS(pitrs[i].Start, 0)
+		if table.deleteAt.GreaterEq(&start) && pitrs[i].Covers(table.accID, table.dbID, table.tid) {
+			return true
+		}
+	}
+	return false
+}
+
 func CloseSnapshotList(snapshots map[uint32]containers.Vector) {
 	for _, snapshot := range snapshots {
 		snapshot.Close()
@@ -0,0 +1,59 @@
+// Copyright 2024 Matrix Origin
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logtail
+
+import (
+	"testing"
+	"time"
+
+	"github.com/matrixorigin/matrixone/pkg/container/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPITRStart(t *testing.T) {
+	now := time.Now().UTC()
+	start, err := pitrStart(now, 2, "h")
+	require.NoError(t, err)
+	require.Equal(t, now.Add(-2*time.Hour), start)
+	start, err = pitrStart(now, 1, "d")
+	require.NoError(t, err)
+	require.Equal(t, now.AddDate(0, 0, -1), start)
+	start, err = pitrStart(now, 3, "mo")
+	require.NoError(t, err)
+	require.Equal(t, now.AddDate(0, -3, 0), start)
+	start, err = pitrStart(now, 1, "y")
+	require.NoError(t, err)
+	require.Equal(t, now.AddDate(-1, 0, 0), start)
+
+	_, err = pitrStart(now, 1, "w")
+	require.Error(t, err)
+}
+
+func TestPITRRefersTable(t *testing.T) {
+	now := time.Now().UTC()
+	pitrs := []PITRRange{
+		{Name: "p1", Level: PITRLevelAccount, Account: 1, Start: now.Add(-time.Hour).UnixNano()},
+		{Name: "p2", Level: PITRLevelTable, ObjID: 272515, Start: now.Add(-time.Hour).UnixNano()},
+	}
+	dropped := types.BuildTS(now.UnixNano(), 0)
+	droppedBefore := types.BuildTS(now.Add(-2*time.Hour).UnixNano(), 0)
+
+	require.True(t, isPITRRefers(&TableInfo{accID: 1, tid: 1000, deleteAt: dropped}, pitrs))
+	require.True(t, isPITRRefers(&TableInfo{accID: 2, tid: 272515, deleteAt: dropped}, pitrs))
+	require.False(t, isPITRRefers(&TableInfo{accID: 2, tid: 1000, deleteAt: dropped}, pitrs))
+	require.False(t, isPITRRefers(&TableInfo{accID: 1, tid: 1000, deleteAt: droppedBefore}, pitrs))
+	require.True(t, (&PITRRange{Level: PITRLevelCluster}).Covers(3, 4, 5))
+	require.True(t, (&PITRRange{Level: PITRLevelDatabase, ObjID: 4}).Covers(3, 4, 5))
+}
@@ -87,12 +87,14 @@ func GetNewWriter(
 	fs fileservice.FileService,
 	ver uint32, seqnums []uint16,
 	sortkeyPos int, sortkeyIsPK bool,
+	compressAlg uint8,
 ) *blockio.BlockWriter {
 	name := objectio.BuildObjectNameWithObjectID(objectio.NewObjectid())
 	writer, err := blockio.NewBlockWriterNew(fs, name, ver, seqnums)
 	if err != nil {
 		panic(err) // it is impossible
 	}
+	writer.SetCompressAlg(compressAlg)
 	// has sortkey
 	if sortkeyPos >= 0 {
 		if sortkeyIsPK {
@@ -169,6 +171,42 @@ func DoMergeAndWrite(
 	return nil
 }
 
+// AddExpiredRows records in the commit entry the rows of the block dropped
+// by the merge because they expired by the table TTL. The deletes on these
+// rows committed during the merge have no transfer mapping and are skipped.
+func AddExpiredRows(entry *api.MergeCommitEntry, blkID *types.Blockid, rows *nulls.Nulls) error {
+	if rows.IsEmpty() {
+		return nil
+	}
+	data, err := rows.Show()
+	if err != nil {
+		return err
+	}
+	entry.ExpiredRows = append(entry.ExpiredRows, api.ExpiredRows{
+		BlkId: blkID[:],
+		Rows:  data,
+	})
+	return nil
+}
+
+// GetExpiredRows returns the rows recorded by AddExpiredRows by block.
+func GetExpiredRows(entry *api.MergeCommitEntry) (map[types.Blockid]*nulls.Nulls, error) {
+	if len(entry.ExpiredRows) == 0 {
+		return nil, nil
+	}
+	ret := make(map[types.Blockid]*nulls.Nulls, len(entry.ExpiredRows))
+	for _, expired := range entry.ExpiredRows {
+		rows := nulls.NewWithSize(0)
+		if err := rows.Read(expired.Rows); err != nil {
+			return nil, err
+		}
+		var blkID types.Blockid
+		copy(blkID[:], expired.BlkId)
+		ret[blkID] = rows
+	}
+	return ret, nil
+}
+
 // not defined in api.go to avoid import cycle
 
 func CleanTransMapping(b api.TransferMaps) {
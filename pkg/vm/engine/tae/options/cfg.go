@@ -61,6 +61,23 @@ type GCCfg struct {
 	ScanGCInterval time.Duration `toml:"scan-gc-interval"`
 	DisableGC      bool          `toml:"disable-gc"`
 	CheckGC        bool          `toml:"check-gc"`
+	// ScrubInterval is the interval of the object scrub task, 0 disables it
+	ScrubInterval time.Duration `toml:"scrub-interval"`
+}
+
+type WalCfg struct {
+	// Compression is the compression algorithm of the WAL records appended
+	// into logservice, one of "none", "lz4" and "zstd".
+	Compression string `toml:"compression"`
+	// CompressionMinSize is the min size of the WAL records to compress.
+	CompressionMinSize int `toml:"compression-min-size"`
+	// GroupCommitLatency is the max duration a WAL entry waits to be appended
+	// with the concurrent ones, 0 disables group commit.
+	GroupCommitLatency time.Duration `toml:"group-commit-latency"`
+	// GroupCommitSize is the size of the waiting WAL entries to stop waiting.
+	GroupCommitSize int `toml:"group-commit-size"`
+	// TailInterval is the interval a hot-standby tails the WAL.
+	TailInterval time.Duration `toml:"tail-interval"`
 }
 
 type CatalogCfg struct {
@@ -202,6 +202,19 @@ func (o *Options) FillDefaults(dirname string) *Options {
 		o.GCCfg.ScanGCInterval = DefaultScanGCInterval
 	}
 
+	if o.WalCfg == nil {
+		o.WalCfg = new(WalCfg)
+	}
+	if o.WalCfg.Compression == "" {
+		o.WalCfg.Compression = DefaultWalCompression
+	}
+	if o.WalCfg.CompressionMinSize <= 0 {
+		o.WalCfg.CompressionMinSize = DefaultWalCompressionMinSize
+	}
+	if o.WalCfg.TailInterval <= 0 {
+		o.WalCfg.TailInterval = DefaultWalTailInterval
+	}
+
 	if o.SchedulerCfg == nil {
 		ioworkers := DefaultIOWorkers
 		procs := runtime.GOMAXPROCS(0)
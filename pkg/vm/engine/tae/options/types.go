@@ -49,6 +49,10 @@ const (
 	DefaultScanGCInterval = time.Minute * 30
 	DefaultGCTTL          = time.Hour
 
+	DefaultWalCompression        = "none"
+	DefaultWalCompressionMinSize = 4 * 1024
+	DefaultWalTailInterval       = time.Millisecond * 100
+
 	DefaultCatalogGCInterval = time.Minute * 30
 
 	DefaultIOWorkers    = int(16)
@@ -71,6 +75,7 @@ type Options struct {
 	CheckpointCfg *CheckpointCfg `toml:"checkpoint-cfg"`
 	SchedulerCfg  *SchedulerCfg  `toml:"scheduler-cfg"`
 	GCCfg         *GCCfg         `toml:"gc-cfg"`
+	WalCfg        *WalCfg        `toml:"wal-cfg"`
 	LogtailCfg    *LogtailCfg
 	MergeCfg      *MergeConfig
 	CatalogCfg    *CatalogCfg
@@ -90,4 +95,9 @@ type Options struct {
 	Clock             clock.Clock                              `toml:"-"`
 	TaskServiceGetter taskservice.Getter                       `toml:"-"`
 	SID               string                                   `toml:"-"`
+
+	// StandbyLc creates the read-only log clients of a hot-standby. If it's
+	// set, the db tails the WAL after opened, and writes nothing until it's
+	// promoted.
+	StandbyLc logservicedriver.LogServiceClientFactory `toml:"-"`
 }
@@ -229,7 +229,7 @@ func (h *Handle) HandleDiskCleaner(
 	ctx context.Context,
 	meta txn.TxnMeta,
 	req *db.DiskCleaner,
-	resp *api.SyncLogTailResp) (cb func(), err error) {
+	resp *db.DiskCleanerResp) (cb func(), err error) {
 
 	op := req.Op
 	key := req.Key
@@ -237,6 +237,14 @@ func (h *Handle) HandleDiskCleaner(
 	if op == gc.RemoveChecker {
 		return nil, h.db.DiskCleaner.GetCleaner().RemoveChecker(key)
 	}
+	if op == gc.DryRun {
+		var report *gc.DryRunReport
+		if report, err = h.db.DiskCleaner.GetCleaner().DryRunGC(ctx); err != nil {
+			return nil, err
+		}
+		resp.Summary = report.Summary()
+		return
+	}
 	switch key {
 	case gc.CheckerKeyTTL:
 		// Set a ttl, checkpoints whose endTS is less than this ttl can be consumed
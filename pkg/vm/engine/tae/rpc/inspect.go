@@ -766,6 +766,10 @@ type mergePolicyArg struct {
 	maxOsizeObject    int32
 	cnMinMergeSize    int32
 	hints             []api.MergeHint
+	policy            string
+	sizeRatio         uint32
+	timeColumn        string
+	timeWindow        time.Duration
 
 	disableDeltaLocMerge bool
 }
@@ -783,6 +787,10 @@ func (c *mergePolicyArg) PrepareCommand() *cobra.Command {
 	policyCmd.Flags().Int32P("minCNMergeSize", "c", common.DefaultMinCNMergeSize, "Merge task whose memory occupation exceeds minCNMergeSize(MB) will be moved to CN")
 	policyCmd.Flags().Int32SliceP("mergeHints", "n", []int32{0}, "hints to merge the table")
 	policyCmd.Flags().BoolP("disableDeltaLocMerge", "d", merge.DisableDeltaLocMerge.Load(), "enable merging based on delta location")
+	policyCmd.Flags().StringP("policy", "p", merge.PolicyBasic, "merge policy of the table: basic, tiered or timewindow")
+	policyCmd.Flags().Uint32P("sizeRatio", "s", 4, "size ratio between tiers of the tiered policy")
+	policyCmd.Flags().StringP("timeColumn", "w", "", "column to bucket objects by for the timewindow policy")
+	policyCmd.Flags().DurationP("timeWindow", "b", 24*time.Hour, "bucket width of the timewindow policy")
 	return policyCmd
 }
 
@@ -809,6 +817,38 @@ func (c *mergePolicyArg) FromCommand(cmd *cobra.Command) (err error) {
 		}
 		c.hints = append(c.hints, api.MergeHint(h))
 	}
+	c.policy, _ = cmd.Flags().GetString("policy")
+	c.sizeRatio, _ = cmd.Flags().GetUint32("sizeRatio")
+	c.timeColumn, _ = cmd.Flags().GetString("timeColumn")
+	c.timeWindow, _ = cmd.Flags().GetDuration("timeWindow")
+	return c.checkPolicy()
+}
+
+func (c *mergePolicyArg) checkPolicy() error {
+	if !merge.IsRegisteredPolicy(c.policy) {
+		return moerr.NewInvalidArgNoCtx("merge policy", c.policy)
+	}
+	if c.policy != merge.PolicyBasic && c.tbl == nil {
+		return moerr.NewInvalidInputNoCtx("merge policy %s should be set for a table", c.policy)
+	}
+	switch c.policy {
+	case merge.PolicyTiered:
+		if c.sizeRatio < 2 {
+			return moerr.NewInvalidInputNoCtx("sizeRatio should be at least 2")
+		}
+	case merge.PolicyTimeWindow:
+		if c.timeWindow < time.Second {
+			return moerr.NewInvalidInputNoCtx("timeWindow should be at least 1s")
+		}
+		schema := c.tbl.GetLastestSchema()
+		idx := schema.GetColIdx(strings.ToLower(c.timeColumn))
+		if idx < 0 {
+			return moerr.NewInvalidInputNoCtx("column %q not found in %s", c.timeColumn, schema.Name)
+		}
+		if typ := schema.ColDefs[idx].Type; !merge.IsTimeWindowColumnType(typ.Oid) {
+			return moerr.NewInvalidInputNoCtx("column %q of type %s can not bucket time windows", c.timeColumn, typ.String())
+		}
+	}
 	return nil
 }
 
@@ -818,8 +858,8 @@ func (c *mergePolicyArg) String() string {
 		t = fmt.Sprintf("%d-%s", c.tbl.ID, c.tbl.GetLastestSchemaLocked().Name)
 	}
 	return fmt.Sprintf(
-		"(%s) maxMergeObjN: %v, maxOsizeObj: %vMB, minOsizeQualified: %vMB, offloadToCnSize: %vMB, hints: %v",
-		t, c.maxMergeObjN, c.maxOsizeObject, c.minOsizeQualified, c.cnMinMergeSize, c.hints,
+		"(%s) maxMergeObjN: %v, maxOsizeObj: %vMB, minOsizeQualified: %vMB, offloadToCnSize: %vMB, hints: %v, policy: %v",
+		t, c.maxMergeObjN, c.maxOsizeObject, c.minOsizeQualified, c.cnMinMergeSize, c.hints, c.policy,
 	)
 }
 
@@ -847,6 +887,10 @@ func (c *mergePolicyArg) Run() error {
 			MaxOsizeMergedObj: maxosize,
 			MinCNMergeSize:    cnsize,
 			MergeHints:        c.hints,
+			Policy:            c.policy,
+			SizeRatio:         c.sizeRatio,
+			TimeColumn:        c.timeColumn,
+			TimeWindow:        c.timeWindow,
 		})
 	}
 	c.ctx.resp.Payload = []byte("<empty>")
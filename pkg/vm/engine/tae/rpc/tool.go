@@ -26,6 +26,7 @@ import (
 	"github.com/matrixorigin/matrixone/pkg/fileservice"
 	"github.com/matrixorigin/matrixone/pkg/objectio"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/checkpoint"
+	gc "github.com/matrixorigin/matrixone/pkg/vm/engine/tae/db/gc/v1"
 	"github.com/matrixorigin/matrixone/pkg/vm/engine/tae/logtail"
 	"github.com/spf13/cobra"
 	"path/filepath"
@@ -140,6 +141,9 @@ func (c *MoInspectArg) PrepareCommand() *cobra.Command {
 	ckp := CheckpointArg{}
 	moInspectCmd.AddCommand(ckp.PrepareCommand())
 
+	scrub := ScrubArg{}
+	moInspectCmd.AddCommand(scrub.PrepareCommand())
+
 	return moInspectCmd
 }
 
@@ -154,6 +158,7 @@ func (c *MoInspectArg) String() string {
 func (c *MoInspectArg) Usage() (res string) {
 	res += "Offline Commands:\n"
 	res += fmt.Sprintf("  %-8v show object information\n", "object")
+	res += fmt.Sprintf("  %-8v verify objects and find dangling and orphan objects\n", "scrub")
 
 	res += "\n"
 	res += "Online Commands:\n"
@@ -1276,3 +1281,88 @@ func (c *ckpListArg) getTableList(ctx context.Context) (res string, err error) {
 
 	return
 }
+
+type ScrubArg struct {
+	ctx      *inspectContext
+	dir      string
+	local    bool
+	gcWindow time.Duration
+	res      string
+}
+
+func (c *ScrubArg) PrepareCommand() *cobra.Command {
+	scrubCmd := &cobra.Command{
+		Use:   "scrub",
+		Short: "scrub objects",
+		Long:  "Verify the checksums of the objects and find the dangling and orphan objects",
+		Run:   RunFactory(c),
+	}
+
+	scrubCmd.SetUsageTemplate(c.Usage())
+
+	scrubCmd.Flags().StringP("dir", "d", "", "dir")
+	scrubCmd.Flags().BoolP("local", "", false, "local")
+	scrubCmd.Flags().DurationP("gc-window", "w", time.Hour, "gc window")
+
+	return scrubCmd
+}
+
+func (c *ScrubArg) FromCommand(cmd *cobra.Command) (err error) {
+	c.dir, _ = cmd.Flags().GetString("dir")
+	c.local, _ = cmd.Flags().GetBool("local")
+	c.gcWindow, _ = cmd.Flags().GetDuration("gc-window")
+	if cmd.Flag("ictx") != nil {
+		c.ctx = cmd.Flag("ictx").Value.(*inspectContext)
+	}
+
+	return nil
+}
+
+func (c *ScrubArg) String() string {
+	return c.res
+}
+
+func (c *ScrubArg) Usage() (res string) {
+	res += "Examples:\n"
+	res += "  # Scrub the objects of the shared storage\n"
+	res += "  inspect scrub -d /your/path/shared\n"
+	res += "\n"
+	res += "  # Scrub the objects downloaded from a standalone machine\n"
+	res += "  inspect scrub -d /your/path/shared --local\n"
+
+	res += "\n"
+	res += "Options:\n"
+	res += "  -d, --dir='':\n"
+	res += "    The dir of the objects and the checkpoints\n"
+	res += "  -w, --gc-window=1h:\n"
+	res += "    Unreferenced objects older than it are reported as orphans\n"
+	res += "  --local=false:\n"
+	res += "    If the files are downloaded from a standalone machine, you should use this flag\n"
+
+	return
+}
+
+func (c *ScrubArg) Run() (err error) {
+	ctx := context.Background()
+	var scrubber *gc.Scrubber
+	if c.ctx != nil {
+		scrubber = c.ctx.db.Scrubber
+	} else {
+		if c.dir == "" {
+			return moerr.NewInfoNoCtx("invalid inputs: dir is required\n")
+		}
+		arg := moObjStatArg{dir: c.dir}
+		if err = arg.initFs(ctx, c.local); err != nil {
+			return moerr.NewInfoNoCtx(fmt.Sprintf("failed to init fs %v", err))
+		}
+		scrubber = gc.NewOfflineScrubber("", objectio.NewObjectFS(arg.fs, ""), c.gcWindow)
+	}
+
+	result, err := scrubber.Scrub(ctx)
+	if err != nil {
+		return
+	}
+	c.res = result.String()
+
+	return
+}
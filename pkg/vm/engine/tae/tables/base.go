@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/RoaringBitmap/roaring"
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/bitmap"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
@@ -673,6 +674,9 @@ func (blk *baseObject) dedupWithLoad(
 		}
 	}
 	defer view.Close()
+	if err = blk.fillExpiredRows(ctx, txn, schema, blkOffset, &view.Deletes, mp); err != nil {
+		return err
+	}
 	var dedupFn any
 	if isAblk {
 		dedupFn = containers.MakeForeachVectorOp(
@@ -687,6 +691,30 @@ func (blk *baseObject) dedupWithLoad(
 	return
 }
 
+// fillExpiredRows adds the rows of a ttl table expired at the snapshot of
+// the txn to deletes. The reads hide them, so the dedup ignores them too.
+func (blk *baseObject) fillExpiredRows(
+	ctx context.Context,
+	txn txnif.TxnReader,
+	schema *catalog.Schema,
+	blkOffset uint16,
+	deletes **nulls.Bitmap,
+	mp *mpool.MPool,
+) error {
+	ttl, colIdx, ok := schema.GetTTL()
+	if !ok {
+		return nil
+	}
+	vec, err := blk.LoadPersistedColumnData(ctx, schema, colIdx, mp, blkOffset)
+	if err != nil {
+		return err
+	}
+	defer vec.Close()
+	now := pkgcatalog.TTLNow(txn.GetStartTS().ToTimestamp())
+	*deletes = ttl.ExpiredRows(vec.GetDownstreamVector(), now, *deletes)
+	return nil
+}
+
 func (blk *baseObject) PersistedBatchDedup(
 	ctx context.Context,
 	txn txnif.TxnReader,
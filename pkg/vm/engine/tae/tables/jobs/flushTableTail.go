@@ -597,6 +597,8 @@ func (task *flushTableTailTask) mergeAObjs(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	writer.SetCompressAlg(schema.GetCompressAlg())
+	writer.SetColumnFilters(schema.GetColumnFilters())
 	if schema.HasPK() {
 		pkIdx := schema.GetSingleSortKeyIdx()
 		writer.SetPrimaryKey(uint16(pkIdx))
@@ -93,6 +93,8 @@ func (task *flushObjTask) Execute(ctx context.Context) (err error) {
 	if task.isAObj {
 		writer.SetAppendable()
 	}
+	writer.SetCompressAlg(task.meta.GetSchema().GetCompressAlg())
+	writer.SetColumnFilters(task.meta.GetSchema().GetColumnFilters())
 	if task.meta.GetSchema().HasPK() {
 		writer.SetPrimaryKey(uint16(task.meta.GetSchema().GetSingleSortKeyIdx()))
 	} else if task.meta.GetSchema().HasSortKey() {
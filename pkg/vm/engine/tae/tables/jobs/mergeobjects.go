@@ -67,6 +67,11 @@ type mergeObjectsTask struct {
 
 	targetObjSize uint32
 
+	// rows expired at the start of the merge txn are dropped
+	ttl    pkgcatalog.TableTTL
+	ttlIdx int
+	hasTTL bool
+
 	createAt time.Time
 }
 
@@ -117,6 +122,7 @@ func NewMergeObjectsTask(
 	}
 	task.schema = task.rel.Schema().(*catalog.Schema)
 	task.doTransfer = !strings.Contains(task.schema.Comment, pkgcatalog.MO_COMMENT_NO_DEL_HINT)
+	task.ttl, task.ttlIdx, task.hasTTL = task.schema.GetTTL()
 	task.idxs = make([]int, 0, len(task.schema.ColDefs)-1)
 	task.attrs = make([]string, 0, len(task.schema.ColDefs)-1)
 	for _, def := range task.schema.ColDefs {
@@ -202,7 +208,8 @@ func (task *mergeObjectsTask) LoadNextBatch(ctx context.Context, objIdx uint32)
 	}()
 
 	obj := task.mergedObjsHandle[objIdx]
-	view, err = obj.GetColumnDataByIds(ctx, uint16(task.nMergedBlk[objIdx]), task.idxs, common.MergeAllocator)
+	blkOffset := uint16(task.nMergedBlk[objIdx])
+	view, err = obj.GetColumnDataByIds(ctx, blkOffset, task.idxs, common.MergeAllocator)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -216,7 +223,24 @@ func (task *mergeObjectsTask) LoadNextBatch(ctx context.Context, objIdx uint32)
 		bat.Vecs[i] = col.GetDownstreamVector()
 	}
 	bat.SetRowCount(view.Vecs[0].Length())
-	return bat, view.Deletes, releaseF, nil
+	deletes := view.Deletes
+	if task.hasTTL {
+		now := pkgcatalog.TTLNow(task.txn.GetStartTS().ToTimestamp())
+		if expired := task.ttl.ExpiredRows(bat.Vecs[task.ttlIdx], now, nil); expired != nil {
+			if task.doTransfer {
+				blkID := objectio.NewBlockidWithObjectID(task.mergedObjs[objIdx].ID(), blkOffset)
+				if err = mergesort.AddExpiredRows(task.GetCommitEntry(), blkID, expired); err != nil {
+					return nil, nil, nil, err
+				}
+			}
+			if deletes == nil {
+				deletes = expired
+			} else {
+				deletes.Or(expired)
+			}
+		}
+	}
+	return bat, deletes, releaseF, nil
 }
 
 func (task *mergeObjectsTask) GetCommitEntry() *api.MergeCommitEntry {
@@ -272,7 +296,9 @@ func (task *mergeObjectsTask) PrepareNewWriter() *blockio.BlockWriter {
 		sortkeyPos = schema.GetSingleSortKeyIdx()
 	}
 
-	return mergesort.GetNewWriter(task.rt.Fs.Service, schema.Version, seqnums, sortkeyPos, sortkeyIsPK)
+	writer := mergesort.GetNewWriter(task.rt.Fs.Service, schema.Version, seqnums, sortkeyPos, sortkeyIsPK, schema.GetCompressAlg())
+	writer.SetColumnFilters(schema.GetColumnFilters())
+	return writer
 }
 
 func (task *mergeObjectsTask) DoTransfer() bool {
@@ -376,6 +402,11 @@ func HandleMergeEntryInTxn(
 		objEntry.SetSorted()
 	}
 
+	expiredRows, err := mergesort.GetExpiredRows(entry)
+	if err != nil {
+		return nil, err
+	}
+
 	txnEntry, err := txnentries.NewMergeObjectsEntry(
 		ctx,
 		txn,
@@ -384,6 +415,7 @@ func HandleMergeEntryInTxn(
 		mergedObjs,
 		createdObjs,
 		booking,
+		expiredRows,
 		rt,
 	)
 	if err != nil {
@@ -18,8 +18,10 @@ import (
 	"context"
 
 	"github.com/RoaringBitmap/roaring"
+	pkgcatalog "github.com/matrixorigin/matrixone/pkg/catalog"
 	"github.com/matrixorigin/matrixone/pkg/common/moerr"
 	"github.com/matrixorigin/matrixone/pkg/common/mpool"
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
@@ -415,10 +417,20 @@ func (node *memoryNode) checkConflictAndDupClosure(
 	dupRow *uint32,
 	rowmask *roaring.Bitmap,
 ) func(row uint32) error {
+	var (
+		expired       *nulls.Bitmap
+		expiredLoaded bool
+	)
 	return func(row uint32) (err error) {
 		if rowmask != nil && rowmask.Contains(row) {
 			return nil
 		}
+		if !expiredLoaded {
+			expired, expiredLoaded = node.expiredRows(txn), true
+		}
+		if expired.Contains(uint64(row)) {
+			return nil
+		}
 		appendnode := node.object.appendMVCC.GetAppendNodeByRow(row)
 		var visible bool
 		if visible, err = node.checkConflictAandVisibility(
@@ -459,6 +471,17 @@ func (node *memoryNode) checkConflictAndDupClosure(
 	}
 }
 
+// expiredRows returns the rows of a ttl table expired at the snapshot of
+// the txn, which the dedup ignores like the reads do.
+func (node *memoryNode) expiredRows(txn txnif.TxnReader) *nulls.Bitmap {
+	ttl, colIdx, ok := node.writeSchema.GetTTL()
+	if !ok {
+		return nil
+	}
+	now := pkgcatalog.TTLNow(txn.GetStartTS().ToTimestamp())
+	return ttl.ExpiredRows(node.mustData().Vecs[colIdx].GetDownstreamVector(), now, nil)
+}
+
 func (node *memoryNode) checkConflictAandVisibility(
 	n txnif.BaseMVCCNode,
 	isCommitting bool,
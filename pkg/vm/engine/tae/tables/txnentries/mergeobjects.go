@@ -21,6 +21,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/matrixorigin/matrixone/pkg/container/nulls"
 	"github.com/matrixorigin/matrixone/pkg/container/types"
 	"github.com/matrixorigin/matrixone/pkg/container/vector"
 	"github.com/matrixorigin/matrixone/pkg/logutil"
@@ -45,6 +46,9 @@ type mergeObjectsEntry struct {
 	createdObjs   []*catalog.ObjectEntry
 	transMappings api.TransferMaps
 	skipTransfer  bool
+	// the rows dropped by the merge because they expired by the table TTL,
+	// which have no transfer mapping
+	expiredRows map[types.Blockid]*nulls.Nulls
 
 	rt                   *dbutils.Runtime
 	pageIds              []*common.ID
@@ -61,6 +65,7 @@ func NewMergeObjectsEntry(
 	relation handle.Relation,
 	droppedObjs, createdObjs []*catalog.ObjectEntry,
 	transMappings api.TransferMaps,
+	expiredRows map[types.Blockid]*nulls.Nulls,
 	rt *dbutils.Runtime,
 ) (*mergeObjectsEntry, error) {
 	totalCreatedBlkCnt := 0
@@ -75,6 +80,7 @@ func NewMergeObjectsEntry(
 		droppedObjs:   droppedObjs,
 		transMappings: transMappings,
 		skipTransfer:  transMappings == nil,
+		expiredRows:   expiredRows,
 		rt:            rt,
 		taskName:      taskName,
 	}
@@ -242,6 +248,11 @@ func (entry *mergeObjectsEntry) transferObjectDeletes(
 			continue
 		}
 		destpos, ok := mapping[row]
+		if !ok && entry.expiredRows[*rowid[i].BorrowBlockID()].Contains(uint64(row)) {
+			// the row was expired and dropped by the merge, the delete has
+			// nothing to apply on
+			continue
+		}
 		if !ok {
 			_min, _max := uint32(math.MaxUint32), uint32(0)
 			for k := range mapping {
@@ -40,9 +40,9 @@ type walDriver struct {
 	wg            sync.WaitGroup
 }
 
-func NewDriverWithLogservice(ctx context.Context, factory logservicedriver.LogServiceClientFactory) Driver {
+func NewDriverWithLogservice(ctx context.Context, cfg *logservicedriver.Config) Driver {
 	ckpDuration := time.Second * 5
-	impl := store.NewStoreWithLogserviceDriver(factory)
+	impl := store.NewStoreWithLogserviceDriver(cfg)
 	driver := NewDriverWithStore(ctx, impl, true, ckpDuration)
 	return driver
 }
@@ -86,6 +86,10 @@ func (driver *walDriver) Replay(handle store.ApplyHandle) error {
 	return driver.impl.Replay(driver.replayhandle(handle))
 }
 
+func (driver *walDriver) Tail(handle store.ApplyHandle) error {
+	return driver.impl.Tail(driver.replayhandle(handle))
+}
+
 func (driver *walDriver) GetPenddingCnt() uint64 {
 	return driver.impl.GetPendding(GroupPrepare)
 }
@@ -39,6 +39,7 @@ type Driver interface {
 	GetCurrSeqNum() uint64
 	GetPenddingCnt() uint64
 	Replay(handle store.ApplyHandle) error
+	Tail(handle store.ApplyHandle) error
 	Start()
 	Close() error
 }